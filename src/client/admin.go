@@ -21,7 +21,16 @@ func (c APIClient) InspectCluster() (*admin.ClusterInfo, error) {
 
 // Extract all cluster state, call f with each operation.
 func (c APIClient) Extract(objects bool, f func(op *admin.Op) error) error {
-	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), &admin.ExtractRequest{NoObjects: !objects})
+	return c.ExtractWithRequest(&admin.ExtractRequest{NoObjects: !objects}, f)
+}
+
+// ExtractWithRequest extracts the cluster state selected by 'request' (see
+// admin.ExtractRequest), calling f with each operation. The last operation is
+// the extract's BackupMarker, which can be passed to a later extract as
+// 'since'. If request.URL is set, the operations are written to that URL, and
+// f is only called with the marker, if request.Marker is set.
+func (c APIClient) ExtractWithRequest(request *admin.ExtractRequest, f func(op *admin.Op) error) error {
+	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), request)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
@@ -62,18 +71,9 @@ func (c APIClient) ExtractWriter(objects bool, w io.Writer) error {
 
 // ExtractURL extracts all cluster state and marshalls it to object storage.
 func (c APIClient) ExtractURL(url string) error {
-	extractClient, err := c.AdminAPIClient.Extract(c.Ctx(), &admin.ExtractRequest{URL: url})
-	if err != nil {
-		return grpcutil.ScrubGRPC(err)
-	}
-	resp, err := extractClient.Recv()
-	if err == nil {
-		return fmt.Errorf("unexpected response from extract: %v", resp)
-	}
-	if err != io.EOF {
-		return err
-	}
-	return nil
+	return c.ExtractWithRequest(&admin.ExtractRequest{URL: url}, func(op *admin.Op) error {
+		return fmt.Errorf("unexpected response from extract: %v", op)
+	})
 }
 
 // ExtractPipeline extracts a single pipeline.
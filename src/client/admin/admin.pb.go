@@ -10,6 +10,7 @@
 	It has these top-level messages:
 		Op1_7
 		Op
+		BackupMarker
 		ExtractRequest
 		ExtractPipelineRequest
 		RestoreRequest
@@ -21,7 +22,10 @@ import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import google_protobuf "github.com/gogo/protobuf/types"
+import google_protobuf1 "github.com/gogo/protobuf/types"
 import _ "github.com/gogo/protobuf/gogoproto"
+import auth "github.com/pachyderm/pachyderm/src/client/auth"
+import enterprise "github.com/pachyderm/pachyderm/src/client/enterprise"
 import pfs "github.com/pachyderm/pachyderm/src/client/pfs"
 import pps "github.com/pachyderm/pachyderm/src/client/pps"
 
@@ -48,6 +52,12 @@ type Op1_7 struct {
 	Commit   *pfs.BuildCommitRequest    `protobuf:"bytes,5,opt,name=commit" json:"commit,omitempty"`
 	Branch   *pfs.CreateBranchRequest   `protobuf:"bytes,6,opt,name=branch" json:"branch,omitempty"`
 	Pipeline *pps.CreatePipelineRequest `protobuf:"bytes,7,opt,name=pipeline" json:"pipeline,omitempty"`
+	// marker is the last op of every complete extract. It's ignored by Restore.
+	Marker     *BackupMarker               `protobuf:"bytes,8,opt,name=marker" json:"marker,omitempty"`
+	Enterprise *enterprise.ActivateRequest `protobuf:"bytes,9,opt,name=enterprise" json:"enterprise,omitempty"`
+	Admins     *auth.ModifyAdminsRequest   `protobuf:"bytes,10,opt,name=admins" json:"admins,omitempty"`
+	Group      *auth.ModifyMembersRequest  `protobuf:"bytes,11,opt,name=group" json:"group,omitempty"`
+	Acl        *auth.SetACLRequest         `protobuf:"bytes,12,opt,name=acl" json:"acl,omitempty"`
 }
 
 func (m *Op1_7) Reset()                    { *m = Op1_7{} }
@@ -97,6 +107,41 @@ func (m *Op1_7) GetPipeline() *pps.CreatePipelineRequest {
 	return nil
 }
 
+func (m *Op1_7) GetMarker() *BackupMarker {
+	if m != nil {
+		return m.Marker
+	}
+	return nil
+}
+
+func (m *Op1_7) GetEnterprise() *enterprise.ActivateRequest {
+	if m != nil {
+		return m.Enterprise
+	}
+	return nil
+}
+
+func (m *Op1_7) GetAdmins() *auth.ModifyAdminsRequest {
+	if m != nil {
+		return m.Admins
+	}
+	return nil
+}
+
+func (m *Op1_7) GetGroup() *auth.ModifyMembersRequest {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (m *Op1_7) GetAcl() *auth.SetACLRequest {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
 type Op struct {
 	Op1_7 *Op1_7 `protobuf:"bytes,1,opt,name=op1_7,json=op17" json:"op1_7,omitempty"`
 }
@@ -113,6 +158,34 @@ func (m *Op) GetOp1_7() *Op1_7 {
 	return nil
 }
 
+// BackupMarker identifies the point in a cluster's history at which an
+// extract was taken. Passing it to a later extract as 'since' makes that
+// extract incremental.
+type BackupMarker struct {
+	ClusterID string `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"cluster_id,omitempty"`
+	// time is when the extract started
+	Time *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=time" json:"time,omitempty"`
+}
+
+func (m *BackupMarker) Reset()                    { *m = BackupMarker{} }
+func (m *BackupMarker) String() string            { return proto.CompactTextString(m) }
+func (*BackupMarker) ProtoMessage()               {}
+func (*BackupMarker) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{2} }
+
+func (m *BackupMarker) GetClusterID() string {
+	if m != nil {
+		return m.ClusterID
+	}
+	return ""
+}
+
+func (m *BackupMarker) GetTime() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
 type ExtractRequest struct {
 	// URL is an object storage URL, if it's not "" data will be extracted to
 	// this URL rather than returned.
@@ -123,12 +196,34 @@ type ExtractRequest struct {
 	NoRepos bool `protobuf:"varint,3,opt,name=no_repos,json=noRepos,proto3" json:"no_repos,omitempty"`
 	// NoPipelines, if true, will cause extract to omit pipelines.
 	NoPipelines bool `protobuf:"varint,4,opt,name=no_pipelines,json=noPipelines,proto3" json:"no_pipelines,omitempty"`
+	// Repos, if set, limits the extract to these repos (and their commits,
+	// branches and ACLs, and the objects and tags that their commits
+	// reference). Only pipelines in Pipelines are extracted.
+	Repos []string `protobuf:"bytes,5,rep,name=repos" json:"repos,omitempty"`
+	// Pipelines, if set, limits the extract to these pipelines. Only repos in
+	// Repos are extracted.
+	Pipelines []string `protobuf:"bytes,6,rep,name=pipelines" json:"pipelines,omitempty"`
+	// Since, if set, makes the extract incremental: it only includes the
+	// commits, pipelines, objects and tags that were created after the extract
+	// that returned Since. Since must come from the same cluster.
+	Since *BackupMarker `protobuf:"bytes,7,opt,name=since" json:"since,omitempty"`
+	// NoAuth, if true, will cause extract to omit admins, groups and ACLs.
+	NoAuth bool `protobuf:"varint,8,opt,name=no_auth,json=noAuth,proto3" json:"no_auth,omitempty"`
+	// Marker, if true, makes an extract to URL also return its BackupMarker
+	// (which is always written to URL, and always ends extracts that aren't
+	// written to a URL). Clients that predate backup markers expect no
+	// response from an extract to URL, so it isn't returned by default.
+	Marker bool `protobuf:"varint,10,opt,name=marker,proto3" json:"marker,omitempty"`
+	// Enterprise, if true, will cause extract to include the cluster's
+	// enterprise activation code. It's a secret, so it's only included on
+	// request.
+	Enterprise bool `protobuf:"varint,11,opt,name=enterprise,proto3" json:"enterprise,omitempty"`
 }
 
 func (m *ExtractRequest) Reset()                    { *m = ExtractRequest{} }
 func (m *ExtractRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExtractRequest) ProtoMessage()               {}
-func (*ExtractRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{2} }
+func (*ExtractRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{3} }
 
 func (m *ExtractRequest) GetURL() string {
 	if m != nil {
@@ -158,6 +253,48 @@ func (m *ExtractRequest) GetNoPipelines() bool {
 	return false
 }
 
+func (m *ExtractRequest) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetPipelines() []string {
+	if m != nil {
+		return m.Pipelines
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetSince() *BackupMarker {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *ExtractRequest) GetNoAuth() bool {
+	if m != nil {
+		return m.NoAuth
+	}
+	return false
+}
+
+func (m *ExtractRequest) GetMarker() bool {
+	if m != nil {
+		return m.Marker
+	}
+	return false
+}
+
+func (m *ExtractRequest) GetEnterprise() bool {
+	if m != nil {
+		return m.Enterprise
+	}
+	return false
+}
+
 type ExtractPipelineRequest struct {
 	Pipeline *pps.Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 }
@@ -165,7 +302,7 @@ type ExtractPipelineRequest struct {
 func (m *ExtractPipelineRequest) Reset()                    { *m = ExtractPipelineRequest{} }
 func (m *ExtractPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*ExtractPipelineRequest) ProtoMessage()               {}
-func (*ExtractPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{3} }
+func (*ExtractPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{4} }
 
 func (m *ExtractPipelineRequest) GetPipeline() *pps.Pipeline {
 	if m != nil {
@@ -184,7 +321,7 @@ type RestoreRequest struct {
 func (m *RestoreRequest) Reset()                    { *m = RestoreRequest{} }
 func (m *RestoreRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestoreRequest) ProtoMessage()               {}
-func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{4} }
+func (*RestoreRequest) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{5} }
 
 func (m *RestoreRequest) GetOp() *Op {
 	if m != nil {
@@ -207,7 +344,7 @@ type ClusterInfo struct {
 func (m *ClusterInfo) Reset()                    { *m = ClusterInfo{} }
 func (m *ClusterInfo) String() string            { return proto.CompactTextString(m) }
 func (*ClusterInfo) ProtoMessage()               {}
-func (*ClusterInfo) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{5} }
+func (*ClusterInfo) Descriptor() ([]byte, []int) { return fileDescriptorAdmin, []int{6} }
 
 func (m *ClusterInfo) GetID() string {
 	if m != nil {
@@ -219,6 +356,7 @@ func (m *ClusterInfo) GetID() string {
 func init() {
 	proto.RegisterType((*Op1_7)(nil), "admin.Op1_7")
 	proto.RegisterType((*Op)(nil), "admin.Op")
+	proto.RegisterType((*BackupMarker)(nil), "admin.BackupMarker")
 	proto.RegisterType((*ExtractRequest)(nil), "admin.ExtractRequest")
 	proto.RegisterType((*ExtractPipelineRequest)(nil), "admin.ExtractPipelineRequest")
 	proto.RegisterType((*RestoreRequest)(nil), "admin.RestoreRequest")
@@ -533,6 +671,56 @@ func (m *Op1_7) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n6
 	}
+	if m.Marker != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Marker.Size()))
+		n7, err := m.Marker.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n7
+	}
+	if m.Enterprise != nil {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Enterprise.Size()))
+		n8, err := m.Enterprise.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	if m.Admins != nil {
+		dAtA[i] = 0x52
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Admins.Size()))
+		n9, err := m.Admins.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	if m.Group != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Group.Size()))
+		n10, err := m.Group.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.Acl != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Acl.Size()))
+		n11, err := m.Acl.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
 	return i, nil
 }
 
@@ -555,11 +743,45 @@ func (m *Op) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintAdmin(dAtA, i, uint64(m.Op1_7.Size()))
-		n7, err := m.Op1_7.MarshalTo(dAtA[i:])
+		n12, err := m.Op1_7.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n7
+		i += n12
+	}
+	return i, nil
+}
+
+func (m *BackupMarker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupMarker) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClusterID) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(len(m.ClusterID)))
+		i += copy(dAtA[i:], m.ClusterID)
+	}
+	if m.Time != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Time.Size()))
+		n13, err := m.Time.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n13
 	}
 	return i, nil
 }
@@ -615,6 +837,76 @@ func (m *ExtractRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			dAtA[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			dAtA[i] = 0x32
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
+	if m.Since != nil {
+		dAtA[i] = 0x3a
+		i++
+		i = encodeVarintAdmin(dAtA, i, uint64(m.Since.Size()))
+		n14, err := m.Since.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n14
+	}
+	if m.NoAuth {
+		dAtA[i] = 0x40
+		i++
+		if m.NoAuth {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Marker {
+		dAtA[i] = 0x50
+		i++
+		if m.Marker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Enterprise {
+		dAtA[i] = 0x58
+		i++
+		if m.Enterprise {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -637,11 +929,11 @@ func (m *ExtractPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintAdmin(dAtA, i, uint64(m.Pipeline.Size()))
-		n8, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n15, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n15
 	}
 	return i, nil
 }
@@ -665,11 +957,11 @@ func (m *RestoreRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintAdmin(dAtA, i, uint64(m.Op.Size()))
-		n9, err := m.Op.MarshalTo(dAtA[i:])
+		n16, err := m.Op.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n16
 	}
 	if len(m.URL) > 0 {
 		dAtA[i] = 0x12
@@ -740,6 +1032,26 @@ func (m *Op1_7) Size() (n int) {
 		l = m.Pipeline.Size()
 		n += 1 + l + sovAdmin(uint64(l))
 	}
+	if m.Marker != nil {
+		l = m.Marker.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Enterprise != nil {
+		l = m.Enterprise.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Admins != nil {
+		l = m.Admins.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Group != nil {
+		l = m.Group.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Acl != nil {
+		l = m.Acl.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
 	return n
 }
 
@@ -753,6 +1065,20 @@ func (m *Op) Size() (n int) {
 	return n
 }
 
+func (m *BackupMarker) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ClusterID)
+	if l > 0 {
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.Time != nil {
+		l = m.Time.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	return n
+}
+
 func (m *ExtractRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -769,6 +1095,31 @@ func (m *ExtractRequest) Size() (n int) {
 	if m.NoPipelines {
 		n += 2
 	}
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if len(m.Pipelines) > 0 {
+		for _, s := range m.Pipelines {
+			l = len(s)
+			n += 1 + l + sovAdmin(uint64(l))
+		}
+	}
+	if m.Since != nil {
+		l = m.Since.Size()
+		n += 1 + l + sovAdmin(uint64(l))
+	}
+	if m.NoAuth {
+		n += 2
+	}
+	if m.Marker {
+		n += 2
+	}
+	if m.Enterprise {
+		n += 2
+	}
 	return n
 }
 
@@ -1046,59 +1397,42 @@ func (m *Op1_7) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAdmin(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthAdmin
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Op) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAdmin
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Marker == nil {
+				m.Marker = &BackupMarker{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Marker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Op: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Op1_7", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Enterprise", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1122,10 +1456,304 @@ func (m *Op) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Op1_7 == nil {
-				m.Op1_7 = &Op1_7{}
+			if m.Enterprise == nil {
+				m.Enterprise = &enterprise.ActivateRequest{}
 			}
-			if err := m.Op1_7.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Enterprise.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Admins == nil {
+				m.Admins = &auth.ModifyAdminsRequest{}
+			}
+			if err := m.Admins.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Group == nil {
+				m.Group = &auth.ModifyMembersRequest{}
+			}
+			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Acl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Acl == nil {
+				m.Acl = &auth.SetACLRequest{}
+			}
+			if err := m.Acl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Op) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Op: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Op: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op1_7", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Op1_7 == nil {
+				m.Op1_7 = &Op1_7{}
+			}
+			if err := m.Op1_7.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAdmin(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupMarker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAdmin
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupMarker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupMarker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1268,6 +1896,157 @@ func (m *ExtractRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.NoPipelines = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipelines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pipelines = append(m.Pipelines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAdmin
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &BackupMarker{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoAuth", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoAuth = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Marker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Marker = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enterprise", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAdmin
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enterprise = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAdmin(dAtA[iNdEx:])
@@ -1671,40 +2450,57 @@ var (
 func init() { proto.RegisterFile("client/admin/admin.proto", fileDescriptorAdmin) }
 
 var fileDescriptorAdmin = []byte{
-	// 558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x93, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x86, 0x9b, 0x74, 0x4d, 0xdb, 0xd3, 0x51, 0x26, 0x8b, 0x95, 0x2c, 0x68, 0x85, 0x45, 0x42,
-	0x1b, 0x48, 0x24, 0xdb, 0x90, 0xd8, 0x0d, 0x43, 0xa2, 0x65, 0x17, 0x95, 0x90, 0x5a, 0x59, 0x70,
-	0x5d, 0xa5, 0xad, 0x1b, 0x82, 0x5a, 0x1f, 0x13, 0xbb, 0x12, 0x5c, 0xf1, 0x1a, 0x3c, 0x01, 0xcf,
-	0xc2, 0x25, 0x4f, 0x80, 0x50, 0x79, 0x04, 0x5e, 0x00, 0xc5, 0x4e, 0xb2, 0xb6, 0x12, 0x17, 0xae,
-	0xdc, 0xe3, 0xef, 0x3f, 0x3e, 0xff, 0x1f, 0x19, 0xdc, 0xe9, 0x22, 0x61, 0x5c, 0x85, 0xd1, 0x6c,
-	0x99, 0x70, 0xf3, 0x1b, 0x88, 0x14, 0x15, 0x92, 0x9a, 0xfe, 0xe3, 0x3d, 0x88, 0x11, 0xe3, 0x05,
-	0x0b, 0x75, 0x71, 0xb2, 0x9a, 0x87, 0x6c, 0x29, 0xd4, 0x17, 0xc3, 0x78, 0xf7, 0x62, 0x8c, 0x51,
-	0x6f, 0xc3, 0x6c, 0x57, 0x54, 0xf3, 0x9e, 0x62, 0x2e, 0xb3, 0xb5, 0x5b, 0x15, 0x32, 0x5b, 0xa6,
-	0xea, 0x7f, 0xb7, 0xa1, 0x36, 0x14, 0x17, 0xe3, 0x2b, 0xf2, 0x0c, 0x1c, 0x9c, 0x7c, 0x64, 0x53,
-	0xe5, 0xda, 0x8f, 0xac, 0xb3, 0xd6, 0xe5, 0x61, 0x90, 0x69, 0x47, 0x2b, 0x35, 0xd4, 0x55, 0xca,
-	0x3e, 0xad, 0x98, 0x54, 0x34, 0x87, 0xc8, 0x29, 0x54, 0x55, 0x14, 0xbb, 0xd5, 0x0d, 0xf6, 0x5d,
-	0x14, 0x6f, 0xb3, 0x19, 0x41, 0x9e, 0xc2, 0x5e, 0xca, 0x04, 0xba, 0x7b, 0x9a, 0xec, 0x68, 0xb2,
-	0x9f, 0xb2, 0x48, 0x31, 0xca, 0x04, 0x16, 0xa8, 0x66, 0x48, 0x08, 0xce, 0x14, 0x97, 0xcb, 0x44,
-	0xb9, 0x35, 0x4d, 0xdf, 0xd7, 0x74, 0x6f, 0x95, 0x2c, 0x66, 0x7d, 0x5d, 0x2f, 0xa7, 0x30, 0x18,
-	0x39, 0x07, 0x67, 0x92, 0x46, 0x7c, 0xfa, 0xc1, 0x75, 0xb4, 0xc0, 0xdd, 0x68, 0xdf, 0xd3, 0x07,
-	0xa5, 0xc2, 0x70, 0xe4, 0x05, 0x34, 0x44, 0x22, 0xd8, 0x22, 0xe1, 0xcc, 0xad, 0x6b, 0x8d, 0x17,
-	0x64, 0x71, 0x18, 0xcd, 0x28, 0x3f, 0x2a, 0x54, 0x25, 0xeb, 0x9f, 0x82, 0x3d, 0x14, 0xe4, 0x04,
-	0x6a, 0x98, 0xa5, 0xe5, 0x5a, 0x5a, 0xba, 0x1f, 0x98, 0x2f, 0xa6, 0x13, 0xa4, 0x7b, 0x28, 0x2e,
-	0xae, 0xfc, 0xaf, 0xd0, 0xbe, 0xf9, 0xac, 0xd2, 0xa8, 0x8c, 0x81, 0x1c, 0x40, 0xf5, 0x3d, 0x7d,
-	0xab, 0x25, 0x4d, 0x9a, 0x6d, 0xc9, 0x31, 0x00, 0xc7, 0xb1, 0x49, 0x52, 0xea, 0xbc, 0x1b, 0xb4,
-	0xc9, 0xd1, 0xa4, 0x27, 0xc9, 0x11, 0x34, 0x38, 0x8e, 0xb3, 0x44, 0xa4, 0x0e, 0xb8, 0x41, 0xeb,
-	0x1c, 0xb3, 0xb4, 0x24, 0x39, 0x81, 0x7d, 0x8e, 0xe3, 0x62, 0x2a, 0xa9, 0x53, 0x6d, 0xd0, 0x16,
-	0xc7, 0x62, 0x72, 0xe9, 0xf7, 0xa1, 0x93, 0x0f, 0xb0, 0xe3, 0x86, 0x3c, 0xd9, 0xf0, 0x6e, 0x0c,
-	0xdc, 0xd1, 0xde, 0x4b, 0xee, 0xd6, 0xee, 0x35, 0xb4, 0x29, 0x93, 0x0a, 0xd3, 0x52, 0x7c, 0x04,
-	0x36, 0x8a, 0x5c, 0xd6, 0x2c, 0x7d, 0x53, 0x1b, 0x45, 0x61, 0xd0, 0x2e, 0x0d, 0xfa, 0x8f, 0xa1,
-	0xd5, 0x5f, 0xac, 0xa4, 0x62, 0xe9, 0x80, 0xcf, 0x91, 0x74, 0xc0, 0x4e, 0x66, 0x26, 0x80, 0x9e,
-	0xb3, 0xfe, 0xf5, 0xd0, 0x1e, 0xbc, 0xa1, 0x76, 0x32, 0xbb, 0xfc, 0x6b, 0x41, 0xf5, 0xf5, 0x68,
-	0x40, 0x42, 0xa8, 0xe7, 0x23, 0x93, 0xc3, 0xbc, 0xf5, 0x76, 0x86, 0xde, 0xed, 0x8d, 0x7e, 0xe5,
-	0xdc, 0x22, 0xd7, 0x70, 0x77, 0xc7, 0x23, 0x39, 0xde, 0x16, 0xee, 0x78, 0xdf, 0x6a, 0x40, 0x5e,
-	0x42, 0x3d, 0x77, 0x57, 0xde, 0xb7, 0xed, 0xd6, 0xeb, 0x04, 0xe6, 0xdd, 0x05, 0xc5, 0xbb, 0x0b,
-	0x6e, 0xb2, 0x77, 0xe7, 0x57, 0xce, 0x2c, 0xf2, 0x0a, 0xda, 0x03, 0x2e, 0x05, 0x9b, 0xaa, 0xdc,
-	0x23, 0xf9, 0x0f, 0xed, 0x91, 0xbc, 0xf9, 0x46, 0x16, 0x7e, 0xa5, 0x77, 0xf0, 0x63, 0xdd, 0xb5,
-	0x7e, 0xae, 0xbb, 0xd6, 0xef, 0x75, 0xd7, 0xfa, 0xf6, 0xa7, 0x5b, 0x99, 0x38, 0x5a, 0xf7, 0xfc,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0xba, 0xf0, 0x99, 0x0e, 0x04, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdf, 0x8e, 0xda, 0x46,
+	0x14, 0xc6, 0x17, 0x03, 0x06, 0x1f, 0x36, 0xdb, 0xd5, 0x69, 0x42, 0xbc, 0xa4, 0x61, 0x13, 0x4b,
+	0x51, 0x36, 0xfd, 0x63, 0x76, 0x53, 0xa9, 0xb9, 0x68, 0x53, 0x09, 0x48, 0x2e, 0xa8, 0xb2, 0xda,
+	0xd5, 0x34, 0xbd, 0x46, 0xc6, 0x0c, 0xac, 0x1b, 0xf0, 0x4c, 0xed, 0x71, 0xd5, 0xbc, 0x49, 0x2f,
+	0xfa, 0x1a, 0x7d, 0x87, 0x5e, 0xf6, 0x09, 0x56, 0x15, 0x7d, 0x84, 0xbe, 0x40, 0xe5, 0x33, 0x63,
+	0x63, 0x68, 0x73, 0x81, 0x35, 0x3e, 0xe7, 0xf7, 0x9d, 0xf1, 0x99, 0xf3, 0x0d, 0xe0, 0x86, 0xab,
+	0x88, 0xc7, 0x6a, 0x10, 0xcc, 0xd7, 0x51, 0xac, 0x9f, 0xbe, 0x4c, 0x84, 0x12, 0xd8, 0xa4, 0x97,
+	0xde, 0x83, 0xa5, 0x10, 0xcb, 0x15, 0x1f, 0x50, 0x70, 0x96, 0x2d, 0x06, 0x7c, 0x2d, 0xd5, 0x7b,
+	0xcd, 0xf4, 0x4e, 0xf7, 0x93, 0x2a, 0x5a, 0xf3, 0x54, 0x05, 0x6b, 0x69, 0x80, 0xbb, 0x4b, 0xb1,
+	0x14, 0xb4, 0x1c, 0xe4, 0x2b, 0x13, 0xed, 0x16, 0x9b, 0x66, 0xea, 0x86, 0x1e, 0x26, 0xee, 0x99,
+	0x38, 0x8f, 0x15, 0x4f, 0x64, 0x12, 0xa5, 0xbc, 0xb2, 0x2c, 0x2a, 0x1a, 0x46, 0x2e, 0xd2, 0xfc,
+	0xb7, 0x1f, 0x95, 0x69, 0xfe, 0xd3, 0x51, 0xef, 0xb7, 0x06, 0x34, 0xaf, 0xe4, 0xc5, 0xf4, 0x05,
+	0x7e, 0x01, 0xb6, 0x98, 0xfd, 0xc8, 0x43, 0xe5, 0x5a, 0x8f, 0x6a, 0x67, 0x9d, 0xe7, 0xf7, 0xfc,
+	0x5c, 0x7b, 0x9d, 0xa9, 0x2b, 0x8a, 0x32, 0xfe, 0x53, 0xc6, 0x53, 0xc5, 0x0c, 0x84, 0x4f, 0xa1,
+	0xae, 0x82, 0xa5, 0x5b, 0xaf, 0xb0, 0x6f, 0x83, 0xe5, 0x2e, 0x9b, 0x13, 0xf8, 0x29, 0x34, 0x12,
+	0x2e, 0x85, 0xdb, 0x20, 0xb2, 0x4b, 0xe4, 0x38, 0xe1, 0x81, 0xe2, 0x8c, 0x4b, 0x51, 0xa0, 0xc4,
+	0xe0, 0x00, 0xec, 0x50, 0xac, 0xd7, 0x91, 0x72, 0x9b, 0x44, 0xdf, 0x27, 0x7a, 0x94, 0x45, 0xab,
+	0xf9, 0x98, 0xe2, 0xe5, 0x57, 0x68, 0x0c, 0xcf, 0xc1, 0x9e, 0x25, 0x41, 0x1c, 0xde, 0xb8, 0x36,
+	0x09, 0xdc, 0x4a, 0xf9, 0x11, 0x25, 0x4a, 0x85, 0xe6, 0xf0, 0x2b, 0x68, 0xcb, 0x48, 0xf2, 0x55,
+	0x14, 0x73, 0xb7, 0x45, 0x9a, 0x9e, 0x2f, 0x65, 0xa1, 0xb9, 0x36, 0xa9, 0x42, 0x55, 0xb2, 0xf8,
+	0x19, 0xd8, 0xeb, 0x20, 0x79, 0xc7, 0x13, 0xb7, 0x4d, 0xaa, 0x8f, 0x7d, 0xed, 0x84, 0x51, 0x10,
+	0xbe, 0xcb, 0xe4, 0x25, 0xa5, 0x98, 0x41, 0xf0, 0x6b, 0x80, 0xed, 0x54, 0x5c, 0x87, 0x04, 0x0f,
+	0xfc, 0x6d, 0xc8, 0x1f, 0x86, 0x2a, 0xfa, 0x39, 0x50, 0xe5, 0x3e, 0x15, 0x1c, 0x2f, 0xc0, 0xa6,
+	0xd2, 0xa9, 0x0b, 0x24, 0x3c, 0xf1, 0x69, 0xfe, 0x97, 0x62, 0x1e, 0x2d, 0xde, 0x0f, 0x29, 0x53,
+	0x36, 0xa5, 0x41, 0x3c, 0x87, 0xe6, 0x32, 0x11, 0x99, 0x74, 0x3b, 0xa6, 0xa3, 0x8a, 0xe2, 0x92,
+	0xaf, 0x67, 0x3c, 0x29, 0x25, 0x1a, 0xc4, 0x27, 0x50, 0x0f, 0xc2, 0x95, 0x7b, 0x58, 0xf4, 0x92,
+	0xf3, 0xdf, 0x73, 0x35, 0x1c, 0xbf, 0x29, 0x87, 0x17, 0x84, 0x2b, 0xef, 0x29, 0x58, 0x57, 0x12,
+	0x1f, 0x43, 0x53, 0xe4, 0x1e, 0x71, 0x6b, 0x84, 0x1f, 0x9a, 0xd6, 0xc9, 0x37, 0xac, 0x21, 0xe4,
+	0xc5, 0x0b, 0x6f, 0x05, 0x87, 0xd5, 0x93, 0xc0, 0xcf, 0x01, 0xc2, 0x55, 0x96, 0x2a, 0x9e, 0x4c,
+	0xa3, 0x39, 0xe9, 0x9c, 0xd1, 0x9d, 0xcd, 0xed, 0xa9, 0x33, 0xd6, 0xd1, 0xc9, 0x2b, 0xe6, 0x18,
+	0x60, 0x32, 0x47, 0x1f, 0x1a, 0xf9, 0xb5, 0x30, 0xce, 0xeb, 0xf9, 0xfa, 0xce, 0xf8, 0xc5, 0x9d,
+	0xf1, 0xdf, 0x16, 0x77, 0x86, 0x11, 0xe7, 0xfd, 0x6e, 0xc1, 0xd1, 0xeb, 0x5f, 0x54, 0x12, 0x94,
+	0x5e, 0xc3, 0x63, 0xa8, 0xff, 0xc0, 0xde, 0xe8, 0x9d, 0x58, 0xbe, 0xc4, 0x87, 0x00, 0xb1, 0x98,
+	0x6a, 0xbb, 0xa6, 0x54, 0xba, 0xcd, 0x9c, 0x58, 0x68, 0x8b, 0xa6, 0x78, 0x02, 0xed, 0x58, 0x4c,
+	0x73, 0xdb, 0xa5, 0xe4, 0xe2, 0x36, 0x6b, 0xc5, 0x22, 0xb7, 0x64, 0x8a, 0x8f, 0xe1, 0x30, 0x16,
+	0xd3, 0x62, 0xf4, 0x29, 0x59, 0xb7, 0xcd, 0x3a, 0xb1, 0x28, 0xec, 0x91, 0xe2, 0x5d, 0x68, 0x6a,
+	0x69, 0xf3, 0x51, 0xfd, 0xcc, 0x61, 0xfa, 0x05, 0x3f, 0x01, 0x67, 0xab, 0xb2, 0x29, 0xb3, 0x0d,
+	0xe0, 0x33, 0x68, 0xa6, 0x51, 0x1c, 0x16, 0xbe, 0xfb, 0x5f, 0x07, 0x69, 0x02, 0xef, 0x43, 0x2b,
+	0x16, 0xd3, 0x7c, 0x2a, 0x64, 0xb7, 0x36, 0xb3, 0x63, 0x31, 0xcc, 0xd4, 0x0d, 0x76, 0x4b, 0x1b,
+	0x82, 0x8e, 0xeb, 0x37, 0xec, 0xef, 0x38, 0xae, 0x43, 0xb9, 0x4a, 0xe4, 0xbb, 0x46, 0xdb, 0x39,
+	0x06, 0x6f, 0x0c, 0x5d, 0x73, 0x6c, 0x7b, 0x46, 0xc7, 0x67, 0x95, 0x6b, 0xa1, 0xa7, 0x7c, 0x87,
+	0xae, 0x45, 0xc9, 0x95, 0x69, 0xef, 0x25, 0x1c, 0x31, 0x9e, 0x2a, 0x91, 0x94, 0xe2, 0x13, 0xb0,
+	0x84, 0x34, 0x32, 0xa7, 0x34, 0x07, 0xb3, 0x84, 0x2c, 0xc6, 0x62, 0x95, 0x63, 0xf1, 0x9e, 0x40,
+	0xa7, 0xf0, 0x40, 0xbc, 0x10, 0xd8, 0x05, 0xab, 0x34, 0x88, 0xbd, 0xb9, 0x3d, 0xb5, 0x26, 0xaf,
+	0x98, 0x15, 0xcd, 0x9f, 0xff, 0x53, 0x83, 0xfa, 0xf0, 0x7a, 0x82, 0x03, 0x68, 0x99, 0x4f, 0xc6,
+	0x7b, 0xa6, 0xf4, 0xee, 0xe4, 0x7b, 0xdb, 0x1d, 0xbd, 0x83, 0xf3, 0x1a, 0xbe, 0x84, 0x8f, 0xf6,
+	0x7a, 0xc4, 0x87, 0xbb, 0xc2, 0xbd, 0xde, 0x77, 0x0a, 0xe0, 0x37, 0xd0, 0x32, 0xdd, 0x95, 0xfb,
+	0xed, 0x76, 0xdb, 0xeb, 0xfe, 0xc7, 0x9e, 0xaf, 0xf3, 0xff, 0x7b, 0xef, 0xe0, 0xac, 0x86, 0xdf,
+	0xc2, 0xd1, 0x24, 0x4e, 0x25, 0x0f, 0x95, 0xe9, 0x11, 0x3f, 0x40, 0xf7, 0xd0, 0x14, 0xaf, 0x9c,
+	0x85, 0x77, 0x30, 0x3a, 0xfe, 0x63, 0xd3, 0xaf, 0xfd, 0xb9, 0xe9, 0xd7, 0xfe, 0xda, 0xf4, 0x6b,
+	0xbf, 0xfe, 0xdd, 0x3f, 0x98, 0xd9, 0xa4, 0xfb, 0xf2, 0xdf, 0x01, 0x00, 0x72, 0xa2, 0xb7, 0xea,
+	0x86, 0x06, 0x00, 0x00,
 }
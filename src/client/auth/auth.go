@@ -25,6 +25,11 @@ const (
 	// any ACLs)
 	GitHubPrefix = "github:"
 
+	// OIDCPrefix indicates that this Subject is a user of the cluster's OpenID
+	// Connect identity provider (the rest of the Subject is the value of the
+	// provider's username claim)
+	OIDCPrefix = "oidc:"
+
 	// RobotPrefix indicates that this Subject is a Pachyderm robot user. Any
 	// string (with this prefix) is a logical Pachyderm robot user.
 	RobotPrefix = "robot:"
@@ -33,8 +38,36 @@ const (
 	// (with this prefix) is a logical PPS pipeline (even though the pipeline may
 	// not exist).
 	PipelinePrefix = "pipeline:"
+
+	// GroupPrefix indicates that this Principal is a group of users. Groups
+	// can't authenticate, but may appear on ACLs, granting all of their members
+	// access
+	GroupPrefix = "group:"
+
+	// BranchSeparator separates a repo from a branch in the target of an ACL
+	// (e.g. "repo@master"). Branch ACLs control who may write to a single branch
+	// of a repo
+	BranchSeparator = "@"
 )
 
+// RepoBranch returns the ACL target for 'branch' in 'repo' (i.e.
+// "repo@branch"). If 'branch' is empty, it returns 'repo'
+func RepoBranch(repo, branch string) string {
+	if branch == "" {
+		return repo
+	}
+	return repo + BranchSeparator + branch
+}
+
+// ParseRepoBranch splits the ACL target 's' (either "repo" or "repo@branch")
+// into its repo and branch. 'branch' is empty if 's' is a repo
+func ParseRepoBranch(s string) (repo, branch string) {
+	if i := strings.Index(s, BranchSeparator); i >= 0 {
+		return s[:i], s[i+len(BranchSeparator):]
+	}
+	return s, ""
+}
+
 // ParseScope parses the string 's' to a scope (for example, parsing a command-
 // line argument.
 func ParseScope(s string) (Scope, error) {
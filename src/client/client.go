@@ -19,9 +19,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/deploy"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/health"
+	"github.com/pachyderm/pachyderm/src/client/notify"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 )
@@ -55,6 +57,9 @@ type VersionAPIClient versionpb.APIClient
 // AdminAPIClient is an alias of admin.APIClient
 type AdminAPIClient admin.APIClient
 
+// NotifyAPIClient is an alias of notify.APIClient
+type NotifyAPIClient notify.APIClient
+
 // An APIClient is a wrapper around pfs, pps and block APIClients.
 type APIClient struct {
 	PfsAPIClient
@@ -64,6 +69,7 @@ type APIClient struct {
 	DeployAPIClient
 	VersionAPIClient
 	AdminAPIClient
+	NotifyAPIClient
 	Enterprise enterprise.APIClient // not embedded--method name conflicts with AuthAPIClient
 
 	// addr is a "host:port" string pointing at a pachd endpoint
@@ -261,6 +267,7 @@ func (c *APIClient) connect() error {
 		PermitWithoutStream: true,             // send ping even if no active RPCs
 	})
 	dialOptions := append(PachDialOptions(), keepaliveOpt)
+	dialOptions = append(dialOptions, tracing.DialOptions()...)
 	clientConn, err := grpc.Dial(c.addr, dialOptions...)
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
@@ -273,6 +280,7 @@ func (c *APIClient) connect() error {
 	c.DeployAPIClient = deploy.NewAPIClient(clientConn)
 	c.VersionAPIClient = versionpb.NewAPIClient(clientConn)
 	c.AdminAPIClient = admin.NewAPIClient(clientConn)
+	c.NotifyAPIClient = notify.NewAPIClient(clientConn)
 	c.clientConn = clientConn
 	c.healthClient = health.NewHealthClient(clientConn)
 	return nil
@@ -295,6 +303,11 @@ func (c *APIClient) AddMetadata(ctx context.Context) context.Context {
 		clientData["userid"] = c.metricsUserID
 		clientData["prefix"] = c.metricsPrefix
 	}
+	// Propagate the span in 'ctx' (if any), so that the server's spans are
+	// its children. This replaces any span in the incoming metadata of 'ctx'
+	if traceparent := tracing.TraceParent(ctx); traceparent != "" {
+		clientData[tracing.MetadataKey] = traceparent
+	}
 
 	// Rescue any metadata pairs already in 'ctx' (otherwise
 	// metadata.NewOutgoingContext() would drop them). Note that this is similar
@@ -313,10 +326,11 @@ func (c *APIClient) AddMetadata(ctx context.Context) context.Context {
 }
 
 // Ctx is a convenience function that returns adds Pachyderm authn metadata
-// to context.Background().
+// to context.Background() (or tracing.Background(), if this process has a
+// background span).
 func (c *APIClient) Ctx() context.Context {
 	if c.ctx == nil {
-		return c.AddMetadata(context.Background())
+		return c.AddMetadata(tracing.Background())
 	}
 	return c.AddMetadata(c.ctx)
 }
@@ -16,6 +16,8 @@
 		GetStateResponse
 		DeactivateRequest
 		DeactivateResponse
+		GetActivationCodeRequest
+		GetActivationCodeResponse
 */
 package enterprise
 
@@ -204,6 +206,52 @@ func (m *DeactivateResponse) String() string            { return proto.CompactTe
 func (*DeactivateResponse) ProtoMessage()               {}
 func (*DeactivateResponse) Descriptor() ([]byte, []int) { return fileDescriptorEnterprise, []int{7} }
 
+type GetActivationCodeRequest struct {
+}
+
+func (m *GetActivationCodeRequest) Reset()         { *m = GetActivationCodeRequest{} }
+func (m *GetActivationCodeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActivationCodeRequest) ProtoMessage()    {}
+func (*GetActivationCodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorEnterprise, []int{8}
+}
+
+type GetActivationCodeResponse struct {
+	State State      `protobuf:"varint,1,opt,name=state,proto3,enum=enterprise.State" json:"state,omitempty"`
+	Info  *TokenInfo `protobuf:"bytes,2,opt,name=info" json:"info,omitempty"`
+	// activation_code is the code that the cluster was activated with (unset if
+	// 'state' is NONE)
+	ActivationCode string `protobuf:"bytes,3,opt,name=activation_code,json=activationCode,proto3" json:"activation_code,omitempty"`
+}
+
+func (m *GetActivationCodeResponse) Reset()         { *m = GetActivationCodeResponse{} }
+func (m *GetActivationCodeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActivationCodeResponse) ProtoMessage()    {}
+func (*GetActivationCodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptorEnterprise, []int{9}
+}
+
+func (m *GetActivationCodeResponse) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State_NONE
+}
+
+func (m *GetActivationCodeResponse) GetInfo() *TokenInfo {
+	if m != nil {
+		return m.Info
+	}
+	return nil
+}
+
+func (m *GetActivationCodeResponse) GetActivationCode() string {
+	if m != nil {
+		return m.ActivationCode
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EnterpriseRecord)(nil), "enterprise.EnterpriseRecord")
 	proto.RegisterType((*TokenInfo)(nil), "enterprise.TokenInfo")
@@ -213,6 +261,8 @@ func init() {
 	proto.RegisterType((*GetStateResponse)(nil), "enterprise.GetStateResponse")
 	proto.RegisterType((*DeactivateRequest)(nil), "enterprise.DeactivateRequest")
 	proto.RegisterType((*DeactivateResponse)(nil), "enterprise.DeactivateResponse")
+	proto.RegisterType((*GetActivationCodeRequest)(nil), "enterprise.GetActivationCodeRequest")
+	proto.RegisterType((*GetActivationCodeResponse)(nil), "enterprise.GetActivationCodeResponse")
 	proto.RegisterEnum("enterprise.State", State_name, State_value)
 }
 
@@ -231,6 +281,10 @@ type APIClient interface {
 	// features, such as the Pachyderm Dashboard and Auth system
 	Activate(ctx context.Context, in *ActivateRequest, opts ...grpc.CallOption) (*ActivateResponse, error)
 	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+	// GetActivationCode returns the code that the cluster was activated with,
+	// so that it can be backed up (see admin.API.Extract). If auth is
+	// activated, only cluster admins may call it.
+	GetActivationCode(ctx context.Context, in *GetActivationCodeRequest, opts ...grpc.CallOption) (*GetActivationCodeResponse, error)
 	// Deactivate is a testing API. It removes a cluster's enterprise activation
 	// token and sets its enterprise state to NONE (normally, once a cluster has
 	// been activated, the only reachable state is EXPIRED).
@@ -267,6 +321,15 @@ func (c *aPIClient) GetState(ctx context.Context, in *GetStateRequest, opts ...g
 	return out, nil
 }
 
+func (c *aPIClient) GetActivationCode(ctx context.Context, in *GetActivationCodeRequest, opts ...grpc.CallOption) (*GetActivationCodeResponse, error) {
+	out := new(GetActivationCodeResponse)
+	err := grpc.Invoke(ctx, "/enterprise.API/GetActivationCode", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) Deactivate(ctx context.Context, in *DeactivateRequest, opts ...grpc.CallOption) (*DeactivateResponse, error) {
 	out := new(DeactivateResponse)
 	err := grpc.Invoke(ctx, "/enterprise.API/Deactivate", in, out, c.cc, opts...)
@@ -283,6 +346,10 @@ type APIServer interface {
 	// features, such as the Pachyderm Dashboard and Auth system
 	Activate(context.Context, *ActivateRequest) (*ActivateResponse, error)
 	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+	// GetActivationCode returns the code that the cluster was activated with,
+	// so that it can be backed up (see admin.API.Extract). If auth is
+	// activated, only cluster admins may call it.
+	GetActivationCode(context.Context, *GetActivationCodeRequest) (*GetActivationCodeResponse, error)
 	// Deactivate is a testing API. It removes a cluster's enterprise activation
 	// token and sets its enterprise state to NONE (normally, once a cluster has
 	// been activated, the only reachable state is EXPIRED).
@@ -333,6 +400,24 @@ func _API_GetState_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetActivationCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActivationCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetActivationCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enterprise.API/GetActivationCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetActivationCode(ctx, req.(*GetActivationCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_Deactivate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeactivateRequest)
 	if err := dec(in); err != nil {
@@ -363,6 +448,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetState",
 			Handler:    _API_GetState_Handler,
 		},
+		{
+			MethodName: "GetActivationCode",
+			Handler:    _API_GetActivationCode_Handler,
+		},
 		{
 			MethodName: "Deactivate",
 			Handler:    _API_Deactivate_Handler,
@@ -583,6 +672,63 @@ func (m *DeactivateResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *GetActivationCodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetActivationCodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *GetActivationCodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetActivationCodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.State != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.State))
+	}
+	if m.Info != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.Info.Size()))
+		n6, err := m.Info.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n6
+	}
+	if len(m.ActivationCode) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.ActivationCode)))
+		i += copy(dAtA[i:], m.ActivationCode)
+	}
+	return i, nil
+}
+
 func encodeVarintEnterprise(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -671,6 +817,29 @@ func (m *DeactivateResponse) Size() (n int) {
 	return n
 }
 
+func (m *GetActivationCodeRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetActivationCodeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.State != 0 {
+		n += 1 + sovEnterprise(uint64(m.State))
+	}
+	if m.Info != nil {
+		l = m.Info.Size()
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	l = len(m.ActivationCode)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	return n
+}
+
 func sovEnterprise(x uint64) (n int) {
 	for {
 		n++
@@ -1326,6 +1495,187 @@ func (m *DeactivateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GetActivationCodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetActivationCodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetActivationCodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetActivationCodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetActivationCodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetActivationCodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (State(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Info == nil {
+				m.Info = &TokenInfo{}
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationCode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActivationCode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEnterprise(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1434,31 +1784,33 @@ var (
 func init() { proto.RegisterFile("client/enterprise/enterprise.proto", fileDescriptorEnterprise) }
 
 var fileDescriptorEnterprise = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xcf, 0x8e, 0xd2, 0x50,
-	0x14, 0xc6, 0x7b, 0x91, 0xbf, 0x87, 0x04, 0xca, 0x55, 0x13, 0x52, 0xb5, 0x92, 0xbb, 0x01, 0x59,
-	0x94, 0x04, 0xdd, 0xba, 0xa8, 0xd0, 0x90, 0x2e, 0x44, 0x52, 0x89, 0x71, 0x67, 0x4a, 0x39, 0x25,
-	0x8d, 0xd0, 0x5b, 0xda, 0x8b, 0xf1, 0x51, 0x7c, 0x24, 0x97, 0x3e, 0x82, 0x61, 0x92, 0x79, 0x8e,
-	0x09, 0x2d, 0xa5, 0x85, 0x99, 0xc9, 0xcc, 0x66, 0x76, 0xcd, 0x39, 0x5f, 0xbe, 0xdf, 0x77, 0xbf,
-	0x53, 0x60, 0xce, 0xda, 0x43, 0x5f, 0x0c, 0xd0, 0x17, 0x18, 0x06, 0xa1, 0x17, 0x61, 0xee, 0x53,
-	0x0b, 0x42, 0x2e, 0x38, 0x85, 0x6c, 0xa2, 0xbc, 0x5d, 0x71, 0xbe, 0x5a, 0xe3, 0x20, 0xde, 0x2c,
-	0x76, 0xee, 0x40, 0x78, 0x1b, 0x8c, 0x84, 0xbd, 0x09, 0x12, 0x31, 0xdb, 0x82, 0x6c, 0x9c, 0xe4,
-	0x16, 0x3a, 0x3c, 0x5c, 0xd2, 0x2e, 0x34, 0x6d, 0x47, 0x78, 0xbf, 0x6c, 0xe1, 0x71, 0xff, 0x87,
-	0xc3, 0x97, 0xd8, 0x26, 0x1d, 0xd2, 0xab, 0x59, 0x8d, 0x6c, 0x3c, 0xe2, 0x4b, 0xa4, 0x1f, 0xa0,
-	0x82, 0xbf, 0x03, 0x2f, 0xc4, 0xa8, 0x5d, 0xe8, 0x90, 0x5e, 0x7d, 0xa8, 0x68, 0x09, 0x4f, 0x4b,
-	0x79, 0xda, 0x3c, 0xe5, 0x59, 0xa9, 0x94, 0xe9, 0x50, 0x9b, 0xf3, 0x9f, 0xe8, 0x9b, 0xbe, 0xcb,
-	0xf3, 0x16, 0xe4, 0xf1, 0x16, 0x01, 0x34, 0xf5, 0x24, 0x0a, 0x5a, 0xb8, 0xdd, 0x61, 0x24, 0x9e,
-	0x3a, 0xf4, 0x47, 0x90, 0x33, 0x62, 0x14, 0x70, 0x3f, 0x42, 0xfa, 0x0e, 0x8a, 0x9e, 0xef, 0xf2,
-	0x63, 0xf0, 0x97, 0x5a, 0xee, 0x12, 0xa7, 0x07, 0x5a, 0xb1, 0x84, 0xb5, 0xa0, 0x39, 0x41, 0xf1,
-	0x55, 0x64, 0x81, 0x99, 0x0b, 0x72, 0x36, 0x3a, 0x3a, 0x76, 0xa1, 0x14, 0x1d, 0x06, 0xb1, 0x65,
-	0x63, 0xd8, 0xca, 0x5b, 0x26, 0xca, 0x64, 0x7f, 0x42, 0x17, 0x1e, 0x46, 0x3f, 0x87, 0xd6, 0x18,
-	0xed, 0xf3, 0xb6, 0xd8, 0x0b, 0xa0, 0xf9, 0x61, 0x82, 0xef, 0xf7, 0xa1, 0x14, 0x53, 0x68, 0x15,
-	0x8a, 0xd3, 0x2f, 0x53, 0x43, 0x96, 0x28, 0x40, 0x59, 0x1f, 0xcd, 0xcd, 0x6f, 0x86, 0x4c, 0x68,
-	0x1d, 0x2a, 0xc6, 0xf7, 0x99, 0x69, 0x19, 0x63, 0xb9, 0x30, 0xbc, 0x26, 0xf0, 0x4c, 0x9f, 0x99,
-	0x74, 0x02, 0xd5, 0xb4, 0x18, 0xfa, 0x2a, 0x9f, 0xe3, 0xe2, 0x40, 0xca, 0xeb, 0xbb, 0x97, 0x09,
-	0x9a, 0x49, 0x07, 0xa3, 0xb4, 0x8f, 0x73, 0xa3, 0x8b, 0xe2, 0xce, 0x8d, 0x2e, 0x2b, 0x64, 0x12,
-	0xfd, 0x0c, 0x90, 0xbd, 0x8d, 0xbe, 0xc9, 0xab, 0x6f, 0x15, 0xa1, 0xa8, 0xf7, 0xad, 0x53, 0xbb,
-	0x4f, 0xf2, 0xdf, 0xbd, 0x4a, 0xfe, 0xed, 0x55, 0xf2, 0x7f, 0xaf, 0x92, 0x3f, 0x57, 0xaa, 0xb4,
-	0x28, 0xc7, 0x3f, 0xca, 0xfb, 0x9b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0x5e, 0xb4, 0x7c, 0x8d,
-	0x03, 0x00, 0x00,
+	// 438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x4d, 0xaf, 0xd2, 0x50,
+	0x14, 0xe4, 0xf6, 0x7d, 0x9f, 0x97, 0x3c, 0xda, 0xab, 0x26, 0x58, 0x9f, 0x95, 0x34, 0x1a, 0x90,
+	0x45, 0x49, 0xd0, 0xad, 0x8b, 0x0a, 0x0d, 0xe9, 0x42, 0x24, 0x95, 0x18, 0x77, 0xa6, 0x94, 0x53,
+	0xd2, 0x08, 0xbd, 0xa5, 0xbd, 0x18, 0x7f, 0x0a, 0x3f, 0xc9, 0xb8, 0xf2, 0x27, 0x18, 0xfc, 0x23,
+	0x86, 0x96, 0x7e, 0x00, 0x35, 0xbc, 0x0d, 0x3b, 0x72, 0x66, 0xce, 0xcc, 0x70, 0xe6, 0x16, 0x54,
+	0x67, 0xe6, 0xa1, 0xcf, 0xdb, 0xe8, 0x73, 0x0c, 0x83, 0xd0, 0x8b, 0xb0, 0xf0, 0x53, 0x0b, 0x42,
+	0xc6, 0x19, 0x85, 0x7c, 0x22, 0xbf, 0x98, 0x32, 0x36, 0x9d, 0x61, 0x3b, 0x46, 0xc6, 0x4b, 0xb7,
+	0xcd, 0xbd, 0x39, 0x46, 0xdc, 0x9e, 0x07, 0x09, 0x59, 0x5d, 0x80, 0x68, 0x64, 0x74, 0x0b, 0x1d,
+	0x16, 0x4e, 0x68, 0x03, 0xaa, 0xb6, 0xc3, 0xbd, 0xef, 0x36, 0xf7, 0x98, 0xff, 0xd5, 0x61, 0x13,
+	0xac, 0x91, 0x3a, 0x69, 0xde, 0x58, 0x77, 0xf9, 0xb8, 0xcb, 0x26, 0x48, 0xdf, 0xc2, 0x15, 0xfe,
+	0x08, 0xbc, 0x10, 0xa3, 0x9a, 0x50, 0x27, 0xcd, 0xdb, 0x8e, 0xac, 0x25, 0x7e, 0x5a, 0xea, 0xa7,
+	0x8d, 0x52, 0x3f, 0x2b, 0xa5, 0xaa, 0x3a, 0xdc, 0x8c, 0xd8, 0x37, 0xf4, 0x4d, 0xdf, 0x65, 0x45,
+	0x09, 0xf2, 0x70, 0x89, 0x00, 0xaa, 0x7a, 0x12, 0x05, 0x2d, 0x5c, 0x2c, 0x31, 0xe2, 0xa7, 0x0e,
+	0xfd, 0x0e, 0xc4, 0xdc, 0x31, 0x0a, 0x98, 0x1f, 0x21, 0x7d, 0x0d, 0xe7, 0x9e, 0xef, 0xb2, 0x6d,
+	0xf0, 0x27, 0x5a, 0xa1, 0x89, 0xec, 0x0f, 0x5a, 0x31, 0x45, 0x95, 0xa0, 0xda, 0x47, 0xfe, 0x89,
+	0xe7, 0x81, 0x55, 0x17, 0xc4, 0x7c, 0xb4, 0x55, 0x6c, 0xc0, 0x45, 0xb4, 0x19, 0xc4, 0x92, 0x77,
+	0x1d, 0xa9, 0x28, 0x99, 0x30, 0x13, 0x3c, 0xb3, 0x16, 0x8e, 0x5b, 0x3f, 0x02, 0xa9, 0x87, 0xf6,
+	0xee, 0xb5, 0xd4, 0xc7, 0x40, 0x8b, 0xc3, 0xc4, 0x5e, 0x95, 0xa1, 0xd6, 0x47, 0xae, 0xef, 0xdc,
+	0x2b, 0xdd, 0x58, 0x11, 0x78, 0x5a, 0x02, 0x9e, 0x2e, 0x78, 0x59, 0xa3, 0x67, 0x65, 0x8d, 0xb6,
+	0x5a, 0x70, 0x11, 0x7b, 0xd0, 0x6b, 0x38, 0x1f, 0x7c, 0x1c, 0x18, 0x62, 0x85, 0x02, 0x5c, 0xea,
+	0xdd, 0x91, 0xf9, 0xd9, 0x10, 0x09, 0xbd, 0x85, 0x2b, 0xe3, 0xcb, 0xd0, 0xb4, 0x8c, 0x9e, 0x28,
+	0x74, 0x7e, 0x09, 0x70, 0xa6, 0x0f, 0x4d, 0xda, 0x87, 0xeb, 0xb4, 0x4f, 0xfa, 0xac, 0x98, 0x62,
+	0xef, 0x5d, 0xc9, 0xf7, 0xe5, 0xe0, 0xf6, 0x62, 0x95, 0x8d, 0x50, 0x5a, 0xe3, 0xae, 0xd0, 0x5e,
+	0xdf, 0xf2, 0x7d, 0x39, 0x98, 0x09, 0x8d, 0x41, 0x3a, 0xb8, 0x2f, 0x7d, 0xb9, 0xb7, 0x54, 0xda,
+	0x8d, 0xfc, 0xea, 0x08, 0x2b, 0xf3, 0xf8, 0x00, 0x90, 0xd7, 0x4e, 0x9f, 0x17, 0xd7, 0x0e, 0xde,
+	0x88, 0xac, 0xfc, 0x0f, 0x4e, 0xe5, 0xde, 0x8b, 0x3f, 0xd7, 0x0a, 0xf9, 0xbd, 0x56, 0xc8, 0x9f,
+	0xb5, 0x42, 0x56, 0x7f, 0x95, 0xca, 0xf8, 0x32, 0xfe, 0x86, 0xde, 0xfc, 0x1b, 0x00, 0x8d, 0xd8,
+	0xea, 0x3d, 0xa8, 0x04, 0x00, 0x00,
 }
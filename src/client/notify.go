@@ -0,0 +1,54 @@
+package client
+
+import (
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+)
+
+// CreateSink registers 'sink', which pachd delivers events to (see
+// notify.Sink). If update is true, the existing sink with the same name is
+// replaced.
+func (c APIClient) CreateSink(sink *notify.Sink, update bool) error {
+	_, err := c.NotifyAPIClient.CreateSink(
+		c.Ctx(),
+		&notify.CreateSinkRequest{
+			Sink:   sink,
+			Update: update,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// InspectSink returns the spec and delivery status of the sink 'name'.
+func (c APIClient) InspectSink(name string) (*notify.SinkInfo, error) {
+	sinkInfo, err := c.NotifyAPIClient.InspectSink(
+		c.Ctx(),
+		&notify.InspectSinkRequest{Name: name},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return sinkInfo, nil
+}
+
+// ListSink returns the spec and delivery status of every sink.
+func (c APIClient) ListSink() ([]*notify.SinkInfo, error) {
+	sinkInfos, err := c.NotifyAPIClient.ListSink(
+		c.Ctx(),
+		&notify.ListSinkRequest{},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return sinkInfos.SinkInfo, nil
+}
+
+// DeleteSink deletes the sink 'name', along with the events that haven't been
+// delivered to it yet.
+func (c APIClient) DeleteSink(name string) error {
+	_, err := c.NotifyAPIClient.DeleteSink(
+		c.Ctx(),
+		&notify.DeleteSinkRequest{Name: name},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
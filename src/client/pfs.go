@@ -328,6 +328,54 @@ func (c APIClient) DeleteBranch(repoName string, branch string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// CreateReplication starts replicating the commits on a branch to the pachd
+// at 'remote' (host:port). Commits are replicated to 'remoteRepo', which
+// defaults to 'repoName' if it's empty. 'remoteAuthToken' authenticates the
+// replicator with the remote cluster, if auth is activated there.
+func (c APIClient) CreateReplication(repoName string, branch string, remote string, remoteRepo string, remoteAuthToken string) error {
+	_, err := c.PfsAPIClient.CreateReplication(
+		c.Ctx(),
+		&pfs.CreateReplicationRequest{
+			Replication: &pfs.Replication{
+				Branch: NewBranch(repoName, branch),
+				Remote: remote,
+			},
+			RemoteRepo:      remoteRepo,
+			RemoteAuthToken: remoteAuthToken,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
+// ListReplication returns the status of each replication. If 'repoName' is
+// set, only the replications of its branches are returned.
+func (c APIClient) ListReplication(repoName string) ([]*pfs.ReplicationInfo, error) {
+	request := &pfs.ListReplicationRequest{}
+	if repoName != "" {
+		request.Repo = NewRepo(repoName)
+	}
+	replicationInfos, err := c.PfsAPIClient.ListReplication(c.Ctx(), request)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return replicationInfos.ReplicationInfo, nil
+}
+
+// DeleteReplication stops replicating a branch to 'remote'. Commits that have
+// already been replicated are left in the remote cluster.
+func (c APIClient) DeleteReplication(repoName string, branch string, remote string) error {
+	_, err := c.PfsAPIClient.DeleteReplication(
+		c.Ctx(),
+		&pfs.DeleteReplicationRequest{
+			Replication: &pfs.Replication{
+				Branch: NewBranch(repoName, branch),
+				Remote: remote,
+			},
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // DeleteCommit deletes a commit.
 // Note it is currently not implemented.
 func (c APIClient) DeleteCommit(repoName string, commitID string) error {
@@ -340,6 +388,24 @@ func (c APIClient) DeleteCommit(repoName string, commitID string) error {
 	return grpcutil.ScrubGRPC(err)
 }
 
+// SquashCommits merges the commits from 'fromCommitID' to 'toCommitID'
+// (inclusive) in repo 'repoName' into a single commit, which it returns.
+// 'fromCommitID' must be an ancestor of 'toCommitID', and all of the commits
+// must be finished.
+func (c APIClient) SquashCommits(repoName string, fromCommitID string, toCommitID string) (*pfs.Commit, error) {
+	commit, err := c.PfsAPIClient.SquashCommits(
+		c.Ctx(),
+		&pfs.SquashCommitsRequest{
+			From: NewCommit(repoName, fromCommitID),
+			To:   NewCommit(repoName, toCommitID),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return commit, nil
+}
+
 // FlushCommit returns an iterator that returns commits that have the
 // specified `commits` as provenance.  Note that the iterator can block if
 // jobs have not successfully completed. This in effect waits for all of the
@@ -502,7 +568,18 @@ func (c APIClient) PutObject(_r io.Reader, tags ...string) (object *pfs.Object,
 // PutObjectSplit is the same as PutObject except that the data is splitted
 // into several smaller objects.  This is primarily useful if you'd like to
 // be able to resume upload.
-func (c APIClient) PutObjectSplit(_r io.Reader) (objects []*pfs.Object, _ int64, retErr error) {
+func (c APIClient) PutObjectSplit(r io.Reader) ([]*pfs.Object, int64, error) {
+	objects, written, err := c.PutObjectSplitObjects(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	return objects.Objects, written, nil
+}
+
+// PutObjectSplitObjects is the same as PutObjectSplit except that it returns
+// the full response from pachd, which also includes the size of each object
+// and the number of bytes that weren't already stored.
+func (c APIClient) PutObjectSplitObjects(_r io.Reader) (objects *pfs.Objects, _ int64, retErr error) {
 	r := grpcutil.ReaderWrapper{_r}
 	w, err := c.newPutObjectSplitWriteCloser()
 	if err != nil {
@@ -1090,7 +1167,7 @@ func (w *putObjectWriteCloser) Close() error {
 type putObjectSplitWriteCloser struct {
 	request *pfs.PutObjectRequest
 	client  pfs.ObjectAPI_PutObjectSplitClient
-	objects []*pfs.Object
+	objects *pfs.Objects
 }
 
 func (c APIClient) newPutObjectSplitWriteCloser() (*putObjectSplitWriteCloser, error) {
@@ -1117,7 +1194,7 @@ func (w *putObjectSplitWriteCloser) Close() error {
 	if err != nil {
 		return grpcutil.ScrubGRPC(err)
 	}
-	w.objects = objects.Objects
+	w.objects = objects
 	return nil
 }
 
@@ -9,7 +9,7 @@ import (
 )
 
 var (
-	// ChunkSize is the size of file chunks when resumable upload is used
+	// ChunkSize is the maximum size of the chunks that PFS splits files into
 	ChunkSize = int64(16 * 1024 * 1024) // 16 MB
 )
 
@@ -41,6 +41,7 @@
 		ListBranchRequest
 		DeleteBranchRequest
 		DeleteCommitRequest
+		SquashCommitsRequest
 		FlushCommitRequest
 		SubscribeCommitRequest
 		GetFileRequest
@@ -56,6 +57,13 @@
 		DiffFileRequest
 		DiffFileResponse
 		DeleteFileRequest
+		Replication
+		CreateReplicationRequest
+		ListReplicationRequest
+		DeleteReplicationRequest
+		EtcdReplication
+		ReplicationInfo
+		ReplicationInfos
 		PutObjectRequest
 		GetObjectsRequest
 		TagObjectRequest
@@ -79,6 +87,7 @@ import math "math"
 import google_protobuf "github.com/gogo/protobuf/types"
 import google_protobuf1 "github.com/gogo/protobuf/types"
 import google_protobuf2 "github.com/gogo/protobuf/types"
+import google_protobuf3 "github.com/gogo/protobuf/types"
 import _ "github.com/gogo/protobuf/gogoproto"
 import auth "github.com/pachyderm/pachyderm/src/client/auth"
 
@@ -122,29 +131,94 @@ func (x FileType) String() string {
 }
 func (FileType) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{0} }
 
+// Compression is the codec that an object's data is compressed with in
+// object storage
+type Compression int32
+
+const (
+	Compression_UNCOMPRESSED Compression = 0
+	Compression_GZIP         Compression = 1
+	Compression_SNAPPY       Compression = 2
+)
+
+var Compression_name = map[int32]string{
+	0: "UNCOMPRESSED",
+	1: "GZIP",
+	2: "SNAPPY",
+}
+var Compression_value = map[string]int32{
+	"UNCOMPRESSED": 0,
+	"GZIP":         1,
+	"SNAPPY":       2,
+}
+
+func (x Compression) String() string {
+	return proto.EnumName(Compression_name, int32(x))
+}
+func (Compression) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+
 type Delimiter int32
 
 const (
 	Delimiter_NONE Delimiter = 0
 	Delimiter_JSON Delimiter = 1
 	Delimiter_LINE Delimiter = 2
+	// CSV splits a CSV file into records (which may contain quoted newlines),
+	// and repeats the file's header row at the start of every split file
+	Delimiter_CSV Delimiter = 3
+	// SQL splits a SQL dump (as written by pg_dump) into INSERT statements or
+	// the rows of COPY statements, and repeats the dump's schema statements at
+	// the start of every split file
+	Delimiter_SQL Delimiter = 4
 )
 
 var Delimiter_name = map[int32]string{
 	0: "NONE",
 	1: "JSON",
 	2: "LINE",
+	3: "CSV",
+	4: "SQL",
 }
 var Delimiter_value = map[string]int32{
 	"NONE": 0,
 	"JSON": 1,
 	"LINE": 2,
+	"CSV":  3,
+	"SQL":  4,
 }
 
 func (x Delimiter) String() string {
 	return proto.EnumName(Delimiter_name, int32(x))
 }
-func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{1} }
+func (Delimiter) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{2} }
+
+type ReplicationState int32
+
+const (
+	// REPLICATION_STARTING means the replicator hasn't connected to the remote
+	// cluster yet
+	ReplicationState_REPLICATION_STARTING ReplicationState = 0
+	ReplicationState_REPLICATION_RUNNING  ReplicationState = 1
+	// REPLICATION_FAILING means the last attempt to replicate a commit failed
+	// (see 'reason'). The replicator keeps retrying.
+	ReplicationState_REPLICATION_FAILING ReplicationState = 2
+)
+
+var ReplicationState_name = map[int32]string{
+	0: "REPLICATION_STARTING",
+	1: "REPLICATION_RUNNING",
+	2: "REPLICATION_FAILING",
+}
+var ReplicationState_value = map[string]int32{
+	"REPLICATION_STARTING": 0,
+	"REPLICATION_RUNNING":  1,
+	"REPLICATION_FAILING":  2,
+}
+
+func (x ReplicationState) String() string {
+	return proto.EnumName(ReplicationState_name, int32(x))
+}
+func (ReplicationState) EnumDescriptor() ([]byte, []int) { return fileDescriptorPfs, []int{3} }
 
 type Repo struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -333,11 +407,16 @@ func (m *Tag) GetName() string {
 
 // RepoInfo is the main data structure representing a Repo in etcd
 type RepoInfo struct {
-	Repo        *Repo                       `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
-	Created     *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=created" json:"created,omitempty"`
-	SizeBytes   uint64                      `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
-	Description string                      `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Branches    []*Branch                   `protobuf:"bytes,7,rep,name=branches" json:"branches,omitempty"`
+	Repo      *Repo                       `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+	Created   *google_protobuf2.Timestamp `protobuf:"bytes,2,opt,name=created" json:"created,omitempty"`
+	SizeBytes uint64                      `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// physical_size_bytes is the number of bytes that the repo's commits have
+	// added to object storage. Data that's shared with other commits (or
+	// other repos) is only counted once, so this may be smaller than
+	// size_bytes, the logical size of the repo.
+	PhysicalSizeBytes uint64    `protobuf:"varint,8,opt,name=physical_size_bytes,json=physicalSizeBytes,proto3" json:"physical_size_bytes,omitempty"`
+	Description       string    `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Branches          []*Branch `protobuf:"bytes,7,rep,name=branches" json:"branches,omitempty"`
 	// Set by ListRepo and InspectRepo if Pachyderm's auth system is active, but
 	// not stored in etcd. To set a user's auth scope for a repo, use the
 	// Pachyderm Auth API (in src/client/auth/auth.proto)
@@ -356,7 +435,7 @@ func (m *RepoInfo) GetRepo() *Repo {
 	return nil
 }
 
-func (m *RepoInfo) GetCreated() *google_protobuf1.Timestamp {
+func (m *RepoInfo) GetCreated() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Created
 	}
@@ -370,6 +449,13 @@ func (m *RepoInfo) GetSizeBytes() uint64 {
 	return 0
 }
 
+func (m *RepoInfo) GetPhysicalSizeBytes() uint64 {
+	if m != nil {
+		return m.PhysicalSizeBytes
+	}
+	return 0
+}
+
 func (m *RepoInfo) GetDescription() string {
 	if m != nil {
 		return m.Description
@@ -473,9 +559,17 @@ type CommitInfo struct {
 	Description  string                      `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
 	ParentCommit *Commit                     `protobuf:"bytes,2,opt,name=parent_commit,json=parentCommit" json:"parent_commit,omitempty"`
 	ChildCommits []*Commit                   `protobuf:"bytes,11,rep,name=child_commits,json=childCommits" json:"child_commits,omitempty"`
-	Started      *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=started" json:"started,omitempty"`
-	Finished     *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=finished" json:"finished,omitempty"`
+	Started      *google_protobuf2.Timestamp `protobuf:"bytes,3,opt,name=started" json:"started,omitempty"`
+	Finished     *google_protobuf2.Timestamp `protobuf:"bytes,4,opt,name=finished" json:"finished,omitempty"`
 	SizeBytes    uint64                      `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// physical_size_bytes is the number of bytes that this commit added to
+	// object storage, i.e. the size of the chunks written by PutFile that
+	// weren't already stored. Chunks of data that are identical to chunks in
+	// other commits (e.g. the unchanged regions of a large file) aren't stored
+	// again. Commits finished with a tree (e.g. a pipeline's output commits)
+	// were written before they were finished, so their physical size isn't
+	// known and this is 0.
+	PhysicalSizeBytes uint64 `protobuf:"varint,12,opt,name=physical_size_bytes,json=physicalSizeBytes,proto3" json:"physical_size_bytes,omitempty"`
 	// Commits on which this commit is provenant. provenance[i] is a commit in
 	// branch_provenance[i] (a branch name, and one of the branches on which this
 	// commit's branch is provenant)
@@ -523,14 +617,14 @@ func (m *CommitInfo) GetChildCommits() []*Commit {
 	return nil
 }
 
-func (m *CommitInfo) GetStarted() *google_protobuf1.Timestamp {
+func (m *CommitInfo) GetStarted() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Started
 	}
 	return nil
 }
 
-func (m *CommitInfo) GetFinished() *google_protobuf1.Timestamp {
+func (m *CommitInfo) GetFinished() *google_protobuf2.Timestamp {
 	if m != nil {
 		return m.Finished
 	}
@@ -544,6 +638,13 @@ func (m *CommitInfo) GetSizeBytes() uint64 {
 	return 0
 }
 
+func (m *CommitInfo) GetPhysicalSizeBytes() uint64 {
+	if m != nil {
+		return m.PhysicalSizeBytes
+	}
+	return 0
+}
+
 func (m *CommitInfo) GetProvenance() []*Commit {
 	if m != nil {
 		return m.Provenance
@@ -655,8 +756,14 @@ func (m *ByteRange) GetUpper() uint64 {
 }
 
 type BlockRef struct {
-	Block *Block     `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
-	Range *ByteRange `protobuf:"bytes,2,opt,name=range" json:"range,omitempty"`
+	Block *Block `protobuf:"bytes,1,opt,name=block" json:"block,omitempty"`
+	// range is the range of (possibly compressed) bytes in 'block' that hold
+	// the object's data
+	Range       *ByteRange  `protobuf:"bytes,2,opt,name=range" json:"range,omitempty"`
+	Compression Compression `protobuf:"varint,3,opt,name=compression,proto3,enum=pfs.Compression" json:"compression,omitempty"`
+	// size_bytes is the size of the object's data once it's decompressed. It's
+	// only set if 'compression' isn't UNCOMPRESSED.
+	SizeBytes uint64 `protobuf:"varint,4,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
 }
 
 func (m *BlockRef) Reset()                    { *m = BlockRef{} }
@@ -678,6 +785,20 @@ func (m *BlockRef) GetRange() *ByteRange {
 	return nil
 }
 
+func (m *BlockRef) GetCompression() Compression {
+	if m != nil {
+		return m.Compression
+	}
+	return Compression_UNCOMPRESSED
+}
+
+func (m *BlockRef) GetSizeBytes() uint64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
 type ObjectInfo struct {
 	Object   *Object   `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
 	BlockRef *BlockRef `protobuf:"bytes,2,opt,name=block_ref,json=blockRef" json:"block_ref,omitempty"`
@@ -814,6 +935,9 @@ type StartCommitRequest struct {
 	Description string    `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 	Branch      string    `protobuf:"bytes,3,opt,name=branch,proto3" json:"branch,omitempty"`
 	Provenance  []*Commit `protobuf:"bytes,2,rep,name=provenance" json:"provenance,omitempty"`
+	// branch_provenance, if set, must have the same length as provenance, and
+	// branch_provenance[i] is the branch that provenance[i] was read from.
+	BranchProvenance []*Branch `protobuf:"bytes,5,rep,name=branch_provenance,json=branchProvenance" json:"branch_provenance,omitempty"`
 }
 
 func (m *StartCommitRequest) Reset()                    { *m = StartCommitRequest{} }
@@ -849,6 +973,13 @@ func (m *StartCommitRequest) GetProvenance() []*Commit {
 	return nil
 }
 
+func (m *StartCommitRequest) GetBranchProvenance() []*Branch {
+	if m != nil {
+		return m.BranchProvenance
+	}
+	return nil
+}
+
 type BuildCommitRequest struct {
 	Parent     *Commit   `protobuf:"bytes,1,opt,name=parent" json:"parent,omitempty"`
 	Branch     string    `protobuf:"bytes,4,opt,name=branch,proto3" json:"branch,omitempty"`
@@ -1139,6 +1270,32 @@ func (m *DeleteCommitRequest) GetCommit() *Commit {
 	return nil
 }
 
+type SquashCommitsRequest struct {
+	// from and to are the oldest and newest commits (respectively) of the run
+	// of commits being squashed. 'from' must be an ancestor of 'to'
+	From *Commit `protobuf:"bytes,1,opt,name=from" json:"from,omitempty"`
+	To   *Commit `protobuf:"bytes,2,opt,name=to" json:"to,omitempty"`
+}
+
+func (m *SquashCommitsRequest) Reset()                    { *m = SquashCommitsRequest{} }
+func (m *SquashCommitsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SquashCommitsRequest) ProtoMessage()               {}
+func (*SquashCommitsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
+
+func (m *SquashCommitsRequest) GetFrom() *Commit {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *SquashCommitsRequest) GetTo() *Commit {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
 type FlushCommitRequest struct {
 	Commits []*Commit `protobuf:"bytes,1,rep,name=commits" json:"commits,omitempty"`
 	ToRepos []*Repo   `protobuf:"bytes,2,rep,name=to_repos,json=toRepos" json:"to_repos,omitempty"`
@@ -1147,7 +1304,7 @@ type FlushCommitRequest struct {
 func (m *FlushCommitRequest) Reset()                    { *m = FlushCommitRequest{} }
 func (m *FlushCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*FlushCommitRequest) ProtoMessage()               {}
-func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{33} }
+func (*FlushCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
 
 func (m *FlushCommitRequest) GetCommits() []*Commit {
 	if m != nil {
@@ -1173,7 +1330,7 @@ type SubscribeCommitRequest struct {
 func (m *SubscribeCommitRequest) Reset()                    { *m = SubscribeCommitRequest{} }
 func (m *SubscribeCommitRequest) String() string            { return proto.CompactTextString(m) }
 func (*SubscribeCommitRequest) ProtoMessage()               {}
-func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{34} }
+func (*SubscribeCommitRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
 
 func (m *SubscribeCommitRequest) GetRepo() *Repo {
 	if m != nil {
@@ -1205,7 +1362,7 @@ type GetFileRequest struct {
 func (m *GetFileRequest) Reset()                    { *m = GetFileRequest{} }
 func (m *GetFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetFileRequest) ProtoMessage()               {}
-func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{35} }
+func (*GetFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
 
 func (m *GetFileRequest) GetFile() *File {
 	if m != nil {
@@ -1239,7 +1396,7 @@ type OverwriteIndex struct {
 func (m *OverwriteIndex) Reset()                    { *m = OverwriteIndex{} }
 func (m *OverwriteIndex) String() string            { return proto.CompactTextString(m) }
 func (*OverwriteIndex) ProtoMessage()               {}
-func (*OverwriteIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{36} }
+func (*OverwriteIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
 
 func (m *OverwriteIndex) GetIndex() int64 {
 	if m != nil {
@@ -1272,7 +1429,7 @@ type PutFileRequest struct {
 func (m *PutFileRequest) Reset()                    { *m = PutFileRequest{} }
 func (m *PutFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRequest) ProtoMessage()               {}
-func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{37} }
+func (*PutFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
 
 func (m *PutFileRequest) GetFile() *File {
 	if m != nil {
@@ -1340,7 +1497,7 @@ type PutFileRecord struct {
 func (m *PutFileRecord) Reset()                    { *m = PutFileRecord{} }
 func (m *PutFileRecord) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRecord) ProtoMessage()               {}
-func (*PutFileRecord) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{38} }
+func (*PutFileRecord) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
 
 func (m *PutFileRecord) GetSizeBytes() int64 {
 	if m != nil {
@@ -1367,12 +1524,15 @@ type PutFileRecords struct {
 	Split     bool             `protobuf:"varint,1,opt,name=split,proto3" json:"split,omitempty"`
 	Records   []*PutFileRecord `protobuf:"bytes,2,rep,name=records" json:"records,omitempty"`
 	Tombstone bool             `protobuf:"varint,3,opt,name=tombstone,proto3" json:"tombstone,omitempty"`
+	// physical_size_bytes is the number of bytes that writing 'records' added
+	// to object storage
+	PhysicalSizeBytes int64 `protobuf:"varint,4,opt,name=physical_size_bytes,json=physicalSizeBytes,proto3" json:"physical_size_bytes,omitempty"`
 }
 
 func (m *PutFileRecords) Reset()                    { *m = PutFileRecords{} }
 func (m *PutFileRecords) String() string            { return proto.CompactTextString(m) }
 func (*PutFileRecords) ProtoMessage()               {}
-func (*PutFileRecords) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{39} }
+func (*PutFileRecords) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
 
 func (m *PutFileRecords) GetSplit() bool {
 	if m != nil {
@@ -1395,6 +1555,13 @@ func (m *PutFileRecords) GetTombstone() bool {
 	return false
 }
 
+func (m *PutFileRecords) GetPhysicalSizeBytes() int64 {
+	if m != nil {
+		return m.PhysicalSizeBytes
+	}
+	return 0
+}
+
 type CopyFileRequest struct {
 	Src       *File `protobuf:"bytes,1,opt,name=src" json:"src,omitempty"`
 	Dst       *File `protobuf:"bytes,2,opt,name=dst" json:"dst,omitempty"`
@@ -1404,7 +1571,7 @@ type CopyFileRequest struct {
 func (m *CopyFileRequest) Reset()                    { *m = CopyFileRequest{} }
 func (m *CopyFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*CopyFileRequest) ProtoMessage()               {}
-func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{40} }
+func (*CopyFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
 
 func (m *CopyFileRequest) GetSrc() *File {
 	if m != nil {
@@ -1434,7 +1601,7 @@ type InspectFileRequest struct {
 func (m *InspectFileRequest) Reset()                    { *m = InspectFileRequest{} }
 func (m *InspectFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectFileRequest) ProtoMessage()               {}
-func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{41} }
+func (*InspectFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
 
 func (m *InspectFileRequest) GetFile() *File {
 	if m != nil {
@@ -1456,7 +1623,7 @@ type ListFileRequest struct {
 func (m *ListFileRequest) Reset()                    { *m = ListFileRequest{} }
 func (m *ListFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListFileRequest) ProtoMessage()               {}
-func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{42} }
+func (*ListFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
 
 func (m *ListFileRequest) GetFile() *File {
 	if m != nil {
@@ -1480,7 +1647,7 @@ type GlobFileRequest struct {
 func (m *GlobFileRequest) Reset()                    { *m = GlobFileRequest{} }
 func (m *GlobFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*GlobFileRequest) ProtoMessage()               {}
-func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{43} }
+func (*GlobFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
 
 func (m *GlobFileRequest) GetCommit() *Commit {
 	if m != nil {
@@ -1504,7 +1671,7 @@ type FileInfos struct {
 func (m *FileInfos) Reset()                    { *m = FileInfos{} }
 func (m *FileInfos) String() string            { return proto.CompactTextString(m) }
 func (*FileInfos) ProtoMessage()               {}
-func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{44} }
+func (*FileInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
 
 func (m *FileInfos) GetFileInfo() []*FileInfo {
 	if m != nil {
@@ -1524,7 +1691,7 @@ type DiffFileRequest struct {
 func (m *DiffFileRequest) Reset()                    { *m = DiffFileRequest{} }
 func (m *DiffFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*DiffFileRequest) ProtoMessage()               {}
-func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{45} }
+func (*DiffFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
 
 func (m *DiffFileRequest) GetNewFile() *File {
 	if m != nil {
@@ -1555,7 +1722,7 @@ type DiffFileResponse struct {
 func (m *DiffFileResponse) Reset()                    { *m = DiffFileResponse{} }
 func (m *DiffFileResponse) String() string            { return proto.CompactTextString(m) }
 func (*DiffFileResponse) ProtoMessage()               {}
-func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{46} }
+func (*DiffFileResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
 
 func (m *DiffFileResponse) GetNewFiles() []*FileInfo {
 	if m != nil {
@@ -1578,7 +1745,7 @@ type DeleteFileRequest struct {
 func (m *DeleteFileRequest) Reset()                    { *m = DeleteFileRequest{} }
 func (m *DeleteFileRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteFileRequest) ProtoMessage()               {}
-func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{47} }
+func (*DeleteFileRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
 
 func (m *DeleteFileRequest) GetFile() *File {
 	if m != nil {
@@ -1587,6 +1754,291 @@ func (m *DeleteFileRequest) GetFile() *File {
 	return nil
 }
 
+// Replication identifies the continuous replication of a branch to a remote
+// pachd
+type Replication struct {
+	Branch *Branch `protobuf:"bytes,1,opt,name=branch" json:"branch,omitempty"`
+	// remote is the address (host:port) of the pachd that the branch is
+	// replicated to
+	Remote string `protobuf:"bytes,2,opt,name=remote,proto3" json:"remote,omitempty"`
+}
+
+func (m *Replication) Reset()                    { *m = Replication{} }
+func (m *Replication) String() string            { return proto.CompactTextString(m) }
+func (*Replication) ProtoMessage()               {}
+func (*Replication) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+
+func (m *Replication) GetBranch() *Branch {
+	if m != nil {
+		return m.Branch
+	}
+	return nil
+}
+
+func (m *Replication) GetRemote() string {
+	if m != nil {
+		return m.Remote
+	}
+	return ""
+}
+
+type CreateReplicationRequest struct {
+	Replication *Replication `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
+	// remote_repo is the repo in the remote cluster that commits are replicated
+	// to. It defaults to the name of the replicated repo.
+	RemoteRepo string `protobuf:"bytes,2,opt,name=remote_repo,json=remoteRepo,proto3" json:"remote_repo,omitempty"`
+	// remote_auth_token authenticates the replicator with the remote cluster,
+	// if auth is activated there
+	RemoteAuthToken string `protobuf:"bytes,3,opt,name=remote_auth_token,json=remoteAuthToken,proto3" json:"remote_auth_token,omitempty"`
+}
+
+func (m *CreateReplicationRequest) Reset()                    { *m = CreateReplicationRequest{} }
+func (m *CreateReplicationRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateReplicationRequest) ProtoMessage()               {}
+func (*CreateReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
+
+func (m *CreateReplicationRequest) GetReplication() *Replication {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
+func (m *CreateReplicationRequest) GetRemoteRepo() string {
+	if m != nil {
+		return m.RemoteRepo
+	}
+	return ""
+}
+
+func (m *CreateReplicationRequest) GetRemoteAuthToken() string {
+	if m != nil {
+		return m.RemoteAuthToken
+	}
+	return ""
+}
+
+type ListReplicationRequest struct {
+	// repo, if set, restricts the results to replications of branches in repo
+	Repo *Repo `protobuf:"bytes,1,opt,name=repo" json:"repo,omitempty"`
+}
+
+func (m *ListReplicationRequest) Reset()                    { *m = ListReplicationRequest{} }
+func (m *ListReplicationRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListReplicationRequest) ProtoMessage()               {}
+func (*ListReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
+
+func (m *ListReplicationRequest) GetRepo() *Repo {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+type DeleteReplicationRequest struct {
+	Replication *Replication `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
+}
+
+func (m *DeleteReplicationRequest) Reset()                    { *m = DeleteReplicationRequest{} }
+func (m *DeleteReplicationRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteReplicationRequest) ProtoMessage()               {}
+func (*DeleteReplicationRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
+
+func (m *DeleteReplicationRequest) GetReplication() *Replication {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
+// EtcdReplication is how a replication is stored in etcd
+type EtcdReplication struct {
+	Replication     *Replication `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
+	RemoteRepo      string       `protobuf:"bytes,2,opt,name=remote_repo,json=remoteRepo,proto3" json:"remote_repo,omitempty"`
+	RemoteAuthToken string       `protobuf:"bytes,3,opt,name=remote_auth_token,json=remoteAuthToken,proto3" json:"remote_auth_token,omitempty"`
+	// auth_token authenticates the replicator with this cluster, as the user who
+	// created the replication (it's empty if auth isn't activated). Like
+	// remote_auth_token, it's stored unencrypted. It doesn't expire, so it's
+	// revoked when the replication is deleted.
+	AuthToken string                      `protobuf:"bytes,4,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	Created   *google_protobuf2.Timestamp `protobuf:"bytes,5,opt,name=created" json:"created,omitempty"`
+	// creator is the user who created the replication (it's empty if auth isn't
+	// activated). The creator can delete the replication without owning the
+	// repo.
+	Creator string `protobuf:"bytes,6,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *EtcdReplication) Reset()                    { *m = EtcdReplication{} }
+func (m *EtcdReplication) String() string            { return proto.CompactTextString(m) }
+func (*EtcdReplication) ProtoMessage()               {}
+func (*EtcdReplication) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
+
+func (m *EtcdReplication) GetReplication() *Replication {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
+func (m *EtcdReplication) GetRemoteRepo() string {
+	if m != nil {
+		return m.RemoteRepo
+	}
+	return ""
+}
+
+func (m *EtcdReplication) GetRemoteAuthToken() string {
+	if m != nil {
+		return m.RemoteAuthToken
+	}
+	return ""
+}
+
+func (m *EtcdReplication) GetAuthToken() string {
+	if m != nil {
+		return m.AuthToken
+	}
+	return ""
+}
+
+func (m *EtcdReplication) GetCreated() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *EtcdReplication) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+type ReplicationInfo struct {
+	Replication *Replication                `protobuf:"bytes,1,opt,name=replication" json:"replication,omitempty"`
+	RemoteRepo  string                      `protobuf:"bytes,2,opt,name=remote_repo,json=remoteRepo,proto3" json:"remote_repo,omitempty"`
+	Created     *google_protobuf2.Timestamp `protobuf:"bytes,3,opt,name=created" json:"created,omitempty"`
+	State       ReplicationState            `protobuf:"varint,4,opt,name=state,proto3,enum=pfs.ReplicationState" json:"state,omitempty"`
+	Reason      string                      `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// last_replicated is the most recent commit that exists in the remote cluster
+	LastReplicated *Commit `protobuf:"bytes,6,opt,name=last_replicated,json=lastReplicated" json:"last_replicated,omitempty"`
+	// lag_commits is the number of commits on the branch that haven't been
+	// replicated yet, and lag is how long ago the oldest of them was finished
+	LagCommits int64                     `protobuf:"varint,7,opt,name=lag_commits,json=lagCommits,proto3" json:"lag_commits,omitempty"`
+	Lag        *google_protobuf.Duration `protobuf:"bytes,8,opt,name=lag" json:"lag,omitempty"`
+	// The totals shipped to the remote cluster by the current replicator
+	CommitsReplicated uint64                      `protobuf:"varint,9,opt,name=commits_replicated,json=commitsReplicated,proto3" json:"commits_replicated,omitempty"`
+	ObjectsReplicated uint64                      `protobuf:"varint,10,opt,name=objects_replicated,json=objectsReplicated,proto3" json:"objects_replicated,omitempty"`
+	BytesReplicated   uint64                      `protobuf:"varint,11,opt,name=bytes_replicated,json=bytesReplicated,proto3" json:"bytes_replicated,omitempty"`
+	Updated           *google_protobuf2.Timestamp `protobuf:"bytes,12,opt,name=updated" json:"updated,omitempty"`
+}
+
+func (m *ReplicationInfo) Reset()                    { *m = ReplicationInfo{} }
+func (m *ReplicationInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationInfo) ProtoMessage()               {}
+func (*ReplicationInfo) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
+
+func (m *ReplicationInfo) GetReplication() *Replication {
+	if m != nil {
+		return m.Replication
+	}
+	return nil
+}
+
+func (m *ReplicationInfo) GetRemoteRepo() string {
+	if m != nil {
+		return m.RemoteRepo
+	}
+	return ""
+}
+
+func (m *ReplicationInfo) GetCreated() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ReplicationInfo) GetState() ReplicationState {
+	if m != nil {
+		return m.State
+	}
+	return ReplicationState_REPLICATION_STARTING
+}
+
+func (m *ReplicationInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ReplicationInfo) GetLastReplicated() *Commit {
+	if m != nil {
+		return m.LastReplicated
+	}
+	return nil
+}
+
+func (m *ReplicationInfo) GetLagCommits() int64 {
+	if m != nil {
+		return m.LagCommits
+	}
+	return 0
+}
+
+func (m *ReplicationInfo) GetLag() *google_protobuf.Duration {
+	if m != nil {
+		return m.Lag
+	}
+	return nil
+}
+
+func (m *ReplicationInfo) GetCommitsReplicated() uint64 {
+	if m != nil {
+		return m.CommitsReplicated
+	}
+	return 0
+}
+
+func (m *ReplicationInfo) GetObjectsReplicated() uint64 {
+	if m != nil {
+		return m.ObjectsReplicated
+	}
+	return 0
+}
+
+func (m *ReplicationInfo) GetBytesReplicated() uint64 {
+	if m != nil {
+		return m.BytesReplicated
+	}
+	return 0
+}
+
+func (m *ReplicationInfo) GetUpdated() *google_protobuf2.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+type ReplicationInfos struct {
+	ReplicationInfo []*ReplicationInfo `protobuf:"bytes,1,rep,name=replication_info,json=replicationInfo" json:"replication_info,omitempty"`
+}
+
+func (m *ReplicationInfos) Reset()                    { *m = ReplicationInfos{} }
+func (m *ReplicationInfos) String() string            { return proto.CompactTextString(m) }
+func (*ReplicationInfos) ProtoMessage()               {}
+func (*ReplicationInfos) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
+
+func (m *ReplicationInfos) GetReplicationInfo() []*ReplicationInfo {
+	if m != nil {
+		return m.ReplicationInfo
+	}
+	return nil
+}
+
 type PutObjectRequest struct {
 	Value []byte `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Tags  []*Tag `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty"`
@@ -1595,7 +2047,7 @@ type PutObjectRequest struct {
 func (m *PutObjectRequest) Reset()                    { *m = PutObjectRequest{} }
 func (m *PutObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*PutObjectRequest) ProtoMessage()               {}
-func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{48} }
+func (*PutObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
 
 func (m *PutObjectRequest) GetValue() []byte {
 	if m != nil {
@@ -1625,7 +2077,7 @@ type GetObjectsRequest struct {
 func (m *GetObjectsRequest) Reset()                    { *m = GetObjectsRequest{} }
 func (m *GetObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetObjectsRequest) ProtoMessage()               {}
-func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{49} }
+func (*GetObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
 
 func (m *GetObjectsRequest) GetObjects() []*Object {
 	if m != nil {
@@ -1663,7 +2115,7 @@ type TagObjectRequest struct {
 func (m *TagObjectRequest) Reset()                    { *m = TagObjectRequest{} }
 func (m *TagObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*TagObjectRequest) ProtoMessage()               {}
-func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{50} }
+func (*TagObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
 
 func (m *TagObjectRequest) GetObject() *Object {
 	if m != nil {
@@ -1685,7 +2137,7 @@ type ListObjectsRequest struct {
 func (m *ListObjectsRequest) Reset()                    { *m = ListObjectsRequest{} }
 func (m *ListObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListObjectsRequest) ProtoMessage()               {}
-func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{51} }
+func (*ListObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{59} }
 
 type ListTagsRequest struct {
 	Prefix        string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -1695,7 +2147,7 @@ type ListTagsRequest struct {
 func (m *ListTagsRequest) Reset()                    { *m = ListTagsRequest{} }
 func (m *ListTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListTagsRequest) ProtoMessage()               {}
-func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{52} }
+func (*ListTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{60} }
 
 func (m *ListTagsRequest) GetPrefix() string {
 	if m != nil {
@@ -1719,7 +2171,7 @@ type ListTagsResponse struct {
 func (m *ListTagsResponse) Reset()                    { *m = ListTagsResponse{} }
 func (m *ListTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListTagsResponse) ProtoMessage()               {}
-func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{53} }
+func (*ListTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{61} }
 
 func (m *ListTagsResponse) GetTag() *Tag {
 	if m != nil {
@@ -1742,7 +2194,7 @@ type DeleteObjectsRequest struct {
 func (m *DeleteObjectsRequest) Reset()                    { *m = DeleteObjectsRequest{} }
 func (m *DeleteObjectsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectsRequest) ProtoMessage()               {}
-func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{54} }
+func (*DeleteObjectsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{62} }
 
 func (m *DeleteObjectsRequest) GetObjects() []*Object {
 	if m != nil {
@@ -1757,7 +2209,7 @@ type DeleteObjectsResponse struct {
 func (m *DeleteObjectsResponse) Reset()                    { *m = DeleteObjectsResponse{} }
 func (m *DeleteObjectsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteObjectsResponse) ProtoMessage()               {}
-func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{55} }
+func (*DeleteObjectsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{63} }
 
 type DeleteTagsRequest struct {
 	Tags []*Tag `protobuf:"bytes,1,rep,name=tags" json:"tags,omitempty"`
@@ -1766,7 +2218,7 @@ type DeleteTagsRequest struct {
 func (m *DeleteTagsRequest) Reset()                    { *m = DeleteTagsRequest{} }
 func (m *DeleteTagsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteTagsRequest) ProtoMessage()               {}
-func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{56} }
+func (*DeleteTagsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{64} }
 
 func (m *DeleteTagsRequest) GetTags() []*Tag {
 	if m != nil {
@@ -1781,7 +2233,7 @@ type DeleteTagsResponse struct {
 func (m *DeleteTagsResponse) Reset()                    { *m = DeleteTagsResponse{} }
 func (m *DeleteTagsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteTagsResponse) ProtoMessage()               {}
-func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{57} }
+func (*DeleteTagsResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{65} }
 
 type CheckObjectRequest struct {
 	Object *Object `protobuf:"bytes,1,opt,name=object" json:"object,omitempty"`
@@ -1790,7 +2242,7 @@ type CheckObjectRequest struct {
 func (m *CheckObjectRequest) Reset()                    { *m = CheckObjectRequest{} }
 func (m *CheckObjectRequest) String() string            { return proto.CompactTextString(m) }
 func (*CheckObjectRequest) ProtoMessage()               {}
-func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{58} }
+func (*CheckObjectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{66} }
 
 func (m *CheckObjectRequest) GetObject() *Object {
 	if m != nil {
@@ -1806,7 +2258,7 @@ type CheckObjectResponse struct {
 func (m *CheckObjectResponse) Reset()                    { *m = CheckObjectResponse{} }
 func (m *CheckObjectResponse) String() string            { return proto.CompactTextString(m) }
 func (*CheckObjectResponse) ProtoMessage()               {}
-func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{59} }
+func (*CheckObjectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{67} }
 
 func (m *CheckObjectResponse) GetExists() bool {
 	if m != nil {
@@ -1817,12 +2269,18 @@ func (m *CheckObjectResponse) GetExists() bool {
 
 type Objects struct {
 	Objects []*Object `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty"`
+	// sizes holds the size of each object in 'objects' (set by PutObjectSplit)
+	Sizes []int64 `protobuf:"varint,2,rep,packed,name=sizes" json:"sizes,omitempty"`
+	// physical_size_bytes is the number of bytes that were written to object
+	// storage, i.e. the total size of the objects that weren't already stored
+	// (set by PutObjectSplit)
+	PhysicalSizeBytes int64 `protobuf:"varint,3,opt,name=physical_size_bytes,json=physicalSizeBytes,proto3" json:"physical_size_bytes,omitempty"`
 }
 
 func (m *Objects) Reset()                    { *m = Objects{} }
 func (m *Objects) String() string            { return proto.CompactTextString(m) }
 func (*Objects) ProtoMessage()               {}
-func (*Objects) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{60} }
+func (*Objects) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{68} }
 
 func (m *Objects) GetObjects() []*Object {
 	if m != nil {
@@ -1831,6 +2289,20 @@ func (m *Objects) GetObjects() []*Object {
 	return nil
 }
 
+func (m *Objects) GetSizes() []int64 {
+	if m != nil {
+		return m.Sizes
+	}
+	return nil
+}
+
+func (m *Objects) GetPhysicalSizeBytes() int64 {
+	if m != nil {
+		return m.PhysicalSizeBytes
+	}
+	return 0
+}
+
 type ObjectIndex struct {
 	Objects map[string]*BlockRef `protobuf:"bytes,1,rep,name=objects" json:"objects,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
 	Tags    map[string]*Object   `protobuf:"bytes,2,rep,name=tags" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value"`
@@ -1839,7 +2311,7 @@ type ObjectIndex struct {
 func (m *ObjectIndex) Reset()                    { *m = ObjectIndex{} }
 func (m *ObjectIndex) String() string            { return proto.CompactTextString(m) }
 func (*ObjectIndex) ProtoMessage()               {}
-func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{61} }
+func (*ObjectIndex) Descriptor() ([]byte, []int) { return fileDescriptorPfs, []int{69} }
 
 func (m *ObjectIndex) GetObjects() map[string]*BlockRef {
 	if m != nil {
@@ -1889,6 +2361,7 @@ func init() {
 	proto.RegisterType((*ListBranchRequest)(nil), "pfs.ListBranchRequest")
 	proto.RegisterType((*DeleteBranchRequest)(nil), "pfs.DeleteBranchRequest")
 	proto.RegisterType((*DeleteCommitRequest)(nil), "pfs.DeleteCommitRequest")
+	proto.RegisterType((*SquashCommitsRequest)(nil), "pfs.SquashCommitsRequest")
 	proto.RegisterType((*FlushCommitRequest)(nil), "pfs.FlushCommitRequest")
 	proto.RegisterType((*SubscribeCommitRequest)(nil), "pfs.SubscribeCommitRequest")
 	proto.RegisterType((*GetFileRequest)(nil), "pfs.GetFileRequest")
@@ -1904,6 +2377,13 @@ func init() {
 	proto.RegisterType((*DiffFileRequest)(nil), "pfs.DiffFileRequest")
 	proto.RegisterType((*DiffFileResponse)(nil), "pfs.DiffFileResponse")
 	proto.RegisterType((*DeleteFileRequest)(nil), "pfs.DeleteFileRequest")
+	proto.RegisterType((*Replication)(nil), "pfs.Replication")
+	proto.RegisterType((*CreateReplicationRequest)(nil), "pfs.CreateReplicationRequest")
+	proto.RegisterType((*ListReplicationRequest)(nil), "pfs.ListReplicationRequest")
+	proto.RegisterType((*DeleteReplicationRequest)(nil), "pfs.DeleteReplicationRequest")
+	proto.RegisterType((*EtcdReplication)(nil), "pfs.EtcdReplication")
+	proto.RegisterType((*ReplicationInfo)(nil), "pfs.ReplicationInfo")
+	proto.RegisterType((*ReplicationInfos)(nil), "pfs.ReplicationInfos")
 	proto.RegisterType((*PutObjectRequest)(nil), "pfs.PutObjectRequest")
 	proto.RegisterType((*GetObjectsRequest)(nil), "pfs.GetObjectsRequest")
 	proto.RegisterType((*TagObjectRequest)(nil), "pfs.TagObjectRequest")
@@ -1919,7 +2399,9 @@ func init() {
 	proto.RegisterType((*Objects)(nil), "pfs.Objects")
 	proto.RegisterType((*ObjectIndex)(nil), "pfs.ObjectIndex")
 	proto.RegisterEnum("pfs.FileType", FileType_name, FileType_value)
+	proto.RegisterEnum("pfs.Compression", Compression_name, Compression_value)
 	proto.RegisterEnum("pfs.Delimiter", Delimiter_name, Delimiter_value)
+	proto.RegisterEnum("pfs.ReplicationState", ReplicationState_name, ReplicationState_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1936,18 +2418,18 @@ type APIClient interface {
 	// Repo rpcs
 	// CreateRepo creates a new repo.
 	// An error is returned if the repo already exists.
-	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// InspectRepo returns info about a repo.
 	InspectRepo(ctx context.Context, in *InspectRepoRequest, opts ...grpc.CallOption) (*RepoInfo, error)
 	// ListRepo returns info about all repos.
 	ListRepo(ctx context.Context, in *ListRepoRequest, opts ...grpc.CallOption) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
-	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(ctx context.Context, in *StartCommitRequest, opts ...grpc.CallOption) (*Commit, error)
 	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// InspectCommit returns the info about a commit.
 	InspectCommit(ctx context.Context, in *InspectCommitRequest, opts ...grpc.CallOption) (*CommitInfo, error)
 	// ListCommit returns info about all commits. This is deprecated in favor of
@@ -1956,7 +2438,10 @@ type APIClient interface {
 	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
 	ListCommitStream(ctx context.Context, in *ListCommitRequest, opts ...grpc.CallOption) (API_ListCommitStreamClient, error)
 	// DeleteCommit deletes a commit.
-	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// SquashCommits merges a run of finished commits into a single commit, and
+	// returns the resulting commit.
+	SquashCommits(ctx context.Context, in *SquashCommitsRequest, opts ...grpc.CallOption) (*Commit, error)
 	// FlushCommit waits for downstream commits to finish
 	FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error)
 	// SubscribeCommit subscribes for new commits on a given branch
@@ -1964,18 +2449,18 @@ type APIClient interface {
 	// BuildCommit builds a commit that's backed by the given tree
 	BuildCommit(ctx context.Context, in *BuildCommitRequest, opts ...grpc.CallOption) (*Commit, error)
 	// CreateBranch creates a new branch
-	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// InspectBranch returns info about a branch.
 	InspectBranch(ctx context.Context, in *InspectBranchRequest, opts ...grpc.CallOption) (*BranchInfo, error)
 	// ListBranch returns info about the heads of branches.
 	ListBranch(ctx context.Context, in *ListBranchRequest, opts ...grpc.CallOption) (*BranchInfos, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// File rpcs
 	// PutFile writes the specified file to pfs.
 	PutFile(ctx context.Context, opts ...grpc.CallOption) (API_PutFileClient, error)
 	// CopyFile copies the contents of one file to another.
-	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
 	GetFile(ctx context.Context, in *GetFileRequest, opts ...grpc.CallOption) (API_GetFileClient, error)
 	// InspectFile returns info about a file.
@@ -1997,9 +2482,17 @@ type APIClient interface {
 	// DiffFile returns the differences between 2 paths at 2 commits.
 	DiffFile(ctx context.Context, in *DiffFileRequest, opts ...grpc.CallOption) (*DiffFileResponse, error)
 	// DeleteFile deletes a file.
-	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// Replication rpcs
+	// CreateReplication starts replicating a branch's commits to a remote pachd
+	CreateReplication(ctx context.Context, in *CreateReplicationRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
+	// ListReplication returns the status of each replication
+	ListReplication(ctx context.Context, in *ListReplicationRequest, opts ...grpc.CallOption) (*ReplicationInfos, error)
+	// DeleteReplication stops replicating a branch. Commits that have already
+	// been replicated are left in the remote cluster.
+	DeleteReplication(ctx context.Context, in *DeleteReplicationRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	// DeleteAll deletes everything
-	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	DeleteAll(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 }
 
 type aPIClient struct {
@@ -2010,8 +2503,8 @@ func NewAPIClient(cc *grpc.ClientConn) APIClient {
 	return &aPIClient{cc}
 }
 
-func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) CreateRepo(ctx context.Context, in *CreateRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/CreateRepo", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2037,8 +2530,8 @@ func (c *aPIClient) ListRepo(ctx context.Context, in *ListRepoRequest, opts ...g
 	return out, nil
 }
 
-func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteRepo", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2055,8 +2548,8 @@ func (c *aPIClient) StartCommit(ctx context.Context, in *StartCommitRequest, opt
 	return out, nil
 }
 
-func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) FinishCommit(ctx context.Context, in *FinishCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/FinishCommit", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2114,8 +2607,8 @@ func (x *aPIListCommitStreamClient) Recv() (*CommitInfo, error) {
 	return m, nil
 }
 
-func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteCommit", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2123,6 +2616,15 @@ func (c *aPIClient) DeleteCommit(ctx context.Context, in *DeleteCommitRequest, o
 	return out, nil
 }
 
+func (c *aPIClient) SquashCommits(ctx context.Context, in *SquashCommitsRequest, opts ...grpc.CallOption) (*Commit, error) {
+	out := new(Commit)
+	err := grpc.Invoke(ctx, "/pfs.API/SquashCommits", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) FlushCommit(ctx context.Context, in *FlushCommitRequest, opts ...grpc.CallOption) (API_FlushCommitClient, error) {
 	stream, err := grpc.NewClientStream(ctx, &_API_serviceDesc.Streams[1], c.cc, "/pfs.API/FlushCommit", opts...)
 	if err != nil {
@@ -2196,8 +2698,8 @@ func (c *aPIClient) BuildCommit(ctx context.Context, in *BuildCommitRequest, opt
 	return out, nil
 }
 
-func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) CreateBranch(ctx context.Context, in *CreateBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/CreateBranch", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2223,8 +2725,8 @@ func (c *aPIClient) ListBranch(ctx context.Context, in *ListBranchRequest, opts
 	return out, nil
 }
 
-func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) DeleteBranch(ctx context.Context, in *DeleteBranchRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteBranch", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2243,7 +2745,7 @@ func (c *aPIClient) PutFile(ctx context.Context, opts ...grpc.CallOption) (API_P
 
 type API_PutFileClient interface {
 	Send(*PutFileRequest) error
-	CloseAndRecv() (*google_protobuf.Empty, error)
+	CloseAndRecv() (*google_protobuf1.Empty, error)
 	grpc.ClientStream
 }
 
@@ -2255,19 +2757,19 @@ func (x *aPIPutFileClient) Send(m *PutFileRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *aPIPutFileClient) CloseAndRecv() (*google_protobuf.Empty, error) {
+func (x *aPIPutFileClient) CloseAndRecv() (*google_protobuf1.Empty, error) {
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	m := new(google_protobuf.Empty)
+	m := new(google_protobuf1.Empty)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) CopyFile(ctx context.Context, in *CopyFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/CopyFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2291,7 +2793,7 @@ func (c *aPIClient) GetFile(ctx context.Context, in *GetFileRequest, opts ...grp
 }
 
 type API_GetFileClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
@@ -2299,8 +2801,8 @@ type aPIGetFileClient struct {
 	grpc.ClientStream
 }
 
-func (x *aPIGetFileClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *aPIGetFileClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -2407,8 +2909,8 @@ func (c *aPIClient) DiffFile(ctx context.Context, in *DiffFileRequest, opts ...g
 	return out, nil
 }
 
-func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteFile", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2416,8 +2918,35 @@ func (c *aPIClient) DeleteFile(ctx context.Context, in *DeleteFileRequest, opts
 	return out, nil
 }
 
-func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *aPIClient) CreateReplication(ctx context.Context, in *CreateReplicationRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/CreateReplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListReplication(ctx context.Context, in *ListReplicationRequest, opts ...grpc.CallOption) (*ReplicationInfos, error) {
+	out := new(ReplicationInfos)
+	err := grpc.Invoke(ctx, "/pfs.API/ListReplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteReplication(ctx context.Context, in *DeleteReplicationRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
+	err := grpc.Invoke(ctx, "/pfs.API/DeleteReplication", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.API/DeleteAll", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -2431,18 +2960,18 @@ type APIServer interface {
 	// Repo rpcs
 	// CreateRepo creates a new repo.
 	// An error is returned if the repo already exists.
-	CreateRepo(context.Context, *CreateRepoRequest) (*google_protobuf.Empty, error)
+	CreateRepo(context.Context, *CreateRepoRequest) (*google_protobuf1.Empty, error)
 	// InspectRepo returns info about a repo.
 	InspectRepo(context.Context, *InspectRepoRequest) (*RepoInfo, error)
 	// ListRepo returns info about all repos.
 	ListRepo(context.Context, *ListRepoRequest) (*ListRepoResponse, error)
 	// DeleteRepo deletes a repo.
-	DeleteRepo(context.Context, *DeleteRepoRequest) (*google_protobuf.Empty, error)
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*google_protobuf1.Empty, error)
 	// Commit rpcs
 	// StartCommit creates a new write commit from a parent commit.
 	StartCommit(context.Context, *StartCommitRequest) (*Commit, error)
 	// FinishCommit turns a write commit into a read commit.
-	FinishCommit(context.Context, *FinishCommitRequest) (*google_protobuf.Empty, error)
+	FinishCommit(context.Context, *FinishCommitRequest) (*google_protobuf1.Empty, error)
 	// InspectCommit returns the info about a commit.
 	InspectCommit(context.Context, *InspectCommitRequest) (*CommitInfo, error)
 	// ListCommit returns info about all commits. This is deprecated in favor of
@@ -2451,7 +2980,10 @@ type APIServer interface {
 	// ListCommitStream is like ListCommit, but returns its results in a GRPC stream
 	ListCommitStream(*ListCommitRequest, API_ListCommitStreamServer) error
 	// DeleteCommit deletes a commit.
-	DeleteCommit(context.Context, *DeleteCommitRequest) (*google_protobuf.Empty, error)
+	DeleteCommit(context.Context, *DeleteCommitRequest) (*google_protobuf1.Empty, error)
+	// SquashCommits merges a run of finished commits into a single commit, and
+	// returns the resulting commit.
+	SquashCommits(context.Context, *SquashCommitsRequest) (*Commit, error)
 	// FlushCommit waits for downstream commits to finish
 	FlushCommit(*FlushCommitRequest, API_FlushCommitServer) error
 	// SubscribeCommit subscribes for new commits on a given branch
@@ -2459,18 +2991,18 @@ type APIServer interface {
 	// BuildCommit builds a commit that's backed by the given tree
 	BuildCommit(context.Context, *BuildCommitRequest) (*Commit, error)
 	// CreateBranch creates a new branch
-	CreateBranch(context.Context, *CreateBranchRequest) (*google_protobuf.Empty, error)
+	CreateBranch(context.Context, *CreateBranchRequest) (*google_protobuf1.Empty, error)
 	// InspectBranch returns info about a branch.
 	InspectBranch(context.Context, *InspectBranchRequest) (*BranchInfo, error)
 	// ListBranch returns info about the heads of branches.
 	ListBranch(context.Context, *ListBranchRequest) (*BranchInfos, error)
 	// DeleteBranch deletes a branch; note that the commits still exist.
-	DeleteBranch(context.Context, *DeleteBranchRequest) (*google_protobuf.Empty, error)
+	DeleteBranch(context.Context, *DeleteBranchRequest) (*google_protobuf1.Empty, error)
 	// File rpcs
 	// PutFile writes the specified file to pfs.
 	PutFile(API_PutFileServer) error
 	// CopyFile copies the contents of one file to another.
-	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf.Empty, error)
+	CopyFile(context.Context, *CopyFileRequest) (*google_protobuf1.Empty, error)
 	// GetFile returns a byte stream of the contents of the file.
 	GetFile(*GetFileRequest, API_GetFileServer) error
 	// InspectFile returns info about a file.
@@ -2492,9 +3024,17 @@ type APIServer interface {
 	// DiffFile returns the differences between 2 paths at 2 commits.
 	DiffFile(context.Context, *DiffFileRequest) (*DiffFileResponse, error)
 	// DeleteFile deletes a file.
-	DeleteFile(context.Context, *DeleteFileRequest) (*google_protobuf.Empty, error)
+	DeleteFile(context.Context, *DeleteFileRequest) (*google_protobuf1.Empty, error)
+	// Replication rpcs
+	// CreateReplication starts replicating a branch's commits to a remote pachd
+	CreateReplication(context.Context, *CreateReplicationRequest) (*google_protobuf1.Empty, error)
+	// ListReplication returns the status of each replication
+	ListReplication(context.Context, *ListReplicationRequest) (*ReplicationInfos, error)
+	// DeleteReplication stops replicating a branch. Commits that have already
+	// been replicated are left in the remote cluster.
+	DeleteReplication(context.Context, *DeleteReplicationRequest) (*google_protobuf1.Empty, error)
 	// DeleteAll deletes everything
-	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	DeleteAll(context.Context, *google_protobuf1.Empty) (*google_protobuf1.Empty, error)
 }
 
 func RegisterAPIServer(s *grpc.Server, srv APIServer) {
@@ -2684,12 +3224,30 @@ func _API_DeleteCommit_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(FlushCommitRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_SquashCommits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SquashCommitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).FlushCommit(m, &aPIFlushCommitServer{stream})
+	if interceptor == nil {
+		return srv.(APIServer).SquashCommits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/SquashCommits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SquashCommits(ctx, req.(*SquashCommitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_FlushCommit_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FlushCommitRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).FlushCommit(m, &aPIFlushCommitServer{stream})
 }
 
 type API_FlushCommitServer interface {
@@ -2821,7 +3379,7 @@ func _API_PutFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 }
 
 type API_PutFileServer interface {
-	SendAndClose(*google_protobuf.Empty) error
+	SendAndClose(*google_protobuf1.Empty) error
 	Recv() (*PutFileRequest, error)
 	grpc.ServerStream
 }
@@ -2830,7 +3388,7 @@ type aPIPutFileServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIPutFileServer) SendAndClose(m *google_protobuf.Empty) error {
+func (x *aPIPutFileServer) SendAndClose(m *google_protobuf1.Empty) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -2869,7 +3427,7 @@ func _API_GetFile_Handler(srv interface{}, stream grpc.ServerStream) error {
 }
 
 type API_GetFileServer interface {
-	Send(*google_protobuf2.BytesValue) error
+	Send(*google_protobuf3.BytesValue) error
 	grpc.ServerStream
 }
 
@@ -2877,7 +3435,7 @@ type aPIGetFileServer struct {
 	grpc.ServerStream
 }
 
-func (x *aPIGetFileServer) Send(m *google_protobuf2.BytesValue) error {
+func (x *aPIGetFileServer) Send(m *google_protobuf3.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -3013,8 +3571,62 @@ func _API_DeleteFile_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_CreateReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/CreateReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateReplication(ctx, req.(*CreateReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/ListReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListReplication(ctx, req.(*ListReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pfs.API/DeleteReplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteReplication(ctx, req.(*DeleteReplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
+	in := new(google_protobuf1.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3026,7 +3638,7 @@ func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(inter
 		FullMethod: "/pfs.API/DeleteAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).DeleteAll(ctx, req.(*google_protobuf.Empty))
+		return srv.(APIServer).DeleteAll(ctx, req.(*google_protobuf1.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3071,6 +3683,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCommit",
 			Handler:    _API_DeleteCommit_Handler,
 		},
+		{
+			MethodName: "SquashCommits",
+			Handler:    _API_SquashCommits_Handler,
+		},
 		{
 			MethodName: "BuildCommit",
 			Handler:    _API_BuildCommit_Handler,
@@ -3115,6 +3731,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFile",
 			Handler:    _API_DeleteFile_Handler,
 		},
+		{
+			MethodName: "CreateReplication",
+			Handler:    _API_CreateReplication_Handler,
+		},
+		{
+			MethodName: "ListReplication",
+			Handler:    _API_ListReplication_Handler,
+		},
+		{
+			MethodName: "DeleteReplication",
+			Handler:    _API_DeleteReplication_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -3167,7 +3795,7 @@ type ObjectAPIClient interface {
 	PutObjectSplit(ctx context.Context, opts ...grpc.CallOption) (ObjectAPI_PutObjectSplitClient, error)
 	GetObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (ObjectAPI_GetObjectClient, error)
 	GetObjects(ctx context.Context, in *GetObjectsRequest, opts ...grpc.CallOption) (ObjectAPI_GetObjectsClient, error)
-	TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 	InspectObject(ctx context.Context, in *Object, opts ...grpc.CallOption) (*ObjectInfo, error)
 	// CheckObject checks if an object exists in the blob store without
 	// actually reading the object.
@@ -3178,7 +3806,7 @@ type ObjectAPIClient interface {
 	InspectTag(ctx context.Context, in *Tag, opts ...grpc.CallOption) (*ObjectInfo, error)
 	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (ObjectAPI_ListTagsClient, error)
 	DeleteTags(ctx context.Context, in *DeleteTagsRequest, opts ...grpc.CallOption) (*DeleteTagsResponse, error)
-	Compact(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	Compact(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error)
 }
 
 type objectAPIClient struct {
@@ -3273,7 +3901,7 @@ func (c *objectAPIClient) GetObject(ctx context.Context, in *Object, opts ...grp
 }
 
 type ObjectAPI_GetObjectClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
@@ -3281,8 +3909,8 @@ type objectAPIGetObjectClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetObjectClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *objectAPIGetObjectClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -3305,7 +3933,7 @@ func (c *objectAPIClient) GetObjects(ctx context.Context, in *GetObjectsRequest,
 }
 
 type ObjectAPI_GetObjectsClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
@@ -3313,16 +3941,16 @@ type objectAPIGetObjectsClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetObjectsClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *objectAPIGetObjectsClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *objectAPIClient) TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *objectAPIClient) TagObject(ctx context.Context, in *TagObjectRequest, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/TagObject", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -3405,7 +4033,7 @@ func (c *objectAPIClient) GetTag(ctx context.Context, in *Tag, opts ...grpc.Call
 }
 
 type ObjectAPI_GetTagClient interface {
-	Recv() (*google_protobuf2.BytesValue, error)
+	Recv() (*google_protobuf3.BytesValue, error)
 	grpc.ClientStream
 }
 
@@ -3413,8 +4041,8 @@ type objectAPIGetTagClient struct {
 	grpc.ClientStream
 }
 
-func (x *objectAPIGetTagClient) Recv() (*google_protobuf2.BytesValue, error) {
-	m := new(google_protobuf2.BytesValue)
+func (x *objectAPIGetTagClient) Recv() (*google_protobuf3.BytesValue, error) {
+	m := new(google_protobuf3.BytesValue)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -3471,8 +4099,8 @@ func (c *objectAPIClient) DeleteTags(ctx context.Context, in *DeleteTagsRequest,
 	return out, nil
 }
 
-func (c *objectAPIClient) Compact(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
-	out := new(google_protobuf.Empty)
+func (c *objectAPIClient) Compact(ctx context.Context, in *google_protobuf1.Empty, opts ...grpc.CallOption) (*google_protobuf1.Empty, error) {
+	out := new(google_protobuf1.Empty)
 	err := grpc.Invoke(ctx, "/pfs.ObjectAPI/Compact", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
@@ -3487,7 +4115,7 @@ type ObjectAPIServer interface {
 	PutObjectSplit(ObjectAPI_PutObjectSplitServer) error
 	GetObject(*Object, ObjectAPI_GetObjectServer) error
 	GetObjects(*GetObjectsRequest, ObjectAPI_GetObjectsServer) error
-	TagObject(context.Context, *TagObjectRequest) (*google_protobuf.Empty, error)
+	TagObject(context.Context, *TagObjectRequest) (*google_protobuf1.Empty, error)
 	InspectObject(context.Context, *Object) (*ObjectInfo, error)
 	// CheckObject checks if an object exists in the blob store without
 	// actually reading the object.
@@ -3498,7 +4126,7 @@ type ObjectAPIServer interface {
 	InspectTag(context.Context, *Tag) (*ObjectInfo, error)
 	ListTags(*ListTagsRequest, ObjectAPI_ListTagsServer) error
 	DeleteTags(context.Context, *DeleteTagsRequest) (*DeleteTagsResponse, error)
-	Compact(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
+	Compact(context.Context, *google_protobuf1.Empty) (*google_protobuf1.Empty, error)
 }
 
 func RegisterObjectAPIServer(s *grpc.Server, srv ObjectAPIServer) {
@@ -3566,7 +4194,7 @@ func _ObjectAPI_GetObject_Handler(srv interface{}, stream grpc.ServerStream) err
 }
 
 type ObjectAPI_GetObjectServer interface {
-	Send(*google_protobuf2.BytesValue) error
+	Send(*google_protobuf3.BytesValue) error
 	grpc.ServerStream
 }
 
@@ -3574,7 +4202,7 @@ type objectAPIGetObjectServer struct {
 	grpc.ServerStream
 }
 
-func (x *objectAPIGetObjectServer) Send(m *google_protobuf2.BytesValue) error {
+func (x *objectAPIGetObjectServer) Send(m *google_protobuf3.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -3587,7 +4215,7 @@ func _ObjectAPI_GetObjects_Handler(srv interface{}, stream grpc.ServerStream) er
 }
 
 type ObjectAPI_GetObjectsServer interface {
-	Send(*google_protobuf2.BytesValue) error
+	Send(*google_protobuf3.BytesValue) error
 	grpc.ServerStream
 }
 
@@ -3595,7 +4223,7 @@ type objectAPIGetObjectsServer struct {
 	grpc.ServerStream
 }
 
-func (x *objectAPIGetObjectsServer) Send(m *google_protobuf2.BytesValue) error {
+func (x *objectAPIGetObjectsServer) Send(m *google_protobuf3.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -3701,7 +4329,7 @@ func _ObjectAPI_GetTag_Handler(srv interface{}, stream grpc.ServerStream) error
 }
 
 type ObjectAPI_GetTagServer interface {
-	Send(*google_protobuf2.BytesValue) error
+	Send(*google_protobuf3.BytesValue) error
 	grpc.ServerStream
 }
 
@@ -3709,7 +4337,7 @@ type objectAPIGetTagServer struct {
 	grpc.ServerStream
 }
 
-func (x *objectAPIGetTagServer) Send(m *google_protobuf2.BytesValue) error {
+func (x *objectAPIGetTagServer) Send(m *google_protobuf3.BytesValue) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -3771,7 +4399,7 @@ func _ObjectAPI_DeleteTags_Handler(srv interface{}, ctx context.Context, dec fun
 }
 
 func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(google_protobuf.Empty)
+	in := new(google_protobuf1.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -3783,7 +4411,7 @@ func _ObjectAPI_Compact_Handler(srv interface{}, ctx context.Context, dec func(i
 		FullMethod: "/pfs.ObjectAPI/Compact",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ObjectAPIServer).Compact(ctx, req.(*google_protobuf.Empty))
+		return srv.(ObjectAPIServer).Compact(ctx, req.(*google_protobuf1.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4203,6 +4831,11 @@ func (m *RepoInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.PhysicalSizeBytes != 0 {
+		dAtA[i] = 0x40
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PhysicalSizeBytes))
+	}
 	return i, nil
 }
 
@@ -4425,6 +5058,11 @@ func (m *CommitInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.PhysicalSizeBytes != 0 {
+		dAtA[i] = 0x60
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PhysicalSizeBytes))
+	}
 	return i, nil
 }
 
@@ -4562,6 +5200,16 @@ func (m *BlockRef) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n18
 	}
+	if m.Compression != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Compression))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
 	return i, nil
 }
 
@@ -4820,6 +5468,18 @@ func (m *StartCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPfs(dAtA, i, uint64(len(m.Description)))
 		i += copy(dAtA[i:], m.Description)
 	}
+	if len(m.BranchProvenance) > 0 {
+		for _, msg := range m.BranchProvenance {
+			dAtA[i] = 0x2a
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -5238,6 +5898,44 @@ func (m *DeleteCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *SquashCommitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SquashCommitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.From != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
+		n39, err := m.From.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.To != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.To.Size()))
+		n40, err := m.To.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	return i, nil
+}
+
 func (m *FlushCommitRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5299,11 +5997,11 @@ func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
-		n39, err := m.Repo.MarshalTo(dAtA[i:])
+		n41, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n41
 	}
 	if len(m.Branch) > 0 {
 		dAtA[i] = 0x12
@@ -5315,11 +6013,11 @@ func (m *SubscribeCommitRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.From.Size()))
-		n40, err := m.From.MarshalTo(dAtA[i:])
+		n42, err := m.From.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n42
 	}
 	return i, nil
 }
@@ -5343,11 +6041,11 @@ func (m *GetFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n41, err := m.File.MarshalTo(dAtA[i:])
+		n43, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n43
 	}
 	if m.OffsetBytes != 0 {
 		dAtA[i] = 0x10
@@ -5404,11 +6102,11 @@ func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n42, err := m.File.MarshalTo(dAtA[i:])
+		n44, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n44
 	}
 	if len(m.Value) > 0 {
 		dAtA[i] = 0x1a
@@ -5451,11 +6149,11 @@ func (m *PutFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n43, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		n45, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	return i, nil
 }
@@ -5490,11 +6188,11 @@ func (m *PutFileRecord) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.OverwriteIndex.Size()))
-		n44, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
+		n46, err := m.OverwriteIndex.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n46
 	}
 	return i, nil
 }
@@ -5546,6 +6244,11 @@ func (m *PutFileRecords) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.PhysicalSizeBytes != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PhysicalSizeBytes))
+	}
 	return i, nil
 }
 
@@ -5568,21 +6271,21 @@ func (m *CopyFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Src.Size()))
-		n45, err := m.Src.MarshalTo(dAtA[i:])
+		n47, err := m.Src.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	if m.Dst != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Dst.Size()))
-		n46, err := m.Dst.MarshalTo(dAtA[i:])
+		n48, err := m.Dst.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n48
 	}
 	if m.Overwrite {
 		dAtA[i] = 0x18
@@ -5616,11 +6319,11 @@ func (m *InspectFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n47, err := m.File.MarshalTo(dAtA[i:])
+		n49, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n49
 	}
 	return i, nil
 }
@@ -5644,11 +6347,11 @@ func (m *ListFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n48, err := m.File.MarshalTo(dAtA[i:])
+		n50, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n50
 	}
 	if m.Full {
 		dAtA[i] = 0x10
@@ -5682,11 +6385,11 @@ func (m *GlobFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.Commit.Size()))
-		n49, err := m.Commit.MarshalTo(dAtA[i:])
+		n51, err := m.Commit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n51
 	}
 	if len(m.Pattern) > 0 {
 		dAtA[i] = 0x12
@@ -5746,21 +6449,21 @@ func (m *DiffFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.NewFile.Size()))
-		n50, err := m.NewFile.MarshalTo(dAtA[i:])
+		n52, err := m.NewFile.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n52
 	}
 	if m.OldFile != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.OldFile.Size()))
-		n51, err := m.OldFile.MarshalTo(dAtA[i:])
+		n53, err := m.OldFile.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n53
 	}
 	if m.Shallow {
 		dAtA[i] = 0x18
@@ -5836,16 +6539,16 @@ func (m *DeleteFileRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPfs(dAtA, i, uint64(m.File.Size()))
-		n52, err := m.File.MarshalTo(dAtA[i:])
+		n54, err := m.File.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n54
 	}
 	return i, nil
 }
 
-func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *Replication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5855,33 +6558,31 @@ func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *Replication) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Value) > 0 {
+	if m.Branch != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
-		i += copy(dAtA[i:], m.Value)
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
+		i = encodeVarintPfs(dAtA, i, uint64(m.Branch.Size()))
+		n55, err := m.Branch.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n55
+	}
+	if len(m.Remote) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Remote)))
+		i += copy(dAtA[i:], m.Remote)
 	}
 	return i, nil
 }
 
-func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *CreateReplicationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5891,42 +6592,37 @@ func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateReplicationRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
-	}
-	if m.OffsetBytes != 0 {
-		dAtA[i] = 0x10
+	if m.Replication != nil {
+		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+		i = encodeVarintPfs(dAtA, i, uint64(m.Replication.Size()))
+		n56, err := m.Replication.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
 	}
-	if m.SizeBytes != 0 {
-		dAtA[i] = 0x18
+	if len(m.RemoteRepo) > 0 {
+		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RemoteRepo)))
+		i += copy(dAtA[i:], m.RemoteRepo)
 	}
-	if m.TotalSize != 0 {
-		dAtA[i] = 0x20
+	if len(m.RemoteAuthToken) > 0 {
+		dAtA[i] = 0x1a
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RemoteAuthToken)))
+		i += copy(dAtA[i:], m.RemoteAuthToken)
 	}
 	return i, nil
 }
 
-func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListReplicationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5936,37 +6632,25 @@ func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListReplicationRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
+	if m.Repo != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n53, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Repo.Size()))
+		n57, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
-	}
-	if len(m.Tags) > 0 {
-		for _, msg := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
-			n, err := msg.MarshalTo(dAtA[i:])
-			if err != nil {
-				return 0, err
-			}
-			i += n
-		}
+		i += n57
 	}
 	return i, nil
 }
 
-func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *DeleteReplicationRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5976,15 +6660,25 @@ func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteReplicationRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Replication != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Replication.Size()))
+		n58, err := m.Replication.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
 	return i, nil
 }
 
-func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *EtcdReplication) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -5994,31 +6688,59 @@ func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EtcdReplication) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prefix) > 0 {
+	if m.Replication != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
-		i += copy(dAtA[i:], m.Prefix)
+		i = encodeVarintPfs(dAtA, i, uint64(m.Replication.Size()))
+		n59, err := m.Replication.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n59
 	}
-	if m.IncludeObject {
-		dAtA[i] = 0x10
+	if len(m.RemoteRepo) > 0 {
+		dAtA[i] = 0x12
 		i++
-		if m.IncludeObject {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RemoteRepo)))
+		i += copy(dAtA[i:], m.RemoteRepo)
+	}
+	if len(m.RemoteAuthToken) > 0 {
+		dAtA[i] = 0x1a
 		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RemoteAuthToken)))
+		i += copy(dAtA[i:], m.RemoteAuthToken)
 	}
-	return i, nil
-}
-
-func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
+	if len(m.AuthToken) > 0 {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.AuthToken)))
+		i += copy(dAtA[i:], m.AuthToken)
+	}
+	if m.Created != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
+		n60, err := m.Created.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n60
+	}
+	if len(m.Creator) > 0 {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Creator)))
+		i += copy(dAtA[i:], m.Creator)
+	}
+	return i, nil
+}
+
+func (m *ReplicationInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6028,35 +6750,102 @@ func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationInfo) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Tag != nil {
+	if m.Replication != nil {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Tag.Size()))
-		n54, err := m.Tag.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(m.Replication.Size()))
+		n61, err := m.Replication.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n61
 	}
-	if m.Object != nil {
+	if len(m.RemoteRepo) > 0 {
 		dAtA[i] = 0x12
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n55, err := m.Object.MarshalTo(dAtA[i:])
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.RemoteRepo)))
+		i += copy(dAtA[i:], m.RemoteRepo)
+	}
+	if m.Created != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Created.Size()))
+		n62, err := m.Created.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n62
+	}
+	if m.State != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.State))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.LastReplicated != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.LastReplicated.Size()))
+		n63, err := m.LastReplicated.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n63
+	}
+	if m.LagCommits != 0 {
+		dAtA[i] = 0x38
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.LagCommits))
+	}
+	if m.Lag != nil {
+		dAtA[i] = 0x42
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Lag.Size()))
+		n64, err := m.Lag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n64
+	}
+	if m.CommitsReplicated != 0 {
+		dAtA[i] = 0x48
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.CommitsReplicated))
+	}
+	if m.ObjectsReplicated != 0 {
+		dAtA[i] = 0x50
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.ObjectsReplicated))
+	}
+	if m.BytesReplicated != 0 {
+		dAtA[i] = 0x58
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.BytesReplicated))
+	}
+	if m.Updated != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Updated.Size()))
+		n65, err := m.Updated.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n65
 	}
 	return i, nil
 }
 
-func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
+func (m *ReplicationInfos) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6066,13 +6855,13 @@ func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReplicationInfos) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, msg := range m.Objects {
+	if len(m.ReplicationInfo) > 0 {
+		for _, msg := range m.ReplicationInfo {
 			dAtA[i] = 0xa
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
@@ -6086,7 +6875,7 @@ func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
+func (m *PutObjectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6096,15 +6885,33 @@ func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PutObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Value) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Value)))
+		i += copy(dAtA[i:], m.Value)
+	}
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
-func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+func (m *GetObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6114,14 +6921,69 @@ func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.OffsetBytes != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		dAtA[i] = 0x20
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.TotalSize))
+	}
+	return i, nil
+}
+
+func (m *TagObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TagObjectRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n66, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n66
+	}
 	if len(m.Tags) > 0 {
 		for _, msg := range m.Tags {
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 			i++
 			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(dAtA[i:])
@@ -6134,7 +6996,7 @@ func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6144,7 +7006,7 @@ func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6152,7 +7014,7 @@ func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+func (m *ListTagsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6162,25 +7024,31 @@ func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTagsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Object != nil {
+	if len(m.Prefix) > 0 {
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
-		n56, err := m.Object.MarshalTo(dAtA[i:])
-		if err != nil {
-			return 0, err
+		i = encodeVarintPfs(dAtA, i, uint64(len(m.Prefix)))
+		i += copy(dAtA[i:], m.Prefix)
+	}
+	if m.IncludeObject {
+		dAtA[i] = 0x10
+		i++
+		if m.IncludeObject {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i += n56
+		i++
 	}
 	return i, nil
 }
 
-func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+func (m *ListTagsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6190,25 +7058,35 @@ func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ListTagsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if m.Exists {
-		dAtA[i] = 0x8
+	if m.Tag != nil {
+		dAtA[i] = 0xa
 		i++
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+		i = encodeVarintPfs(dAtA, i, uint64(m.Tag.Size()))
+		n67, err := m.Tag.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
 		}
+		i += n67
+	}
+	if m.Object != nil {
+		dAtA[i] = 0x12
 		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n68, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
 	}
 	return i, nil
 }
 
-func (m *Objects) Marshal() (dAtA []byte, err error) {
+func (m *DeleteObjectsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6218,7 +7096,7 @@ func (m *Objects) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteObjectsRequest) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
@@ -6238,7 +7116,7 @@ func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
-func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+func (m *DeleteObjectsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
@@ -6248,47 +7126,222 @@ func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteObjectsResponse) MarshalTo(dAtA []byte) (int, error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for k, _ := range m.Objects {
-			dAtA[i] = 0xa
-			i++
-			v := m.Objects[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
-				msgSize += 1 + sovPfs(uint64(msgSize))
-			}
-			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
-			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+	return i, nil
+}
+
+func (m *DeleteTagsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for _, msg := range m.Tags {
 			dAtA[i] = 0xa
 			i++
-			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
-			i += copy(dAtA[i:], k)
-			if v != nil {
-				dAtA[i] = 0x12
-				i++
-				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n57, err := v.MarshalTo(dAtA[i:])
-				if err != nil {
-					return 0, err
-				}
-				i += n57
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
 			}
+			i += n
 		}
 	}
-	if len(m.Tags) > 0 {
-		for k, _ := range m.Tags {
-			dAtA[i] = 0x12
-			i++
-			v := m.Tags[k]
-			msgSize := 0
-			if v != nil {
-				msgSize = v.Size()
+	return i, nil
+}
+
+func (m *DeleteTagsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteTagsResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	return i, nil
+}
+
+func (m *CheckObjectRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Object != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.Object.Size()))
+		n69, err := m.Object.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	return i, nil
+}
+
+func (m *CheckObjectResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckObjectResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		dAtA[i] = 0x8
+		i++
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
+func (m *Objects) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Objects) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, msg := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Sizes) > 0 {
+		dAtA71 := make([]byte, len(m.Sizes)*10)
+		var j70 int
+		for _, num1 := range m.Sizes {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA71[j70] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j70++
+			}
+			dAtA71[j70] = uint8(num)
+			j70++
+		}
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(j70))
+		i += copy(dAtA[i:], dAtA71[:j70])
+	}
+	if m.PhysicalSizeBytes != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPfs(dAtA, i, uint64(m.PhysicalSizeBytes))
+	}
+	return i, nil
+}
+
+func (m *ObjectIndex) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for k, _ := range m.Objects {
+			dAtA[i] = 0xa
+			i++
+			v := m.Objects[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
+				msgSize += 1 + sovPfs(uint64(msgSize))
+			}
+			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
+			i = encodeVarintPfs(dAtA, i, uint64(mapSize))
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPfs(dAtA, i, uint64(len(k)))
+			i += copy(dAtA[i:], k)
+			if v != nil {
+				dAtA[i] = 0x12
+				i++
+				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
+				n72, err := v.MarshalTo(dAtA[i:])
+				if err != nil {
+					return 0, err
+				}
+				i += n72
+			}
+		}
+	}
+	if len(m.Tags) > 0 {
+		for k, _ := range m.Tags {
+			dAtA[i] = 0x12
+			i++
+			v := m.Tags[k]
+			msgSize := 0
+			if v != nil {
+				msgSize = v.Size()
 				msgSize += 1 + sovPfs(uint64(msgSize))
 			}
 			mapSize := 1 + len(k) + sovPfs(uint64(len(k))) + msgSize
@@ -6301,11 +7354,11 @@ func (m *ObjectIndex) MarshalTo(dAtA []byte) (int, error) {
 				dAtA[i] = 0x12
 				i++
 				i = encodeVarintPfs(dAtA, i, uint64(v.Size()))
-				n58, err := v.MarshalTo(dAtA[i:])
+				n73, err := v.MarshalTo(dAtA[i:])
 				if err != nil {
 					return 0, err
 				}
-				i += n58
+				i += n73
 			}
 		}
 	}
@@ -6465,6 +7518,9 @@ func (m *RepoInfo) Size() (n int) {
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if m.PhysicalSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.PhysicalSizeBytes))
+	}
 	return n
 }
 
@@ -6559,6 +7615,9 @@ func (m *CommitInfo) Size() (n int) {
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if m.PhysicalSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.PhysicalSizeBytes))
+	}
 	return n
 }
 
@@ -6617,6 +7676,12 @@ func (m *BlockRef) Size() (n int) {
 		l = m.Range.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if m.Compression != 0 {
+		n += 1 + sovPfs(uint64(m.Compression))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
 	return n
 }
 
@@ -6716,6 +7781,12 @@ func (m *StartCommitRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
+	if len(m.BranchProvenance) > 0 {
+		for _, e := range m.BranchProvenance {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6881,6 +7952,20 @@ func (m *DeleteCommitRequest) Size() (n int) {
 	return n
 }
 
+func (m *SquashCommitsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.From != nil {
+		l = m.From.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.To != nil {
+		l = m.To.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
 func (m *FlushCommitRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -7008,6 +8093,9 @@ func (m *PutFileRecords) Size() (n int) {
 	if m.Tombstone {
 		n += 2
 	}
+	if m.PhysicalSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.PhysicalSizeBytes))
+	}
 	return n
 }
 
@@ -7122,87 +8210,230 @@ func (m *DeleteFileRequest) Size() (n int) {
 	return n
 }
 
-func (m *PutObjectRequest) Size() (n int) {
+func (m *Replication) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
+	if m.Branch != nil {
+		l = m.Branch.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
+	l = len(m.Remote)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *GetObjectsRequest) Size() (n int) {
+func (m *CreateReplicationRequest) Size() (n int) {
 	var l int
 	_ = l
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
-	if m.OffsetBytes != 0 {
-		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.SizeBytes != 0 {
-		n += 1 + sovPfs(uint64(m.SizeBytes))
+	l = len(m.RemoteRepo)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.TotalSize != 0 {
-		n += 1 + sovPfs(uint64(m.TotalSize))
+	l = len(m.RemoteAuthToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *TagObjectRequest) Size() (n int) {
+func (m *ListReplicationRequest) Size() (n int) {
 	var l int
 	_ = l
-	if m.Object != nil {
-		l = m.Object.Size()
+	if m.Repo != nil {
+		l = m.Repo.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if len(m.Tags) > 0 {
-		for _, e := range m.Tags {
-			l = e.Size()
-			n += 1 + l + sovPfs(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *ListObjectsRequest) Size() (n int) {
+func (m *DeleteReplicationRequest) Size() (n int) {
 	var l int
 	_ = l
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
 	return n
 }
 
-func (m *ListTagsRequest) Size() (n int) {
+func (m *EtcdReplication) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Prefix)
+	if m.Replication != nil {
+		l = m.Replication.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.RemoteRepo)
 	if l > 0 {
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.IncludeObject {
-		n += 2
+	l = len(m.RemoteAuthToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.AuthToken)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
 }
 
-func (m *ListTagsResponse) Size() (n int) {
+func (m *ReplicationInfo) Size() (n int) {
 	var l int
 	_ = l
-	if m.Tag != nil {
-		l = m.Tag.Size()
+	if m.Replication != nil {
+		l = m.Replication.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
-	if m.Object != nil {
-		l = m.Object.Size()
+	l = len(m.RemoteRepo)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Created != nil {
+		l = m.Created.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovPfs(uint64(m.State))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.LastReplicated != nil {
+		l = m.LastReplicated.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.LagCommits != 0 {
+		n += 1 + sovPfs(uint64(m.LagCommits))
+	}
+	if m.Lag != nil {
+		l = m.Lag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.CommitsReplicated != 0 {
+		n += 1 + sovPfs(uint64(m.CommitsReplicated))
+	}
+	if m.ObjectsReplicated != 0 {
+		n += 1 + sovPfs(uint64(m.ObjectsReplicated))
+	}
+	if m.BytesReplicated != 0 {
+		n += 1 + sovPfs(uint64(m.BytesReplicated))
+	}
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplicationInfos) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.ReplicationInfo) > 0 {
+		for _, e := range m.ReplicationInfo {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PutObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	if m.OffsetBytes != 0 {
+		n += 1 + sovPfs(uint64(m.OffsetBytes))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.SizeBytes))
+	}
+	if m.TotalSize != 0 {
+		n += 1 + sovPfs(uint64(m.TotalSize))
+	}
+	return n
+}
+
+func (m *TagObjectRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Object != nil {
+		l = m.Object.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, e := range m.Tags {
+			l = e.Size()
+			n += 1 + l + sovPfs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ListObjectsRequest) Size() (n int) {
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ListTagsRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Prefix)
+	if l > 0 {
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.IncludeObject {
+		n += 2
+	}
+	return n
+}
+
+func (m *ListTagsResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Tag != nil {
+		l = m.Tag.Size()
+		n += 1 + l + sovPfs(uint64(l))
+	}
+	if m.Object != nil {
+		l = m.Object.Size()
 		n += 1 + l + sovPfs(uint64(l))
 	}
 	return n
@@ -7272,6 +8503,16 @@ func (m *Objects) Size() (n int) {
 			n += 1 + l + sovPfs(uint64(l))
 		}
 	}
+	if len(m.Sizes) > 0 {
+		l = 0
+		for _, e := range m.Sizes {
+			l += sovPfs(uint64(e))
+		}
+		n += 1 + sovPfs(uint64(l)) + l
+	}
+	if m.PhysicalSizeBytes != 0 {
+		n += 1 + sovPfs(uint64(m.PhysicalSizeBytes))
+	}
 	return n
 }
 
@@ -8054,7 +9295,953 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Tag) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AuthInfo == nil {
+				m.AuthInfo = &RepoAuthInfo{}
+			}
+			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, &Branch{})
+			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicalSizeBytes", wireType)
+			}
+			m.PhysicalSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhysicalSizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+			}
+			m.AccessLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessLevel |= (auth.Scope(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Commit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lower == nil {
+				m.Lower = &Commit{}
+			}
+			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Upper == nil {
+				m.Upper = &Commit{}
+			}
+			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ParentCommit == nil {
+				m.ParentCommit = &Commit{}
+			}
+			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8064,76 +10251,59 @@ func (m *Object) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
+			m.Subvenance = append(m.Subvenance, &CommitRange{})
+			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchProvenance", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Tag) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BranchProvenance = append(m.BranchProvenance, &Branch{})
+			if err := m.BranchProvenance[len(m.BranchProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Tag: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Tag: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8143,21 +10313,42 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ChildCommits = append(m.ChildCommits, &Commit{})
+			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicalSizeBytes", wireType)
+			}
+			m.PhysicalSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhysicalSizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8179,7 +10370,7 @@ func (m *Tag) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoInfo) Unmarshal(dAtA []byte) error {
+func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8202,15 +10393,15 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8234,18 +10425,18 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
 			}
-			var msglen int
+			m.FileType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8255,25 +10446,11 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.FileType |= (FileType(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Created == nil {
-				m.Created = &google_protobuf1.Timestamp{}
-			}
-			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
@@ -8293,9 +10470,9 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8320,13 +10497,13 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8336,28 +10513,26 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AuthInfo == nil {
-				m.AuthInfo = &RepoAuthInfo{}
-			}
-			if err := m.AuthInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8381,8 +10556,8 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branches = append(m.Branches, &Branch{})
-			if err := m.Branches[len(m.Branches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, &Object{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8407,7 +10582,7 @@ func (m *RepoInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
+func (m *ByteRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8430,17 +10605,17 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAuthInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAuthInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccessLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
 			}
-			m.AccessLevel = 0
+			m.Lower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8450,7 +10625,26 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AccessLevel |= (auth.Scope(b) & 0x7F) << shift
+				m.Lower |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			}
+			m.Upper = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Upper |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8476,7 +10670,7 @@ func (m *RepoAuthInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Commit) Unmarshal(dAtA []byte) error {
+func (m *BlockRef) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8495,19 +10689,52 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Commit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Commit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &Block{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8531,18 +10758,18 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Range == nil {
+				m.Range = &ByteRange{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compression", wireType)
 			}
-			var stringLen uint64
+			m.Compression = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8552,21 +10779,30 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Compression |= (Compression(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -8588,7 +10824,7 @@ func (m *Commit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitRange) Unmarshal(dAtA []byte) error {
+func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8611,15 +10847,15 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8643,16 +10879,16 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lower == nil {
-				m.Lower = &Commit{}
+			if m.Object == nil {
+				m.Object = &Object{}
 			}
-			if err := m.Lower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8676,10 +10912,10 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Upper == nil {
-				m.Upper = &Commit{}
+			if m.BlockRef == nil {
+				m.BlockRef = &BlockRef{}
 			}
-			if err := m.Upper.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8704,7 +10940,7 @@ func (m *CommitRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfo) Unmarshal(dAtA []byte) error {
+func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8727,15 +10963,15 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8759,18 +10995,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8780,30 +11016,26 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ParentCommit == nil {
-				m.ParentCommit = &Commit{}
-			}
-			if err := m.ParentCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -8813,28 +11045,65 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			m.Update = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Started == nil {
-				m.Started = &google_protobuf1.Timestamp{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8858,66 +11127,116 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finished == nil {
-				m.Finished = &google_protobuf1.Timestamp{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
 			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8941,45 +11260,64 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
-			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
+			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 9:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subvenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9003,16 +11341,18 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subvenance = append(m.Subvenance, &CommitRange{})
-			if err := m.Subvenance[len(m.Subvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BranchProvenance", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9022,28 +11362,17 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BranchProvenance = append(m.BranchProvenance, &Branch{})
-			if err := m.BranchProvenance[len(m.BranchProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChildCommits", wireType)
+			m.Force = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9053,23 +11382,12 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChildCommits = append(m.ChildCommits, &Commit{})
-			if err := m.ChildCommits[len(m.ChildCommits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.All = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9091,7 +11409,7 @@ func (m *CommitInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FileInfo) Unmarshal(dAtA []byte) error {
+func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9114,15 +11432,15 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9146,18 +11464,18 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			m.FileType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9167,33 +11485,26 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileType |= (FileType(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Children", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9218,13 +11529,13 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Children = append(m.Children, string(dAtA[iNdEx:postIndex]))
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9234,26 +11545,24 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BranchProvenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9277,8 +11586,8 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &Object{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BranchProvenance = append(m.BranchProvenance, &Branch{})
+			if err := m.BranchProvenance[len(m.BranchProvenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9303,7 +11612,7 @@ func (m *FileInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ByteRange) Unmarshal(dAtA []byte) error {
+func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9326,36 +11635,17 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ByteRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ByteRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lower", wireType)
-			}
-			m.Lower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lower |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upper", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
 			}
-			m.Upper = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9365,64 +11655,28 @@ func (m *ByteRange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Upper |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockRef) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Parent == nil {
+				m.Parent = &Commit{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRef: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRef: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9446,16 +11700,14 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Block == nil {
-				m.Block = &Block{}
-			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Provenance = append(m.Provenance, &Commit{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9479,68 +11731,18 @@ func (m *BlockRef) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Range == nil {
-				m.Range = &ByteRange{}
+			if m.Tree == nil {
+				m.Tree = &Object{}
 			}
-			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Object", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9550,30 +11752,26 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Object == nil {
-				m.Object = &Object{}
-			}
-			if err := m.Object.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9583,24 +11781,20 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BlockRef == nil {
-				m.BlockRef = &BlockRef{}
-			}
-			if err := m.BlockRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9623,7 +11817,7 @@ func (m *ObjectInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9646,15 +11840,15 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9678,18 +11872,47 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
+			}
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -9699,24 +11922,28 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.Tree == nil {
+				m.Tree = &Object{}
+			}
+			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -9733,7 +11960,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Update = bool(v != 0)
+			m.Empty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9755,7 +11982,7 @@ func (m *CreateRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9778,15 +12005,15 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9810,13 +12037,33 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Block = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9838,7 +12085,7 @@ func (m *InspectRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9861,65 +12108,81 @@ func (m *ListRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if iNdEx >= l {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.From == nil {
+				m.From = &Commit{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListRepoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRepoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9943,11 +12206,32 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoInfo = append(m.RepoInfo, &RepoInfo{})
-			if err := m.RepoInfo[len(m.RepoInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.To == nil {
+				m.To = &Commit{}
+			}
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			}
+			m.Number = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Number |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -9969,7 +12253,7 @@ func (m *ListRepoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
+func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9992,15 +12276,15 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRepoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRepoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10017,60 +12301,18 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Force = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.All = bool(v != 0)
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
+			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10092,7 +12334,7 @@ func (m *DeleteRepoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10115,15 +12357,15 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StartCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10147,18 +12389,18 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Head == nil {
+				m.Head = &Commit{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SBranch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10168,28 +12410,26 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SBranch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10199,26 +12439,30 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10228,20 +12472,22 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Provenance = append(m.Provenance, &Branch{})
+			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10264,7 +12510,7 @@ func (m *StartCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10287,15 +12533,15 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BuildCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BuildCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10319,47 +12565,66 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Parent == nil {
-				m.Parent = &Commit{}
+			if m.Branch == nil {
+				m.Branch = &Branch{}
 			}
-			if err := m.Parent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provenance = append(m.Provenance, &Commit{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10383,18 +12648,68 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10404,26 +12719,30 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10433,21 +12752,12 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Force = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10469,7 +12779,7 @@ func (m *BuildCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10492,10 +12802,10 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinishCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinishCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10531,11 +12841,61 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SquashCommitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SquashCommitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SquashCommitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10545,24 +12905,28 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.From == nil {
+				m.From = &Commit{}
+			}
+			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tree", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10586,33 +12950,13 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tree == nil {
-				m.Tree = &Object{}
+			if m.To == nil {
+				m.To = &Commit{}
 			}
-			if err := m.Tree.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Empty", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Empty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10634,7 +12978,7 @@ func (m *FinishCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10657,15 +13001,15 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10689,18 +13033,16 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, &Commit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10710,12 +13052,23 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Block = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToRepos = append(m.ToRepos, &Repo{})
+			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10737,7 +13090,7 @@ func (m *InspectCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10760,10 +13113,10 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10800,6 +13153,35 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
@@ -10832,9 +13214,59 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10858,18 +13290,37 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.To == nil {
-				m.To = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.To.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 4:
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+			}
+			m.OffsetBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
 			}
-			m.Number = 0
+			m.SizeBytes = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10879,7 +13330,7 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= (uint64(b) & 0x7F) << shift
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -10905,7 +13356,7 @@ func (m *ListCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CommitInfos) Unmarshal(dAtA []byte) error {
+func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10928,17 +13379,17 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CommitInfos: wiretype end group for non-group")
+			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CommitInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -10948,23 +13399,11 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Index |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CommitInfo = append(m.CommitInfo, &CommitInfo{})
-			if err := m.CommitInfo[len(m.CommitInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -10986,7 +13425,7 @@ func (m *CommitInfos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11009,15 +13448,15 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11041,18 +13480,18 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Head == nil {
-				m.Head = &Commit{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SBranch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11062,26 +13501,28 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SBranch = string(dAtA[iNdEx:postIndex])
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11091,30 +13532,26 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provenance", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11124,78 +13561,17 @@ func (m *CreateBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Provenance = append(m.Provenance, &Branch{})
-			if err := m.Provenance[len(m.Provenance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: InspectBranchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			m.Recursive = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
 			}
-			var msglen int
+			m.Delimiter = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11205,78 +13581,52 @@ func (m *InspectBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			m.TargetFileDatums = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TargetFileBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListBranchRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11300,10 +13650,10 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11328,7 +13678,7 @@ func (m *ListBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11351,17 +13701,36 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteBranchRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteBranchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11371,30 +13740,26 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Branch == nil {
-				m.Branch = &Branch{}
-			}
-			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ObjectHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Force", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11404,12 +13769,25 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Force = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OverwriteIndex == nil {
+				m.OverwriteIndex = &OverwriteIndex{}
+			}
+			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11431,7 +13809,7 @@ func (m *DeleteBranchRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11454,15 +13832,35 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Split = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11486,13 +13884,50 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
-			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, &PutFileRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstone = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicalSizeBytes", wireType)
+			}
+			m.PhysicalSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhysicalSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11514,7 +13949,7 @@ func (m *DeleteCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11537,15 +13972,15 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FlushCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FlushCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11569,14 +14004,16 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, &Commit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Src == nil {
+				m.Src = &File{}
+			}
+			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToRepos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11600,11 +14037,33 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToRepos = append(m.ToRepos, &Repo{})
-			if err := m.ToRepos[len(m.ToRepos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Dst == nil {
+				m.Dst = &File{}
+			}
+			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overwrite = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11626,7 +14085,7 @@ func (m *FlushCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
+func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11649,15 +14108,15 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubscribeCommitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11681,18 +14140,68 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &Repo{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11702,26 +14211,30 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			if m.File == nil {
+				m.File = &File{}
+			}
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11731,25 +14244,12 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.From == nil {
-				m.From = &Commit{}
-			}
-			if err := m.From.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Full = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11771,7 +14271,7 @@ func (m *SubscribeCommitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
+func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11794,15 +14294,15 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11826,18 +14326,18 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Commit == nil {
+				m.Commit = &Commit{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
 			}
-			m.OffsetBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11847,30 +14347,21 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffsetBytes |= (int64(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Pattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11892,7 +14383,7 @@ func (m *GetFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
+func (m *FileInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11915,17 +14406,17 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OverwriteIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OverwriteIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
 			}
-			m.Index = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -11935,11 +14426,23 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileInfo = append(m.FileInfo, &FileInfo{})
+			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -11961,7 +14464,7 @@ func (m *OverwriteIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11984,15 +14487,15 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12016,18 +14519,18 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.NewFile == nil {
+				m.NewFile = &File{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12037,28 +14540,30 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
+			if m.OldFile == nil {
+				m.OldFile = &File{}
+			}
+			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12068,65 +14573,67 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			m.Shallow = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recursive", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
 			}
-			m.Recursive = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delimiter", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Delimiter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Delimiter |= (Delimiter(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileDatums", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
 			}
-			m.TargetFileDatums = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12136,33 +14643,26 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TargetFileDatums |= (int64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetFileBytes", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
 			}
-			m.TargetFileBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetFileBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 10:
+			m.NewFiles = append(m.NewFiles, &FileInfo{})
+			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12186,10 +14686,8 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
-			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OldFiles = append(m.OldFiles, &FileInfo{})
+			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12214,7 +14712,7 @@ func (m *PutFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
+func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12237,63 +14735,15 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteFileRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
-			}
-			m.SizeBytes = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SizeBytes |= (int64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ObjectHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OverwriteIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12317,10 +14767,10 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OverwriteIndex == nil {
-				m.OverwriteIndex = &OverwriteIndex{}
+			if m.File == nil {
+				m.File = &File{}
 			}
-			if err := m.OverwriteIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12345,7 +14795,7 @@ func (m *PutFileRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
+func (m *Replication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12368,35 +14818,15 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PutFileRecords: wiretype end group for non-group")
+			return fmt.Errorf("proto: Replication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PutFileRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Replication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Split", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPfs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= (int(b) & 0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Split = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12420,16 +14850,18 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, &PutFileRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Branch == nil {
+				m.Branch = &Branch{}
+			}
+			if err := m.Branch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remote", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12439,12 +14871,21 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstone = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12466,7 +14907,7 @@ func (m *PutFileRecords) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
+func (m *CreateReplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12489,15 +14930,15 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CopyFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateReplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CopyFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Src", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12521,18 +14962,18 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Src == nil {
-				m.Src = &File{}
+			if m.Replication == nil {
+				m.Replication = &Replication{}
 			}
-			if err := m.Src.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRepo", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12542,30 +14983,105 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Dst == nil {
-				m.Dst = &File{}
+			m.RemoteRepo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAuthToken", wireType)
 			}
-			if err := m.Dst.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.RemoteAuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Overwrite", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPfs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListReplicationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListReplicationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12575,12 +15091,25 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Overwrite = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &Repo{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12602,7 +15131,7 @@ func (m *CopyFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
+func (m *DeleteReplicationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12625,15 +15154,15 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteReplicationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteReplicationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12657,10 +15186,10 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Replication == nil {
+				m.Replication = &Replication{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12685,7 +15214,7 @@ func (m *InspectFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
+func (m *EtcdReplication) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12708,15 +15237,15 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: EtcdReplication: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EtcdReplication: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12740,18 +15269,18 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
+			if m.Replication == nil {
+				m.Replication = &Replication{}
 			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Full", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRepo", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12761,12 +15290,141 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Full = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteRepo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAuthToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAuthToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -12788,7 +15446,7 @@ func (m *ListFileRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12811,15 +15469,15 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Replication", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12843,16 +15501,16 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Commit == nil {
-				m.Commit = &Commit{}
+			if m.Replication == nil {
+				m.Replication = &Replication{}
 			}
-			if err := m.Commit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Replication.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pattern", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteRepo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12877,63 +15535,65 @@ func (m *GlobFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pattern = string(dAtA[iNdEx:postIndex])
+			m.RemoteRepo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			if skippy < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FileInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
+			if m.Created == nil {
+				m.Created = &google_protobuf2.Timestamp{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.Created.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FileInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FileInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (ReplicationState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -12943,76 +15603,24 @@ func (m *FileInfos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPfs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileInfo = append(m.FileInfo, &FileInfo{})
-			if err := m.FileInfo[len(m.FileInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReplicated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13036,16 +15644,35 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NewFile == nil {
-				m.NewFile = &File{}
+			if m.LastReplicated == nil {
+				m.LastReplicated = &Commit{}
 			}
-			if err := m.NewFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastReplicated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LagCommits", wireType)
+			}
+			m.LagCommits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LagCommits |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lag", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13069,18 +15696,18 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OldFile == nil {
-				m.OldFile = &File{}
+			if m.Lag == nil {
+				m.Lag = &google_protobuf.Duration{}
 			}
-			if err := m.OldFile.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lag.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shallow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitsReplicated", wireType)
 			}
-			var v int
+			m.CommitsReplicated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13090,67 +15717,16 @@ func (m *DiffFileRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				m.CommitsReplicated |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Shallow = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPfs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPfs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPfs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DiffFileResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiffFileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFiles", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsReplicated", wireType)
 			}
-			var msglen int
+			m.ObjectsReplicated = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPfs
@@ -13160,26 +15736,33 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ObjectsReplicated |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPfs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BytesReplicated", wireType)
 			}
-			m.NewFiles = append(m.NewFiles, &FileInfo{})
-			if err := m.NewFiles[len(m.NewFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.BytesReplicated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BytesReplicated |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldFiles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13203,8 +15786,10 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OldFiles = append(m.OldFiles, &FileInfo{})
-			if err := m.OldFiles[len(m.OldFiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Updated == nil {
+				m.Updated = &google_protobuf2.Timestamp{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13229,7 +15814,7 @@ func (m *DiffFileResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
+func (m *ReplicationInfos) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13252,15 +15837,15 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteFileRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReplicationInfos: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteFileRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReplicationInfos: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13284,10 +15869,8 @@ func (m *DeleteFileRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.File == nil {
-				m.File = &File{}
-			}
-			if err := m.File.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReplicationInfo = append(m.ReplicationInfo, &ReplicationInfo{})
+			if err := m.ReplicationInfo[len(m.ReplicationInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14416,6 +16999,87 @@ func (m *Objects) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Sizes = append(m.Sizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPfs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPfs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPfs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Sizes = append(m.Sizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sizes", wireType)
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PhysicalSizeBytes", wireType)
+			}
+			m.PhysicalSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PhysicalSizeBytes |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPfs(dAtA[iNdEx:])
@@ -14841,177 +17505,213 @@ var (
 func init() { proto.RegisterFile("client/pfs/pfs.proto", fileDescriptorPfs) }
 
 var fileDescriptorPfs = []byte{
-	// 2749 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0x14, 0xb9, 0x7c, 0x94, 0x28, 0x6a, 0xc4, 0xc8, 0x0c, 0x15, 0x5b, 0xce, 0xd8,
-	0x69, 0x13, 0x27, 0x95, 0x55, 0x39, 0xa9, 0xbf, 0x62, 0x0b, 0xd6, 0x87, 0x1d, 0x19, 0x86, 0xad,
-	0xae, 0xd4, 0x1c, 0x0a, 0x14, 0xc4, 0x92, 0x1c, 0x52, 0x1b, 0x2f, 0xb9, 0x9b, 0x9d, 0xa5, 0x65,
-	0xe5, 0x1f, 0x68, 0x2f, 0x3d, 0xf5, 0x52, 0xa0, 0x97, 0x02, 0xfd, 0x03, 0x7a, 0xeb, 0xbd, 0xb7,
-	0x02, 0x05, 0x8a, 0xfe, 0x05, 0x45, 0xe1, 0xfe, 0x17, 0x3d, 0xb4, 0xc5, 0x7c, 0xed, 0xce, 0x7e,
-	0x50, 0x94, 0x02, 0xf4, 0x60, 0x73, 0x76, 0xe6, 0xbd, 0x37, 0xef, 0x6b, 0xde, 0xfc, 0xde, 0x08,
-	0x9a, 0x3d, 0xd7, 0x21, 0xe3, 0xf0, 0xb6, 0x3f, 0xa0, 0xec, 0xdf, 0x86, 0x1f, 0x78, 0xa1, 0x87,
-	0x8a, 0xfe, 0x80, 0xb6, 0xd7, 0x86, 0x9e, 0x37, 0x74, 0xc9, 0x6d, 0x3e, 0xd5, 0x9d, 0x0c, 0x6e,
-	0x93, 0x91, 0x1f, 0x9e, 0x09, 0x8a, 0xf6, 0x7a, 0x7a, 0x31, 0x74, 0x46, 0x84, 0x86, 0xf6, 0xc8,
-	0x97, 0x04, 0xd7, 0xd2, 0x04, 0xa7, 0x81, 0xed, 0xfb, 0x24, 0x90, 0x5b, 0xb4, 0x9b, 0x43, 0x6f,
-	0xe8, 0xf1, 0xe1, 0x6d, 0x36, 0x92, 0xb3, 0xab, 0x52, 0x1d, 0x7b, 0x12, 0x9e, 0xf0, 0xff, 0xc4,
-	0x3c, 0x6e, 0x43, 0xc9, 0x22, 0xbe, 0x87, 0x10, 0x94, 0xc6, 0xf6, 0x88, 0xb4, 0x8c, 0xeb, 0xc6,
-	0xc7, 0x55, 0x8b, 0x8f, 0xf1, 0x43, 0x28, 0xef, 0x04, 0xf6, 0xb8, 0x77, 0x82, 0xae, 0x42, 0x29,
-	0x20, 0xbe, 0xc7, 0x57, 0x6b, 0x5b, 0xd5, 0x0d, 0x66, 0x10, 0x63, 0xb3, 0xf8, 0x74, 0xc4, 0x5c,
-	0xd0, 0x98, 0xff, 0x6d, 0x00, 0x08, 0xee, 0x83, 0xf1, 0x20, 0x57, 0x3e, 0x5a, 0x87, 0xd2, 0x09,
-	0xb1, 0xfb, 0x9c, 0xad, 0xb6, 0x55, 0xe3, 0x52, 0x77, 0xbd, 0xd1, 0xc8, 0x09, 0x2d, 0xbe, 0x80,
-	0x3e, 0x05, 0xf0, 0x03, 0xef, 0x0d, 0x19, 0xdb, 0xe3, 0x1e, 0x69, 0x15, 0xaf, 0x17, 0x23, 0x32,
-	0x21, 0xd9, 0xd2, 0x96, 0xd1, 0x0d, 0x28, 0x77, 0xf9, 0x6c, 0xab, 0xa4, 0xc9, 0x93, 0x84, 0x72,
-	0x89, 0x49, 0xa4, 0x93, 0xae, 0x92, 0x38, 0x9f, 0x23, 0x31, 0x5e, 0x46, 0xf7, 0x60, 0xb9, 0xef,
-	0x04, 0xa4, 0x17, 0x76, 0x34, 0x2d, 0xca, 0x59, 0x9e, 0x86, 0xa0, 0x3a, 0x8c, 0x88, 0xf0, 0x36,
-	0xd4, 0x62, 0xdb, 0x29, 0xda, 0x84, 0x9a, 0xd8, 0xbf, 0xe3, 0x8c, 0x07, 0xcc, 0x8b, 0x4c, 0xc4,
-	0x92, 0x26, 0x82, 0x91, 0x59, 0xd0, 0x8d, 0xc6, 0x78, 0x1b, 0x4a, 0x4f, 0x1d, 0x97, 0x1b, 0xd5,
-	0xe3, 0x1e, 0x91, 0xae, 0x4f, 0x38, 0x49, 0x2e, 0x31, 0xdf, 0xfa, 0x76, 0x78, 0xa2, 0xdc, 0xcf,
-	0xc6, 0x78, 0x0d, 0xe6, 0x77, 0x5c, 0xaf, 0xf7, 0x9a, 0x2d, 0x9e, 0xd8, 0xf4, 0x44, 0x39, 0x9e,
-	0x8d, 0xf1, 0x07, 0x50, 0x7e, 0xd5, 0xfd, 0x86, 0xf4, 0xc2, 0xdc, 0xd5, 0xf7, 0xa1, 0x78, 0x6c,
-	0x0f, 0x73, 0x33, 0xe2, 0xbf, 0x06, 0x98, 0x2c, 0xee, 0x3c, 0xa4, 0x33, 0x92, 0xe2, 0x73, 0xa8,
-	0xf4, 0x02, 0x62, 0x87, 0x44, 0x05, 0xb8, 0xbd, 0x21, 0x32, 0x77, 0x43, 0x65, 0xee, 0xc6, 0xb1,
-	0x4a, 0x6d, 0x4b, 0x91, 0xa2, 0xab, 0x00, 0xd4, 0xf9, 0x8e, 0x74, 0xba, 0x67, 0x21, 0xa1, 0xad,
-	0xe2, 0x75, 0xe3, 0xe3, 0x92, 0x55, 0x65, 0x33, 0x3b, 0x6c, 0x02, 0x5d, 0x87, 0x5a, 0x9f, 0xd0,
-	0x5e, 0xe0, 0xf8, 0xa1, 0xe3, 0x8d, 0x5b, 0xf3, 0x5c, 0x37, 0x7d, 0x0a, 0x6d, 0x40, 0x95, 0xa5,
-	0xb7, 0xf0, 0x74, 0x99, 0x6f, 0xbc, 0x1c, 0xa9, 0xf6, 0x64, 0x12, 0x0a, 0x5f, 0x9b, 0xb6, 0x1c,
-	0xa1, 0x1f, 0x82, 0x29, 0xfc, 0x4e, 0x68, 0xab, 0x92, 0x8d, 0x6d, 0xb4, 0xf8, 0xbc, 0x64, 0x96,
-	0x1a, 0xf3, 0xf8, 0x31, 0x2c, 0xe8, 0x82, 0xd0, 0x06, 0x2c, 0xd8, 0xbd, 0x1e, 0xa1, 0xb4, 0xe3,
-	0x92, 0x37, 0xc4, 0xe5, 0xce, 0xa8, 0x6f, 0xd5, 0x36, 0xf8, 0x11, 0x3b, 0xea, 0x79, 0x3e, 0xb1,
-	0x6a, 0x82, 0xe0, 0x05, 0x5b, 0xc7, 0xdb, 0x50, 0x16, 0xd1, 0x9b, 0xe5, 0xbe, 0x55, 0x28, 0x38,
-	0xc2, 0x73, 0xd5, 0x9d, 0xf2, 0xbb, 0x7f, 0xac, 0x17, 0x0e, 0xf6, 0xac, 0x82, 0xd3, 0xc7, 0x47,
-	0x50, 0x93, 0xe1, 0xb7, 0xc7, 0x43, 0x82, 0x3e, 0x84, 0x79, 0xd7, 0x3b, 0x25, 0x41, 0x5e, 0x7e,
-	0x88, 0x15, 0x46, 0x32, 0x61, 0x05, 0x22, 0xef, 0x9c, 0x89, 0x15, 0xfc, 0x9f, 0x22, 0x80, 0x98,
-	0xe1, 0x46, 0x5d, 0x28, 0xeb, 0x36, 0x61, 0xd1, 0xb7, 0x03, 0x32, 0x0e, 0x3b, 0x92, 0x36, 0x47,
-	0xfc, 0x82, 0xa0, 0x90, 0x16, 0x7f, 0x0e, 0x15, 0x1a, 0xda, 0x01, 0xcb, 0x88, 0xe2, 0xec, 0x8c,
-	0x90, 0xa4, 0xe8, 0x27, 0x60, 0x0e, 0x9c, 0xb1, 0x43, 0x4f, 0x48, 0x5f, 0x9e, 0xec, 0xf3, 0xd8,
-	0x22, 0xda, 0x54, 0x26, 0xcd, 0xa7, 0x33, 0x29, 0x59, 0x5b, 0xf4, 0x53, 0x2d, 0x75, 0xd7, 0x6b,
-	0xcb, 0x3a, 0x94, 0xc2, 0x80, 0x90, 0x56, 0x45, 0x33, 0x51, 0x9c, 0x20, 0x8b, 0x2f, 0xa4, 0xf3,
-	0xd2, 0xcc, 0xe6, 0xe5, 0x66, 0xa2, 0xf2, 0x54, 0xf9, 0x7e, 0x0d, 0x7d, 0x3f, 0x16, 0xce, 0x74,
-	0xf9, 0x91, 0x55, 0x43, 0x53, 0x14, 0x72, 0xca, 0x8f, 0xa0, 0x8a, 0xcb, 0x0f, 0x0b, 0x4d, 0xef,
-	0xc4, 0x71, 0xfb, 0x32, 0x32, 0xb4, 0x55, 0xcb, 0x9a, 0xb7, 0xc0, 0x29, 0xc4, 0x07, 0xc5, 0x7f,
-	0x35, 0xc0, 0x64, 0x05, 0x47, 0x1d, 0xec, 0x81, 0xe3, 0x92, 0x44, 0x66, 0xb2, 0x45, 0x8b, 0x4f,
-	0xa3, 0x5b, 0x50, 0x65, 0xbf, 0x9d, 0xf0, 0xcc, 0x17, 0x25, 0xbf, 0xbe, 0xb5, 0x18, 0xd1, 0x1c,
-	0x9f, 0xf9, 0x84, 0x05, 0x41, 0x8c, 0x66, 0x1d, 0xe7, 0x36, 0x98, 0x5c, 0x8d, 0x80, 0x8c, 0x79,
-	0x08, 0xaa, 0x56, 0xf4, 0x1d, 0x95, 0x26, 0xe6, 0xf3, 0x05, 0x51, 0x9a, 0xd0, 0x47, 0x50, 0xf1,
-	0xb8, 0xdb, 0x69, 0xcb, 0xd4, 0x4c, 0x92, 0xa1, 0x50, 0x6b, 0xf8, 0x2e, 0x54, 0x99, 0x7c, 0x71,
-	0x42, 0x9a, 0xfa, 0x09, 0x29, 0xa9, 0x43, 0xd1, 0xd4, 0x0f, 0x45, 0x49, 0x9d, 0x03, 0x0b, 0x4c,
-	0x5e, 0x35, 0x2d, 0x32, 0x40, 0xd7, 0x61, 0xbe, 0xcb, 0xc6, 0xd2, 0x0d, 0x20, 0x5c, 0xce, 0x57,
-	0xc5, 0x02, 0xba, 0x09, 0xf3, 0x01, 0xdb, 0x42, 0x66, 0x7e, 0x5d, 0x50, 0xa8, 0x8d, 0x2d, 0xb1,
-	0x88, 0x7f, 0x01, 0x20, 0xf4, 0x53, 0x47, 0x4b, 0x68, 0x99, 0x38, 0x5a, 0xd2, 0x00, 0xb9, 0xc4,
-	0x3c, 0xcc, 0x77, 0xe8, 0x04, 0x64, 0x20, 0x85, 0x2f, 0x6a, 0xdb, 0x93, 0x81, 0x65, 0x76, 0xe5,
-	0x08, 0x07, 0xb0, 0xbc, 0xcb, 0x6b, 0x27, 0xaf, 0x1d, 0xe4, 0xdb, 0x09, 0xa1, 0x33, 0x6b, 0x4b,
-	0x2a, 0x5b, 0x8b, 0xd9, 0x6c, 0x5d, 0x85, 0xf2, 0xc4, 0xef, 0xdb, 0x21, 0xe1, 0x47, 0xce, 0xb4,
-	0xe4, 0xd7, 0xf3, 0x92, 0x59, 0x68, 0x14, 0xf1, 0x1d, 0x40, 0x07, 0x63, 0xea, 0x33, 0x95, 0x2f,
-	0xbc, 0x29, 0xbe, 0x02, 0x4b, 0x2f, 0x1c, 0xaa, 0x73, 0x3c, 0x2f, 0x99, 0x46, 0xa3, 0x80, 0x1f,
-	0x43, 0x23, 0x5e, 0xa0, 0xbe, 0x37, 0xa6, 0x3c, 0xc7, 0x18, 0x93, 0x7e, 0x5f, 0x2e, 0x46, 0x02,
-	0x45, 0x05, 0x0f, 0xe4, 0x08, 0xff, 0x1c, 0x96, 0xf7, 0x88, 0x4b, 0x2e, 0xe5, 0x81, 0x26, 0xcc,
-	0x0f, 0xbc, 0xa0, 0x27, 0x42, 0x67, 0x5a, 0xe2, 0x03, 0x35, 0xa0, 0x68, 0xbb, 0x2e, 0xf7, 0x87,
-	0x69, 0xb1, 0x21, 0xfe, 0xbd, 0x01, 0xe8, 0x88, 0x15, 0x22, 0x79, 0x6a, 0xa4, 0xf4, 0x1b, 0x50,
-	0x16, 0x95, 0x2d, 0xb7, 0x40, 0x8a, 0xa5, 0x54, 0x85, 0x29, 0x9c, 0x5f, 0x61, 0x56, 0x23, 0xf4,
-	0x22, 0xa2, 0xa1, 0x00, 0x4b, 0x2a, 0x54, 0xa5, 0x4c, 0xa8, 0xf0, 0x1f, 0x0d, 0x40, 0x3b, 0x93,
-	0xe8, 0x2c, 0xff, 0xff, 0x54, 0x54, 0x45, 0xb0, 0x38, 0xad, 0x08, 0xae, 0x26, 0x10, 0x58, 0x6c,
-	0x43, 0x1d, 0x0a, 0x07, 0x7b, 0xf2, 0xae, 0x2e, 0x1c, 0xec, 0xe1, 0xdf, 0x18, 0xb0, 0xf2, 0x94,
-	0x97, 0xe9, 0x8c, 0xca, 0xb3, 0xaf, 0x9d, 0x94, 0x43, 0x0a, 0xd9, 0xdc, 0x9d, 0xa9, 0x67, 0x13,
-	0xe6, 0x39, 0xe2, 0x96, 0xb9, 0x2d, 0x3e, 0xf0, 0x4f, 0xa1, 0x29, 0x93, 0xfa, 0x7b, 0x68, 0xd5,
-	0x54, 0xc5, 0x42, 0xe6, 0x13, 0xff, 0xc0, 0xbf, 0x32, 0x60, 0x99, 0xa5, 0x76, 0x52, 0xe0, 0x8c,
-	0xd4, 0x5c, 0x87, 0xd2, 0x20, 0xf0, 0x46, 0xb9, 0xa8, 0x98, 0x2d, 0xa0, 0x35, 0x28, 0x84, 0x5e,
-	0xc2, 0x3a, 0xb9, 0x5c, 0x08, 0x19, 0x6c, 0x28, 0x8f, 0x27, 0xa3, 0x2e, 0x09, 0xb8, 0x71, 0x25,
-	0x4b, 0x7e, 0x31, 0x44, 0x1a, 0x5f, 0xf0, 0x1c, 0x91, 0x0a, 0xcd, 0xb3, 0x88, 0x34, 0x26, 0xb3,
-	0xa0, 0x17, 0x8d, 0xf1, 0x1f, 0x0c, 0x58, 0x11, 0x85, 0x46, 0x5e, 0x3b, 0xd2, 0x1a, 0x05, 0xe2,
-	0x8d, 0x69, 0x20, 0xfe, 0x7d, 0x30, 0x69, 0x47, 0xe6, 0x85, 0x88, 0x56, 0x85, 0xca, 0xb6, 0xe2,
-	0x46, 0x22, 0xe9, 0xa7, 0x43, 0x76, 0x2d, 0x47, 0x4b, 0xe7, 0x36, 0x01, 0xf8, 0x61, 0x14, 0xc4,
-	0xa4, 0x96, 0xf1, 0x4e, 0xc6, 0xd4, 0x9d, 0xf0, 0x96, 0x88, 0x56, 0x92, 0x73, 0x46, 0x55, 0x3b,
-	0x84, 0x15, 0x51, 0x7c, 0x2e, 0xbf, 0x5f, 0x7e, 0x11, 0xc2, 0x0f, 0x94, 0xc4, 0xcb, 0xa7, 0x21,
-	0xb6, 0x01, 0x3d, 0x75, 0x27, 0xe9, 0x73, 0xf5, 0x11, 0x54, 0x14, 0x10, 0x30, 0xb2, 0x47, 0x5c,
-	0xad, 0xa1, 0x9b, 0x60, 0x86, 0x5e, 0x87, 0x59, 0x45, 0x65, 0x29, 0xd0, 0xac, 0xad, 0x84, 0x1e,
-	0xfb, 0xa5, 0xd8, 0x87, 0xd5, 0xa3, 0x49, 0x97, 0x9d, 0xb6, 0x2e, 0xb9, 0x54, 0x5e, 0xc7, 0xd5,
-	0xa1, 0x90, 0xa8, 0x0e, 0x2a, 0xdf, 0x8b, 0x53, 0xf2, 0x1d, 0x7f, 0x0b, 0xf5, 0x67, 0x24, 0xe4,
-	0x00, 0x24, 0xde, 0xe9, 0x3c, 0x80, 0xf2, 0x21, 0x2c, 0x78, 0x83, 0x01, 0x25, 0xa1, 0x84, 0x1d,
-	0x6c, 0xbf, 0xa2, 0x55, 0x13, 0x73, 0x02, 0x78, 0x64, 0x71, 0x49, 0x51, 0xc3, 0x25, 0xf8, 0x07,
-	0x50, 0x7f, 0xf5, 0x86, 0x04, 0xa7, 0x81, 0x13, 0x92, 0x83, 0x71, 0x9f, 0xbc, 0x65, 0xb1, 0x72,
-	0xd8, 0x80, 0xef, 0x59, 0xb4, 0xc4, 0x07, 0xfe, 0x73, 0x01, 0xea, 0x87, 0x93, 0xcb, 0xe8, 0xd6,
-	0x84, 0xf9, 0x37, 0xb6, 0x3b, 0x11, 0xd5, 0x69, 0xc1, 0x12, 0x1f, 0xec, 0xe2, 0x99, 0x04, 0xae,
-	0x2c, 0x91, 0x6c, 0x88, 0x3e, 0x60, 0x17, 0x60, 0x6f, 0x12, 0x50, 0xe7, 0x0d, 0xe1, 0x6d, 0x8c,
-	0x69, 0xc5, 0x13, 0xe8, 0x33, 0xa8, 0xf6, 0x89, 0xeb, 0x8c, 0x9c, 0x90, 0x04, 0x1c, 0x20, 0xd5,
-	0x25, 0xfa, 0xd8, 0x53, 0xb3, 0x56, 0x4c, 0x80, 0x3e, 0x03, 0x14, 0xda, 0xc1, 0x90, 0x84, 0x1d,
-	0x8e, 0xdb, 0xfa, 0x76, 0x38, 0x19, 0x51, 0x8e, 0x51, 0x8b, 0x56, 0x43, 0xac, 0x30, 0x0d, 0xf7,
-	0xf8, 0x3c, 0xba, 0x05, 0xcb, 0x3a, 0xb5, 0xf0, 0x50, 0x95, 0x13, 0x2f, 0xc5, 0xc4, 0xc2, 0x8d,
-	0x5f, 0xc2, 0x92, 0xa7, 0xfc, 0xd4, 0x11, 0xfe, 0x01, 0x6e, 0xf7, 0x8a, 0xa8, 0xba, 0x09, 0x1f,
-	0x5a, 0x75, 0x2f, 0xf1, 0x2d, 0xc1, 0xc4, 0xaf, 0x0d, 0x58, 0x8c, 0x7c, 0xd8, 0xf3, 0x82, 0x34,
-	0x72, 0x37, 0x52, 0xc1, 0x41, 0xeb, 0x50, 0x13, 0x38, 0xa9, 0xc3, 0xf1, 0xa1, 0xc8, 0x26, 0x10,
-	0x53, 0x5f, 0x31, 0x94, 0x98, 0xa3, 0x55, 0xf1, 0xc2, 0x5a, 0xe1, 0x40, 0x0b, 0x29, 0x53, 0x87,
-	0xb2, 0x98, 0x51, 0xdf, 0x95, 0x27, 0xcf, 0xb4, 0xc4, 0x07, 0xfa, 0x0c, 0x2a, 0x81, 0x20, 0x90,
-	0xa7, 0x05, 0x71, 0xe9, 0x09, 0x5e, 0x4b, 0x91, 0xb0, 0x78, 0x86, 0xde, 0xa8, 0x4b, 0x43, 0x6f,
-	0x4c, 0x24, 0xc0, 0x88, 0x27, 0xb0, 0x03, 0x4b, 0xbb, 0x9e, 0x7f, 0xa6, 0xe7, 0xd1, 0x1a, 0x14,
-	0x69, 0xd0, 0xcb, 0xa6, 0x11, 0x9b, 0x65, 0x8b, 0x7d, 0xaa, 0x3a, 0x2e, 0x7d, 0xb1, 0x4f, 0x43,
-	0xb6, 0x55, 0x64, 0x92, 0xda, 0x2a, 0x9a, 0xd0, 0xb0, 0xdb, 0xc5, 0xb3, 0x16, 0xef, 0x09, 0xec,
-	0x76, 0x89, 0x3c, 0x47, 0x50, 0x1a, 0x4c, 0x5c, 0x57, 0x96, 0x36, 0x3e, 0xc6, 0x87, 0xb0, 0xf4,
-	0xcc, 0xf5, 0xba, 0xba, 0x94, 0x0b, 0x5d, 0xae, 0x2d, 0xa8, 0xf8, 0x76, 0x18, 0x92, 0x40, 0x5d,
-	0xf7, 0xea, 0x93, 0x01, 0x7d, 0xd5, 0xb5, 0xd0, 0xa8, 0x2f, 0xc9, 0x60, 0x46, 0x45, 0x22, 0xfa,
-	0x12, 0x7e, 0x9b, 0x9d, 0xc2, 0xd2, 0x9e, 0x33, 0x18, 0xe8, 0xaa, 0xdc, 0x04, 0x73, 0x4c, 0x4e,
-	0x3b, 0xf9, 0x46, 0x55, 0xc6, 0xe4, 0x94, 0x3f, 0xc8, 0xdc, 0x04, 0xd3, 0x73, 0xfb, 0x82, 0x2a,
-	0xe3, 0xfe, 0x8a, 0xe7, 0xf6, 0x39, 0x55, 0x0b, 0x2a, 0xf4, 0xc4, 0x76, 0x5d, 0xef, 0x54, 0x06,
-	0x40, 0x7d, 0xe2, 0x6f, 0xa0, 0x11, 0x6f, 0x1c, 0x83, 0x5d, 0xb5, 0x33, 0x9d, 0xa2, 0xb8, 0xdc,
-	0x9e, 0x1b, 0xa9, 0xf6, 0x57, 0x79, 0x97, 0xa6, 0x95, 0x4a, 0x50, 0x76, 0x9f, 0x89, 0x9b, 0xe4,
-	0x12, 0x91, 0x7e, 0x0a, 0x8d, 0xc3, 0x49, 0x28, 0xe1, 0x92, 0x64, 0x89, 0x6a, 0x96, 0xa1, 0xd7,
-	0xac, 0x0f, 0xa0, 0x14, 0xda, 0x43, 0xa5, 0x84, 0xc9, 0x05, 0x1d, 0xdb, 0x43, 0x8b, 0xcf, 0xe2,
-	0xdf, 0x19, 0xb0, 0xfc, 0x8c, 0x48, 0x41, 0x54, 0xbb, 0x89, 0x54, 0xff, 0x66, 0x4c, 0xef, 0xdf,
-	0x72, 0x0b, 0x78, 0x69, 0x56, 0x01, 0x4f, 0x34, 0x96, 0x57, 0x01, 0x42, 0x2f, 0xb4, 0xdd, 0x0e,
-	0x9b, 0x92, 0x50, 0xa8, 0xca, 0x67, 0x8e, 0x9c, 0xef, 0x08, 0xfe, 0x19, 0x34, 0x8e, 0xed, 0x61,
-	0xd2, 0xca, 0x0b, 0x75, 0x66, 0xe7, 0x1b, 0xdd, 0x04, 0xc4, 0x8e, 0x49, 0xd2, 0x68, 0x96, 0xf6,
-	0x6c, 0xf6, 0xd8, 0x1e, 0x46, 0x7e, 0x58, 0x85, 0xb2, 0x1f, 0x90, 0x81, 0xf3, 0x56, 0xbe, 0xae,
-	0xc9, 0x2f, 0xf4, 0x11, 0xd4, 0x9d, 0x71, 0xcf, 0x9d, 0xf4, 0x49, 0x47, 0xea, 0x22, 0xce, 0xcf,
-	0xa2, 0x9c, 0x15, 0x92, 0xf1, 0x91, 0xe8, 0x98, 0x84, 0x44, 0x99, 0x44, 0x6d, 0x28, 0x86, 0xf6,
-	0x50, 0xea, 0x1e, 0x2b, 0xc6, 0x26, 0x35, 0xd3, 0x0a, 0x53, 0x4d, 0xc3, 0x8f, 0xa0, 0x29, 0xb2,
-	0xe5, 0x7b, 0xc5, 0x0c, 0x5f, 0x81, 0xf7, 0x52, 0xec, 0x42, 0x31, 0xfc, 0x63, 0x95, 0x85, 0xba,
-	0x03, 0x94, 0x1f, 0x8d, 0x69, 0x7e, 0xd4, 0x59, 0xa4, 0xa0, 0xfb, 0x80, 0x76, 0x4f, 0x48, 0xef,
-	0xf5, 0xe5, 0xc3, 0x86, 0x7f, 0x04, 0x2b, 0x09, 0x56, 0xe9, 0xb3, 0x55, 0x28, 0x93, 0xb7, 0x0e,
-	0x0d, 0xa9, 0xac, 0xec, 0xf2, 0x0b, 0x6f, 0x42, 0x45, 0x5a, 0x71, 0x51, 0xeb, 0x7f, 0x59, 0x80,
-	0x9a, 0xea, 0xf2, 0x19, 0x5c, 0xb8, 0x9b, 0x66, 0xbb, 0xaa, 0xb1, 0x71, 0x12, 0x39, 0xa6, 0xfb,
-	0xe3, 0x30, 0x38, 0x8b, 0x53, 0x7f, 0x23, 0x91, 0x60, 0xed, 0x0c, 0x17, 0xf3, 0x88, 0x60, 0xe1,
-	0x74, 0xed, 0x03, 0x58, 0xd0, 0x05, 0x31, 0x24, 0xf1, 0x9a, 0x9c, 0xc9, 0xb4, 0x62, 0x43, 0x74,
-	0x43, 0x9d, 0xde, 0xdc, 0x87, 0x04, 0xb1, 0xf6, 0xa0, 0x70, 0xcf, 0x68, 0xef, 0x41, 0x35, 0x92,
-	0x9e, 0x23, 0xe7, 0xc3, 0xa4, 0x9c, 0x84, 0x1f, 0x62, 0x29, 0xb7, 0x3e, 0x15, 0x0f, 0x49, 0xfc,
-	0xf5, 0x67, 0x01, 0x4c, 0x6b, 0xff, 0x68, 0xdf, 0xfa, 0x7a, 0x7f, 0xaf, 0x31, 0x87, 0x4c, 0x28,
-	0x3d, 0x3d, 0x78, 0xb1, 0xdf, 0x30, 0x50, 0x05, 0x8a, 0x7b, 0x07, 0x56, 0xa3, 0x70, 0xeb, 0x13,
-	0xa8, 0x46, 0x88, 0x85, 0xad, 0xbf, 0x7c, 0xf5, 0x72, 0x5f, 0x50, 0x3e, 0x3f, 0x7a, 0xf5, 0xb2,
-	0x61, 0xb0, 0xd1, 0x8b, 0x83, 0x97, 0xfb, 0x8d, 0xc2, 0xd6, 0x9f, 0x16, 0xa1, 0xf8, 0xe4, 0xf0,
-	0x00, 0x3d, 0x06, 0x88, 0xdf, 0x3b, 0xd0, 0xaa, 0xb8, 0x2f, 0xd2, 0x0f, 0x20, 0xed, 0xd5, 0xcc,
-	0x13, 0xe1, 0x3e, 0x6f, 0xf2, 0xe6, 0xd0, 0x5d, 0xa8, 0x69, 0x6f, 0x17, 0xe8, 0x0a, 0x17, 0x90,
-	0x7d, 0xcd, 0x68, 0x27, 0x9f, 0x1b, 0xf0, 0x1c, 0xba, 0x0f, 0xa6, 0x7a, 0xa6, 0x40, 0x4d, 0xbe,
-	0x98, 0x7a, 0xce, 0x68, 0xbf, 0x97, 0x9a, 0x95, 0x79, 0x3b, 0xc7, 0x74, 0x8e, 0x5f, 0x28, 0xa4,
-	0xce, 0x99, 0x27, 0x8b, 0x73, 0x74, 0xfe, 0x02, 0x6a, 0xda, 0x23, 0x84, 0xd4, 0x39, 0xfb, 0x2c,
-	0xd1, 0xd6, 0x6f, 0x4f, 0x3c, 0x87, 0x76, 0x60, 0x41, 0x6f, 0xb3, 0x51, 0x4b, 0x16, 0xfb, 0x4c,
-	0xe7, 0x7d, 0xce, 0xd6, 0x8f, 0x60, 0x31, 0xd1, 0x15, 0xa3, 0xf7, 0x75, 0x87, 0x25, 0xa5, 0xa4,
-	0xfb, 0x47, 0x3c, 0x87, 0xee, 0x01, 0xc4, 0x0d, 0xb0, 0xb4, 0x3c, 0xd3, 0x11, 0xb7, 0x1b, 0x29,
-	0x46, 0x8a, 0xe7, 0xd0, 0xb6, 0xa8, 0x71, 0x62, 0xf2, 0x28, 0x0c, 0x88, 0x3d, 0x9a, 0xca, 0x9f,
-	0xdd, 0x78, 0xd3, 0x60, 0xd6, 0xeb, 0x7d, 0x94, 0xb4, 0x3e, 0xa7, 0xb5, 0x3a, 0xc7, 0xfa, 0x87,
-	0x50, 0xd3, 0xfa, 0x29, 0xe9, 0xf8, 0x6c, 0x87, 0x95, 0xaf, 0xc0, 0x2e, 0x2c, 0xa5, 0x3a, 0x25,
-	0xb4, 0x26, 0x22, 0x97, 0xdb, 0x3f, 0xe5, 0x0b, 0xf9, 0x02, 0x6a, 0xda, 0xe3, 0x8e, 0xd4, 0x20,
-	0xfb, 0xdc, 0x93, 0x13, 0x7a, 0xbd, 0x59, 0x97, 0xc6, 0xe7, 0xf4, 0xef, 0x17, 0x0a, 0xbd, 0x14,
-	0x92, 0x08, 0x7d, 0x52, 0x4a, 0xfa, 0x8f, 0x59, 0x71, 0xe8, 0x25, 0x6f, 0x1c, 0xba, 0x24, 0x63,
-	0x23, 0xc5, 0x48, 0x85, 0xf2, 0x7a, 0x4f, 0x9d, 0x88, 0xdc, 0x45, 0x95, 0x7f, 0x00, 0x15, 0x89,
-	0xc4, 0xd1, 0x4a, 0x12, 0x97, 0xcf, 0xe0, 0xfc, 0xd8, 0x40, 0x0f, 0xc0, 0x54, 0x68, 0x5c, 0x9e,
-	0xf4, 0x14, 0x38, 0x3f, 0x67, 0xdf, 0x6d, 0xa8, 0xc8, 0x66, 0x55, 0xee, 0x9b, 0x6c, 0x5d, 0xdb,
-	0x6b, 0x19, 0x4e, 0x0e, 0x58, 0xbe, 0x66, 0xf5, 0x93, 0x07, 0x3c, 0xae, 0x4f, 0x5c, 0x48, 0xa2,
-	0x3e, 0xe9, 0x82, 0x92, 0xa8, 0x0f, 0xcf, 0xa1, 0x2d, 0x51, 0x9f, 0x34, 0xad, 0x53, 0x90, 0xbd,
-	0x5d, 0x4f, 0xb0, 0x50, 0x5e, 0xd3, 0xea, 0x8a, 0x48, 0x1e, 0xb1, 0x7c, 0xce, 0xf4, 0x66, 0x9b,
-	0x06, 0xdb, 0x4e, 0x81, 0x79, 0xc9, 0x94, 0xc2, 0xf6, 0xf9, 0xdb, 0x29, 0xa2, 0xc4, 0x76, 0x69,
-	0xce, 0x9c, 0xed, 0xee, 0x83, 0xa9, 0x70, 0xb3, 0x64, 0x4a, 0xe1, 0x77, 0x59, 0x7d, 0xd3, 0xe0,
-	0x5a, 0xaf, 0xbe, 0x9c, 0x59, 0xaf, 0xbe, 0x17, 0x0b, 0xe9, 0x23, 0x7e, 0x49, 0x91, 0x90, 0x3c,
-	0x71, 0x5d, 0x34, 0x85, 0x6c, 0x3a, 0xfb, 0xd6, 0xdf, 0xca, 0x50, 0x15, 0xd7, 0x24, 0xbb, 0xbe,
-	0xee, 0x40, 0x35, 0xc2, 0xd7, 0xe8, 0x3d, 0x95, 0x99, 0x09, 0x48, 0xd3, 0xd6, 0xaf, 0x56, 0x9e,
-	0x90, 0xf7, 0x79, 0x4b, 0x2a, 0x26, 0x8e, 0x78, 0xf3, 0x39, 0x85, 0x73, 0x41, 0xe3, 0xa4, 0x92,
-	0xb5, 0x1a, 0xc1, 0x70, 0xa4, 0x0b, 0x9e, 0x9d, 0x89, 0xfb, 0x00, 0x31, 0x82, 0x97, 0x7e, 0xcb,
-	0x40, 0xfa, 0xd9, 0x62, 0xbe, 0xe4, 0xb0, 0x22, 0x61, 0x71, 0x1a, 0x7b, 0x9f, 0xe3, 0xfc, 0xdb,
-	0x51, 0x11, 0xca, 0xb3, 0x61, 0x29, 0x81, 0x8f, 0xf8, 0x31, 0xd8, 0x81, 0x9a, 0x06, 0xf5, 0xe4,
-	0xf9, 0xc9, 0xe2, 0xc6, 0x76, 0x2b, 0xbb, 0x10, 0x65, 0xcc, 0x5d, 0xa8, 0x69, 0x38, 0x5e, 0xca,
-	0xc8, 0x22, 0xfb, 0x54, 0xa0, 0x36, 0x0d, 0xf4, 0x15, 0x2c, 0x26, 0x40, 0xb0, 0x2c, 0x99, 0x79,
-	0xb8, 0xba, 0xdd, 0xce, 0x5b, 0x8a, 0x54, 0xb8, 0x03, 0xe5, 0x67, 0x84, 0x21, 0x7c, 0x14, 0x81,
-	0xe3, 0xd9, 0xae, 0xfe, 0x04, 0x40, 0x3a, 0x2b, 0xc9, 0x98, 0xe3, 0xa6, 0x87, 0xa2, 0x5a, 0x30,
-	0xc0, 0xa7, 0x9d, 0x79, 0x0d, 0xa2, 0x6b, 0x68, 0x26, 0x81, 0xc2, 0xd9, 0x3e, 0xdb, 0xea, 0x44,
-	0x71, 0x76, 0xfd, 0x44, 0xe9, 0x02, 0xae, 0x64, 0xe6, 0x23, 0xeb, 0x1e, 0x42, 0x65, 0xd7, 0x1b,
-	0xf9, 0x76, 0x2f, 0xbc, 0xfc, 0x81, 0xda, 0x69, 0xfc, 0xe5, 0xdd, 0x35, 0xe3, 0xef, 0xef, 0xae,
-	0x19, 0xff, 0x7c, 0x77, 0xcd, 0xf8, 0xed, 0xbf, 0xae, 0xcd, 0x75, 0xcb, 0x9c, 0xe6, 0xce, 0xff,
-	0x02, 0x00, 0x00, 0xff, 0xff, 0xe9, 0x2c, 0x43, 0x2f, 0x74, 0x23, 0x00, 0x00,
+	// 3326 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3a, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xd5, 0x1a, 0x0e, 0x9f, 0x87, 0x14, 0x39, 0xba, 0xa2, 0x65, 0x9a, 0x8e, 0x1f, 0x99, 0x24, 0xdf,
+	0x97, 0xc8, 0x89, 0xac, 0x4f, 0x76, 0x3e, 0xc7, 0x76, 0x12, 0x43, 0x2f, 0x2b, 0x34, 0x54, 0x59,
+	0x19, 0x2a, 0x01, 0x1a, 0xa0, 0x25, 0x86, 0xe4, 0x25, 0x35, 0xf1, 0x90, 0xc3, 0xcc, 0x0c, 0xad,
+	0x28, 0xbb, 0xae, 0xda, 0x4d, 0x57, 0xdd, 0x14, 0xe8, 0xae, 0x8f, 0x6d, 0xf3, 0x1b, 0xba, 0x2b,
+	0x50, 0xa0, 0xe8, 0xaa, 0x8b, 0x2e, 0x8a, 0xc2, 0xfd, 0x17, 0x5d, 0x15, 0xf7, 0x35, 0x73, 0xe7,
+	0x41, 0x91, 0x0a, 0x1a, 0x74, 0x91, 0xe8, 0xce, 0x3d, 0x8f, 0x7b, 0xee, 0x79, 0xdd, 0x73, 0x0e,
+	0x0d, 0xf5, 0x9e, 0x6d, 0xe1, 0xb1, 0x7f, 0x77, 0x32, 0xf0, 0xc8, 0x7f, 0x1b, 0x13, 0xd7, 0xf1,
+	0x1d, 0xa4, 0x4e, 0x06, 0x5e, 0xf3, 0xe6, 0xd0, 0x71, 0x86, 0x36, 0xbe, 0x4b, 0xb7, 0xba, 0xd3,
+	0xc1, 0xdd, 0xfe, 0xd4, 0x35, 0x7d, 0xcb, 0x19, 0x33, 0xa4, 0xe6, 0xf5, 0x38, 0x1c, 0x8f, 0x26,
+	0xfe, 0x39, 0x07, 0xde, 0x8a, 0x03, 0x7d, 0x6b, 0x84, 0x3d, 0xdf, 0x1c, 0x4d, 0x38, 0x42, 0x82,
+	0xfb, 0x99, 0x6b, 0x4e, 0x26, 0xd8, 0xe5, 0x22, 0x34, 0xeb, 0x43, 0x67, 0xe8, 0xd0, 0xe5, 0x5d,
+	0xb2, 0xe2, 0xbb, 0x6b, 0x5c, 0x5c, 0x73, 0xea, 0x9f, 0xd2, 0xff, 0xb1, 0x7d, 0xbd, 0x09, 0x59,
+	0x03, 0x4f, 0x1c, 0x84, 0x20, 0x3b, 0x36, 0x47, 0xb8, 0xa1, 0xdc, 0x56, 0xde, 0x2e, 0x19, 0x74,
+	0xad, 0x3f, 0x86, 0xfc, 0x8e, 0x6b, 0x8e, 0x7b, 0xa7, 0xe8, 0x06, 0x64, 0x5d, 0x3c, 0x71, 0x28,
+	0xb4, 0xbc, 0x55, 0xda, 0x20, 0x17, 0x26, 0x64, 0x46, 0xd6, 0x95, 0x89, 0x33, 0x12, 0xf1, 0xbf,
+	0x14, 0x00, 0x46, 0xdd, 0x1a, 0x0f, 0x52, 0xf9, 0xa3, 0x5b, 0x90, 0x3d, 0xc5, 0x66, 0x9f, 0x92,
+	0x95, 0xb7, 0xca, 0x94, 0xeb, 0xae, 0x33, 0x1a, 0x59, 0xbe, 0x41, 0x01, 0xe8, 0x0e, 0xc0, 0xc4,
+	0x75, 0x5e, 0xe2, 0xb1, 0x39, 0xee, 0xe1, 0x86, 0x7a, 0x5b, 0x0d, 0xd0, 0x18, 0x67, 0x43, 0x02,
+	0xa3, 0x37, 0x20, 0xdf, 0xa5, 0xbb, 0x8d, 0xec, 0x6d, 0x25, 0x8e, 0xc8, 0x41, 0x84, 0xa3, 0x37,
+	0xed, 0x0a, 0x8e, 0xb9, 0x14, 0x8e, 0x21, 0x18, 0x7d, 0x00, 0x2b, 0x7d, 0xcb, 0xc5, 0x3d, 0xbf,
+	0x23, 0x49, 0x91, 0x4f, 0xd2, 0x68, 0x0c, 0xeb, 0x38, 0x40, 0xd2, 0x9f, 0x40, 0x39, 0xbc, 0xbb,
+	0x87, 0x36, 0xa1, 0xcc, 0xce, 0xef, 0x58, 0xe3, 0x01, 0xd1, 0x22, 0x61, 0x51, 0x93, 0x58, 0x10,
+	0x34, 0x03, 0xba, 0xc1, 0x5a, 0x7f, 0x02, 0xd9, 0xa7, 0x96, 0x4d, 0x2f, 0xd5, 0xa3, 0x1a, 0xe1,
+	0xaa, 0x8f, 0x28, 0x89, 0x83, 0x88, 0x6e, 0x27, 0xa6, 0x7f, 0x2a, 0xd4, 0x4f, 0xd6, 0xfa, 0x75,
+	0xc8, 0xed, 0xd8, 0x4e, 0xef, 0x05, 0x01, 0x9e, 0x9a, 0xde, 0xa9, 0x50, 0x3c, 0x59, 0xeb, 0xaf,
+	0x41, 0xfe, 0x79, 0xf7, 0x4b, 0xdc, 0xf3, 0x53, 0xa1, 0xd7, 0x40, 0x3d, 0x31, 0x87, 0xa9, 0x1e,
+	0xf1, 0x6d, 0x06, 0x8a, 0xc4, 0xee, 0xd4, 0xa4, 0x73, 0x9c, 0xe2, 0x3e, 0x14, 0x7a, 0x2e, 0x36,
+	0x7d, 0x2c, 0x0c, 0xdc, 0xdc, 0x60, 0x9e, 0xbb, 0x21, 0x3c, 0x77, 0xe3, 0x44, 0xb8, 0xb6, 0x21,
+	0x50, 0xd1, 0x0d, 0x00, 0xcf, 0xfa, 0x06, 0x77, 0xba, 0xe7, 0x3e, 0xf6, 0x1a, 0xea, 0x6d, 0xe5,
+	0xed, 0xac, 0x51, 0x22, 0x3b, 0x3b, 0x64, 0x03, 0xdd, 0x86, 0x72, 0x1f, 0x7b, 0x3d, 0xd7, 0x9a,
+	0x90, 0x78, 0x6a, 0xe4, 0xa8, 0x6c, 0xf2, 0x16, 0xda, 0x80, 0x12, 0x71, 0x6f, 0xa6, 0xe9, 0x3c,
+	0x3d, 0x78, 0x25, 0x10, 0x6d, 0x7b, 0xea, 0x33, 0x5d, 0x17, 0x4d, 0xbe, 0x42, 0xff, 0x0b, 0x45,
+	0xa6, 0x77, 0xec, 0x35, 0x0a, 0x49, 0xdb, 0x06, 0x40, 0xb4, 0x01, 0xab, 0x93, 0xd3, 0x73, 0xcf,
+	0xea, 0x99, 0x76, 0x47, 0x12, 0xb1, 0x48, 0x45, 0x5c, 0x11, 0xa0, 0xb6, 0x10, 0xf5, 0x59, 0xb6,
+	0x98, 0xd5, 0x72, 0xfa, 0xc7, 0x50, 0x91, 0x0f, 0x46, 0x1b, 0x50, 0x31, 0x7b, 0x3d, 0xec, 0x79,
+	0x1d, 0x1b, 0xbf, 0xc4, 0x36, 0x55, 0x5e, 0x75, 0xab, 0xbc, 0x41, 0x43, 0xb2, 0xdd, 0x73, 0x26,
+	0xd8, 0x28, 0x33, 0x84, 0x43, 0x02, 0xd7, 0x9f, 0x40, 0x9e, 0x59, 0x7b, 0x9e, 0xba, 0xd7, 0x20,
+	0x63, 0x31, 0x4d, 0x97, 0x76, 0xf2, 0xaf, 0xfe, 0x7e, 0x2b, 0xd3, 0xda, 0x33, 0x32, 0x56, 0x5f,
+	0x6f, 0x43, 0x99, 0xbb, 0x8b, 0x39, 0x1e, 0x62, 0xf4, 0x3a, 0xe4, 0x6c, 0xe7, 0x0c, 0xbb, 0x69,
+	0xfe, 0xc4, 0x20, 0x04, 0x65, 0x4a, 0x12, 0x4a, 0x5a, 0x5c, 0x32, 0x88, 0xfe, 0xfb, 0x2c, 0x00,
+	0xdb, 0xa1, 0x97, 0x5a, 0xc8, 0x4b, 0x37, 0x61, 0x79, 0x62, 0xba, 0x78, 0xec, 0x77, 0x38, 0x6e,
+	0x0a, 0xfb, 0x0a, 0xc3, 0xe0, 0x37, 0xbe, 0x0f, 0x05, 0xcf, 0x37, 0x5d, 0xe2, 0x41, 0xea, 0x7c,
+	0x0f, 0xe2, 0xa8, 0xe8, 0xff, 0xa1, 0x38, 0xb0, 0xc6, 0x96, 0x77, 0x8a, 0xfb, 0x8d, 0xec, 0x5c,
+	0xb2, 0x00, 0x37, 0xe6, 0x79, 0xb9, 0xb8, 0xe7, 0x45, 0x73, 0x91, 0x9c, 0x05, 0xb8, 0xec, 0x12,
+	0x98, 0x64, 0x36, 0xdf, 0xc5, 0xb8, 0x51, 0x90, 0xae, 0xc8, 0x22, 0xce, 0xa0, 0x80, 0xb8, 0x1f,
+	0x17, 0x93, 0x7e, 0xbc, 0x19, 0xc9, 0x54, 0x25, 0x7a, 0x9e, 0x26, 0x9f, 0x47, 0xcc, 0x19, 0x4f,
+	0x57, 0x3c, 0xcb, 0x48, 0x82, 0x42, 0x4a, 0xba, 0x62, 0x58, 0x61, 0xba, 0x22, 0xa6, 0xe9, 0x9d,
+	0x5a, 0x76, 0x9f, 0x5b, 0xc6, 0x6b, 0x94, 0x93, 0xd7, 0xab, 0x50, 0x0c, 0xf6, 0x31, 0x33, 0x18,
+	0x2a, 0x33, 0x82, 0x41, 0xff, 0x93, 0x02, 0x45, 0x92, 0xd0, 0x44, 0xe2, 0x18, 0x58, 0x36, 0x8e,
+	0x78, 0x32, 0x01, 0x1a, 0x74, 0x1b, 0xad, 0x43, 0x89, 0xfc, 0xed, 0xf8, 0xe7, 0x13, 0xf6, 0xa4,
+	0x54, 0xb7, 0x96, 0x03, 0x9c, 0x93, 0xf3, 0x09, 0x26, 0x46, 0x63, 0xab, 0x79, 0xe9, 0xa2, 0x09,
+	0x45, 0x2a, 0xb6, 0x8b, 0xc7, 0xd4, 0x64, 0x25, 0x23, 0xf8, 0x0e, 0x52, 0x1f, 0xb1, 0x51, 0x85,
+	0xa5, 0x3e, 0xf4, 0x16, 0x14, 0x1c, 0x6a, 0x26, 0x12, 0xd7, 0x6a, 0xdc, 0x74, 0x02, 0xa6, 0x3f,
+	0x80, 0x12, 0xe1, 0xcf, 0x22, 0xaa, 0x2e, 0x47, 0x54, 0x56, 0x04, 0x51, 0x5d, 0x0e, 0xa2, 0xac,
+	0x88, 0x9b, 0xdf, 0x29, 0x50, 0xa4, 0x69, 0xd9, 0xc0, 0x03, 0x74, 0x1b, 0x72, 0x5d, 0xb2, 0xe6,
+	0x7a, 0x00, 0x66, 0x23, 0x0a, 0x65, 0x00, 0xf4, 0x26, 0xe4, 0x5c, 0x72, 0x06, 0x0f, 0x95, 0x2a,
+	0xc3, 0x10, 0x27, 0x1b, 0x0c, 0x88, 0xb6, 0xa0, 0xdc, 0x73, 0x46, 0x13, 0x17, 0x7b, 0x1e, 0xf1,
+	0x25, 0x95, 0x6a, 0x2c, 0x70, 0x15, 0xb1, 0x6f, 0xc8, 0x48, 0x31, 0xbd, 0x65, 0x63, 0x7a, 0xd3,
+	0x7f, 0x04, 0xc0, 0xee, 0x2c, 0xc2, 0x9b, 0xdd, 0x3c, 0x12, 0xde, 0x5c, 0x29, 0x1c, 0x44, 0xac,
+	0x46, 0x85, 0xee, 0xb8, 0x78, 0xc0, 0xe5, 0x5d, 0x96, 0x6e, 0x84, 0x07, 0x46, 0xb1, 0xcb, 0x57,
+	0xba, 0x0b, 0x2b, 0xbb, 0x34, 0xdf, 0xd3, 0xfc, 0x85, 0xbf, 0x9a, 0x62, 0x6f, 0x6e, 0x7e, 0x8b,
+	0x45, 0x8c, 0x9a, 0x8c, 0x98, 0x35, 0xc8, 0x4f, 0x27, 0x7d, 0xd3, 0xc7, 0xf4, 0x3e, 0x45, 0x83,
+	0x7f, 0x3d, 0xcb, 0x16, 0x33, 0x9a, 0xaa, 0xdf, 0x03, 0xd4, 0x1a, 0x7b, 0x13, 0x22, 0xf2, 0xc2,
+	0x87, 0xea, 0x57, 0xa1, 0x76, 0x68, 0x79, 0x32, 0xc5, 0xb3, 0x6c, 0x51, 0xd1, 0x32, 0xfa, 0xc7,
+	0xa0, 0x85, 0x00, 0x6f, 0xe2, 0x8c, 0x3d, 0xea, 0xb7, 0x84, 0x48, 0x7e, 0xe3, 0x97, 0x03, 0x86,
+	0xec, 0xd5, 0x71, 0xf9, 0x4a, 0xff, 0x02, 0x56, 0xf6, 0xb0, 0x8d, 0x2f, 0xa5, 0x81, 0x3a, 0xe4,
+	0x06, 0x8e, 0xdb, 0x63, 0xde, 0x50, 0x34, 0xd8, 0x07, 0xd2, 0x40, 0x35, 0x6d, 0x9b, 0xea, 0xa3,
+	0x68, 0x90, 0xa5, 0xfe, 0x37, 0x05, 0x50, 0x9b, 0x24, 0x43, 0x1e, 0xb9, 0x9c, 0xfb, 0x1b, 0x90,
+	0x67, 0xd9, 0x35, 0x35, 0x49, 0x33, 0x50, 0x2c, 0xcb, 0x65, 0x2e, 0xce, 0x72, 0x6b, 0x41, 0xc5,
+	0xc5, 0xac, 0xc1, 0xbf, 0xe2, 0xa6, 0xca, 0x26, 0x4d, 0x95, 0x9a, 0xaa, 0x72, 0x0b, 0xa4, 0x2a,
+	0xfd, 0x5b, 0x05, 0xd0, 0xce, 0x34, 0xc8, 0x44, 0xdf, 0xdf, 0xe5, 0x44, 0x0a, 0x57, 0x67, 0xa5,
+	0xf0, 0xb5, 0x48, 0xbd, 0x19, 0xde, 0xbe, 0x0a, 0x99, 0xd6, 0x1e, 0xaf, 0x4c, 0x32, 0xad, 0x3d,
+	0xfd, 0x17, 0x0a, 0xac, 0x3e, 0xa5, 0x8f, 0x4c, 0x42, 0xe4, 0xf9, 0x8f, 0x66, 0x4c, 0x95, 0x99,
+	0xa4, 0x2a, 0xe7, 0xca, 0x59, 0x87, 0x1c, 0xed, 0x2f, 0x78, 0x54, 0xb0, 0x0f, 0xfd, 0x53, 0xa8,
+	0xf3, 0x70, 0xf8, 0x0e, 0x52, 0xd5, 0x45, 0xe6, 0xe2, 0x9e, 0x48, 0x3f, 0xf4, 0x9f, 0x29, 0xb0,
+	0x42, 0x82, 0x22, 0xca, 0x70, 0x8e, 0x53, 0xdf, 0x82, 0xec, 0xc0, 0x75, 0x46, 0xa9, 0x3d, 0x00,
+	0x01, 0xa0, 0xeb, 0x90, 0xf1, 0x9d, 0x86, 0x9a, 0x04, 0x67, 0x7c, 0x52, 0xf4, 0xe4, 0xc7, 0xd3,
+	0x51, 0x17, 0xbb, 0x3c, 0x85, 0xf1, 0x2f, 0x52, 0x7f, 0x87, 0xe5, 0x09, 0xad, 0xbf, 0x99, 0xe4,
+	0xc9, 0xfa, 0x3b, 0x44, 0x33, 0xa0, 0x17, 0xac, 0xf5, 0xdf, 0x28, 0xb0, 0xca, 0x52, 0x14, 0xf7,
+	0x44, 0x7e, 0x1b, 0xd1, 0xb2, 0x28, 0xb3, 0x5a, 0x96, 0x6b, 0x50, 0xf4, 0x3a, 0xdc, 0x2f, 0x98,
+	0xb5, 0x0a, 0x1e, 0x63, 0x21, 0x35, 0x28, 0xea, 0x85, 0x0d, 0x8a, 0xe4, 0xa3, 0xd9, 0x0b, 0x5b,
+	0x1e, 0xfd, 0x71, 0x60, 0xc4, 0xa8, 0x94, 0xe1, 0x49, 0xca, 0xcc, 0x93, 0xf4, 0x2d, 0x66, 0xad,
+	0x28, 0xe5, 0x9c, 0x7c, 0x78, 0x0c, 0xab, 0x2c, 0x6d, 0x5d, 0xfe, 0xbc, 0xf4, 0xf4, 0xa5, 0x3f,
+	0x12, 0x1c, 0x2f, 0xef, 0x86, 0xfa, 0x09, 0xd4, 0xdb, 0x5f, 0x4d, 0x4d, 0x11, 0x58, 0x9e, 0x64,
+	0x24, 0xea, 0x53, 0xca, 0xc5, 0x3e, 0x95, 0x49, 0xf5, 0x29, 0xdd, 0x04, 0xf4, 0xd4, 0x9e, 0xc6,
+	0xa3, 0xf5, 0x2d, 0x28, 0x88, 0xe2, 0x48, 0x49, 0x26, 0x0e, 0x01, 0x43, 0x6f, 0x42, 0xd1, 0x77,
+	0x3a, 0x44, 0x57, 0x1e, 0x4f, 0x30, 0x92, 0x0e, 0x0b, 0xbe, 0x43, 0xfe, 0x7a, 0xfa, 0x04, 0xd6,
+	0xda, 0xd3, 0x2e, 0x89, 0xe1, 0x2e, 0xbe, 0x54, 0xb4, 0x84, 0x39, 0x27, 0x13, 0xc9, 0x39, 0xe2,
+	0xc6, 0xea, 0x8c, 0x1b, 0xeb, 0x5f, 0x41, 0xf5, 0x00, 0xfb, 0xb4, 0xc8, 0x0a, 0x4f, 0xba, 0xa8,
+	0x08, 0x7b, 0x1d, 0x2a, 0xce, 0x60, 0xe0, 0x61, 0x9f, 0x97, 0x08, 0xe4, 0x3c, 0xd5, 0x28, 0xb3,
+	0x3d, 0x56, 0x5c, 0x25, 0x6b, 0x2f, 0x55, 0xae, 0x21, 0xfe, 0x07, 0xaa, 0xcf, 0x5f, 0x62, 0xf7,
+	0xcc, 0xb5, 0x7c, 0xdc, 0x1a, 0xf7, 0xf1, 0xd7, 0xc4, 0x03, 0x2c, 0xb2, 0xa0, 0x67, 0xaa, 0x06,
+	0xfb, 0xd0, 0xff, 0x90, 0x81, 0xea, 0xf1, 0xf4, 0x32, 0xb2, 0xd5, 0x21, 0xf7, 0xd2, 0xb4, 0xa7,
+	0x2c, 0xe7, 0x55, 0x0c, 0xf6, 0x41, 0x1e, 0xc2, 0xa9, 0x6b, 0xf3, 0xc4, 0x4b, 0x96, 0xe8, 0x35,
+	0xf2, 0x20, 0xf7, 0xa6, 0xae, 0x67, 0xbd, 0xc4, 0xb4, 0x15, 0x2c, 0x1a, 0xe1, 0x06, 0x7a, 0x17,
+	0x4a, 0x7d, 0x6c, 0x5b, 0x23, 0xcb, 0xc7, 0x2e, 0x2d, 0x02, 0xab, 0xbc, 0xc0, 0xda, 0x13, 0xbb,
+	0x46, 0x88, 0x80, 0xde, 0x05, 0xe4, 0x9b, 0xee, 0x10, 0xfb, 0x1d, 0x5a, 0x9b, 0xf6, 0x4d, 0x7f,
+	0x3a, 0x62, 0xcd, 0x9f, 0x6a, 0x68, 0x0c, 0x42, 0x24, 0xdc, 0xa3, 0xfb, 0x68, 0x1d, 0x56, 0x64,
+	0x6c, 0xa6, 0xa1, 0x12, 0x45, 0xae, 0x85, 0xc8, 0x4c, 0x8d, 0x1f, 0x42, 0xcd, 0x11, 0x7a, 0xea,
+	0x30, 0xfd, 0x00, 0xbd, 0xf7, 0x2a, 0xcb, 0xe5, 0x11, 0x1d, 0x1a, 0x55, 0x27, 0xf2, 0xcd, 0x8b,
+	0x9b, 0x9f, 0x2b, 0xb0, 0x1c, 0xe8, 0xb0, 0xe7, 0xb8, 0xf1, 0x6e, 0x46, 0x89, 0x19, 0x07, 0xdd,
+	0x82, 0x32, 0xab, 0xdb, 0x3a, 0xb4, 0x06, 0x66, 0xde, 0x04, 0x6c, 0xeb, 0x13, 0x52, 0x09, 0xa7,
+	0x48, 0xa5, 0x2e, 0x2c, 0x95, 0xfe, 0x6b, 0x05, 0xaa, 0x11, 0x79, 0x3c, 0x62, 0x34, 0x6f, 0x62,
+	0xf3, 0x80, 0x2e, 0x1a, 0xec, 0x03, 0xbd, 0x0b, 0x05, 0x97, 0x21, 0xf0, 0x70, 0x41, 0x94, 0x7d,
+	0x84, 0xd6, 0x10, 0x28, 0xc4, 0xa0, 0xbe, 0x33, 0xea, 0x7a, 0xbe, 0x33, 0xc6, 0xbc, 0xe2, 0x09,
+	0x37, 0x66, 0xf5, 0x24, 0x59, 0x7a, 0xf7, 0x94, 0x9e, 0xc4, 0x82, 0xda, 0xae, 0x33, 0x39, 0x97,
+	0x1d, 0xef, 0x3a, 0xa8, 0x9e, 0xdb, 0x4b, 0xfa, 0x1d, 0xd9, 0x25, 0xc0, 0xbe, 0x27, 0xda, 0x56,
+	0x19, 0xd8, 0xf7, 0x7c, 0x22, 0x5a, 0xa0, 0x03, 0x21, 0x5a, 0xb0, 0x21, 0x15, 0x9f, 0x8b, 0xbb,
+	0xb9, 0xbe, 0xc7, 0x8a, 0xcf, 0x4b, 0x04, 0x06, 0x82, 0xec, 0x60, 0x6a, 0xdb, 0x3c, 0xc3, 0xd2,
+	0xb5, 0x7e, 0x0c, 0xb5, 0x03, 0xdb, 0xe9, 0xca, 0x5c, 0x16, 0x7a, 0xe3, 0x1b, 0x50, 0x98, 0x98,
+	0xbe, 0x8f, 0x5d, 0x51, 0x75, 0x88, 0x4f, 0xd2, 0xfd, 0x88, 0x56, 0xce, 0x0b, 0x9a, 0xb5, 0x44,
+	0xd1, 0x2b, 0x50, 0x58, 0xb3, 0x46, 0x56, 0xfa, 0x19, 0xd4, 0xf6, 0xac, 0xc1, 0x40, 0x16, 0xe5,
+	0x4d, 0x28, 0x8e, 0xf1, 0x59, 0x27, 0xfd, 0x52, 0x85, 0x31, 0x3e, 0x23, 0x0b, 0x82, 0xe5, 0xd8,
+	0x7d, 0x86, 0x95, 0x50, 0x7f, 0xc1, 0xb1, 0xfb, 0x14, 0xab, 0x01, 0x05, 0xef, 0xd4, 0xb4, 0x6d,
+	0xe7, 0x8c, 0x1b, 0x40, 0x7c, 0xea, 0x5f, 0x82, 0x16, 0x1e, 0x1c, 0x56, 0xeb, 0xe2, 0x64, 0x6f,
+	0x86, 0xe0, 0xfc, 0x78, 0x7a, 0x49, 0x71, 0xbe, 0xf0, 0xd3, 0x38, 0x2e, 0x17, 0xc2, 0x23, 0xcf,
+	0x2a, 0x7b, 0xd0, 0x2e, 0x61, 0xe9, 0x67, 0x50, 0x36, 0xf0, 0xc4, 0xb6, 0x7a, 0x74, 0x4a, 0xbc,
+	0xd8, 0x73, 0xba, 0x06, 0x79, 0x17, 0x8f, 0x1c, 0x5f, 0x4c, 0x5d, 0xf9, 0x97, 0xfe, 0x2b, 0x05,
+	0x1a, 0x41, 0x73, 0x25, 0x58, 0x0a, 0x39, 0xb6, 0xa0, 0xec, 0x86, 0xbb, 0x9c, 0xbd, 0x26, 0x5e,
+	0x99, 0x00, 0x5b, 0x46, 0x22, 0xa9, 0x82, 0xb1, 0xa6, 0xcf, 0x9a, 0x48, 0x15, 0x6c, 0x8b, 0x8e,
+	0x8e, 0xd7, 0x61, 0x85, 0x23, 0xd0, 0xc1, 0x9b, 0xef, 0xbc, 0xc0, 0xa2, 0x3f, 0xab, 0x31, 0x00,
+	0x99, 0x7e, 0x9d, 0x90, 0x6d, 0xfd, 0x01, 0xac, 0xf1, 0xbe, 0x29, 0x2e, 0xda, 0x9c, 0xca, 0xe3,
+	0x08, 0x1a, 0x41, 0xc3, 0xf4, 0x1f, 0xb8, 0x95, 0xfe, 0x93, 0x0c, 0xd4, 0xf6, 0xfd, 0x5e, 0x5f,
+	0xd6, 0xfb, 0x7f, 0x5b, 0x3b, 0x24, 0x69, 0x4b, 0x48, 0xac, 0xad, 0x28, 0x99, 0x01, 0x58, 0x9a,
+	0xa8, 0xe6, 0x16, 0x9f, 0xa8, 0x36, 0x38, 0x95, 0xe3, 0xd2, 0x37, 0xb0, 0x64, 0x88, 0x4f, 0xfd,
+	0xb7, 0x59, 0xa8, 0x49, 0x17, 0xa3, 0xbd, 0xfe, 0xf7, 0xa2, 0x03, 0x49, 0x70, 0x75, 0x71, 0xc1,
+	0xef, 0x40, 0xce, 0xf3, 0x45, 0x3b, 0x5f, 0xdd, 0xba, 0x12, 0x17, 0xa2, 0x4d, 0x80, 0x06, 0xc3,
+	0x61, 0xe1, 0x60, 0x7a, 0xc1, 0x4c, 0x98, 0x7f, 0xa1, 0xfb, 0x50, 0xb3, 0x4d, 0xcf, 0xef, 0x08,
+	0x79, 0x71, 0xbf, 0x91, 0x97, 0x82, 0x8a, 0x27, 0xbd, 0x2a, 0xc1, 0x31, 0x02, 0x14, 0x72, 0x23,
+	0xdb, 0x1c, 0x06, 0xe3, 0xb0, 0x02, 0x7d, 0x42, 0xc0, 0x36, 0x87, 0x62, 0xfe, 0x75, 0x07, 0x54,
+	0xdb, 0x1c, 0xd2, 0xf7, 0xbf, 0xbc, 0x75, 0x2d, 0x71, 0x9b, 0x3d, 0xfe, 0x83, 0x8f, 0x41, 0xb0,
+	0xd0, 0x7b, 0x80, 0x38, 0x27, 0x59, 0x8c, 0x12, 0x9b, 0x95, 0xf5, 0x44, 0xed, 0x1a, 0x1c, 0xfe,
+	0x1e, 0x20, 0x3e, 0x68, 0x92, 0xd1, 0x81, 0xa1, 0x73, 0x88, 0x84, 0xfe, 0x0e, 0x68, 0xf4, 0xa1,
+	0x93, 0x91, 0xcb, 0x14, 0xb9, 0x46, 0xf7, 0x25, 0xd4, 0xfb, 0x50, 0x60, 0x33, 0x91, 0x7e, 0xa3,
+	0x32, 0xdf, 0x0e, 0x1c, 0x55, 0x6f, 0x83, 0x16, 0xf3, 0x12, 0x0f, 0x3d, 0x01, 0x4d, 0xf2, 0x00,
+	0x39, 0xfb, 0xd7, 0xe3, 0x66, 0x22, 0x04, 0xc4, 0xd5, 0x23, 0x1b, 0xfa, 0x53, 0xd0, 0x8e, 0xa7,
+	0x3e, 0x6f, 0x54, 0x79, 0x1c, 0x07, 0x75, 0x9d, 0x22, 0xd7, 0x75, 0xaf, 0x41, 0xd6, 0x37, 0x87,
+	0x22, 0xef, 0x16, 0x29, 0xfb, 0x13, 0x73, 0x68, 0xd0, 0x5d, 0x92, 0xee, 0x56, 0x0e, 0x30, 0x67,
+	0xe4, 0x49, 0xd5, 0xba, 0x98, 0xe3, 0x29, 0xb3, 0xe7, 0x78, 0xa9, 0x45, 0x6e, 0x76, 0x5e, 0x91,
+	0x1b, 0x19, 0x30, 0xde, 0x00, 0xf0, 0x1d, 0x9f, 0x17, 0x1c, 0x62, 0x8e, 0x46, 0x77, 0x48, 0x9d,
+	0xa1, 0x7f, 0x06, 0xda, 0x89, 0x39, 0x8c, 0xde, 0x72, 0xa1, 0x69, 0xda, 0xc5, 0x97, 0xae, 0x03,
+	0x22, 0x59, 0x34, 0x7a, 0x69, 0xf2, 0xd2, 0x93, 0xdd, 0x13, 0x73, 0x18, 0xe8, 0x61, 0x0d, 0xf2,
+	0x13, 0x17, 0x0f, 0xac, 0xaf, 0xf9, 0xaf, 0x38, 0xfc, 0x0b, 0xbd, 0x05, 0x55, 0x6b, 0xdc, 0xb3,
+	0xa7, 0x7d, 0xdc, 0xe1, 0xb2, 0xb0, 0x92, 0x61, 0x99, 0xef, 0x32, 0xce, 0xc4, 0xf2, 0x21, 0x47,
+	0xfe, 0x6e, 0x36, 0x41, 0xf5, 0xcd, 0x21, 0x97, 0x3d, 0x14, 0x8c, 0x6c, 0x4a, 0x57, 0xcb, 0xcc,
+	0xbc, 0x9a, 0xfe, 0x11, 0xd4, 0x59, 0x26, 0xff, 0x4e, 0x36, 0xd3, 0xaf, 0xc2, 0x95, 0x18, 0x39,
+	0x13, 0x4c, 0xff, 0x3f, 0xf1, 0xf0, 0xca, 0x0a, 0x10, 0x7a, 0x54, 0x66, 0xe9, 0x51, 0x26, 0xe1,
+	0x8c, 0x1e, 0x02, 0xda, 0x3d, 0xc5, 0xbd, 0x17, 0x97, 0x37, 0x9b, 0xfe, 0x1e, 0xac, 0x46, 0x48,
+	0xb9, 0xce, 0xd6, 0x20, 0x8f, 0xbf, 0xb6, 0x3c, 0xdf, 0xe3, 0xc5, 0x2f, 0xff, 0xd2, 0x5f, 0x42,
+	0x81, 0xdf, 0x62, 0x51, 0x8f, 0x25, 0x55, 0xb4, 0xf5, 0x0d, 0xaf, 0x42, 0x54, 0x83, 0x7d, 0xcc,
+	0xaa, 0x7c, 0xd5, 0x59, 0x95, 0xef, 0x4f, 0x33, 0x50, 0x16, 0xf3, 0x5d, 0xd2, 0x98, 0x3d, 0x88,
+	0x1f, 0x7e, 0x43, 0x3a, 0x9c, 0xa2, 0xf0, 0xb5, 0xb7, 0x3f, 0xf6, 0xdd, 0xf3, 0x50, 0x9c, 0x8d,
+	0x88, 0x9b, 0x36, 0x13, 0x54, 0x44, 0xaf, 0x8c, 0x84, 0xe2, 0x35, 0x5b, 0x50, 0x91, 0x19, 0x91,
+	0x9e, 0xed, 0x05, 0x3e, 0xe7, 0xce, 0x49, 0x96, 0xe8, 0x0d, 0x91, 0x03, 0x52, 0x47, 0xc8, 0x0c,
+	0xf6, 0x28, 0xf3, 0x81, 0xd2, 0xdc, 0x83, 0x52, 0xc0, 0x3d, 0x85, 0xcf, 0xeb, 0x51, 0x3e, 0x11,
+	0x6d, 0x86, 0x5c, 0xd6, 0xef, 0xb0, 0x9f, 0x25, 0xe8, 0x6f, 0x09, 0x15, 0x28, 0x1a, 0xfb, 0xed,
+	0x7d, 0xe3, 0xf3, 0xfd, 0x3d, 0x6d, 0x09, 0x15, 0x21, 0xfb, 0xb4, 0x75, 0xb8, 0xaf, 0x29, 0xa8,
+	0x00, 0xea, 0x5e, 0xcb, 0xd0, 0x32, 0xeb, 0xef, 0xd3, 0xa9, 0x52, 0x30, 0x43, 0xd7, 0xa0, 0xf2,
+	0xd9, 0xd1, 0xee, 0xf3, 0x1f, 0x1c, 0x1b, 0xfb, 0xed, 0xb6, 0xa0, 0x39, 0xf8, 0xa2, 0x75, 0xac,
+	0x29, 0x08, 0x20, 0xdf, 0x3e, 0xda, 0x3e, 0x3e, 0xfe, 0xa1, 0x96, 0x59, 0x7f, 0x0c, 0xa5, 0xa0,
+	0xa5, 0x24, 0x28, 0x47, 0xcf, 0x8f, 0xf6, 0x19, 0xf2, 0xb3, 0xf6, 0xf3, 0x23, 0x4d, 0x21, 0xab,
+	0xc3, 0xd6, 0xd1, 0xbe, 0x96, 0x21, 0x47, 0xed, 0xb6, 0x3f, 0xd7, 0x54, 0xb2, 0x68, 0x7f, 0x7a,
+	0xa8, 0x65, 0xd7, 0x7f, 0x0c, 0x5a, 0xfc, 0xc9, 0x43, 0x0d, 0xa8, 0x1b, 0xfb, 0xc7, 0x87, 0xad,
+	0xdd, 0xed, 0x93, 0xd6, 0xf3, 0xa3, 0x4e, 0xfb, 0x64, 0xdb, 0x38, 0x69, 0x1d, 0x1d, 0x68, 0x4b,
+	0xe8, 0x2a, 0xac, 0xca, 0x10, 0xe3, 0xb3, 0xa3, 0x23, 0x02, 0x50, 0xe2, 0x80, 0xa7, 0xdb, 0xad,
+	0x43, 0x02, 0xc8, 0x6c, 0xfd, 0xb5, 0x06, 0xea, 0xf6, 0x71, 0x0b, 0x7d, 0x0c, 0x10, 0x8e, 0xe4,
+	0xd1, 0x1a, 0x7b, 0x1c, 0xe3, 0x33, 0xfa, 0xe6, 0x5a, 0xe2, 0xbd, 0xd8, 0xa7, 0xd3, 0xc4, 0x25,
+	0xf4, 0x00, 0xca, 0xd2, 0x78, 0x1d, 0x5d, 0xa5, 0x0c, 0x92, 0x03, 0xf7, 0x66, 0x74, 0x22, 0xae,
+	0x2f, 0xa1, 0x87, 0x50, 0x14, 0x93, 0x74, 0xc4, 0xde, 0x8e, 0xd8, 0xc4, 0xbd, 0x79, 0x25, 0xb6,
+	0xcb, 0xc3, 0x74, 0x89, 0xc8, 0x1c, 0x0e, 0xd1, 0xb9, 0xcc, 0x89, 0xa9, 0xfa, 0x05, 0x32, 0xbf,
+	0x0f, 0x65, 0x69, 0x4e, 0xce, 0x65, 0x4e, 0x4e, 0xce, 0x9b, 0x72, 0xa9, 0xa0, 0x2f, 0xa1, 0x1d,
+	0xa8, 0xc8, 0xf3, 0x5c, 0xd4, 0xe0, 0xe5, 0x7c, 0x62, 0xc4, 0x7b, 0xc1, 0xd1, 0x1f, 0xc1, 0x72,
+	0x64, 0xfc, 0x8a, 0xae, 0xc9, 0x0a, 0x8b, 0x72, 0x89, 0x0f, 0x2a, 0xf5, 0x25, 0xf4, 0x01, 0x40,
+	0x38, 0x69, 0xe5, 0x37, 0x4f, 0x8c, 0x5e, 0x9b, 0x5a, 0x8c, 0xd0, 0xd3, 0x97, 0xc8, 0xc3, 0x1d,
+	0x22, 0xb6, 0x7d, 0x17, 0x9b, 0xa3, 0x99, 0xf4, 0xc9, 0x83, 0x37, 0x15, 0x72, 0x7b, 0x79, 0x60,
+	0xc7, 0x6f, 0x9f, 0x32, 0xc3, 0xbb, 0xe0, 0xf6, 0x0f, 0x61, 0x39, 0x32, 0xb8, 0xe3, 0xb7, 0x4f,
+	0x1b, 0xe6, 0xc5, 0x95, 0xff, 0x18, 0xca, 0xd2, 0x74, 0x8e, 0xdb, 0x2c, 0x39, 0xaf, 0x4b, 0x97,
+	0x7d, 0x17, 0x6a, 0xb1, 0xb9, 0x1b, 0xba, 0xce, 0x4e, 0x4e, 0x9d, 0xc6, 0xa5, 0x33, 0x79, 0x1f,
+	0xca, 0xd2, 0x0f, 0x10, 0x5c, 0x82, 0xe4, 0x4f, 0x12, 0x29, 0x5e, 0x23, 0x0f, 0x94, 0xb9, 0xde,
+	0x52, 0x66, 0xcc, 0x0b, 0x79, 0x0d, 0x67, 0x12, 0xf1, 0x9a, 0x28, 0x97, 0xf8, 0x3f, 0x2f, 0x09,
+	0xbd, 0x86, 0xd3, 0x86, 0x56, 0x8f, 0x12, 0x6a, 0x31, 0x42, 0x8f, 0x09, 0x2f, 0xcf, 0x7d, 0x23,
+	0x46, 0x5f, 0x54, 0xf8, 0x47, 0x50, 0xe0, 0x63, 0x1d, 0xb4, 0x1a, 0x1d, 0xf2, 0xcc, 0xa1, 0x7c,
+	0x5b, 0x41, 0x8f, 0xa0, 0x28, 0x46, 0x35, 0x3c, 0x49, 0xc4, 0x26, 0x37, 0x17, 0x9c, 0xfb, 0x04,
+	0x0a, 0x07, 0x58, 0x3e, 0x37, 0x3a, 0x08, 0x6d, 0x5e, 0x4f, 0x50, 0xd2, 0x47, 0xf2, 0x73, 0xf2,
+	0x46, 0x50, 0x83, 0x87, 0xa9, 0x8d, 0x32, 0x89, 0xa4, 0x36, 0x99, 0x51, 0x74, 0x24, 0xa0, 0x2f,
+	0xa1, 0x2d, 0x96, 0xda, 0x24, 0xa9, 0x63, 0xf3, 0x9c, 0x66, 0x35, 0x42, 0xe2, 0xd1, 0xd0, 0xa8,
+	0x0a, 0x24, 0x1e, 0x9d, 0xe9, 0x94, 0xf1, 0xc3, 0x36, 0x15, 0x72, 0x9c, 0x98, 0xf4, 0x70, 0xa2,
+	0xd8, 0xe0, 0x27, 0xfd, 0x38, 0x81, 0x14, 0x39, 0x2e, 0x4e, 0x99, 0x72, 0xdc, 0x43, 0x28, 0x8a,
+	0xa1, 0x0a, 0x27, 0x8a, 0x0d, 0x77, 0x9a, 0x57, 0x62, 0xbb, 0xc9, 0xc4, 0x4d, 0x89, 0xe5, 0xc4,
+	0xbd, 0x98, 0x49, 0x0f, 0xa5, 0xdf, 0x8f, 0x83, 0x2e, 0xf4, 0x46, 0xf4, 0xcd, 0x8a, 0x0d, 0x09,
+	0x2e, 0xe0, 0x76, 0x10, 0xfc, 0xc8, 0x1b, 0xf0, 0xba, 0x2e, 0x3f, 0x39, 0x71, 0x4e, 0x57, 0xd2,
+	0x3a, 0x1c, 0x8f, 0x89, 0x95, 0x98, 0x51, 0x70, 0xb1, 0x66, 0xcd, 0x2e, 0x2e, 0x0c, 0xf6, 0x12,
+	0xa3, 0xda, 0xb6, 0x6d, 0x34, 0x03, 0x6d, 0x36, 0xf9, 0xd6, 0x9f, 0xf3, 0x50, 0x62, 0xf5, 0x0e,
+	0x79, 0xde, 0xef, 0x41, 0x29, 0x68, 0xb7, 0xd0, 0x15, 0x11, 0x7e, 0x91, 0x0a, 0xb7, 0x29, 0xd7,
+	0x48, 0x34, 0xea, 0x1e, 0xd2, 0x21, 0x2e, 0xdb, 0x68, 0xd3, 0x71, 0xed, 0x0c, 0xca, 0x8a, 0x44,
+	0xe9, 0x71, 0xd2, 0x52, 0xd0, 0x95, 0x21, 0x99, 0xf1, 0xfc, 0x70, 0xdb, 0x07, 0x08, 0x48, 0x3d,
+	0xee, 0x1c, 0x89, 0x0e, 0x6f, 0x3e, 0x9b, 0x0f, 0x69, 0x7d, 0x18, 0xb9, 0x71, 0xbc, 0x15, 0xbb,
+	0x40, 0xf9, 0x77, 0x83, 0x4c, 0x9b, 0x76, 0x87, 0x5a, 0xa4, 0xd0, 0xa5, 0xb1, 0xbe, 0x03, 0x65,
+	0xa9, 0xf2, 0xe7, 0x49, 0x22, 0xd9, 0x46, 0x34, 0x1b, 0x49, 0x40, 0x10, 0x16, 0x0f, 0xa0, 0x2c,
+	0xb5, 0x75, 0x9c, 0x47, 0xb2, 0xd1, 0x8b, 0x19, 0x6a, 0x53, 0x41, 0x9f, 0xc0, 0x72, 0xa4, 0x27,
+	0xe2, 0xef, 0x42, 0x5a, 0x9b, 0xd5, 0x6c, 0xa6, 0x81, 0x02, 0x11, 0xee, 0x41, 0xfe, 0x00, 0x93,
+	0x86, 0x0f, 0x05, 0xbd, 0xd2, 0x7c, 0x55, 0xbf, 0x03, 0xc0, 0x95, 0x15, 0x25, 0x4c, 0x51, 0xd3,
+	0x63, 0x96, 0x12, 0x49, 0xe5, 0x2e, 0x25, 0x36, 0xa9, 0x63, 0x6b, 0x5e, 0x89, 0xed, 0x0a, 0xd1,
+	0x36, 0x15, 0xf4, 0x44, 0xa4, 0x0d, 0x4a, 0x2e, 0xa7, 0x0d, 0x99, 0xc1, 0xd5, 0xc4, 0x7e, 0x70,
+	0xbb, 0xc7, 0x50, 0x20, 0x05, 0xbc, 0xd9, 0xf3, 0x2f, 0x1f, 0x50, 0x3b, 0xda, 0x1f, 0x5f, 0xdd,
+	0x54, 0xfe, 0xf2, 0xea, 0xa6, 0xf2, 0x8f, 0x57, 0x37, 0x95, 0x5f, 0xfe, 0xf3, 0xe6, 0x52, 0x37,
+	0x4f, 0x71, 0xee, 0xfd, 0x7b, 0x00, 0x0b, 0xf6, 0x85, 0xfb, 0x0b, 0x2c, 0x00, 0x00,
 }
@@ -3,6 +3,8 @@ package grpcutil
 import (
 	"sync"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+
 	"google.golang.org/grpc"
 )
 
@@ -12,9 +14,10 @@ type Dialer interface {
 	CloseConns() error
 }
 
-// NewDialer creates a Dialer.
+// NewDialer creates a Dialer. RPCs sent over the connections that it dials
+// are traced.
 func NewDialer(opts ...grpc.DialOption) Dialer {
-	return newDialer(opts...)
+	return newDialer(append(opts, tracing.DialOptions()...)...)
 }
 
 type dialer struct {
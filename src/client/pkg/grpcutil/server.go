@@ -7,6 +7,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/client/version/versionpb"
 
@@ -24,6 +25,12 @@ type ServeOptions struct {
 	Version    *versionpb.Version
 	MaxMsgSize int
 	Cancel     chan struct{}
+
+	// UnaryInterceptors and StreamInterceptors are installed on the server
+	// and run around every RPC that it serves. The first interceptor in each
+	// list is the outermost one.
+	UnaryInterceptors  []grpc.UnaryServerInterceptor
+	StreamInterceptors []grpc.StreamServerInterceptor
 }
 
 // ServeEnv are environment variables for serving.
@@ -44,7 +51,7 @@ func Serve(
 	if serveEnv.GRPCPort == 0 {
 		serveEnv.GRPCPort = 7070
 	}
-	grpcServer := grpc.NewServer(
+	serverOptions := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(math.MaxUint32),
 		grpc.MaxRecvMsgSize(options.MaxMsgSize),
 		grpc.MaxSendMsgSize(options.MaxMsgSize),
@@ -52,7 +59,16 @@ func Serve(
 			MinTime:             5 * time.Second,
 			PermitWithoutStream: true,
 		}),
+	}
+	// Every RPC is traced, so the tracing interceptors are outermost, and time
+	// the other interceptors as well as the RPC
+	unaryInterceptors := append([]grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor()}, options.UnaryInterceptors...)
+	streamInterceptors := append([]grpc.StreamServerInterceptor{tracing.StreamServerInterceptor()}, options.StreamInterceptors...)
+	serverOptions = append(serverOptions,
+		grpc.UnaryInterceptor(chainUnaryInterceptors(unaryInterceptors)),
+		grpc.StreamInterceptor(chainStreamInterceptors(streamInterceptors)),
 	)
+	grpcServer := grpc.NewServer(serverOptions...)
 	registerFunc(grpcServer)
 	if options.Version != nil {
 		versionpb.RegisterAPIServer(grpcServer, version.NewAPIServer(options.Version, version.APIServerOptions{}))
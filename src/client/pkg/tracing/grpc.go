@@ -0,0 +1,159 @@
+package tracing
+
+import (
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// methodAttributes returns the attributes of a span for the gRPC method
+// 'fullMethod' (e.g. "/pfs.API/PutFile")
+func methodAttributes(fullMethod string) []string {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return []string{"rpc.system", "grpc", "rpc.service", fullMethod[:i], "rpc.method", fullMethod[i+1:]}
+	}
+	return []string{"rpc.system", "grpc", "rpc.method", fullMethod}
+}
+
+// spanName returns the name of a span for the gRPC method 'fullMethod'
+func spanName(fullMethod string) string {
+	return strings.TrimPrefix(fullMethod, "/")
+}
+
+// extractIncoming returns 'ctx' with the caller's span (from the incoming
+// gRPC metadata) as its remote parent. This is done even if tracing is
+// disabled in this process, so that RPCs made while serving the request
+// still carry the caller's span.
+func extractIncoming(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md[MetadataKey]) == 0 {
+		return ctx
+	}
+	return ContextWithRemoteParent(ctx, md[MetadataKey][0])
+}
+
+// injectOutgoing returns 'ctx' with the span in 'ctx' added to its outgoing
+// gRPC metadata, replacing any span that's already there
+func injectOutgoing(ctx context.Context) context.Context {
+	traceparent := TraceParent(ctx)
+	if traceparent == "" {
+		return ctx
+	}
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	md[MetadataKey] = []string{traceparent}
+	return metadata.NewOutgoingContext(ctx, md)
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor that traces every unary
+// RPC that a server serves, as a child of the caller's span
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		span, ctx := startSpan(extractIncoming(ctx), spanName(info.FullMethod), kindServer, methodAttributes(info.FullMethod)...)
+		resp, err := handler(ctx, req)
+		span.Finish(err)
+		return resp, err
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamServerInterceptor returns a gRPC interceptor that traces every
+// streaming RPC that a server serves, as a child of the caller's span
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		span, ctx := startSpan(extractIncoming(stream.Context()), spanName(info.FullMethod), kindServer, methodAttributes(info.FullMethod)...)
+		err := handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+		span.Finish(err)
+		return err
+	}
+}
+
+// UnaryClientInterceptor returns a gRPC interceptor that traces every unary
+// RPC that a client sends, and passes the RPC's span to the server
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		span, ctx := startSpan(ctx, spanName(method), kindClient, methodAttributes(method)...)
+		err := invoker(injectOutgoing(ctx), method, req, reply, cc, opts...)
+		span.Finish(err)
+		return err
+	}
+}
+
+// clientStream finishes the span of a streaming RPC when the stream ends:
+// when RecvMsg returns an error (io.EOF, if the RPC succeeded), when the
+// single response of a client-streaming RPC is received (e.g. by
+// CloseAndRecv), or when the RPC's context is cancelled
+type clientStream struct {
+	grpc.ClientStream
+	desc *grpc.StreamDesc
+	span *Span
+	once sync.Once
+	done chan struct{}
+}
+
+func (s *clientStream) finish(err error) {
+	s.once.Do(func() {
+		if err == io.EOF {
+			err = nil
+		}
+		s.span.Finish(err)
+		close(s.done)
+	})
+}
+
+func (s *clientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil || !s.desc.ServerStreams {
+		s.finish(err)
+	}
+	return err
+}
+
+// StreamClientInterceptor returns a gRPC interceptor that traces every
+// streaming RPC that a client sends, and passes the RPC's span to the server
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		span, ctx := startSpan(ctx, spanName(method), kindClient, methodAttributes(method)...)
+		stream, err := streamer(injectOutgoing(ctx), desc, cc, method, opts...)
+		if err != nil {
+			span.Finish(err)
+			return nil, err
+		}
+		if span == nil {
+			return stream, nil
+		}
+		cs := &clientStream{ClientStream: stream, desc: desc, span: span, done: make(chan struct{})}
+		// As with gRPC itself, callers must either finish the stream or cancel
+		// its context, or this goroutine leaks
+		go func() {
+			select {
+			case <-ctx.Done():
+				cs.finish(ctx.Err())
+			case <-cs.done:
+			}
+		}()
+		return cs, nil
+	}
+}
+
+// DialOptions returns the grpc.DialOptions that trace the RPCs sent over a
+// connection
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(StreamClientInterceptor()),
+	}
+}
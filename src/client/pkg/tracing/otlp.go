@@ -0,0 +1,290 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	mathrand "math/rand"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// EndpointEnvVar is the environment variable that holds the URL of the
+	// OTLP/HTTP receiver that spans are exported to (e.g.
+	// "http://jaeger-collector:4318"). Tracing is disabled if it's unset.
+	EndpointEnvVar = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	// ServiceNameEnvVar is the environment variable that overrides the
+	// service name that a process's spans are exported with
+	ServiceNameEnvVar = "OTEL_SERVICE_NAME"
+	// SampleRatioEnvVar is the environment variable that holds the fraction
+	// (between 0 and 1) of new traces that are sampled. Spans that continue a
+	// trace started elsewhere are always sampled. The default is 1.
+	SampleRatioEnvVar = "OTEL_TRACES_SAMPLER_ARG"
+
+	tracesPath = "/v1/traces"
+	// exportBatchSize is the number of finished spans at which the exporter
+	// sends a batch without waiting for exportInterval
+	exportBatchSize = 512
+	// maxQueuedSpans is the number of finished spans that the exporter holds
+	// before it starts dropping new ones (e.g. if the receiver is down)
+	maxQueuedSpans = 8192
+	exportInterval = 5 * time.Second
+)
+
+var (
+	globalExporterMu sync.RWMutex
+	globalExporter   *exporter
+)
+
+func currentExporter() *exporter {
+	globalExporterMu.RLock()
+	defer globalExporterMu.RUnlock()
+	return globalExporter
+}
+
+func setExporter(e *exporter) {
+	globalExporterMu.Lock()
+	defer globalExporterMu.Unlock()
+	globalExporter = e
+}
+
+// InstallExporterFromEnv enables tracing in this process if EndpointEnvVar
+// is set, exporting spans with the service name 'serviceName' (unless
+// ServiceNameEnvVar overrides it). It returns an error if the environment
+// variables are malformed.
+func InstallExporterFromEnv(serviceName string) error {
+	endpoint := os.Getenv(EndpointEnvVar)
+	if endpoint == "" {
+		return nil
+	}
+	if name := os.Getenv(ServiceNameEnvVar); name != "" {
+		serviceName = name
+	}
+	ratio := 1.0
+	if ratioStr := os.Getenv(SampleRatioEnvVar); ratioStr != "" {
+		var err error
+		ratio, err = strconv.ParseFloat(ratioStr, 64)
+		if err != nil || ratio < 0 || ratio > 1 {
+			return fmt.Errorf("%s must be a number between 0 and 1, but was %q", SampleRatioEnvVar, ratioStr)
+		}
+	}
+	e := newExporter(endpoint, serviceName, ratio)
+	go e.run()
+	setExporter(e)
+	return nil
+}
+
+// Flush synchronously exports every span that has finished but hasn't been
+// exported yet. Short-lived processes, such as pachctl, call it before they
+// exit.
+func Flush() {
+	if e := currentExporter(); e != nil {
+		e.flush()
+	}
+}
+
+// exporter batches finished spans and sends them to an OTLP/HTTP receiver,
+// encoded as JSON
+type exporter struct {
+	url         string
+	serviceName string
+	sampleRatio float64
+	client      *http.Client
+
+	mu      sync.Mutex
+	queue   []*Span
+	dropped int
+	// flushMu serializes sends, so that batches are exported in order
+	flushMu sync.Mutex
+	kick    chan struct{}
+}
+
+func newExporter(endpoint string, serviceName string, sampleRatio float64) *exporter {
+	return &exporter{
+		url:         strings.TrimSuffix(endpoint, "/") + tracesPath,
+		serviceName: serviceName,
+		sampleRatio: sampleRatio,
+		client:      &http.Client{Timeout: 10 * time.Second},
+		kick:        make(chan struct{}, 1),
+	}
+}
+
+func (e *exporter) sample() bool {
+	return e.sampleRatio >= 1 || mathrand.Float64() < e.sampleRatio
+}
+
+func (e *exporter) enqueue(s *Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if len(e.queue) >= maxQueuedSpans {
+		e.dropped++
+		return
+	}
+	e.queue = append(e.queue, s)
+	if len(e.queue) >= exportBatchSize {
+		select {
+		case e.kick <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// run exports queued spans every exportInterval, or sooner if a full batch
+// is queued. It never returns.
+func (e *exporter) run() {
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-e.kick:
+		}
+		e.flush()
+	}
+}
+
+func (e *exporter) flush() {
+	e.flushMu.Lock()
+	defer e.flushMu.Unlock()
+	e.mu.Lock()
+	spans, dropped := e.queue, e.dropped
+	e.queue, e.dropped = nil, 0
+	e.mu.Unlock()
+	if dropped > 0 {
+		log.Errorf("tracing: dropped %d spans because the export queue was full", dropped)
+	}
+	for len(spans) > 0 {
+		n := len(spans)
+		if n > exportBatchSize {
+			n = exportBatchSize
+		}
+		if err := e.export(spans[:n]); err != nil {
+			log.Errorf("tracing: could not export %d spans to %s: %v", n, e.url, err)
+		}
+		spans = spans[n:]
+	}
+}
+
+func (e *exporter) export(spans []*Span) error {
+	body, err := json.Marshal(e.encode(spans))
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("%s: %s", resp.Status, msg)
+	}
+	return nil
+}
+
+// The types below are the subset of the OTLP/JSON encoding of an
+// ExportTraceServiceRequest that pachyderm's spans use
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	// Code is 0 (unset) or 2 (error)
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func (e *exporter) encode(spans []*Span) *otlpRequest {
+	var encoded []otlpSpan
+	for _, s := range spans {
+		s.mu.Lock()
+		span := otlpSpan{
+			TraceID:           hex.EncodeToString(s.context.TraceID[:]),
+			SpanID:            hex.EncodeToString(s.context.SpanID[:]),
+			Name:              s.name,
+			Kind:              s.kind,
+			StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+			Attributes:        encodeAttributes(s.attributes),
+		}
+		if s.parentID != [8]byte{} {
+			span.ParentSpanID = hex.EncodeToString(s.parentID[:])
+		}
+		if s.err != nil {
+			span.Status = otlpStatus{Code: 2, Message: s.err.Error()}
+		}
+		s.mu.Unlock()
+		encoded = append(encoded, span)
+	}
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: encodeAttributes(map[string]string{"service.name": e.serviceName}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/pachyderm/pachyderm"},
+				Spans: encoded,
+			}},
+		}},
+	}
+}
+
+func encodeAttributes(attributes map[string]string) []otlpAttribute {
+	var result []otlpAttribute
+	for key, value := range attributes {
+		result = append(result, otlpAttribute{Key: key, Value: otlpValue{StringValue: value}})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Key < result[j].Key })
+	return result
+}
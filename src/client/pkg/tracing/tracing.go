@@ -0,0 +1,233 @@
+// Package tracing implements distributed tracing for pachctl, pachd and the
+// workers. Spans are propagated between processes in gRPC metadata, using the
+// W3C Trace Context format (https://www.w3.org/TR/trace-context/), and are
+// exported to an OpenTelemetry collector, or to Jaeger (which accepts OTLP
+// directly), over OTLP/HTTP.
+//
+// Tracing is disabled, and StartSpan returns a nil *Span (whose methods are
+// no-ops), unless InstallExporterFromEnv finds an OTLP endpoint in the
+// environment.
+package tracing
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	mathrand "math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+)
+
+// MetadataKey is the gRPC metadata key that carries the caller's span
+const MetadataKey = "traceparent"
+
+// Span kinds, as defined by OTLP
+const (
+	kindInternal = 1
+	kindServer   = 2
+	kindClient   = 3
+)
+
+// SpanContext identifies a span, and is what's propagated between processes
+type SpanContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+}
+
+// IsValid returns true if neither of sc's IDs is all zeroes
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != [16]byte{} && sc.SpanID != [8]byte{}
+}
+
+// String returns sc as a W3C traceparent header. Only sampled spans are
+// propagated, so the sampled flag is always set.
+func (sc SpanContext) String() string {
+	return fmt.Sprintf("00-%s-%s-01", hex.EncodeToString(sc.TraceID[:]), hex.EncodeToString(sc.SpanID[:]))
+}
+
+// ParseSpanContext parses a W3C traceparent header. Spans whose sampled flag
+// isn't set are reported as invalid, as nothing is recorded for them.
+func ParseSpanContext(traceparent string) (SpanContext, error) {
+	var sc SpanContext
+	parts := strings.Split(strings.TrimSpace(traceparent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" {
+		return sc, fmt.Errorf("malformed traceparent %q", traceparent)
+	}
+	traceID, err := hex.DecodeString(parts[1])
+	if err != nil || len(traceID) != len(sc.TraceID) {
+		return sc, fmt.Errorf("malformed trace ID in traceparent %q", traceparent)
+	}
+	spanID, err := hex.DecodeString(parts[2])
+	if err != nil || len(spanID) != len(sc.SpanID) {
+		return sc, fmt.Errorf("malformed span ID in traceparent %q", traceparent)
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return sc, fmt.Errorf("malformed flags in traceparent %q", traceparent)
+	}
+	copy(sc.TraceID[:], traceID)
+	copy(sc.SpanID[:], spanID)
+	if !sc.IsValid() {
+		return sc, fmt.Errorf("traceparent %q has an invalid ID", traceparent)
+	}
+	if flags[0]&1 == 0 {
+		return sc, fmt.Errorf("traceparent %q isn't sampled", traceparent)
+	}
+	return sc, nil
+}
+
+// Span is a timed operation that's part of a trace. A nil *Span is valid,
+// and all of its methods are no-ops.
+type Span struct {
+	context  SpanContext
+	parentID [8]byte
+	name     string
+	kind     int
+	start    time.Time
+	exporter *exporter
+
+	mu         sync.Mutex
+	attributes map[string]string
+	end        time.Time
+	err        error
+}
+
+type spanKey struct{}
+
+type remoteParentKey struct{}
+
+// StartSpan starts a span named 'name', which is a child of the span in
+// 'ctx' (if any), and returns it along with a context that contains it.
+// 'attributes' are alternating keys and values. The caller must Finish the
+// span.
+func StartSpan(ctx context.Context, name string, attributes ...string) (*Span, context.Context) {
+	return startSpan(ctx, name, kindInternal, attributes...)
+}
+
+func startSpan(ctx context.Context, name string, kind int, attributes ...string) (*Span, context.Context) {
+	e := currentExporter()
+	if e == nil {
+		return nil, ctx
+	}
+	span := &Span{
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		exporter:   e,
+		attributes: make(map[string]string),
+	}
+	if parent, ok := SpanContextFromContext(ctx); ok {
+		span.context.TraceID = parent.TraceID
+		span.parentID = parent.SpanID
+	} else {
+		if !e.sample() {
+			return nil, ctx
+		}
+		randomID(span.context.TraceID[:])
+	}
+	randomID(span.context.SpanID[:])
+	for i := 0; i+1 < len(attributes); i += 2 {
+		span.attributes[attributes[i]] = attributes[i+1]
+	}
+	return span, context.WithValue(ctx, spanKey{}, span)
+}
+
+// SetAttribute sets the attribute 'key' of 's' to 'value'
+func (s *Span) SetAttribute(key string, value string) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.attributes[key] = value
+}
+
+// Finish ends 's' and queues it for export. If 'err' is non-nil, the span
+// is marked as failed. Calls after the first have no effect.
+func (s *Span) Finish(err error) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if !s.end.IsZero() {
+		s.mu.Unlock()
+		return
+	}
+	s.end = time.Now()
+	s.err = err
+	s.mu.Unlock()
+	s.exporter.enqueue(s)
+}
+
+// SpanContextFromContext returns the context of the span in 'ctx', which is
+// either a span started in this process or a span that this process was
+// called from
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	if span, ok := ctx.Value(spanKey{}).(*Span); ok && span != nil {
+		return span.context, true
+	}
+	if sc, ok := ctx.Value(remoteParentKey{}).(SpanContext); ok {
+		return sc, true
+	}
+	return SpanContext{}, false
+}
+
+// TraceParent returns the W3C traceparent header of the span in 'ctx', or ""
+// if 'ctx' doesn't contain a span
+func TraceParent(ctx context.Context) string {
+	if sc, ok := SpanContextFromContext(ctx); ok {
+		return sc.String()
+	}
+	return ""
+}
+
+// ContextWithRemoteParent returns a context whose spans are children of the
+// span identified by 'traceparent', which was started in another process.
+// If 'traceparent' can't be parsed, 'ctx' is returned as-is.
+func ContextWithRemoteParent(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	sc, err := ParseSpanContext(traceparent)
+	if err != nil {
+		return ctx
+	}
+	// Mask any span that's already in 'ctx', so that the remote parent is used
+	return context.WithValue(context.WithValue(ctx, spanKey{}, (*Span)(nil)), remoteParentKey{}, sc)
+}
+
+var (
+	backgroundMu sync.RWMutex
+	background   = context.Background()
+)
+
+// Background returns context.Background(), plus the span started by
+// StartBackgroundSpan (if any). Clients that aren't given a context use it,
+// so that every RPC made by a short-lived process (e.g. a pachctl command) is
+// part of one trace.
+func Background() context.Context {
+	backgroundMu.RLock()
+	defer backgroundMu.RUnlock()
+	return background
+}
+
+// StartBackgroundSpan starts a span named 'name' that's the parent of every
+// span started from Background(). The caller must Finish the span.
+func StartBackgroundSpan(name string, attributes ...string) *Span {
+	backgroundMu.Lock()
+	defer backgroundMu.Unlock()
+	span, ctx := StartSpan(context.Background(), name, attributes...)
+	background = ctx
+	return span
+}
+
+// randomID fills 'id' with random bytes, falling back to math/rand if
+// crypto/rand fails (trace IDs only need to be unique, not unpredictable)
+func randomID(id []byte) {
+	if _, err := rand.Read(id); err != nil {
+		mathrand.Read(id)
+	}
+}
@@ -0,0 +1,165 @@
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// withExporter installs an exporter that sends spans to a test server, and
+// returns it along with a channel that receives every request it sends
+func withExporter(t *testing.T) (*exporter, chan *otlpRequest, func()) {
+	requests := make(chan *otlpRequest, 10)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, tracesPath, r.URL.Path)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &otlpRequest{}
+		require.NoError(t, json.Unmarshal(body, req))
+		requests <- req
+	}))
+	e := newExporter(server.URL, "test", 1)
+	setExporter(e)
+	return e, requests, func() {
+		setExporter(nil)
+		server.Close()
+	}
+}
+
+func TestParseSpanContext(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	sc, err := ParseSpanContext(traceparent)
+	require.NoError(t, err)
+	require.True(t, sc.IsValid())
+	require.Equal(t, traceparent, sc.String())
+
+	for _, bad := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00",
+	} {
+		_, err := ParseSpanContext(bad)
+		require.YesError(t, err, bad)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	span, ctx := StartSpan(context.Background(), "noop")
+	require.True(t, span == nil)
+	span.SetAttribute("key", "value")
+	span.Finish(nil)
+	require.Equal(t, "", TraceParent(ctx))
+
+	// Remote parents are still propagated
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	require.Equal(t, traceparent, TraceParent(ContextWithRemoteParent(ctx, traceparent)))
+}
+
+func TestExport(t *testing.T) {
+	e, requests, cleanup := withExporter(t)
+	defer cleanup()
+
+	parent, ctx := StartSpan(context.Background(), "parent", "key", "value")
+	child, _ := StartSpan(ctx, "child")
+	child.Finish(fmt.Errorf("oops"))
+	parent.Finish(nil)
+	parent.Finish(nil) // no-op
+	e.flush()
+
+	req := <-requests
+	require.Equal(t, 1, len(req.ResourceSpans))
+	require.Equal(t, "test", req.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+	spans := req.ResourceSpans[0].ScopeSpans[0].Spans
+	require.Equal(t, 2, len(spans))
+	require.Equal(t, "child", spans[0].Name)
+	require.Equal(t, 2, spans[0].Status.Code)
+	require.Equal(t, "oops", spans[0].Status.Message)
+	require.Equal(t, "parent", spans[1].Name)
+	require.Equal(t, "", spans[1].ParentSpanID)
+	require.Equal(t, []otlpAttribute{{Key: "key", Value: otlpValue{StringValue: "value"}}}, spans[1].Attributes)
+	require.Equal(t, spans[1].TraceID, spans[0].TraceID)
+	require.Equal(t, spans[1].SpanID, spans[0].ParentSpanID)
+}
+
+func TestGRPCPropagation(t *testing.T) {
+	e, requests, cleanup := withExporter(t)
+	defer cleanup()
+
+	span, ctx := StartSpan(context.Background(), "caller")
+	var serverTraceParent string
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		// Deliver the outgoing metadata to the server, as gRPC would
+		md, _ := metadata.FromOutgoingContext(ctx)
+		serverCtx := metadata.NewIncomingContext(context.Background(), md)
+		_, err := UnaryServerInterceptor()(serverCtx, req, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, req interface{}) (interface{}, error) {
+				serverTraceParent = TraceParent(ctx)
+				return nil, nil
+			})
+		return err
+	}
+	require.NoError(t, UnaryClientInterceptor()(ctx, "/pfs.API/InspectRepo", nil, nil, nil, invoker))
+	span.Finish(nil)
+	e.flush()
+
+	spans := (<-requests).ResourceSpans[0].ScopeSpans[0].Spans
+	require.Equal(t, 3, len(spans))
+	server, client, caller := spans[0], spans[1], spans[2]
+	require.Equal(t, "pfs.API/InspectRepo", server.Name)
+	require.Equal(t, kindServer, server.Kind)
+	require.Equal(t, kindClient, client.Kind)
+	require.Equal(t, client.SpanID, server.ParentSpanID)
+	require.Equal(t, caller.SpanID, client.ParentSpanID)
+	require.Equal(t, caller.TraceID, server.TraceID)
+	require.Equal(t, fmt.Sprintf("00-%s-%s-01", server.TraceID, server.SpanID), serverTraceParent)
+}
+
+// testClientStream is a grpc.ClientStream whose RecvMsg always succeeds
+type testClientStream struct {
+	grpc.ClientStream
+}
+
+func (s testClientStream) RecvMsg(m interface{}) error {
+	return nil
+}
+
+func TestClientStreamFinish(t *testing.T) {
+	e, requests, cleanup := withExporter(t)
+	defer cleanup()
+
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return testClientStream{}, nil
+	}
+	// The span of a client-streaming RPC ends when its response is received
+	stream, err := StreamClientInterceptor()(context.Background(), &grpc.StreamDesc{ClientStreams: true}, nil, "/pfs.API/PutFile", streamer)
+	require.NoError(t, err)
+	require.NoError(t, stream.RecvMsg(nil))
+	e.flush()
+	spans := (<-requests).ResourceSpans[0].ScopeSpans[0].Spans
+	require.Equal(t, 1, len(spans))
+	require.Equal(t, "pfs.API/PutFile", spans[0].Name)
+
+	// The span of a server-streaming RPC ends when its context is cancelled
+	ctx, cancel := context.WithCancel(context.Background())
+	stream, err = StreamClientInterceptor()(ctx, &grpc.StreamDesc{ServerStreams: true}, nil, "/pfs.API/GetFile", streamer)
+	require.NoError(t, err)
+	require.NoError(t, stream.RecvMsg(nil))
+	cancel()
+	<-stream.(*clientStream).done
+	e.flush()
+	spans = (<-requests).ResourceSpans[0].ScopeSpans[0].Spans
+	require.Equal(t, 1, len(spans))
+	require.Equal(t, "pfs.API/GetFile", spans[0].Name)
+	require.Equal(t, 2, spans[0].Status.Code)
+}
@@ -97,6 +97,19 @@ func NewAtomInputOpts(name string, repo string, branch string, glob string, lazy
 	}
 }
 
+// NewJoinAtomInput returns a new atom input for use in a join input. The
+// datums of the input are joined on the key captured by the regular
+// expression joinOn (see AtomInput.JoinOn).
+func NewJoinAtomInput(repo string, glob string, joinOn string) *pps.Input {
+	return &pps.Input{
+		Atom: &pps.AtomInput{
+			Repo:   repo,
+			Glob:   glob,
+			JoinOn: joinOn,
+		},
+	}
+}
+
 // NewCrossInput returns an input which is the cross product of other inputs.
 // That means that all combination of datums will be seen by the job /
 // pipeline.
@@ -106,6 +119,15 @@ func NewCrossInput(input ...*pps.Input) *pps.Input {
 	}
 }
 
+// NewJoinInput returns an input which joins other inputs by key. Each of the
+// inputs must be an atom input with JoinOn set, and only the tuples of datums
+// whose join keys match will be seen by the job / pipeline.
+func NewJoinInput(input ...*pps.Input) *pps.Input {
+	return &pps.Input{
+		Join: input,
+	}
+}
+
 // NewUnionInput returns an input which is the union of other inputs. That
 // means that all datums from any of the inputs will be seen individually by
 // the job / pipeline.
@@ -288,12 +310,23 @@ func (c APIClient) RestartDatum(jobID string, datumFilter []string) error {
 
 // ListDatum returns info about all datums in a Job
 func (c APIClient) ListDatum(jobID string, pageSize int64, page int64) (*pps.ListDatumResponse, error) {
+	return c.listDatum(jobID, pageSize, page, false)
+}
+
+// ListFailedDatum returns info about the datums that a Job skipped because
+// they failed (see CreatePipelineRequest.SkipFailedDatums)
+func (c APIClient) ListFailedDatum(jobID string, pageSize int64, page int64) (*pps.ListDatumResponse, error) {
+	return c.listDatum(jobID, pageSize, page, true)
+}
+
+func (c APIClient) listDatum(jobID string, pageSize int64, page int64, failed bool) (*pps.ListDatumResponse, error) {
 	client, err := c.PpsAPIClient.ListDatumStream(
 		c.Ctx(),
 		&pps.ListDatumRequest{
 			Job:      &pps.Job{jobID},
 			PageSize: pageSize,
 			Page:     page,
+			Failed:   failed,
 		},
 	)
 	if err != nil {
@@ -528,6 +561,35 @@ func (c APIClient) RerunPipeline(name string, include []*pfs.Commit, exclude []*
 	return grpcutil.ScrubGRPC(err)
 }
 
+// ListPipelineHistory returns the versions of a pipeline's spec, newest first.
+func (c APIClient) ListPipelineHistory(name string) ([]*pps.PipelineVersion, error) {
+	history, err := c.PpsAPIClient.ListPipelineHistory(
+		c.Ctx(),
+		&pps.ListPipelineHistoryRequest{
+			Pipeline: NewPipeline(name),
+		},
+	)
+	if err != nil {
+		return nil, grpcutil.ScrubGRPC(err)
+	}
+	return history.Versions, nil
+}
+
+// RollbackPipeline restores the spec that a pipeline had at 'version', as a
+// new version of the pipeline. If 'reprocess' is true, the pipeline
+// reprocesses the datums that its current version already processed.
+func (c APIClient) RollbackPipeline(name string, version uint64, reprocess bool) error {
+	_, err := c.PpsAPIClient.RollbackPipeline(
+		c.Ctx(),
+		&pps.RollbackPipelineRequest{
+			Pipeline:  NewPipeline(name),
+			Version:   version,
+			Reprocess: reprocess,
+		},
+	)
+	return grpcutil.ScrubGRPC(err)
+}
+
 // CreatePipelineService creates a new pipeline service.
 func (c APIClient) CreatePipelineService(
 	name string,
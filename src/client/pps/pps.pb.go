@@ -19,6 +19,8 @@
 		Input
 		JobInput
 		ParallelismSpec
+		Autoscaling
+		AutoscalingStatus
 		InputFile
 		Datum
 		DatumInfo
@@ -33,6 +35,7 @@
 		JobInfos
 		Pipeline
 		PipelineInput
+		EtcdJobQueueEntry
 		EtcdPipelineInfo
 		PipelineInfo
 		PipelineInfos
@@ -57,6 +60,10 @@
 		StartPipelineRequest
 		StopPipelineRequest
 		RerunPipelineRequest
+		ListPipelineHistoryRequest
+		PipelineVersion
+		PipelineHistory
+		RollbackPipelineRequest
 		GarbageCollectRequest
 		GarbageCollectResponse
 		ActivateAuthRequest
@@ -99,6 +106,10 @@ const (
 	JobState_JOB_FAILURE  JobState = 2
 	JobState_JOB_SUCCESS  JobState = 3
 	JobState_JOB_KILLED   JobState = 4
+	// JOB_SUCCESS_WITH_FAILURES is the terminal state of a job whose pipeline
+	// sets skip_failed_datums and in which some datums failed. The job's output
+	// commit contains the output of every other datum.
+	JobState_JOB_SUCCESS_WITH_FAILURES JobState = 5
 )
 
 var JobState_name = map[int32]string{
@@ -107,13 +118,15 @@ var JobState_name = map[int32]string{
 	2: "JOB_FAILURE",
 	3: "JOB_SUCCESS",
 	4: "JOB_KILLED",
+	5: "JOB_SUCCESS_WITH_FAILURES",
 }
 var JobState_value = map[string]int32{
-	"JOB_STARTING": 0,
-	"JOB_RUNNING":  1,
-	"JOB_FAILURE":  2,
-	"JOB_SUCCESS":  3,
-	"JOB_KILLED":   4,
+	"JOB_STARTING":              0,
+	"JOB_RUNNING":               1,
+	"JOB_FAILURE":               2,
+	"JOB_SUCCESS":               3,
+	"JOB_KILLED":                4,
+	"JOB_SUCCESS_WITH_FAILURES": 5,
 }
 
 func (x JobState) String() string {
@@ -402,6 +415,11 @@ type AtomInput struct {
 	// empty files. This is useful in shuffle pipelines where you want to read
 	// the names of files and reorganize them using symlinks.
 	EmptyFiles bool `protobuf:"varint,8,opt,name=empty_files,json=emptyFiles,proto3" json:"empty_files,omitempty"`
+	// JoinOn is a regular expression that's matched against the path of each of
+	// this atom's datums when the atom is part of a join input. The datum's
+	// join key is its capture groups (or, if there are none, the whole match),
+	// and datums whose path doesn't match are left out of the join.
+	JoinOn string `protobuf:"bytes,9,opt,name=join_on,json=joinOn,proto3" json:"join_on,omitempty"`
 }
 
 func (m *AtomInput) Reset()                    { *m = AtomInput{} }
@@ -458,6 +476,13 @@ func (m *AtomInput) GetEmptyFiles() bool {
 	return false
 }
 
+func (m *AtomInput) GetJoinOn() string {
+	if m != nil {
+		return m.JoinOn
+	}
+	return ""
+}
+
 type CronInput struct {
 	Name   string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Repo   string                      `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -552,6 +577,7 @@ type Input struct {
 	Union []*Input   `protobuf:"bytes,3,rep,name=union" json:"union,omitempty"`
 	Cron  *CronInput `protobuf:"bytes,4,opt,name=cron" json:"cron,omitempty"`
 	Git   *GitInput  `protobuf:"bytes,5,opt,name=git" json:"git,omitempty"`
+	Join  []*Input   `protobuf:"bytes,6,rep,name=join" json:"join,omitempty"`
 }
 
 func (m *Input) Reset()                    { *m = Input{} }
@@ -594,6 +620,13 @@ func (m *Input) GetGit() *GitInput {
 	return nil
 }
 
+func (m *Input) GetJoin() []*Input {
+	if m != nil {
+		return m.Join
+	}
+	return nil
+}
+
 type JobInput struct {
 	Name   string      `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
 	Commit *pfs.Commit `protobuf:"bytes,1,opt,name=commit" json:"commit,omitempty"`
@@ -649,6 +682,10 @@ type ParallelismSpec struct {
 	// reserve half the nodes in your cluster for other tasks, you might set
 	// 'coefficient' to 0.5.
 	Coefficient float64 `protobuf:"fixed64,3,opt,name=coefficient,proto3" json:"coefficient,omitempty"`
+	// If 'autoscaling' is set, Pachyderm scales the pipeline's workers between
+	// 'autoscaling.min' and 'autoscaling.max' based on how much work is
+	// outstanding, instead of starting a fixed number of workers.
+	Autoscaling *Autoscaling `protobuf:"bytes,4,opt,name=autoscaling" json:"autoscaling,omitempty"`
 }
 
 func (m *ParallelismSpec) Reset()                    { *m = ParallelismSpec{} }
@@ -670,6 +707,86 @@ func (m *ParallelismSpec) GetCoefficient() float64 {
 	return 0
 }
 
+func (m *ParallelismSpec) GetAutoscaling() *Autoscaling {
+	if m != nil {
+		return m.Autoscaling
+	}
+	return nil
+}
+
+type Autoscaling struct {
+	// min is the smallest number of workers that the pipeline is scaled down to
+	// (a pipeline always has at least one worker).
+	Min uint64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	// max is the largest number of workers that the pipeline is scaled up to.
+	Max uint64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	// target_duration is how long the pipeline's outstanding datums should take
+	// to process. The number of workers is chosen by estimating the outstanding
+	// work from the datum chunks that haven't been processed yet and the recent
+	// processing time per datum (defaults to 5m).
+	TargetDuration *google_protobuf2.Duration `protobuf:"bytes,3,opt,name=target_duration,json=targetDuration" json:"target_duration,omitempty"`
+}
+
+func (m *Autoscaling) Reset()                    { *m = Autoscaling{} }
+func (m *Autoscaling) String() string            { return proto.CompactTextString(m) }
+func (*Autoscaling) ProtoMessage()               {}
+func (*Autoscaling) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
+
+func (m *Autoscaling) GetMin() uint64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *Autoscaling) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *Autoscaling) GetTargetDuration() *google_protobuf2.Duration {
+	if m != nil {
+		return m.TargetDuration
+	}
+	return nil
+}
+
+// AutoscalingStatus describes the most recent scaling decision for a pipeline
+// that autoscales.
+type AutoscalingStatus struct {
+	Workers int64                       `protobuf:"varint,1,opt,name=workers,proto3" json:"workers,omitempty"`
+	Reason  string                      `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Updated *google_protobuf1.Timestamp `protobuf:"bytes,3,opt,name=updated" json:"updated,omitempty"`
+}
+
+func (m *AutoscalingStatus) Reset()                    { *m = AutoscalingStatus{} }
+func (m *AutoscalingStatus) String() string            { return proto.CompactTextString(m) }
+func (*AutoscalingStatus) ProtoMessage()               {}
+func (*AutoscalingStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
+
+func (m *AutoscalingStatus) GetWorkers() int64 {
+	if m != nil {
+		return m.Workers
+	}
+	return 0
+}
+
+func (m *AutoscalingStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AutoscalingStatus) GetUpdated() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
 type InputFile struct {
 	// This file's absolute path within its pfs repo.
 	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
@@ -680,7 +797,7 @@ type InputFile struct {
 func (m *InputFile) Reset()                    { *m = InputFile{} }
 func (m *InputFile) String() string            { return proto.CompactTextString(m) }
 func (*InputFile) ProtoMessage()               {}
-func (*InputFile) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{11} }
+func (*InputFile) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
 
 func (m *InputFile) GetPath() string {
 	if m != nil {
@@ -705,7 +822,7 @@ type Datum struct {
 func (m *Datum) Reset()                    { *m = Datum{} }
 func (m *Datum) String() string            { return proto.CompactTextString(m) }
 func (*Datum) ProtoMessage()               {}
-func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{12} }
+func (*Datum) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
 
 func (m *Datum) GetID() string {
 	if m != nil {
@@ -727,12 +844,15 @@ type DatumInfo struct {
 	Stats    *ProcessStats   `protobuf:"bytes,3,opt,name=stats" json:"stats,omitempty"`
 	PfsState *pfs.File       `protobuf:"bytes,4,opt,name=pfs_state,json=pfsState" json:"pfs_state,omitempty"`
 	Data     []*pfs.FileInfo `protobuf:"bytes,5,rep,name=data" json:"data,omitempty"`
+	// attempts is the number of times the worker tried to process the datum
+	// (see CreatePipelineRequest.datum_tries)
+	Attempts int64 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (m *DatumInfo) Reset()                    { *m = DatumInfo{} }
 func (m *DatumInfo) String() string            { return proto.CompactTextString(m) }
 func (*DatumInfo) ProtoMessage()               {}
-func (*DatumInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{13} }
+func (*DatumInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
 
 func (m *DatumInfo) GetDatum() *Datum {
 	if m != nil {
@@ -769,6 +889,13 @@ func (m *DatumInfo) GetData() []*pfs.FileInfo {
 	return nil
 }
 
+func (m *DatumInfo) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 type Aggregate struct {
 	Count                 int64   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	Mean                  float64 `protobuf:"fixed64,2,opt,name=mean,proto3" json:"mean,omitempty"`
@@ -780,7 +907,7 @@ type Aggregate struct {
 func (m *Aggregate) Reset()                    { *m = Aggregate{} }
 func (m *Aggregate) String() string            { return proto.CompactTextString(m) }
 func (*Aggregate) ProtoMessage()               {}
-func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{14} }
+func (*Aggregate) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
 
 func (m *Aggregate) GetCount() int64 {
 	if m != nil {
@@ -823,12 +950,15 @@ type ProcessStats struct {
 	UploadTime    *google_protobuf2.Duration `protobuf:"bytes,3,opt,name=upload_time,json=uploadTime" json:"upload_time,omitempty"`
 	DownloadBytes uint64                     `protobuf:"varint,4,opt,name=download_bytes,json=downloadBytes,proto3" json:"download_bytes,omitempty"`
 	UploadBytes   uint64                     `protobuf:"varint,5,opt,name=upload_bytes,json=uploadBytes,proto3" json:"upload_bytes,omitempty"`
+	// attempts is the number of times datums were processed, including retries
+	// of datums that failed
+	Attempts int64 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (m *ProcessStats) Reset()                    { *m = ProcessStats{} }
 func (m *ProcessStats) String() string            { return proto.CompactTextString(m) }
 func (*ProcessStats) ProtoMessage()               {}
-func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{15} }
+func (*ProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
 
 func (m *ProcessStats) GetDownloadTime() *google_protobuf2.Duration {
 	if m != nil {
@@ -865,6 +995,13 @@ func (m *ProcessStats) GetUploadBytes() uint64 {
 	return 0
 }
 
+func (m *ProcessStats) GetAttempts() int64 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
 type AggregateProcessStats struct {
 	DownloadTime  *Aggregate `protobuf:"bytes,1,opt,name=download_time,json=downloadTime" json:"download_time,omitempty"`
 	ProcessTime   *Aggregate `protobuf:"bytes,2,opt,name=process_time,json=processTime" json:"process_time,omitempty"`
@@ -876,7 +1013,7 @@ type AggregateProcessStats struct {
 func (m *AggregateProcessStats) Reset()                    { *m = AggregateProcessStats{} }
 func (m *AggregateProcessStats) String() string            { return proto.CompactTextString(m) }
 func (*AggregateProcessStats) ProtoMessage()               {}
-func (*AggregateProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{16} }
+func (*AggregateProcessStats) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
 
 func (m *AggregateProcessStats) GetDownloadTime() *Aggregate {
 	if m != nil {
@@ -926,7 +1063,7 @@ type WorkerStatus struct {
 func (m *WorkerStatus) Reset()                    { *m = WorkerStatus{} }
 func (m *WorkerStatus) String() string            { return proto.CompactTextString(m) }
 func (*WorkerStatus) ProtoMessage()               {}
-func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{17} }
+func (*WorkerStatus) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
 
 func (m *WorkerStatus) GetWorkerID() string {
 	if m != nil {
@@ -986,7 +1123,7 @@ type ResourceSpec struct {
 func (m *ResourceSpec) Reset()                    { *m = ResourceSpec{} }
 func (m *ResourceSpec) String() string            { return proto.CompactTextString(m) }
 func (*ResourceSpec) ProtoMessage()               {}
-func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{18} }
+func (*ResourceSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
 
 func (m *ResourceSpec) GetCpu() float32 {
 	if m != nil {
@@ -1028,12 +1165,22 @@ type EtcdJobInfo struct {
 	StatsCommit *pfs.Commit   `protobuf:"bytes,10,opt,name=stats_commit,json=statsCommit" json:"stats_commit,omitempty"`
 	State       JobState      `protobuf:"varint,11,opt,name=state,proto3,enum=pps.JobState" json:"state,omitempty"`
 	Reason      string        `protobuf:"bytes,12,opt,name=reason,proto3" json:"reason,omitempty"`
+	// rerun is set for jobs created by RerunPipeline, whose output commits are
+	// not on the pipeline's output branch
+	Rerun bool `protobuf:"varint,13,opt,name=rerun,proto3" json:"rerun,omitempty"`
+	// failed_datums is set for jobs that skipped failed datums, and is an object
+	// containing the indices of the datums that failed (see ListDatumRequest.failed)
+	FailedDatums *pfs.Object `protobuf:"bytes,14,opt,name=failed_datums,json=failedDatums" json:"failed_datums,omitempty"`
+	// trace_parent is the span (as a W3C traceparent header) of the CreateJob
+	// call that created this job, if it was traced. Workers' spans for the job
+	// are its children.
+	TraceParent string `protobuf:"bytes,15,opt,name=trace_parent,json=traceParent,proto3" json:"trace_parent,omitempty"`
 }
 
 func (m *EtcdJobInfo) Reset()                    { *m = EtcdJobInfo{} }
 func (m *EtcdJobInfo) String() string            { return proto.CompactTextString(m) }
 func (*EtcdJobInfo) ProtoMessage()               {}
-func (*EtcdJobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{19} }
+func (*EtcdJobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
 
 func (m *EtcdJobInfo) GetJob() *Job {
 	if m != nil {
@@ -1119,6 +1266,27 @@ func (m *EtcdJobInfo) GetReason() string {
 	return ""
 }
 
+func (m *EtcdJobInfo) GetRerun() bool {
+	if m != nil {
+		return m.Rerun
+	}
+	return false
+}
+
+func (m *EtcdJobInfo) GetFailedDatums() *pfs.Object {
+	if m != nil {
+		return m.FailedDatums
+	}
+	return nil
+}
+
+func (m *EtcdJobInfo) GetTraceParent() string {
+	if m != nil {
+		return m.TraceParent
+	}
+	return ""
+}
+
 type JobInfo struct {
 	Job              *Job                        `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
 	Transform        *Transform                  `protobuf:"bytes,2,opt,name=transform" json:"transform,omitempty"`
@@ -1154,12 +1322,22 @@ type JobInfo struct {
 	ChunkSpec        *ChunkSpec                  `protobuf:"bytes,37,opt,name=chunk_spec,json=chunkSpec" json:"chunk_spec,omitempty"`
 	DatumTimeout     *google_protobuf2.Duration  `protobuf:"bytes,38,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
 	JobTimeout       *google_protobuf2.Duration  `protobuf:"bytes,39,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	Rerun            bool                        `protobuf:"varint,41,opt,name=rerun,proto3" json:"rerun,omitempty"`
+	DatumTries       int64                       `protobuf:"varint,42,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	DatumBackoff     *google_protobuf2.Duration  `protobuf:"bytes,43,opt,name=datum_backoff,json=datumBackoff" json:"datum_backoff,omitempty"`
+	SkipFailedDatums bool                        `protobuf:"varint,44,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	Priority         int64                       `protobuf:"varint,45,opt,name=priority,proto3" json:"priority,omitempty"`
+	// queue_position is the job's position in the cluster's job queue, if it's
+	// waiting for one of the cluster's max_concurrent_jobs slots (1 means it's
+	// next). It's 0 if the job isn't queued. This is not stored in etcd along
+	// with the rest of this data structure--PPS.InspectJob fills it in
+	QueuePosition int64 `protobuf:"varint,46,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
 }
 
 func (m *JobInfo) Reset()                    { *m = JobInfo{} }
 func (m *JobInfo) String() string            { return proto.CompactTextString(m) }
 func (*JobInfo) ProtoMessage()               {}
-func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{20} }
+func (*JobInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
 
 func (m *JobInfo) GetJob() *Job {
 	if m != nil {
@@ -1399,6 +1577,48 @@ func (m *JobInfo) GetJobTimeout() *google_protobuf2.Duration {
 	return nil
 }
 
+func (m *JobInfo) GetRerun() bool {
+	if m != nil {
+		return m.Rerun
+	}
+	return false
+}
+
+func (m *JobInfo) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *JobInfo) GetDatumBackoff() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumBackoff
+	}
+	return nil
+}
+
+func (m *JobInfo) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *JobInfo) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *JobInfo) GetQueuePosition() int64 {
+	if m != nil {
+		return m.QueuePosition
+	}
+	return 0
+}
+
 type Worker struct {
 	Name  string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	State WorkerState `protobuf:"varint,2,opt,name=state,proto3,enum=pps.WorkerState" json:"state,omitempty"`
@@ -1407,7 +1627,7 @@ type Worker struct {
 func (m *Worker) Reset()                    { *m = Worker{} }
 func (m *Worker) String() string            { return proto.CompactTextString(m) }
 func (*Worker) ProtoMessage()               {}
-func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{21} }
+func (*Worker) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
 
 func (m *Worker) GetName() string {
 	if m != nil {
@@ -1430,7 +1650,7 @@ type JobInfos struct {
 func (m *JobInfos) Reset()                    { *m = JobInfos{} }
 func (m *JobInfos) String() string            { return proto.CompactTextString(m) }
 func (*JobInfos) ProtoMessage()               {}
-func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{22} }
+func (*JobInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
 
 func (m *JobInfos) GetJobInfo() []*JobInfo {
 	if m != nil {
@@ -1446,7 +1666,7 @@ type Pipeline struct {
 func (m *Pipeline) Reset()                    { *m = Pipeline{} }
 func (m *Pipeline) String() string            { return proto.CompactTextString(m) }
 func (*Pipeline) ProtoMessage()               {}
-func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{23} }
+func (*Pipeline) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
 
 func (m *Pipeline) GetName() string {
 	if m != nil {
@@ -1467,7 +1687,7 @@ type PipelineInput struct {
 func (m *PipelineInput) Reset()                    { *m = PipelineInput{} }
 func (m *PipelineInput) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInput) ProtoMessage()               {}
-func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{24} }
+func (*PipelineInput) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
 
 func (m *PipelineInput) GetName() string {
 	if m != nil {
@@ -1511,21 +1731,73 @@ func (m *PipelineInput) GetFrom() *pfs.Commit {
 	return nil
 }
 
+// EtcdJobQueueEntry is stored in etcd for each job that needs one of the
+// cluster's max_concurrent_jobs slots. Jobs wait until pachd sets admitted,
+// and remove their entry when they finish.
+type EtcdJobQueueEntry struct {
+	Job      *Job                        `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
+	Pipeline *Pipeline                   `protobuf:"bytes,2,opt,name=pipeline" json:"pipeline,omitempty"`
+	Priority int64                       `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`
+	Queued   *google_protobuf1.Timestamp `protobuf:"bytes,4,opt,name=queued" json:"queued,omitempty"`
+	Admitted bool                        `protobuf:"varint,5,opt,name=admitted,proto3" json:"admitted,omitempty"`
+}
+
+func (m *EtcdJobQueueEntry) Reset()                    { *m = EtcdJobQueueEntry{} }
+func (m *EtcdJobQueueEntry) String() string            { return proto.CompactTextString(m) }
+func (*EtcdJobQueueEntry) ProtoMessage()               {}
+func (*EtcdJobQueueEntry) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
+
+func (m *EtcdJobQueueEntry) GetJob() *Job {
+	if m != nil {
+		return m.Job
+	}
+	return nil
+}
+
+func (m *EtcdJobQueueEntry) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *EtcdJobQueueEntry) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *EtcdJobQueueEntry) GetQueued() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.Queued
+	}
+	return nil
+}
+
+func (m *EtcdJobQueueEntry) GetAdmitted() bool {
+	if m != nil {
+		return m.Admitted
+	}
+	return false
+}
+
 // EtcdPipelineInfo is proto that Pachd stores in etcd for each pipeline. It
 // tracks the state of the pipeline, and points to its metadata in PFS (and,
 // by pointing to a PFS commit, de facto tracks the pipeline's version)
 type EtcdPipelineInfo struct {
-	State      PipelineState   `protobuf:"varint,1,opt,name=state,proto3,enum=pps.PipelineState" json:"state,omitempty"`
-	Reason     string          `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	SpecCommit *pfs.Commit     `protobuf:"bytes,2,opt,name=spec_commit,json=specCommit" json:"spec_commit,omitempty"`
-	JobCounts  map[int32]int32 `protobuf:"bytes,3,rep,name=job_counts,json=jobCounts" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	AuthToken  string          `protobuf:"bytes,5,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	State             PipelineState      `protobuf:"varint,1,opt,name=state,proto3,enum=pps.PipelineState" json:"state,omitempty"`
+	Reason            string             `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	SpecCommit        *pfs.Commit        `protobuf:"bytes,2,opt,name=spec_commit,json=specCommit" json:"spec_commit,omitempty"`
+	JobCounts         map[int32]int32    `protobuf:"bytes,3,rep,name=job_counts,json=jobCounts" json:"job_counts,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AuthToken         string             `protobuf:"bytes,5,opt,name=auth_token,json=authToken,proto3" json:"auth_token,omitempty"`
+	AutoscalingStatus *AutoscalingStatus `protobuf:"bytes,6,opt,name=autoscaling_status,json=autoscalingStatus" json:"autoscaling_status,omitempty"`
 }
 
 func (m *EtcdPipelineInfo) Reset()                    { *m = EtcdPipelineInfo{} }
 func (m *EtcdPipelineInfo) String() string            { return proto.CompactTextString(m) }
 func (*EtcdPipelineInfo) ProtoMessage()               {}
-func (*EtcdPipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{25} }
+func (*EtcdPipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
 
 func (m *EtcdPipelineInfo) GetState() PipelineState {
 	if m != nil {
@@ -1562,6 +1834,13 @@ func (m *EtcdPipelineInfo) GetAuthToken() string {
 	return ""
 }
 
+func (m *EtcdPipelineInfo) GetAutoscalingStatus() *AutoscalingStatus {
+	if m != nil {
+		return m.AutoscalingStatus
+	}
+	return nil
+}
+
 type PipelineInfo struct {
 	ID              string                      `protobuf:"bytes,17,opt,name=id,proto3" json:"id,omitempty"`
 	Pipeline        *Pipeline                   `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
@@ -1591,20 +1870,28 @@ type PipelineInfo struct {
 	Salt               string                     `protobuf:"bytes,25,opt,name=salt,proto3" json:"salt,omitempty"`
 	Batch              bool                       `protobuf:"varint,27,opt,name=batch,proto3" json:"batch,omitempty"`
 	// reason includes any error messages associated with a failed pipeline
-	Reason       string                     `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
-	MaxQueueSize int64                      `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
-	Service      *Service                   `protobuf:"bytes,30,opt,name=service" json:"service,omitempty"`
-	ChunkSpec    *ChunkSpec                 `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec" json:"chunk_spec,omitempty"`
-	DatumTimeout *google_protobuf2.Duration `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
-	JobTimeout   *google_protobuf2.Duration `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
-	GithookURL   string                     `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
-	SpecCommit   *pfs.Commit                `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit" json:"spec_commit,omitempty"`
+	Reason           string                     `protobuf:"bytes,28,opt,name=reason,proto3" json:"reason,omitempty"`
+	MaxQueueSize     int64                      `protobuf:"varint,29,opt,name=max_queue_size,json=maxQueueSize,proto3" json:"max_queue_size,omitempty"`
+	Service          *Service                   `protobuf:"bytes,30,opt,name=service" json:"service,omitempty"`
+	ChunkSpec        *ChunkSpec                 `protobuf:"bytes,32,opt,name=chunk_spec,json=chunkSpec" json:"chunk_spec,omitempty"`
+	DatumTimeout     *google_protobuf2.Duration `protobuf:"bytes,33,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
+	JobTimeout       *google_protobuf2.Duration `protobuf:"bytes,34,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
+	GithookURL       string                     `protobuf:"bytes,35,opt,name=githook_url,json=githookUrl,proto3" json:"githook_url,omitempty"`
+	SpecCommit       *pfs.Commit                `protobuf:"bytes,36,opt,name=spec_commit,json=specCommit" json:"spec_commit,omitempty"`
+	DatumTries       int64                      `protobuf:"varint,37,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	DatumBackoff     *google_protobuf2.Duration `protobuf:"bytes,38,opt,name=datum_backoff,json=datumBackoff" json:"datum_backoff,omitempty"`
+	SkipFailedDatums bool                       `protobuf:"varint,39,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	Priority         int64                      `protobuf:"varint,40,opt,name=priority,proto3" json:"priority,omitempty"`
+	// autoscaling_status is set for pipelines that autoscale. This is not stored
+	// in PFS along with the rest of this data structure--PPS.InspectPipeline
+	// fills it in
+	AutoscalingStatus *AutoscalingStatus `protobuf:"bytes,41,opt,name=autoscaling_status,json=autoscalingStatus" json:"autoscaling_status,omitempty"`
 }
 
 func (m *PipelineInfo) Reset()                    { *m = PipelineInfo{} }
 func (m *PipelineInfo) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfo) ProtoMessage()               {}
-func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{26} }
+func (*PipelineInfo) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
 
 func (m *PipelineInfo) GetID() string {
 	if m != nil {
@@ -1809,6 +2096,41 @@ func (m *PipelineInfo) GetSpecCommit() *pfs.Commit {
 	return nil
 }
 
+func (m *PipelineInfo) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetDatumBackoff() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumBackoff
+	}
+	return nil
+}
+
+func (m *PipelineInfo) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *PipelineInfo) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *PipelineInfo) GetAutoscalingStatus() *AutoscalingStatus {
+	if m != nil {
+		return m.AutoscalingStatus
+	}
+	return nil
+}
+
 type PipelineInfos struct {
 	PipelineInfo []*PipelineInfo `protobuf:"bytes,1,rep,name=pipeline_info,json=pipelineInfo" json:"pipeline_info,omitempty"`
 }
@@ -1816,7 +2138,7 @@ type PipelineInfos struct {
 func (m *PipelineInfos) Reset()                    { *m = PipelineInfos{} }
 func (m *PipelineInfos) String() string            { return proto.CompactTextString(m) }
 func (*PipelineInfos) ProtoMessage()               {}
-func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{27} }
+func (*PipelineInfos) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
 
 func (m *PipelineInfos) GetPipelineInfo() []*PipelineInfo {
 	if m != nil {
@@ -1833,7 +2155,7 @@ type CreateJobRequest struct {
 func (m *CreateJobRequest) Reset()                    { *m = CreateJobRequest{} }
 func (m *CreateJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreateJobRequest) ProtoMessage()               {}
-func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{28} }
+func (*CreateJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
 
 func (m *CreateJobRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1857,7 +2179,7 @@ type InspectJobRequest struct {
 func (m *InspectJobRequest) Reset()                    { *m = InspectJobRequest{} }
 func (m *InspectJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectJobRequest) ProtoMessage()               {}
-func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{29} }
+func (*InspectJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
 
 func (m *InspectJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1882,7 +2204,7 @@ type ListJobRequest struct {
 func (m *ListJobRequest) Reset()                    { *m = ListJobRequest{} }
 func (m *ListJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListJobRequest) ProtoMessage()               {}
-func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{30} }
+func (*ListJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
 
 func (m *ListJobRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -1913,7 +2235,7 @@ type FlushJobRequest struct {
 func (m *FlushJobRequest) Reset()                    { *m = FlushJobRequest{} }
 func (m *FlushJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*FlushJobRequest) ProtoMessage()               {}
-func (*FlushJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{31} }
+func (*FlushJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
 
 func (m *FlushJobRequest) GetCommits() []*pfs.Commit {
 	if m != nil {
@@ -1936,7 +2258,7 @@ type DeleteJobRequest struct {
 func (m *DeleteJobRequest) Reset()                    { *m = DeleteJobRequest{} }
 func (m *DeleteJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteJobRequest) ProtoMessage()               {}
-func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{32} }
+func (*DeleteJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
 
 func (m *DeleteJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1952,7 +2274,7 @@ type StopJobRequest struct {
 func (m *StopJobRequest) Reset()                    { *m = StopJobRequest{} }
 func (m *StopJobRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopJobRequest) ProtoMessage()               {}
-func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{33} }
+func (*StopJobRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
 
 func (m *StopJobRequest) GetJob() *Job {
 	if m != nil {
@@ -1987,7 +2309,7 @@ type GetLogsRequest struct {
 func (m *GetLogsRequest) Reset()                    { *m = GetLogsRequest{} }
 func (m *GetLogsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetLogsRequest) ProtoMessage()               {}
-func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{34} }
+func (*GetLogsRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
 
 func (m *GetLogsRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2060,7 +2382,7 @@ type LogMessage struct {
 func (m *LogMessage) Reset()                    { *m = LogMessage{} }
 func (m *LogMessage) String() string            { return proto.CompactTextString(m) }
 func (*LogMessage) ProtoMessage()               {}
-func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{35} }
+func (*LogMessage) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
 
 func (m *LogMessage) GetPipelineName() string {
 	if m != nil {
@@ -2133,7 +2455,7 @@ type RestartDatumRequest struct {
 func (m *RestartDatumRequest) Reset()                    { *m = RestartDatumRequest{} }
 func (m *RestartDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*RestartDatumRequest) ProtoMessage()               {}
-func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{36} }
+func (*RestartDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
 
 func (m *RestartDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -2156,7 +2478,7 @@ type InspectDatumRequest struct {
 func (m *InspectDatumRequest) Reset()                    { *m = InspectDatumRequest{} }
 func (m *InspectDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectDatumRequest) ProtoMessage()               {}
-func (*InspectDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{37} }
+func (*InspectDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
 
 func (m *InspectDatumRequest) GetDatum() *Datum {
 	if m != nil {
@@ -2169,12 +2491,15 @@ type ListDatumRequest struct {
 	Job      *Job  `protobuf:"bytes,1,opt,name=job" json:"job,omitempty"`
 	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	Page     int64 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	// failed, if set, lists only the datums that a job skipped because they
+	// failed (see CreatePipelineRequest.skip_failed_datums)
+	Failed bool `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
 }
 
 func (m *ListDatumRequest) Reset()                    { *m = ListDatumRequest{} }
 func (m *ListDatumRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumRequest) ProtoMessage()               {}
-func (*ListDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{38} }
+func (*ListDatumRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
 
 func (m *ListDatumRequest) GetJob() *Job {
 	if m != nil {
@@ -2197,6 +2522,13 @@ func (m *ListDatumRequest) GetPage() int64 {
 	return 0
 }
 
+func (m *ListDatumRequest) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
 type ListDatumResponse struct {
 	DatumInfos []*DatumInfo `protobuf:"bytes,1,rep,name=datum_infos,json=datumInfos" json:"datum_infos,omitempty"`
 	TotalPages int64        `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
@@ -2206,7 +2538,7 @@ type ListDatumResponse struct {
 func (m *ListDatumResponse) Reset()                    { *m = ListDatumResponse{} }
 func (m *ListDatumResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumResponse) ProtoMessage()               {}
-func (*ListDatumResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{39} }
+func (*ListDatumResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
 
 func (m *ListDatumResponse) GetDatumInfos() []*DatumInfo {
 	if m != nil {
@@ -2244,7 +2576,7 @@ type ListDatumStreamResponse struct {
 func (m *ListDatumStreamResponse) Reset()                    { *m = ListDatumStreamResponse{} }
 func (m *ListDatumStreamResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListDatumStreamResponse) ProtoMessage()               {}
-func (*ListDatumStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{40} }
+func (*ListDatumStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
 
 func (m *ListDatumStreamResponse) GetDatumInfo() *DatumInfo {
 	if m != nil {
@@ -2282,7 +2614,7 @@ type ChunkSpec struct {
 func (m *ChunkSpec) Reset()                    { *m = ChunkSpec{} }
 func (m *ChunkSpec) String() string            { return proto.CompactTextString(m) }
 func (*ChunkSpec) ProtoMessage()               {}
-func (*ChunkSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{41} }
+func (*ChunkSpec) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
 
 func (m *ChunkSpec) GetNumber() int64 {
 	if m != nil {
@@ -2323,12 +2655,35 @@ type CreatePipelineRequest struct {
 	DatumTimeout *google_protobuf2.Duration `protobuf:"bytes,24,opt,name=datum_timeout,json=datumTimeout" json:"datum_timeout,omitempty"`
 	JobTimeout   *google_protobuf2.Duration `protobuf:"bytes,25,opt,name=job_timeout,json=jobTimeout" json:"job_timeout,omitempty"`
 	Salt         string                     `protobuf:"bytes,26,opt,name=salt,proto3" json:"salt,omitempty"`
+	// datum_tries is the number of times a worker tries to process a datum
+	// before marking it FAILED (defaults to 3)
+	DatumTries int64 `protobuf:"varint,27,opt,name=datum_tries,json=datumTries,proto3" json:"datum_tries,omitempty"`
+	// datum_backoff is how long a worker waits before retrying a failed datum.
+	// The wait doubles after each failed attempt (defaults to 1s)
+	DatumBackoff *google_protobuf2.Duration `protobuf:"bytes,28,opt,name=datum_backoff,json=datumBackoff" json:"datum_backoff,omitempty"`
+	// skip_failed_datums lets jobs succeed even if some of their datums fail.
+	// Failed datums are left out of the output commit, and the job finishes in
+	// the state JOB_SUCCESS_WITH_FAILURES
+	SkipFailedDatums bool `protobuf:"varint,29,opt,name=skip_failed_datums,json=skipFailedDatums,proto3" json:"skip_failed_datums,omitempty"`
+	// priority determines the order in which the pipeline's jobs are started
+	// when the cluster is running its maximum number of concurrent jobs. Queued
+	// jobs with a higher priority start first (defaults to 0)
+	Priority int64 `protobuf:"varint,30,opt,name=priority,proto3" json:"priority,omitempty"`
+	// dry_run validates the pipeline, as it would be validated if it were
+	// created, without creating or updating it. Dry runs aren't recorded in
+	// the audit log or in usage metrics
+	DryRun bool `protobuf:"varint,31,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// dry_run_new_repos are repos that don't exist yet, but will exist once the
+	// pipeline is created (e.g. the output repos of the pipelines that precede
+	// it in a pipeline spec). A dry run doesn't require inputs from these repos
+	// to exist. It's ignored unless dry_run is set.
+	DryRunNewRepos []string `protobuf:"bytes,32,rep,name=dry_run_new_repos,json=dryRunNewRepos" json:"dry_run_new_repos,omitempty"`
 }
 
 func (m *CreatePipelineRequest) Reset()                    { *m = CreatePipelineRequest{} }
 func (m *CreatePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*CreatePipelineRequest) ProtoMessage()               {}
-func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{42} }
+func (*CreatePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
 
 func (m *CreatePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2484,6 +2839,48 @@ func (m *CreatePipelineRequest) GetSalt() string {
 	return ""
 }
 
+func (m *CreatePipelineRequest) GetDatumTries() int64 {
+	if m != nil {
+		return m.DatumTries
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetDatumBackoff() *google_protobuf2.Duration {
+	if m != nil {
+		return m.DatumBackoff
+	}
+	return nil
+}
+
+func (m *CreatePipelineRequest) GetSkipFailedDatums() bool {
+	if m != nil {
+		return m.SkipFailedDatums
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *CreatePipelineRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *CreatePipelineRequest) GetDryRunNewRepos() []string {
+	if m != nil {
+		return m.DryRunNewRepos
+	}
+	return nil
+}
+
 type InspectPipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 }
@@ -2491,7 +2888,7 @@ type InspectPipelineRequest struct {
 func (m *InspectPipelineRequest) Reset()                    { *m = InspectPipelineRequest{} }
 func (m *InspectPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*InspectPipelineRequest) ProtoMessage()               {}
-func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{43} }
+func (*InspectPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
 
 func (m *InspectPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2506,7 +2903,7 @@ type ListPipelineRequest struct {
 func (m *ListPipelineRequest) Reset()                    { *m = ListPipelineRequest{} }
 func (m *ListPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPipelineRequest) ProtoMessage()               {}
-func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{44} }
+func (*ListPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
 
 type DeletePipelineRequest struct {
 	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
@@ -2516,7 +2913,7 @@ type DeletePipelineRequest struct {
 func (m *DeletePipelineRequest) Reset()                    { *m = DeletePipelineRequest{} }
 func (m *DeletePipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeletePipelineRequest) ProtoMessage()               {}
-func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{45} }
+func (*DeletePipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{48} }
 
 func (m *DeletePipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2539,7 +2936,7 @@ type StartPipelineRequest struct {
 func (m *StartPipelineRequest) Reset()                    { *m = StartPipelineRequest{} }
 func (m *StartPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StartPipelineRequest) ProtoMessage()               {}
-func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{46} }
+func (*StartPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{49} }
 
 func (m *StartPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2555,7 +2952,7 @@ type StopPipelineRequest struct {
 func (m *StopPipelineRequest) Reset()                    { *m = StopPipelineRequest{} }
 func (m *StopPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopPipelineRequest) ProtoMessage()               {}
-func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{47} }
+func (*StopPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{50} }
 
 func (m *StopPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2573,7 +2970,7 @@ type RerunPipelineRequest struct {
 func (m *RerunPipelineRequest) Reset()                    { *m = RerunPipelineRequest{} }
 func (m *RerunPipelineRequest) String() string            { return proto.CompactTextString(m) }
 func (*RerunPipelineRequest) ProtoMessage()               {}
-func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{48} }
+func (*RerunPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{51} }
 
 func (m *RerunPipelineRequest) GetPipeline() *Pipeline {
 	if m != nil {
@@ -2596,52 +2993,177 @@ func (m *RerunPipelineRequest) GetInclude() []*pfs.Commit {
 	return nil
 }
 
-type GarbageCollectRequest struct {
+type ListPipelineHistoryRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
 }
 
-func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
-func (m *GarbageCollectRequest) String() string            { return proto.CompactTextString(m) }
-func (*GarbageCollectRequest) ProtoMessage()               {}
-func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{49} }
+func (m *ListPipelineHistoryRequest) Reset()                    { *m = ListPipelineHistoryRequest{} }
+func (m *ListPipelineHistoryRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPipelineHistoryRequest) ProtoMessage()               {}
+func (*ListPipelineHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{52} }
 
-type GarbageCollectResponse struct {
+func (m *ListPipelineHistoryRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
 }
 
-func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
-func (m *GarbageCollectResponse) String() string            { return proto.CompactTextString(m) }
-func (*GarbageCollectResponse) ProtoMessage()               {}
-func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{50} }
+// PipelineVersion is one version of a pipeline's spec, as stored in the
+// pipeline's branch of the spec repo
+type PipelineVersion struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// created_at is the time at which this version was created
+	CreatedAt *google_protobuf1.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt" json:"created_at,omitempty"`
+	// author is the user who created this version (empty if auth wasn't
+	// activated at the time)
+	Author     string      `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	SpecCommit *pfs.Commit `protobuf:"bytes,4,opt,name=spec_commit,json=specCommit" json:"spec_commit,omitempty"`
+	// pipeline_info is the pipeline's spec at this version. Fields that aren't
+	// stored in the spec repo (state, job_counts, etc) are unset
+	PipelineInfo *PipelineInfo `protobuf:"bytes,5,opt,name=pipeline_info,json=pipelineInfo" json:"pipeline_info,omitempty"`
+}
 
-type ActivateAuthRequest struct {
+func (m *PipelineVersion) Reset()                    { *m = PipelineVersion{} }
+func (m *PipelineVersion) String() string            { return proto.CompactTextString(m) }
+func (*PipelineVersion) ProtoMessage()               {}
+func (*PipelineVersion) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{53} }
+
+func (m *PipelineVersion) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *ActivateAuthRequest) Reset()                    { *m = ActivateAuthRequest{} }
-func (m *ActivateAuthRequest) String() string            { return proto.CompactTextString(m) }
-func (*ActivateAuthRequest) ProtoMessage()               {}
-func (*ActivateAuthRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{51} }
+func (m *PipelineVersion) GetCreatedAt() *google_protobuf1.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
 
-type ActivateAuthResponse struct {
+func (m *PipelineVersion) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
 }
 
-func (m *ActivateAuthResponse) Reset()                    { *m = ActivateAuthResponse{} }
-func (m *ActivateAuthResponse) String() string            { return proto.CompactTextString(m) }
-func (*ActivateAuthResponse) ProtoMessage()               {}
-func (*ActivateAuthResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{52} }
+func (m *PipelineVersion) GetSpecCommit() *pfs.Commit {
+	if m != nil {
+		return m.SpecCommit
+	}
+	return nil
+}
 
-func init() {
-	proto.RegisterType((*Secret)(nil), "pps.Secret")
-	proto.RegisterType((*Transform)(nil), "pps.Transform")
-	proto.RegisterType((*Egress)(nil), "pps.Egress")
-	proto.RegisterType((*Job)(nil), "pps.Job")
-	proto.RegisterType((*Service)(nil), "pps.Service")
-	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
-	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
-	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
-	proto.RegisterType((*Input)(nil), "pps.Input")
-	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
-	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
-	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
-	proto.RegisterType((*Datum)(nil), "pps.Datum")
+func (m *PipelineVersion) GetPipelineInfo() *PipelineInfo {
+	if m != nil {
+		return m.PipelineInfo
+	}
+	return nil
+}
+
+type PipelineHistory struct {
+	// versions holds the pipeline's versions, newest first
+	Versions []*PipelineVersion `protobuf:"bytes,1,rep,name=versions" json:"versions,omitempty"`
+}
+
+func (m *PipelineHistory) Reset()                    { *m = PipelineHistory{} }
+func (m *PipelineHistory) String() string            { return proto.CompactTextString(m) }
+func (*PipelineHistory) ProtoMessage()               {}
+func (*PipelineHistory) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{54} }
+
+func (m *PipelineHistory) GetVersions() []*PipelineVersion {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type RollbackPipelineRequest struct {
+	Pipeline *Pipeline `protobuf:"bytes,1,opt,name=pipeline" json:"pipeline,omitempty"`
+	// version is the version of the pipeline's spec to restore. Rolling back
+	// creates a new version of the pipeline with that spec.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// reprocess has the same meaning as in CreatePipelineRequest
+	Reprocess bool `protobuf:"varint,3,opt,name=reprocess,proto3" json:"reprocess,omitempty"`
+}
+
+func (m *RollbackPipelineRequest) Reset()                    { *m = RollbackPipelineRequest{} }
+func (m *RollbackPipelineRequest) String() string            { return proto.CompactTextString(m) }
+func (*RollbackPipelineRequest) ProtoMessage()               {}
+func (*RollbackPipelineRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{55} }
+
+func (m *RollbackPipelineRequest) GetPipeline() *Pipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return nil
+}
+
+func (m *RollbackPipelineRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RollbackPipelineRequest) GetReprocess() bool {
+	if m != nil {
+		return m.Reprocess
+	}
+	return false
+}
+
+type GarbageCollectRequest struct {
+}
+
+func (m *GarbageCollectRequest) Reset()                    { *m = GarbageCollectRequest{} }
+func (m *GarbageCollectRequest) String() string            { return proto.CompactTextString(m) }
+func (*GarbageCollectRequest) ProtoMessage()               {}
+func (*GarbageCollectRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{56} }
+
+type GarbageCollectResponse struct {
+}
+
+func (m *GarbageCollectResponse) Reset()                    { *m = GarbageCollectResponse{} }
+func (m *GarbageCollectResponse) String() string            { return proto.CompactTextString(m) }
+func (*GarbageCollectResponse) ProtoMessage()               {}
+func (*GarbageCollectResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{57} }
+
+type ActivateAuthRequest struct {
+}
+
+func (m *ActivateAuthRequest) Reset()                    { *m = ActivateAuthRequest{} }
+func (m *ActivateAuthRequest) String() string            { return proto.CompactTextString(m) }
+func (*ActivateAuthRequest) ProtoMessage()               {}
+func (*ActivateAuthRequest) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{58} }
+
+type ActivateAuthResponse struct {
+}
+
+func (m *ActivateAuthResponse) Reset()                    { *m = ActivateAuthResponse{} }
+func (m *ActivateAuthResponse) String() string            { return proto.CompactTextString(m) }
+func (*ActivateAuthResponse) ProtoMessage()               {}
+func (*ActivateAuthResponse) Descriptor() ([]byte, []int) { return fileDescriptorPps, []int{59} }
+
+func init() {
+	proto.RegisterType((*Secret)(nil), "pps.Secret")
+	proto.RegisterType((*Transform)(nil), "pps.Transform")
+	proto.RegisterType((*Egress)(nil), "pps.Egress")
+	proto.RegisterType((*Job)(nil), "pps.Job")
+	proto.RegisterType((*Service)(nil), "pps.Service")
+	proto.RegisterType((*AtomInput)(nil), "pps.AtomInput")
+	proto.RegisterType((*CronInput)(nil), "pps.CronInput")
+	proto.RegisterType((*GitInput)(nil), "pps.GitInput")
+	proto.RegisterType((*Input)(nil), "pps.Input")
+	proto.RegisterType((*JobInput)(nil), "pps.JobInput")
+	proto.RegisterType((*ParallelismSpec)(nil), "pps.ParallelismSpec")
+	proto.RegisterType((*Autoscaling)(nil), "pps.Autoscaling")
+	proto.RegisterType((*AutoscalingStatus)(nil), "pps.AutoscalingStatus")
+	proto.RegisterType((*InputFile)(nil), "pps.InputFile")
+	proto.RegisterType((*Datum)(nil), "pps.Datum")
 	proto.RegisterType((*DatumInfo)(nil), "pps.DatumInfo")
 	proto.RegisterType((*Aggregate)(nil), "pps.Aggregate")
 	proto.RegisterType((*ProcessStats)(nil), "pps.ProcessStats")
@@ -2654,6 +3176,7 @@ func init() {
 	proto.RegisterType((*JobInfos)(nil), "pps.JobInfos")
 	proto.RegisterType((*Pipeline)(nil), "pps.Pipeline")
 	proto.RegisterType((*PipelineInput)(nil), "pps.PipelineInput")
+	proto.RegisterType((*EtcdJobQueueEntry)(nil), "pps.EtcdJobQueueEntry")
 	proto.RegisterType((*EtcdPipelineInfo)(nil), "pps.EtcdPipelineInfo")
 	proto.RegisterType((*PipelineInfo)(nil), "pps.PipelineInfo")
 	proto.RegisterType((*PipelineInfos)(nil), "pps.PipelineInfos")
@@ -2678,6 +3201,10 @@ func init() {
 	proto.RegisterType((*StartPipelineRequest)(nil), "pps.StartPipelineRequest")
 	proto.RegisterType((*StopPipelineRequest)(nil), "pps.StopPipelineRequest")
 	proto.RegisterType((*RerunPipelineRequest)(nil), "pps.RerunPipelineRequest")
+	proto.RegisterType((*ListPipelineHistoryRequest)(nil), "pps.ListPipelineHistoryRequest")
+	proto.RegisterType((*PipelineVersion)(nil), "pps.PipelineVersion")
+	proto.RegisterType((*PipelineHistory)(nil), "pps.PipelineHistory")
+	proto.RegisterType((*RollbackPipelineRequest)(nil), "pps.RollbackPipelineRequest")
 	proto.RegisterType((*GarbageCollectRequest)(nil), "pps.GarbageCollectRequest")
 	proto.RegisterType((*GarbageCollectResponse)(nil), "pps.GarbageCollectResponse")
 	proto.RegisterType((*ActivateAuthRequest)(nil), "pps.ActivateAuthRequest")
@@ -2723,6 +3250,8 @@ type APIClient interface {
 	StartPipeline(ctx context.Context, in *StartPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	StopPipeline(ctx context.Context, in *StopPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	RerunPipeline(ctx context.Context, in *RerunPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
+	ListPipelineHistory(ctx context.Context, in *ListPipelineHistoryRequest, opts ...grpc.CallOption) (*PipelineHistory, error)
+	RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error)
 	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (API_GetLogsClient, error)
@@ -2972,6 +3501,24 @@ func (c *aPIClient) RerunPipeline(ctx context.Context, in *RerunPipelineRequest,
 	return out, nil
 }
 
+func (c *aPIClient) ListPipelineHistory(ctx context.Context, in *ListPipelineHistoryRequest, opts ...grpc.CallOption) (*PipelineHistory, error) {
+	out := new(PipelineHistory)
+	err := grpc.Invoke(ctx, "/pps.API/ListPipelineHistory", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RollbackPipeline(ctx context.Context, in *RollbackPipelineRequest, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
+	out := new(google_protobuf.Empty)
+	err := grpc.Invoke(ctx, "/pps.API/RollbackPipeline", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DeleteAll(ctx context.Context, in *google_protobuf.Empty, opts ...grpc.CallOption) (*google_protobuf.Empty, error) {
 	out := new(google_protobuf.Empty)
 	err := grpc.Invoke(ctx, "/pps.API/DeleteAll", in, out, c.cc, opts...)
@@ -3058,6 +3605,8 @@ type APIServer interface {
 	StartPipeline(context.Context, *StartPipelineRequest) (*google_protobuf.Empty, error)
 	StopPipeline(context.Context, *StopPipelineRequest) (*google_protobuf.Empty, error)
 	RerunPipeline(context.Context, *RerunPipelineRequest) (*google_protobuf.Empty, error)
+	ListPipelineHistory(context.Context, *ListPipelineHistoryRequest) (*PipelineHistory, error)
+	RollbackPipeline(context.Context, *RollbackPipelineRequest) (*google_protobuf.Empty, error)
 	// DeleteAll deletes everything
 	DeleteAll(context.Context, *google_protobuf.Empty) (*google_protobuf.Empty, error)
 	GetLogs(*GetLogsRequest, API_GetLogsServer) error
@@ -3405,6 +3954,42 @@ func _API_RerunPipeline_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ListPipelineHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPipelineHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListPipelineHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/ListPipelineHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPipelineHistory(ctx, req.(*ListPipelineHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RollbackPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RollbackPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pps.API/RollbackPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RollbackPipeline(ctx, req.(*RollbackPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(google_protobuf.Empty)
 	if err := dec(in); err != nil {
@@ -3544,6 +4129,14 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RerunPipeline",
 			Handler:    _API_RerunPipeline_Handler,
 		},
+		{
+			MethodName: "ListPipelineHistory",
+			Handler:    _API_ListPipelineHistory_Handler,
+		},
+		{
+			MethodName: "RollbackPipeline",
+			Handler:    _API_RollbackPipeline_Handler,
+		},
 		{
 			MethodName: "DeleteAll",
 			Handler:    _API_DeleteAll_Handler,
@@ -3897,6 +4490,12 @@ func (m *AtomInput) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if len(m.JoinOn) > 0 {
+		dAtA[i] = 0x4a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.JoinOn)))
+		i += copy(dAtA[i:], m.JoinOn)
+	}
 	return i, nil
 }
 
@@ -4063,6 +4662,18 @@ func (m *Input) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n6
 	}
+	if len(m.Join) > 0 {
+		for _, msg := range m.Join {
+			dAtA[i] = 0x32
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
 	return i, nil
 }
 
@@ -4142,6 +4753,93 @@ func (m *ParallelismSpec) MarshalTo(dAtA []byte) (int, error) {
 		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Coefficient))))
 		i += 8
 	}
+	if m.Autoscaling != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Autoscaling.Size()))
+		n8, err := m.Autoscaling.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n8
+	}
+	return i, nil
+}
+
+func (m *Autoscaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Autoscaling) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Min != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Min))
+	}
+	if m.Max != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Max))
+	}
+	if m.TargetDuration != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.TargetDuration.Size()))
+		n9, err := m.TargetDuration.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n9
+	}
+	return i, nil
+}
+
+func (m *AutoscalingStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoscalingStatus) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Workers != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Workers))
+	}
+	if len(m.Reason) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
+		i += copy(dAtA[i:], m.Reason)
+	}
+	if m.Updated != nil {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Updated.Size()))
+		n10, err := m.Updated.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
 	return i, nil
 }
 
@@ -4200,11 +4898,11 @@ func (m *Datum) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n8, err := m.Job.MarshalTo(dAtA[i:])
+		n11, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n8
+		i += n11
 	}
 	return i, nil
 }
@@ -4228,11 +4926,11 @@ func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n9, err := m.Datum.MarshalTo(dAtA[i:])
+		n12, err := m.Datum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n9
+		i += n12
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x10
@@ -4243,21 +4941,21 @@ func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n10, err := m.Stats.MarshalTo(dAtA[i:])
+		n13, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n13
 	}
 	if m.PfsState != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.PfsState.Size()))
-		n11, err := m.PfsState.MarshalTo(dAtA[i:])
+		n14, err := m.PfsState.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n14
 	}
 	if len(m.Data) > 0 {
 		for _, msg := range m.Data {
@@ -4271,6 +4969,11 @@ func (m *DatumInfo) MarshalTo(dAtA []byte) (int, error) {
 			i += n
 		}
 	}
+	if m.Attempts != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Attempts))
+	}
 	return i, nil
 }
 
@@ -4340,31 +5043,31 @@ func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
-		n12, err := m.DownloadTime.MarshalTo(dAtA[i:])
+		n15, err := m.DownloadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	if m.ProcessTime != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
-		n13, err := m.ProcessTime.MarshalTo(dAtA[i:])
+		n16, err := m.ProcessTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n16
 	}
 	if m.UploadTime != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
-		n14, err := m.UploadTime.MarshalTo(dAtA[i:])
+		n17, err := m.UploadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n17
 	}
 	if m.DownloadBytes != 0 {
 		dAtA[i] = 0x20
@@ -4376,6 +5079,11 @@ func (m *ProcessStats) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes))
 	}
+	if m.Attempts != 0 {
+		dAtA[i] = 0x30
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Attempts))
+	}
 	return i, nil
 }
 
@@ -4398,51 +5106,51 @@ func (m *AggregateProcessStats) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DownloadTime.Size()))
-		n15, err := m.DownloadTime.MarshalTo(dAtA[i:])
+		n18, err := m.DownloadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.ProcessTime != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ProcessTime.Size()))
-		n16, err := m.ProcessTime.MarshalTo(dAtA[i:])
+		n19, err := m.ProcessTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n19
 	}
 	if m.UploadTime != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadTime.Size()))
-		n17, err := m.UploadTime.MarshalTo(dAtA[i:])
+		n20, err := m.UploadTime.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n20
 	}
 	if m.DownloadBytes != nil {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DownloadBytes.Size()))
-		n18, err := m.DownloadBytes.MarshalTo(dAtA[i:])
+		n21, err := m.DownloadBytes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n21
 	}
 	if m.UploadBytes != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.UploadBytes.Size()))
-		n19, err := m.UploadBytes.MarshalTo(dAtA[i:])
+		n22, err := m.UploadBytes.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n22
 	}
 	return i, nil
 }
@@ -4490,21 +5198,21 @@ func (m *WorkerStatus) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x22
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n20, err := m.Started.MarshalTo(dAtA[i:])
+		n23, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n23
 	}
 	if m.Stats != nil {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n21, err := m.Stats.MarshalTo(dAtA[i:])
+		n24, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n24
 	}
 	if m.QueueSize != 0 {
 		dAtA[i] = 0x30
@@ -4568,31 +5276,31 @@ func (m *EtcdJobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n22, err := m.Job.MarshalTo(dAtA[i:])
+		n25, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n25
 	}
 	if m.Pipeline != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n23, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n26, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n26
 	}
 	if m.OutputCommit != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n24, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		n27, err := m.OutputCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n27
 	}
 	if m.Restart != 0 {
 		dAtA[i] = 0x20
@@ -4623,21 +5331,21 @@ func (m *EtcdJobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n25, err := m.Stats.MarshalTo(dAtA[i:])
+		n28, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n28
 	}
 	if m.StatsCommit != nil {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
-		n26, err := m.StatsCommit.MarshalTo(dAtA[i:])
+		n29, err := m.StatsCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n29
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x58
@@ -4650,11 +5358,37 @@ func (m *EtcdJobInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Reason)))
 		i += copy(dAtA[i:], m.Reason)
 	}
-	return i, nil
-}
-
-func (m *JobInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.Rerun {
+		dAtA[i] = 0x68
+		i++
+		if m.Rerun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.FailedDatums != nil {
+		dAtA[i] = 0x72
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.FailedDatums.Size()))
+		n30, err := m.FailedDatums.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n30
+	}
+	if len(m.TraceParent) > 0 {
+		dAtA[i] = 0x7a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.TraceParent)))
+		i += copy(dAtA[i:], m.TraceParent)
+	}
+	return i, nil
+}
+
+func (m *JobInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalTo(dAtA)
 	if err != nil {
@@ -4672,71 +5406,71 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n27, err := m.Job.MarshalTo(dAtA[i:])
+		n31, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n27
+		i += n31
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n28, err := m.Transform.MarshalTo(dAtA[i:])
+		n32, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n28
+		i += n32
 	}
 	if m.Pipeline != nil {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n29, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n33, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n29
+		i += n33
 	}
 	if m.ParentJob != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParentJob.Size()))
-		n30, err := m.ParentJob.MarshalTo(dAtA[i:])
+		n34, err := m.ParentJob.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n30
+		i += n34
 	}
 	if m.Started != nil {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Started.Size()))
-		n31, err := m.Started.MarshalTo(dAtA[i:])
+		n35, err := m.Started.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n31
+		i += n35
 	}
 	if m.Finished != nil {
 		dAtA[i] = 0x42
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Finished.Size()))
-		n32, err := m.Finished.MarshalTo(dAtA[i:])
+		n36, err := m.Finished.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n32
+		i += n36
 	}
 	if m.OutputCommit != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n33, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		n37, err := m.OutputCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n33
+		i += n37
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x50
@@ -4747,11 +5481,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n34, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n38, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n38
 	}
 	if m.PipelineVersion != 0 {
 		dAtA[i] = 0x68
@@ -4762,21 +5496,21 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x72
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n35, err := m.Service.MarshalTo(dAtA[i:])
+		n39, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n39
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n36, err := m.Egress.MarshalTo(dAtA[i:])
+		n40, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n40
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x8a
@@ -4792,11 +5526,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputRepo.Size()))
-		n37, err := m.OutputRepo.MarshalTo(dAtA[i:])
+		n41, err := m.OutputRepo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n41
 	}
 	if m.Restart != 0 {
 		dAtA[i] = 0xa0
@@ -4839,11 +5573,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n38, err := m.ResourceRequests.MarshalTo(dAtA[i:])
+		n42, err := m.ResourceRequests.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n42
 	}
 	if m.Input != nil {
 		dAtA[i] = 0xd2
@@ -4851,11 +5585,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n39, err := m.Input.MarshalTo(dAtA[i:])
+		n43, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n43
 	}
 	if m.NewBranch != nil {
 		dAtA[i] = 0xda
@@ -4863,11 +5597,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.NewBranch.Size()))
-		n40, err := m.NewBranch.MarshalTo(dAtA[i:])
+		n44, err := m.NewBranch.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n44
 	}
 	if m.Incremental {
 		dAtA[i] = 0xe0
@@ -4887,11 +5621,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.StatsCommit.Size()))
-		n41, err := m.StatsCommit.MarshalTo(dAtA[i:])
+		n45, err := m.StatsCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n45
 	}
 	if m.DataSkipped != 0 {
 		dAtA[i] = 0xf0
@@ -4906,11 +5640,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Stats.Size()))
-		n42, err := m.Stats.MarshalTo(dAtA[i:])
+		n46, err := m.Stats.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n46
 	}
 	if m.EnableStats {
 		dAtA[i] = 0x80
@@ -4958,11 +5692,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n43, err := m.ResourceLimits.MarshalTo(dAtA[i:])
+		n47, err := m.ResourceLimits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n47
 	}
 	if m.ChunkSpec != nil {
 		dAtA[i] = 0xaa
@@ -4970,11 +5704,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n44, err := m.ChunkSpec.MarshalTo(dAtA[i:])
+		n48, err := m.ChunkSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n48
 	}
 	if m.DatumTimeout != nil {
 		dAtA[i] = 0xb2
@@ -4982,11 +5716,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n45, err := m.DatumTimeout.MarshalTo(dAtA[i:])
+		n49, err := m.DatumTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n49
 	}
 	if m.JobTimeout != nil {
 		dAtA[i] = 0xba
@@ -4994,11 +5728,11 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n46, err := m.JobTimeout.MarshalTo(dAtA[i:])
+		n50, err := m.JobTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n50
 	}
 	if m.DataFailed != 0 {
 		dAtA[i] = 0xc0
@@ -5007,6 +5741,63 @@ func (m *JobInfo) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DataFailed))
 	}
+	if m.Rerun {
+		dAtA[i] = 0xc8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.Rerun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xd0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.DatumBackoff != nil {
+		dAtA[i] = 0xda
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumBackoff.Size()))
+		n51, err := m.DatumBackoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n51
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xe0
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Priority))
+	}
+	if m.QueuePosition != 0 {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.QueuePosition))
+	}
 	return i, nil
 }
 
@@ -5112,11 +5903,11 @@ func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Repo.Size()))
-		n47, err := m.Repo.MarshalTo(dAtA[i:])
+		n52, err := m.Repo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n52
 	}
 	if len(m.Branch) > 0 {
 		dAtA[i] = 0x12
@@ -5150,11 +5941,74 @@ func (m *PipelineInput) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.From.Size()))
-		n48, err := m.From.MarshalTo(dAtA[i:])
+		n53, err := m.From.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n53
+	}
+	return i, nil
+}
+
+func (m *EtcdJobQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EtcdJobQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Job != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
+		n54, err := m.Job.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if m.Pipeline != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n55, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Priority))
+	}
+	if m.Queued != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Queued.Size()))
+		n56, err := m.Queued.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.Admitted {
+		dAtA[i] = 0x28
+		i++
+		if m.Admitted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
 	}
 	return i, nil
 }
@@ -5183,11 +6037,11 @@ func (m *EtcdPipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
-		n49, err := m.SpecCommit.MarshalTo(dAtA[i:])
+		n57, err := m.SpecCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n57
 	}
 	if len(m.JobCounts) > 0 {
 		for k, _ := range m.JobCounts {
@@ -5216,6 +6070,16 @@ func (m *EtcdPipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.AuthToken)))
 		i += copy(dAtA[i:], m.AuthToken)
 	}
+	if m.AutoscalingStatus != nil {
+		dAtA[i] = 0x32
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.AutoscalingStatus.Size()))
+		n58, err := m.AutoscalingStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
+	}
 	return i, nil
 }
 
@@ -5238,31 +6102,31 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n50, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n59, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n59
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n51, err := m.Transform.MarshalTo(dAtA[i:])
+		n60, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n60
 	}
 	if m.CreatedAt != nil {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
-		n52, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		n61, err := m.CreatedAt.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n61
 	}
 	if m.State != 0 {
 		dAtA[i] = 0x38
@@ -5294,11 +6158,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x52
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n53, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n62, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n62
 	}
 	if m.Version != 0 {
 		dAtA[i] = 0x58
@@ -5309,11 +6173,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x7a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n54, err := m.Egress.MarshalTo(dAtA[i:])
+		n63, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n63
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x82
@@ -5337,11 +6201,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n55, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		n64, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n64
 	}
 	if m.ResourceRequests != nil {
 		dAtA[i] = 0x9a
@@ -5349,11 +6213,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n56, err := m.ResourceRequests.MarshalTo(dAtA[i:])
+		n65, err := m.ResourceRequests.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n65
 	}
 	if m.Input != nil {
 		dAtA[i] = 0xa2
@@ -5361,11 +6225,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n57, err := m.Input.MarshalTo(dAtA[i:])
+		n66, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n66
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0xaa
@@ -5448,11 +6312,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n58, err := m.Service.MarshalTo(dAtA[i:])
+		n67, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n67
 	}
 	if m.ResourceLimits != nil {
 		dAtA[i] = 0xfa
@@ -5460,11 +6324,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n59, err := m.ResourceLimits.MarshalTo(dAtA[i:])
+		n68, err := m.ResourceLimits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n68
 	}
 	if m.ChunkSpec != nil {
 		dAtA[i] = 0x82
@@ -5472,11 +6336,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n60, err := m.ChunkSpec.MarshalTo(dAtA[i:])
+		n69, err := m.ChunkSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n69
 	}
 	if m.DatumTimeout != nil {
 		dAtA[i] = 0x8a
@@ -5484,11 +6348,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n61, err := m.DatumTimeout.MarshalTo(dAtA[i:])
+		n70, err := m.DatumTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n70
 	}
 	if m.JobTimeout != nil {
 		dAtA[i] = 0x92
@@ -5496,11 +6360,11 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n62, err := m.JobTimeout.MarshalTo(dAtA[i:])
+		n71, err := m.JobTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n71
 	}
 	if len(m.GithookURL) > 0 {
 		dAtA[i] = 0x9a
@@ -5516,11 +6380,61 @@ func (m *PipelineInfo) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
-		n63, err := m.SpecCommit.MarshalTo(dAtA[i:])
+		n72, err := m.SpecCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n72
+	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xa8
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.DatumBackoff != nil {
+		dAtA[i] = 0xb2
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumBackoff.Size()))
+		n73, err := m.DatumBackoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n73
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xb8
+		i++
+		dAtA[i] = 0x2
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0xc0
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Priority))
+	}
+	if m.AutoscalingStatus != nil {
+		dAtA[i] = 0xca
+		i++
+		dAtA[i] = 0x2
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.AutoscalingStatus.Size()))
+		n74, err := m.AutoscalingStatus.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n74
 	}
 	return i, nil
 }
@@ -5574,11 +6488,11 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n64, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n75, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n75
 	}
 	if m.OutputCommit != nil {
 		dAtA[i] = 0xca
@@ -5586,11 +6500,11 @@ func (m *CreateJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n65, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		n76, err := m.OutputCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n76
 	}
 	return i, nil
 }
@@ -5614,11 +6528,11 @@ func (m *InspectJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n66, err := m.Job.MarshalTo(dAtA[i:])
+		n77, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n77
 	}
 	if m.BlockState {
 		dAtA[i] = 0x10
@@ -5652,11 +6566,11 @@ func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n67, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n78, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n78
 	}
 	if len(m.InputCommit) > 0 {
 		for _, msg := range m.InputCommit {
@@ -5674,11 +6588,11 @@ func (m *ListJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.OutputCommit.Size()))
-		n68, err := m.OutputCommit.MarshalTo(dAtA[i:])
+		n79, err := m.OutputCommit.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n79
 	}
 	return i, nil
 }
@@ -5744,11 +6658,11 @@ func (m *DeleteJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n69, err := m.Job.MarshalTo(dAtA[i:])
+		n80, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n80
 	}
 	return i, nil
 }
@@ -5772,11 +6686,11 @@ func (m *StopJobRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n70, err := m.Job.MarshalTo(dAtA[i:])
+		n81, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n81
 	}
 	return i, nil
 }
@@ -5800,21 +6714,21 @@ func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n71, err := m.Job.MarshalTo(dAtA[i:])
+		n82, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n82
 	}
 	if m.Pipeline != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n72, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n83, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n83
 	}
 	if len(m.DataFilters) > 0 {
 		for _, s := range m.DataFilters {
@@ -5845,11 +6759,11 @@ func (m *GetLogsRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x32
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n73, err := m.Datum.MarshalTo(dAtA[i:])
+		n84, err := m.Datum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n84
 	}
 	if m.Follow {
 		dAtA[i] = 0x38
@@ -5912,11 +6826,11 @@ func (m *LogMessage) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Ts.Size()))
-		n74, err := m.Ts.MarshalTo(dAtA[i:])
+		n85, err := m.Ts.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n85
 	}
 	if len(m.Message) > 0 {
 		dAtA[i] = 0x32
@@ -5978,11 +6892,11 @@ func (m *RestartDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n75, err := m.Job.MarshalTo(dAtA[i:])
+		n86, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n86
 	}
 	if len(m.DataFilters) > 0 {
 		for _, s := range m.DataFilters {
@@ -6021,11 +6935,11 @@ func (m *InspectDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Datum.Size()))
-		n76, err := m.Datum.MarshalTo(dAtA[i:])
+		n87, err := m.Datum.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n87
 	}
 	return i, nil
 }
@@ -6049,11 +6963,11 @@ func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Job.Size()))
-		n77, err := m.Job.MarshalTo(dAtA[i:])
+		n88, err := m.Job.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n88
 	}
 	if m.PageSize != 0 {
 		dAtA[i] = 0x10
@@ -6065,6 +6979,16 @@ func (m *ListDatumRequest) MarshalTo(dAtA []byte) (int, error) {
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Page))
 	}
+	if m.Failed {
+		dAtA[i] = 0x20
+		i++
+		if m.Failed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -6127,11 +7051,11 @@ func (m *ListDatumStreamResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumInfo.Size()))
-		n78, err := m.DatumInfo.MarshalTo(dAtA[i:])
+		n89, err := m.DatumInfo.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n89
 	}
 	if m.TotalPages != 0 {
 		dAtA[i] = 0x10
@@ -6193,21 +7117,21 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n79, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n90, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n90
 	}
 	if m.Transform != nil {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Transform.Size()))
-		n80, err := m.Transform.MarshalTo(dAtA[i:])
+		n91, err := m.Transform.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n91
 	}
 	if m.Update {
 		dAtA[i] = 0x28
@@ -6223,21 +7147,21 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ParallelismSpec.Size()))
-		n81, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
+		n92, err := m.ParallelismSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n92
 	}
 	if m.Egress != nil {
 		dAtA[i] = 0x4a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Egress.Size()))
-		n82, err := m.Egress.MarshalTo(dAtA[i:])
+		n93, err := m.Egress.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n93
 	}
 	if len(m.OutputBranch) > 0 {
 		dAtA[i] = 0x52
@@ -6249,31 +7173,31 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x5a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ScaleDownThreshold.Size()))
-		n83, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
+		n94, err := m.ScaleDownThreshold.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n83
+		i += n94
 	}
 	if m.ResourceRequests != nil {
 		dAtA[i] = 0x62
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceRequests.Size()))
-		n84, err := m.ResourceRequests.MarshalTo(dAtA[i:])
+		n95, err := m.ResourceRequests.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n84
+		i += n95
 	}
 	if m.Input != nil {
 		dAtA[i] = 0x6a
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Input.Size()))
-		n85, err := m.Input.MarshalTo(dAtA[i:])
+		n96, err := m.Input.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n85
+		i += n96
 	}
 	if len(m.Description) > 0 {
 		dAtA[i] = 0x72
@@ -6348,11 +7272,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Service.Size()))
-		n86, err := m.Service.MarshalTo(dAtA[i:])
+		n97, err := m.Service.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n86
+		i += n97
 	}
 	if m.ResourceLimits != nil {
 		dAtA[i] = 0xb2
@@ -6360,11 +7284,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ResourceLimits.Size()))
-		n87, err := m.ResourceLimits.MarshalTo(dAtA[i:])
+		n98, err := m.ResourceLimits.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n87
+		i += n98
 	}
 	if m.ChunkSpec != nil {
 		dAtA[i] = 0xba
@@ -6372,11 +7296,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.ChunkSpec.Size()))
-		n88, err := m.ChunkSpec.MarshalTo(dAtA[i:])
+		n99, err := m.ChunkSpec.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n88
+		i += n99
 	}
 	if m.DatumTimeout != nil {
 		dAtA[i] = 0xc2
@@ -6384,11 +7308,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.DatumTimeout.Size()))
-		n89, err := m.DatumTimeout.MarshalTo(dAtA[i:])
+		n100, err := m.DatumTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n89
+		i += n100
 	}
 	if m.JobTimeout != nil {
 		dAtA[i] = 0xca
@@ -6396,11 +7320,11 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.JobTimeout.Size()))
-		n90, err := m.JobTimeout.MarshalTo(dAtA[i:])
+		n101, err := m.JobTimeout.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n90
+		i += n101
 	}
 	if len(m.Salt) > 0 {
 		dAtA[i] = 0xd2
@@ -6410,6 +7334,73 @@ func (m *CreatePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintPps(dAtA, i, uint64(len(m.Salt)))
 		i += copy(dAtA[i:], m.Salt)
 	}
+	if m.DatumTries != 0 {
+		dAtA[i] = 0xd8
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumTries))
+	}
+	if m.DatumBackoff != nil {
+		dAtA[i] = 0xe2
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.DatumBackoff.Size()))
+		n102, err := m.DatumBackoff.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n102
+	}
+	if m.SkipFailedDatums {
+		dAtA[i] = 0xe8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.SkipFailedDatums {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if m.Priority != 0 {
+		dAtA[i] = 0xf0
+		i++
+		dAtA[i] = 0x1
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Priority))
+	}
+	if m.DryRun {
+		dAtA[i] = 0xf8
+		i++
+		dAtA[i] = 0x1
+		i++
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	if len(m.DryRunNewRepos) > 0 {
+		for _, s := range m.DryRunNewRepos {
+			dAtA[i] = 0x82
+			i++
+			dAtA[i] = 0x2
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				dAtA[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			dAtA[i] = uint8(l)
+			i++
+			i += copy(dAtA[i:], s)
+		}
+	}
 	return i, nil
 }
 
@@ -6432,11 +7423,11 @@ func (m *InspectPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n91, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n103, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n91
+		i += n103
 	}
 	return i, nil
 }
@@ -6478,11 +7469,11 @@ func (m *DeletePipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n92, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n104, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n92
+		i += n104
 	}
 	if m.All {
 		dAtA[i] = 0x20
@@ -6516,11 +7507,11 @@ func (m *StartPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n93, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n105, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n93
+		i += n105
 	}
 	return i, nil
 }
@@ -6544,11 +7535,11 @@ func (m *StopPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n94, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n106, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n94
+		i += n106
 	}
 	return i, nil
 }
@@ -6572,11 +7563,11 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
-		n95, err := m.Pipeline.MarshalTo(dAtA[i:])
+		n107, err := m.Pipeline.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n95
+		i += n107
 	}
 	if len(m.Exclude) > 0 {
 		for _, msg := range m.Exclude {
@@ -6605,6 +7596,166 @@ func (m *RerunPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *ListPipelineHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListPipelineHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n108, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n108
+	}
+	return i, nil
+}
+
+func (m *PipelineVersion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineVersion) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+	}
+	if m.CreatedAt != nil {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.CreatedAt.Size()))
+		n109, err := m.CreatedAt.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n109
+	}
+	if len(m.Author) > 0 {
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(len(m.Author)))
+		i += copy(dAtA[i:], m.Author)
+	}
+	if m.SpecCommit != nil {
+		dAtA[i] = 0x22
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.SpecCommit.Size()))
+		n110, err := m.SpecCommit.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n110
+	}
+	if m.PipelineInfo != nil {
+		dAtA[i] = 0x2a
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.PipelineInfo.Size()))
+		n111, err := m.PipelineInfo.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n111
+	}
+	return i, nil
+}
+
+func (m *PipelineHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PipelineHistory) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, msg := range m.Versions {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintPps(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
+func (m *RollbackPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Pipeline.Size()))
+		n112, err := m.Pipeline.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n112
+	}
+	if m.Version != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintPps(dAtA, i, uint64(m.Version))
+	}
+	if m.Reprocess {
+		dAtA[i] = 0x18
+		i++
+		if m.Reprocess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
+	return i, nil
+}
+
 func (m *GarbageCollectRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6825,6 +7976,10 @@ func (m *AtomInput) Size() (n int) {
 	if m.EmptyFiles {
 		n += 2
 	}
+	l = len(m.JoinOn)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -6903,6 +8058,12 @@ func (m *Input) Size() (n int) {
 		l = m.Git.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if len(m.Join) > 0 {
+		for _, e := range m.Join {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -6936,45 +8097,82 @@ func (m *ParallelismSpec) Size() (n int) {
 	if m.Coefficient != 0 {
 		n += 9
 	}
+	if m.Autoscaling != nil {
+		l = m.Autoscaling.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
-func (m *InputFile) Size() (n int) {
+func (m *Autoscaling) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.Path)
-	if l > 0 {
-		n += 1 + l + sovPps(uint64(l))
+	if m.Min != 0 {
+		n += 1 + sovPps(uint64(m.Min))
 	}
-	l = len(m.Hash)
-	if l > 0 {
+	if m.Max != 0 {
+		n += 1 + sovPps(uint64(m.Max))
+	}
+	if m.TargetDuration != nil {
+		l = m.TargetDuration.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *Datum) Size() (n int) {
+func (m *AutoscalingStatus) Size() (n int) {
 	var l int
 	_ = l
-	l = len(m.ID)
+	if m.Workers != 0 {
+		n += 1 + sovPps(uint64(m.Workers))
+	}
+	l = len(m.Reason)
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.Job != nil {
-		l = m.Job.Size()
+	if m.Updated != nil {
+		l = m.Updated.Size()
 		n += 1 + l + sovPps(uint64(l))
 	}
 	return n
 }
 
-func (m *DatumInfo) Size() (n int) {
+func (m *InputFile) Size() (n int) {
 	var l int
 	_ = l
-	if m.Datum != nil {
-		l = m.Datum.Size()
+	l = len(m.Path)
+	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
-	if m.State != 0 {
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *Datum) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.ID)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *DatumInfo) Size() (n int) {
+	var l int
+	_ = l
+	if m.Datum != nil {
+		l = m.Datum.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.State != 0 {
 		n += 1 + sovPps(uint64(m.State))
 	}
 	if m.Stats != nil {
@@ -6991,6 +8189,9 @@ func (m *DatumInfo) Size() (n int) {
 			n += 1 + l + sovPps(uint64(l))
 		}
 	}
+	if m.Attempts != 0 {
+		n += 1 + sovPps(uint64(m.Attempts))
+	}
 	return n
 }
 
@@ -7036,6 +8237,9 @@ func (m *ProcessStats) Size() (n int) {
 	if m.UploadBytes != 0 {
 		n += 1 + sovPps(uint64(m.UploadBytes))
 	}
+	if m.Attempts != 0 {
+		n += 1 + sovPps(uint64(m.Attempts))
+	}
 	return n
 }
 
@@ -7157,6 +8361,17 @@ func (m *EtcdJobInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.Rerun {
+		n += 2
+	}
+	if m.FailedDatums != nil {
+		l = m.FailedDatums.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.TraceParent)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7291,6 +8506,25 @@ func (m *JobInfo) Size() (n int) {
 	if m.DataFailed != 0 {
 		n += 2 + sovPps(uint64(m.DataFailed))
 	}
+	if m.Rerun {
+		n += 3
+	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.DatumBackoff != nil {
+		l = m.DatumBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.Priority != 0 {
+		n += 2 + sovPps(uint64(m.Priority))
+	}
+	if m.QueuePosition != 0 {
+		n += 2 + sovPps(uint64(m.QueuePosition))
+	}
 	return n
 }
 
@@ -7358,6 +8592,30 @@ func (m *PipelineInput) Size() (n int) {
 	return n
 }
 
+func (m *EtcdJobQueueEntry) Size() (n int) {
+	var l int
+	_ = l
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovPps(uint64(m.Priority))
+	}
+	if m.Queued != nil {
+		l = m.Queued.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Admitted {
+		n += 2
+	}
+	return n
+}
+
 func (m *EtcdPipelineInfo) Size() (n int) {
 	var l int
 	_ = l
@@ -7384,6 +8642,10 @@ func (m *EtcdPipelineInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPps(uint64(l))
 	}
+	if m.AutoscalingStatus != nil {
+		l = m.AutoscalingStatus.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7504,6 +8766,23 @@ func (m *PipelineInfo) Size() (n int) {
 		l = m.SpecCommit.Size()
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.DatumBackoff != nil {
+		l = m.DatumBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.Priority != 0 {
+		n += 2 + sovPps(uint64(m.Priority))
+	}
+	if m.AutoscalingStatus != nil {
+		l = m.AutoscalingStatus.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
 	return n
 }
 
@@ -7718,6 +8997,9 @@ func (m *ListDatumRequest) Size() (n int) {
 	if m.Page != 0 {
 		n += 1 + sovPps(uint64(m.Page))
 	}
+	if m.Failed {
+		n += 2
+	}
 	return n
 }
 
@@ -7852,6 +9134,28 @@ func (m *CreatePipelineRequest) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovPps(uint64(l))
 	}
+	if m.DatumTries != 0 {
+		n += 2 + sovPps(uint64(m.DatumTries))
+	}
+	if m.DatumBackoff != nil {
+		l = m.DatumBackoff.Size()
+		n += 2 + l + sovPps(uint64(l))
+	}
+	if m.SkipFailedDatums {
+		n += 3
+	}
+	if m.Priority != 0 {
+		n += 2 + sovPps(uint64(m.Priority))
+	}
+	if m.DryRun {
+		n += 3
+	}
+	if len(m.DryRunNewRepos) > 0 {
+		for _, s := range m.DryRunNewRepos {
+			l = len(s)
+			n += 2 + l + sovPps(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -7926,6 +9230,69 @@ func (m *RerunPipelineRequest) Size() (n int) {
 	return n
 }
 
+func (m *ListPipelineHistoryRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineVersion) Size() (n int) {
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	l = len(m.Author)
+	if l > 0 {
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.SpecCommit != nil {
+		l = m.SpecCommit.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.PipelineInfo != nil {
+		l = m.PipelineInfo.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	return n
+}
+
+func (m *PipelineHistory) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Versions) > 0 {
+		for _, e := range m.Versions {
+			l = e.Size()
+			n += 1 + l + sovPps(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RollbackPipelineRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Pipeline != nil {
+		l = m.Pipeline.Size()
+		n += 1 + l + sovPps(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovPps(uint64(m.Version))
+	}
+	if m.Reprocess {
+		n += 2
+	}
+	return n
+}
+
 func (m *GarbageCollectRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -9015,6 +10382,35 @@ func (m *AtomInput) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EmptyFiles = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JoinOn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9591,6 +10987,37 @@ func (m *Input) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Join", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Join = append(m.Join, &Input{})
+			if err := m.Join[len(m.Join)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9832,6 +11259,39 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 			v = uint64(binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Coefficient = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Autoscaling == nil {
+				m.Autoscaling = &Autoscaling{}
+			}
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -9853,7 +11313,7 @@ func (m *ParallelismSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InputFile) Unmarshal(dAtA []byte) error {
+func (m *Autoscaling) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9876,17 +11336,17 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+			return fmt.Errorf("proto: Autoscaling: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Autoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
 			}
-			var stringLen uint64
+			m.Min = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9896,26 +11356,16 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Min |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Path = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
 			}
-			var byteLen int
+			m.Max = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -9925,13 +11375,275 @@ func (m *InputFile) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= (int(b) & 0x7F) << shift
+				m.Max |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthPps
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TargetDuration == nil {
+				m.TargetDuration = &google_protobuf2.Duration{}
+			}
+			if err := m.TargetDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoscalingStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoscalingStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoscalingStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Workers", wireType)
+			}
+			m.Workers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Workers |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Updated == nil {
+				m.Updated = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Updated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputFile) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputFile: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputFile: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPps
 			}
 			postIndex := iNdEx + byteLen
 			if postIndex > l {
@@ -10253,12 +11965,31 @@ func (m *DatumInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if skippy < 0 {
 				return ErrInvalidLengthPps
 			}
@@ -10553,6 +12284,25 @@ func (m *ProcessStats) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -11459,6 +13209,88 @@ func (m *EtcdJobInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rerun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rerun = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDatums", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FailedDatums == nil {
+				m.FailedDatums = &pfs.Object{}
+			}
+			if err := m.FailedDatums.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceParent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceParent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -12480,6 +14312,136 @@ func (m *JobInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 41:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rerun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rerun = bool(v != 0)
+		case 42:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 43:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumBackoff == nil {
+				m.DatumBackoff = &google_protobuf2.Duration{}
+			}
+			if err := m.DatumBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 44:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipFailedDatums = bool(v != 0)
+		case 45:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 46:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuePosition", wireType)
+			}
+			m.QueuePosition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuePosition |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -12982,7 +14944,7 @@ func (m *PipelineInput) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
+func (m *EtcdJobQueueEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13005,17 +14967,17 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EtcdPipelineInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EtcdJobQueueEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EtcdPipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EtcdJobQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			m.State = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13025,14 +14987,28 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.State |= (PipelineState(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &Job{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13056,18 +15032,192 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SpecCommit == nil {
-				m.SpecCommit = &pfs.Commit{}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
 			}
-			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var msglen int
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queued == nil {
+				m.Queued = &google_protobuf1.Timestamp{}
+			}
+			if err := m.Queued.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admitted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Admitted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EtcdPipelineInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EtcdPipelineInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= (PipelineState(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
+			}
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCounts", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -13217,6 +15367,39 @@ func (m *EtcdPipelineInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.AuthToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscalingStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoscalingStatus == nil {
+				m.AutoscalingStatus = &AutoscalingStatus{}
+			}
+			if err := m.AutoscalingStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -14174,61 +16357,11 @@ func (m *PipelineInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+		case 37:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
 			}
-			var msglen int
+			m.DatumTries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -14238,14 +16371,188 @@ func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.DatumTries |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumBackoff == nil {
+				m.DatumBackoff = &google_protobuf2.Duration{}
+			}
+			if err := m.DatumBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 39:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipFailedDatums = bool(v != 0)
+		case 40:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoscalingStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoscalingStatus == nil {
+				m.AutoscalingStatus = &AutoscalingStatus{}
+			}
+			if err := m.AutoscalingStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PipelineInfos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PipelineInfos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PipelineInfos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
 			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
@@ -15751,6 +18058,26 @@ func (m *ListDatumRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Failed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -16694,13 +19021,700 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if m.DatumTimeout == nil {
 				m.DatumTimeout = &google_protobuf2.Duration{}
 			}
-			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DatumTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobTimeout == nil {
+				m.JobTimeout = &google_protobuf2.Duration{}
+			}
+			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumTries", wireType)
+			}
+			m.DatumTries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DatumTries |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatumBackoff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DatumBackoff == nil {
+				m.DatumBackoff = &google_protobuf2.Duration{}
+			}
+			if err := m.DatumBackoff.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipFailedDatums", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipFailedDatums = bool(v != 0)
+		case 30:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRunNewRepos", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DryRunNewRepos = append(m.DryRunNewRepos, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.All = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPps(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthPps
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPps
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pipeline == nil {
+				m.Pipeline = &Pipeline{}
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 25:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobTimeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16724,18 +19738,16 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.JobTimeout == nil {
-				m.JobTimeout = &google_protobuf2.Duration{}
-			}
-			if err := m.JobTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Exclude = append(m.Exclude, &pfs.Commit{})
+			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 26:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16745,20 +19757,22 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPps
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Salt = string(dAtA[iNdEx:postIndex])
+			m.Include = append(m.Include, &pfs.Commit{})
+			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16781,7 +19795,7 @@ func (m *CreatePipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *ListPipelineHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16804,10 +19818,10 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InspectPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListPipelineHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InspectPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListPipelineHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16864,7 +19878,7 @@ func (m *InspectPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *PipelineVersion) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16887,65 +19901,34 @@ func (m *ListPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineVersion: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineVersion: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthPps
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeletePipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeletePipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16969,18 +19952,18 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &google_protobuf1.Timestamp{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field All", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -16990,65 +19973,57 @@ func (m *DeletePipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= (int(b) & 0x7F) << shift
+				stringLen |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.All = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPps(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPps
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPps
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecCommit", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPps
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPps
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SpecCommit == nil {
+				m.SpecCommit = &pfs.Commit{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StartPipelineRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StartPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SpecCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PipelineInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17072,10 +20047,10 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
+			if m.PipelineInfo == nil {
+				m.PipelineInfo = &PipelineInfo{}
 			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PipelineInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17100,7 +20075,7 @@ func (m *StartPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *PipelineHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17123,15 +20098,15 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StopPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PipelineHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StopPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PipelineHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17155,10 +20130,8 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pipeline == nil {
-				m.Pipeline = &Pipeline{}
-			}
-			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Versions = append(m.Versions, &PipelineVersion{})
+			if err := m.Versions[len(m.Versions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17183,7 +20156,7 @@ func (m *StopPipelineRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
+func (m *RollbackPipelineRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17206,10 +20179,10 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RerunPipelineRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RollbackPipelineRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RerunPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RollbackPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -17246,10 +20219,10 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exclude", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17259,28 +20232,16 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				m.Version |= (uint64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Exclude = append(m.Exclude, &pfs.Commit{})
-			if err := m.Exclude[len(m.Exclude)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Include", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprocess", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPps
@@ -17290,23 +20251,12 @@ func (m *RerunPipelineRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= (int(b) & 0x7F) << shift
+				v |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPps
-			}
-			postIndex := iNdEx + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Include = append(m.Include, &pfs.Commit{})
-			if err := m.Include[len(m.Include)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Reprocess = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPps(dAtA[iNdEx:])
@@ -17636,247 +20586,283 @@ var (
 func init() { proto.RegisterFile("client/pps/pps.proto", fileDescriptorPps) }
 
 var fileDescriptorPps = []byte{
-	// 3864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x5a, 0x4f, 0x6f, 0xdb, 0xd8,
-	0x76, 0xb7, 0x44, 0xca, 0x22, 0x8f, 0x64, 0x99, 0xbe, 0xfe, 0x27, 0x2b, 0x93, 0xd8, 0xe1, 0x4c,
-	0x32, 0x49, 0x30, 0xcf, 0x99, 0xe7, 0xbc, 0xa6, 0xaf, 0xd3, 0xe9, 0xcc, 0xf3, 0xbf, 0x04, 0xd6,
-	0xb8, 0x79, 0x2a, 0x6d, 0xbf, 0x2e, 0x55, 0x9a, 0xba, 0x92, 0x19, 0x53, 0x24, 0x1f, 0x49, 0x39,
-	0xc9, 0x00, 0x05, 0xfa, 0x0d, 0x8a, 0x76, 0x55, 0x14, 0xe8, 0xaa, 0xfd, 0x00, 0x45, 0x51, 0xa0,
-	0x9b, 0x2e, 0xba, 0x29, 0x30, 0xcb, 0xf6, 0x0b, 0x04, 0x85, 0x0b, 0x74, 0xd7, 0x75, 0x57, 0x05,
-	0x8a, 0x7b, 0xee, 0x25, 0x45, 0x52, 0xb4, 0x14, 0x27, 0x5d, 0x18, 0xe0, 0x3d, 0xf7, 0xdc, 0x7f,
-	0xe7, 0xdc, 0x73, 0x7e, 0xbf, 0x73, 0x65, 0x58, 0xb1, 0x1c, 0x9b, 0xba, 0xd1, 0x53, 0xdf, 0x0f,
-	0xd9, 0xdf, 0xb6, 0x1f, 0x78, 0x91, 0x47, 0x24, 0xdf, 0x0f, 0x5b, 0x77, 0x06, 0x9e, 0x37, 0x70,
-	0xe8, 0x53, 0x14, 0x9d, 0x8f, 0xfa, 0x4f, 0xe9, 0xd0, 0x8f, 0xde, 0x71, 0x8d, 0xd6, 0x66, 0xbe,
-	0x33, 0xb2, 0x87, 0x34, 0x8c, 0xcc, 0xa1, 0x2f, 0x14, 0xee, 0xe5, 0x15, 0x7a, 0xa3, 0xc0, 0x8c,
-	0x6c, 0xcf, 0x15, 0xfd, 0x2b, 0x03, 0x6f, 0xe0, 0xe1, 0xe7, 0x53, 0xf6, 0x15, 0x4b, 0xe3, 0xed,
-	0xf4, 0x43, 0xf6, 0xc7, 0xa5, 0x7a, 0x1f, 0xe6, 0x4f, 0xa8, 0x15, 0xd0, 0x88, 0x10, 0x90, 0x5d,
-	0x73, 0x48, 0x9b, 0xa5, 0xad, 0xd2, 0x23, 0xd5, 0xc0, 0x6f, 0x72, 0x17, 0x60, 0xe8, 0x8d, 0xdc,
-	0xa8, 0xeb, 0x9b, 0xd1, 0x45, 0xb3, 0x8c, 0x3d, 0x2a, 0x4a, 0x3a, 0x66, 0x74, 0x41, 0xd6, 0xa1,
-	0x4a, 0xdd, 0xab, 0xee, 0x95, 0x19, 0x34, 0x25, 0xec, 0x9b, 0xa7, 0xee, 0xd5, 0x6f, 0xcc, 0x80,
-	0x68, 0x20, 0x5d, 0xd2, 0x77, 0x4d, 0x19, 0x85, 0xec, 0x53, 0xff, 0xd7, 0x32, 0xa8, 0xa7, 0x81,
-	0xe9, 0x86, 0x7d, 0x2f, 0x18, 0x92, 0x15, 0xa8, 0xd8, 0x43, 0x73, 0x10, 0x2f, 0xc6, 0x1b, 0x6c,
-	0x94, 0x35, 0xec, 0x35, 0xcb, 0x5b, 0x12, 0x1b, 0x65, 0x0d, 0x7b, 0xe4, 0x31, 0x48, 0xd4, 0xbd,
-	0x6a, 0x4a, 0x5b, 0xd2, 0xa3, 0xda, 0xce, 0xfa, 0x36, 0xb3, 0x62, 0x32, 0xc9, 0xf6, 0xa1, 0x7b,
-	0x75, 0xe8, 0x46, 0xc1, 0x3b, 0x83, 0xe9, 0x90, 0x07, 0x50, 0x0d, 0xf1, 0x20, 0x61, 0x53, 0x46,
-	0xf5, 0x1a, 0xaa, 0xf3, 0xc3, 0x19, 0x71, 0x1f, 0x5b, 0x39, 0x8c, 0x7a, 0xb6, 0xdb, 0xac, 0xe0,
-	0x2a, 0xbc, 0x41, 0xbe, 0x02, 0x62, 0x5a, 0x16, 0xf5, 0xa3, 0x6e, 0x40, 0xa3, 0x51, 0xe0, 0x76,
-	0x2d, 0xaf, 0x47, 0x9b, 0xf3, 0x5b, 0xd2, 0x23, 0xc9, 0xd0, 0x78, 0x8f, 0x81, 0x1d, 0xfb, 0x5e,
-	0x8f, 0xb2, 0x39, 0x7a, 0xf4, 0x7c, 0x34, 0x68, 0x56, 0xb7, 0x4a, 0x8f, 0x14, 0x83, 0x37, 0xd8,
-	0x1c, 0x78, 0x8c, 0xae, 0x3f, 0x72, 0x9c, 0x6e, 0xbc, 0x17, 0x15, 0x97, 0xd1, 0xb0, 0xa7, 0x33,
-	0x72, 0x1c, 0xbe, 0x9f, 0xb0, 0xf5, 0x1c, 0x94, 0x78, 0xff, 0xb1, 0xb5, 0x4a, 0x89, 0xb5, 0xd8,
-	0x0a, 0x57, 0xa6, 0x33, 0xa2, 0xc2, 0xe4, 0xbc, 0xf1, 0x4d, 0xf9, 0x97, 0x25, 0xbd, 0x05, 0xf3,
-	0x87, 0x83, 0x80, 0x86, 0x21, 0x1b, 0x75, 0x66, 0x1c, 0xc7, 0xa3, 0xce, 0x8c, 0x63, 0xfd, 0x2e,
-	0x48, 0x6d, 0xef, 0x9c, 0xac, 0x41, 0xd9, 0xee, 0x71, 0xf9, 0xde, 0xfc, 0xf5, 0xfb, 0xcd, 0xf2,
-	0xd1, 0x81, 0x51, 0xb6, 0x7b, 0xfa, 0x25, 0x54, 0x4f, 0x68, 0x70, 0x65, 0x5b, 0x94, 0x7c, 0x0e,
-	0x0b, 0xb6, 0x1b, 0xd1, 0xc0, 0x35, 0x9d, 0xae, 0xef, 0x05, 0x11, 0x6a, 0x57, 0x8c, 0x7a, 0x2c,
-	0xec, 0x78, 0x41, 0xc4, 0x94, 0xe8, 0xdb, 0xb4, 0x52, 0x99, 0x2b, 0xc5, 0x42, 0x54, 0x62, 0x8b,
-	0xf9, 0xdc, 0xfb, 0x62, 0xb1, 0x8e, 0x51, 0xb6, 0x7d, 0xfd, 0x1f, 0x4a, 0xa0, 0xee, 0x46, 0xde,
-	0xf0, 0xc8, 0xf5, 0x47, 0xc5, 0x77, 0x8b, 0x80, 0x1c, 0x50, 0xdf, 0x13, 0x47, 0xc4, 0x6f, 0xb2,
-	0x06, 0xf3, 0xe7, 0x81, 0xe9, 0x5a, 0x17, 0xf1, 0x7d, 0xe2, 0x2d, 0x26, 0xb7, 0xbc, 0xe1, 0xd0,
-	0x8e, 0xc4, 0x95, 0x12, 0x2d, 0x36, 0xc7, 0xc0, 0xf1, 0xce, 0x9b, 0x15, 0x3e, 0x07, 0xfb, 0x66,
-	0x32, 0xc7, 0xfc, 0xf1, 0x5d, 0x73, 0x1e, 0x9d, 0x83, 0xdf, 0x64, 0x13, 0x6a, 0x18, 0x61, 0xdd,
-	0xbe, 0xed, 0xd0, 0xb0, 0xa9, 0x60, 0x17, 0xa0, 0xe8, 0x05, 0x93, 0xb4, 0x65, 0xa5, 0xaa, 0x29,
-	0xfa, 0x5f, 0x94, 0x40, 0xdd, 0x0f, 0x3c, 0xf7, 0xd6, 0x9b, 0x16, 0x9b, 0x93, 0xf2, 0x9b, 0x0b,
-	0x7d, 0x6a, 0x89, 0x2d, 0xe3, 0x37, 0xf9, 0x9a, 0x5d, 0x3f, 0x33, 0x88, 0x70, 0xc7, 0xb5, 0x9d,
-	0xd6, 0x36, 0x0f, 0xe5, 0xed, 0x38, 0x94, 0xb7, 0x4f, 0xe3, 0x58, 0x37, 0xb8, 0xa2, 0x6e, 0x83,
-	0xf2, 0xd2, 0x8e, 0x6e, 0xde, 0xd1, 0x06, 0x48, 0xa3, 0xc0, 0xe1, 0x1b, 0xda, 0xab, 0x5e, 0xbf,
-	0xdf, 0x64, 0x57, 0xc1, 0x60, 0xb2, 0xdb, 0x5a, 0x53, 0xff, 0xc7, 0x12, 0x54, 0xf8, 0x42, 0x3a,
-	0xc8, 0x66, 0xe4, 0x0d, 0x71, 0xa1, 0xda, 0x4e, 0x03, 0x23, 0x29, 0xf1, 0xa6, 0x81, 0x7d, 0x64,
-	0x0b, 0x2a, 0x56, 0xe0, 0x85, 0x21, 0xc6, 0x6b, 0x6d, 0x07, 0x50, 0x89, 0x2b, 0xf0, 0x0e, 0xa6,
-	0x31, 0x72, 0x6d, 0xcf, 0x15, 0xf1, 0x9b, 0xd1, 0xc0, 0x0e, 0xb6, 0x8e, 0x15, 0x78, 0x2e, 0xee,
-	0x23, 0x5e, 0x27, 0x71, 0x80, 0x81, 0x7d, 0x64, 0x13, 0xa4, 0x81, 0x1d, 0x1b, 0x6c, 0x01, 0x55,
-	0x62, 0x83, 0x18, 0xac, 0x47, 0xbf, 0x04, 0xa5, 0xed, 0x9d, 0xf3, 0x8d, 0x7f, 0x9e, 0x1c, 0x8d,
-	0x6f, 0xbd, 0xb6, 0xcd, 0x52, 0xdd, 0x3e, 0x8a, 0x26, 0x6e, 0x4d, 0xb9, 0xe0, 0xd6, 0x48, 0xa9,
-	0x5b, 0x13, 0x9b, 0x5b, 0x1e, 0x9b, 0x5b, 0x3f, 0x83, 0xc5, 0x8e, 0x19, 0x98, 0x8e, 0x43, 0x1d,
-	0x3b, 0x1c, 0x9e, 0x30, 0x9f, 0xb6, 0x40, 0xb1, 0x3c, 0x37, 0x8c, 0x4c, 0x97, 0x87, 0x88, 0x6c,
-	0x24, 0x6d, 0xb2, 0x05, 0x35, 0xcb, 0xa3, 0xfd, 0xbe, 0x6d, 0xb1, 0xdc, 0x8b, 0xb3, 0x97, 0x8c,
-	0xb4, 0xa8, 0x2d, 0x2b, 0x25, 0xad, 0xac, 0x3f, 0x03, 0x15, 0x0f, 0xc0, 0x6e, 0x23, 0x5b, 0x17,
-	0xf3, 0xad, 0x58, 0x97, 0x7d, 0x33, 0xd9, 0x85, 0x19, 0x5e, 0xa0, 0x19, 0xea, 0x06, 0x7e, 0xeb,
-	0xbf, 0x0f, 0x95, 0x03, 0x33, 0x1a, 0x0d, 0x6f, 0x8a, 0x78, 0xd2, 0x02, 0xe9, 0xb5, 0x38, 0x67,
-	0x6d, 0x47, 0x41, 0xd3, 0xb5, 0xbd, 0x73, 0x83, 0x09, 0xf5, 0x9f, 0x4a, 0xa0, 0xe2, 0xe8, 0x23,
-	0xb7, 0xef, 0x31, 0x57, 0xf5, 0x58, 0x43, 0x98, 0x8d, 0xbb, 0x0a, 0xbb, 0x0d, 0xde, 0x41, 0x1e,
-	0xe0, 0xcd, 0x8d, 0x78, 0x4a, 0x6a, 0xec, 0x2c, 0x8e, 0x35, 0x4e, 0x98, 0xd8, 0xe0, 0xbd, 0xe4,
-	0x4b, 0xae, 0x16, 0xe2, 0x51, 0x6b, 0x3b, 0x4b, 0xa8, 0xd6, 0x09, 0x3c, 0x8b, 0x86, 0x21, 0x53,
-	0x0c, 0xb9, 0x62, 0x48, 0x1e, 0x82, 0xea, 0xf7, 0xc3, 0x2e, 0x9f, 0x93, 0xfb, 0x5f, 0x45, 0x67,
-	0x31, 0x13, 0x18, 0x8a, 0xdf, 0x47, 0x75, 0x4a, 0xee, 0x83, 0xdc, 0x33, 0x23, 0x13, 0xf3, 0x35,
-	0xfa, 0x5f, 0xa8, 0xb0, 0x6d, 0x1b, 0xd8, 0xa5, 0xff, 0x3d, 0xcb, 0x35, 0x83, 0x41, 0x40, 0x07,
-	0x6c, 0xc0, 0x0a, 0x54, 0x2c, 0x86, 0x50, 0x78, 0x14, 0xc9, 0xe0, 0x0d, 0x66, 0xbf, 0x21, 0x35,
-	0x5d, 0xdc, 0x7d, 0xc9, 0xc0, 0x6f, 0x16, 0x07, 0x61, 0xd4, 0xeb, 0xd1, 0x2b, 0xe1, 0x17, 0xd1,
-	0x22, 0x8f, 0x41, 0xeb, 0xdb, 0xfd, 0xe8, 0xa2, 0xeb, 0xd3, 0xc0, 0xa2, 0x6e, 0x64, 0x3b, 0x7c,
-	0x87, 0x25, 0x63, 0x11, 0xe5, 0x9d, 0x44, 0x4c, 0x9e, 0xc3, 0xba, 0x6b, 0xbb, 0x14, 0x33, 0x4b,
-	0x6e, 0x44, 0x05, 0x47, 0xac, 0xf2, 0xee, 0x17, 0xd9, 0x71, 0xfa, 0x5f, 0x96, 0xa1, 0x9e, 0xb6,
-	0x0a, 0xf9, 0x0e, 0x16, 0x7a, 0xde, 0x1b, 0xd7, 0xf1, 0xcc, 0x5e, 0x97, 0xe1, 0xbd, 0x70, 0xc4,
-	0xc6, 0x44, 0x82, 0x38, 0x10, 0x58, 0x6f, 0xd4, 0x63, 0x7d, 0x96, 0x32, 0xc8, 0xb7, 0x50, 0xf7,
-	0xf9, 0x7c, 0x7c, 0x78, 0x79, 0xd6, 0xf0, 0x9a, 0x50, 0xc7, 0xd1, 0xdf, 0x40, 0x6d, 0xe4, 0x8f,
-	0xd7, 0x96, 0x66, 0x0d, 0x06, 0xae, 0x8d, 0x63, 0x1f, 0x40, 0x23, 0xd9, 0xf9, 0xf9, 0xbb, 0x88,
-	0x86, 0x68, 0x2b, 0xd9, 0x48, 0xce, 0xb3, 0xc7, 0x84, 0xe4, 0x3e, 0xd4, 0xc5, 0x12, 0x5c, 0xa9,
-	0x82, 0x4a, 0x62, 0x59, 0x54, 0xd1, 0xff, 0xba, 0x0c, 0xab, 0x89, 0x1f, 0x33, 0xd6, 0x79, 0x56,
-	0x6c, 0x1d, 0x91, 0x98, 0xe2, 0x21, 0x39, 0x93, 0xfc, 0xbc, 0xd0, 0x24, 0xf9, 0x31, 0x19, 0x3b,
-	0x3c, 0x2d, 0xb2, 0x43, 0x7e, 0x44, 0xfa, 0xf0, 0xbf, 0x53, 0x78, 0xf8, 0xc9, 0x31, 0x39, 0x63,
-	0xfc, 0xbc, 0xc0, 0x18, 0x05, 0x5b, 0x4b, 0x1b, 0xe7, 0x7f, 0x4b, 0x50, 0xff, 0x63, 0x2f, 0xb8,
-	0xa4, 0x01, 0x33, 0xc9, 0x28, 0x24, 0x8f, 0x41, 0x7d, 0x83, 0xed, 0x6e, 0x12, 0xfb, 0xf5, 0xeb,
-	0xf7, 0x9b, 0x0a, 0x57, 0x3a, 0x3a, 0x30, 0x14, 0xde, 0x7d, 0xd4, 0x23, 0x5b, 0x30, 0xff, 0xda,
-	0x3b, 0x67, 0x7a, 0x1c, 0x26, 0xd4, 0xeb, 0xf7, 0x9b, 0x15, 0x96, 0x33, 0x0f, 0x8c, 0xca, 0x6b,
-	0xef, 0xfc, 0xa8, 0xc7, 0x12, 0x31, 0x46, 0x19, 0xcf, 0xd4, 0x8d, 0x71, 0xa6, 0xc6, 0x68, 0xc4,
-	0x3e, 0xf2, 0x0b, 0xa8, 0x22, 0x24, 0xd1, 0x9e, 0x38, 0xe4, 0x34, 0xf4, 0x8a, 0x55, 0xc7, 0x09,
-	0xa1, 0x32, 0x23, 0x21, 0xdc, 0x05, 0xf8, 0xed, 0x88, 0x8e, 0x68, 0x37, 0xb4, 0x7f, 0xa4, 0x88,
-	0xde, 0x92, 0xa1, 0xa2, 0xe4, 0xc4, 0xfe, 0x91, 0xea, 0x6d, 0xa8, 0x1b, 0x34, 0xf4, 0x46, 0x81,
-	0x45, 0x31, 0xeb, 0x32, 0xb2, 0xe8, 0x8f, 0xf0, 0xe0, 0x65, 0x83, 0x7d, 0xb2, 0x70, 0x1e, 0xd2,
-	0xa1, 0x17, 0xbc, 0x13, 0x89, 0x5d, 0xb4, 0x98, 0xe6, 0xc0, 0x1f, 0xa1, 0x33, 0x25, 0x83, 0x7d,
-	0xea, 0xff, 0x22, 0x41, 0xed, 0x30, 0xb2, 0x7a, 0x08, 0x1b, 0x7d, 0x2f, 0xce, 0x93, 0xa5, 0x82,
-	0x3c, 0x49, 0x1e, 0x83, 0xe2, 0xdb, 0x3e, 0x75, 0x6c, 0x37, 0xbe, 0x41, 0x1c, 0x83, 0x3a, 0x42,
-	0x68, 0x24, 0xdd, 0xe4, 0x6b, 0x58, 0xf0, 0x46, 0x91, 0x3f, 0x8a, 0xba, 0x29, 0x3e, 0x90, 0xc3,
-	0xa0, 0x3a, 0xd7, 0xe0, 0x2d, 0xd2, 0x84, 0x6a, 0x40, 0x39, 0x21, 0xe0, 0x41, 0x13, 0x37, 0x31,
-	0xaa, 0xcc, 0xc8, 0xec, 0x8a, 0xdb, 0x49, 0x7b, 0x68, 0x3f, 0xc9, 0x58, 0x60, 0xd2, 0x4e, 0x2c,
-	0x64, 0x51, 0x85, 0x6a, 0xe1, 0xa5, 0xed, 0xfb, 0xb4, 0x27, 0xcc, 0x56, 0x63, 0xb2, 0x13, 0x2e,
-	0x62, 0x76, 0x45, 0x95, 0xc8, 0x8b, 0x4c, 0x07, 0x29, 0xab, 0x64, 0xa8, 0x4c, 0x72, 0xca, 0x04,
-	0x8c, 0x1a, 0x61, 0x77, 0xdf, 0xb4, 0x1d, 0xda, 0x43, 0x6a, 0x24, 0x19, 0x38, 0xe2, 0x05, 0x4a,
-	0xc6, 0x0e, 0x54, 0x67, 0x38, 0x70, 0x1b, 0xea, 0xf8, 0x11, 0x9f, 0x1e, 0x26, 0x4f, 0x5f, 0x43,
-	0x05, 0x71, 0xf8, 0xcf, 0x63, 0x44, 0xa9, 0x21, 0xa2, 0x2c, 0xc4, 0x76, 0xcf, 0xe0, 0xc9, 0x1a,
-	0xcc, 0x07, 0xd4, 0x0c, 0x3d, 0xb7, 0x59, 0xe7, 0x4e, 0xe5, 0x2d, 0xfd, 0x9f, 0x6a, 0x50, 0xfd,
-	0x10, 0xf7, 0x7d, 0x05, 0x6a, 0x14, 0x57, 0x0c, 0x99, 0x0c, 0x90, 0xd4, 0x11, 0xc6, 0x58, 0x21,
-	0xe3, 0x6c, 0x69, 0xba, 0xb3, 0xbf, 0x04, 0xf0, 0xcd, 0x80, 0xba, 0x51, 0x97, 0xad, 0x3d, 0x9f,
-	0x5b, 0x5b, 0xe5, 0x7d, 0x8c, 0x8e, 0xa7, 0xc2, 0xa6, 0xfa, 0xe1, 0x61, 0xf3, 0x1c, 0x94, 0xbe,
-	0xed, 0xda, 0xe1, 0x85, 0xf0, 0xc9, 0xf4, 0x61, 0x89, 0xee, 0xe4, 0x1d, 0x54, 0x67, 0xdd, 0xc1,
-	0xc4, 0x0d, 0x30, 0xc5, 0x0d, 0xdf, 0x83, 0xe6, 0x8f, 0x69, 0x4f, 0x17, 0x79, 0x6d, 0x1d, 0x67,
-	0x5e, 0xe1, 0x06, 0xca, 0x72, 0x22, 0x63, 0xd1, 0xcf, 0x91, 0xa4, 0xc7, 0xa0, 0xc5, 0xa6, 0xeb,
-	0x5e, 0xd1, 0x20, 0x64, 0xb4, 0x70, 0x01, 0xaf, 0xfc, 0x62, 0x2c, 0xff, 0x0d, 0x17, 0x93, 0x87,
-	0xac, 0x92, 0xc3, 0x3a, 0xa5, 0xd9, 0xc0, 0x25, 0xea, 0xa2, 0x92, 0x43, 0x99, 0x11, 0x77, 0x32,
-	0xae, 0x47, 0xb1, 0x14, 0x6a, 0x2e, 0xc6, 0x67, 0xf4, 0xc3, 0x6d, 0x5e, 0x1d, 0x19, 0xa2, 0x8b,
-	0x15, 0x31, 0xc2, 0x1e, 0x82, 0x0a, 0x2f, 0xe1, 0x35, 0x12, 0x26, 0xd8, 0xe3, 0x84, 0xf8, 0x09,
-	0xd4, 0x84, 0x12, 0x92, 0x7b, 0x92, 0x62, 0x23, 0x06, 0xf5, 0x3d, 0x03, 0x78, 0x2f, 0xfb, 0x4e,
-	0x87, 0xec, 0xca, 0xac, 0x90, 0x5d, 0x2b, 0x0a, 0xd9, 0x6c, 0x3c, 0xae, 0xe7, 0xe3, 0xf1, 0x39,
-	0x2c, 0x88, 0xb4, 0x1e, 0x62, 0x9e, 0x6f, 0x36, 0x31, 0x25, 0xf3, 0xb0, 0x4b, 0x03, 0x80, 0x51,
-	0x7f, 0x93, 0x86, 0x83, 0xef, 0x60, 0x29, 0x10, 0xf9, 0xb1, 0x1b, 0xd0, 0xdf, 0x8e, 0x68, 0x18,
-	0x85, 0xcd, 0x8d, 0x54, 0xc8, 0xa6, 0xb3, 0xa7, 0xa1, 0xc5, 0xba, 0x86, 0x50, 0x65, 0x0c, 0xd0,
-	0x66, 0x09, 0xbf, 0xd9, 0x4a, 0x31, 0x40, 0x41, 0xd6, 0xb1, 0x83, 0x6c, 0x03, 0xb8, 0xf4, 0x4d,
-	0x6c, 0xc7, 0x3b, 0xa8, 0xb6, 0x88, 0x46, 0xe2, 0x66, 0x44, 0x46, 0xa6, 0xba, 0xf4, 0x8d, 0xb0,
-	0xea, 0x16, 0xd4, 0x6c, 0xd7, 0x0a, 0xe8, 0x90, 0xba, 0xec, 0xa4, 0x9f, 0x21, 0xb3, 0x4e, 0x8b,
-	0x26, 0x32, 0xc6, 0xdd, 0x19, 0x19, 0x23, 0x9f, 0xed, 0xee, 0x4d, 0x66, 0xbb, 0x24, 0x5b, 0x6d,
-	0xce, 0xc8, 0x56, 0xf7, 0xa1, 0x4e, 0x5d, 0xf3, 0xdc, 0xa1, 0x5d, 0xae, 0xbf, 0xc5, 0xb7, 0xc7,
-	0x65, 0x9c, 0x75, 0xb0, 0x02, 0xce, 0x74, 0xa2, 0xe6, 0x7d, 0x51, 0xc0, 0x99, 0x4e, 0xc4, 0xd8,
-	0xe5, 0xb9, 0x19, 0x59, 0x17, 0x4d, 0x9d, 0xd7, 0xfe, 0xd8, 0x48, 0x65, 0xa9, 0xcf, 0xd3, 0x59,
-	0x8a, 0x7c, 0x03, 0x8b, 0x89, 0x53, 0x1c, 0x7b, 0x68, 0x47, 0x61, 0xf3, 0x8b, 0x9b, 0x5c, 0xd2,
-	0x88, 0x35, 0x8f, 0x51, 0x91, 0xfc, 0x0c, 0xc0, 0xba, 0x18, 0xb9, 0x97, 0x3c, 0xd8, 0x1e, 0xa4,
-	0x2b, 0x24, 0x26, 0xc6, 0x31, 0xaa, 0x15, 0x7f, 0x22, 0x81, 0x64, 0x6c, 0x1c, 0x99, 0x8b, 0x37,
-	0x8a, 0x9a, 0x0f, 0x67, 0x13, 0x48, 0xa6, 0x7f, 0xca, 0xd5, 0x19, 0x05, 0x64, 0x1c, 0x21, 0x1e,
-	0xfd, 0xe5, 0x4c, 0x0a, 0xf8, 0xda, 0x3b, 0x8f, 0xc7, 0xe6, 0x30, 0xe4, 0x51, 0x1e, 0x43, 0xda,
-	0xb2, 0x22, 0x6b, 0x95, 0xb6, 0xac, 0x54, 0xb4, 0x79, 0xfd, 0x00, 0xe6, 0xf9, 0x35, 0x2e, 0x2c,
-	0x67, 0x1f, 0x66, 0xcb, 0x0c, 0x2d, 0x77, 0xed, 0xe3, 0x84, 0xa4, 0x3f, 0x13, 0x45, 0x5f, 0xdf,
-	0x0b, 0xc9, 0x97, 0xa0, 0x20, 0xbd, 0x71, 0xfb, 0x5e, 0xb3, 0x84, 0xd1, 0x52, 0x8f, 0x93, 0x18,
-	0xde, 0xc9, 0xea, 0x6b, 0xfe, 0xa1, 0xdf, 0x03, 0x25, 0xce, 0xe4, 0x45, 0x8b, 0xeb, 0x7f, 0x5b,
-	0x82, 0x85, 0x58, 0x81, 0xd7, 0x93, 0x77, 0x45, 0xbd, 0x5f, 0xca, 0xa7, 0x84, 0xfc, 0x7b, 0x45,
-	0x39, 0x53, 0x61, 0xc7, 0x15, 0xa6, 0x54, 0x50, 0x61, 0xca, 0x05, 0x15, 0x66, 0x25, 0x65, 0x81,
-	0x4d, 0x90, 0xfb, 0x81, 0x37, 0x14, 0x90, 0x92, 0x09, 0x06, 0xec, 0xd0, 0xff, 0xae, 0x0c, 0x1a,
-	0x63, 0x2f, 0xe3, 0x9d, 0xf6, 0x3d, 0xf2, 0x28, 0xb6, 0x5b, 0x09, 0xed, 0x46, 0x32, 0xb0, 0x95,
-	0x49, 0xe5, 0x5f, 0x41, 0x8d, 0xdd, 0xa8, 0x38, 0xe6, 0xca, 0x93, 0xcb, 0x00, 0xeb, 0x17, 0x21,
-	0xb7, 0x0f, 0xcc, 0xd1, 0x5d, 0x2c, 0xa2, 0x42, 0x41, 0x0f, 0xbf, 0xe0, 0x89, 0x36, 0xb7, 0x05,
-	0x66, 0xee, 0x7d, 0x54, 0xe3, 0xaf, 0x72, 0xea, 0xeb, 0xb8, 0x9d, 0x0a, 0x0f, 0x39, 0x13, 0x1e,
-	0x77, 0x01, 0xcc, 0x51, 0x74, 0xd1, 0x8d, 0xbc, 0x4b, 0xea, 0x0a, 0x23, 0xa8, 0x4c, 0x72, 0xca,
-	0x04, 0xad, 0x6f, 0xa1, 0x91, 0x9d, 0x33, 0xfd, 0x52, 0x56, 0x29, 0x78, 0x29, 0xab, 0xa4, 0x5f,
-	0xca, 0x7e, 0x02, 0xa8, 0x67, 0x4c, 0x94, 0x06, 0xf7, 0xd2, 0x74, 0x70, 0xbf, 0x1d, 0x6b, 0xf8,
-	0x3d, 0x00, 0x2b, 0xa0, 0x66, 0x44, 0x7b, 0x5d, 0x33, 0x12, 0x7e, 0x9b, 0x86, 0xd6, 0xaa, 0xd0,
-	0xde, 0x8d, 0xc6, 0x6e, 0xab, 0xce, 0x72, 0xdb, 0x7d, 0xa8, 0x07, 0x94, 0x95, 0x8f, 0x5d, 0x1a,
-	0x04, 0x5e, 0x80, 0xa4, 0x40, 0x35, 0x6a, 0x5c, 0x76, 0xc8, 0x44, 0xe4, 0xfb, 0x8c, 0xaf, 0x54,
-	0xf4, 0xd5, 0x56, 0x66, 0xc6, 0x19, 0x7e, 0x2a, 0x42, 0x79, 0xb8, 0x0d, 0xca, 0x37, 0xa1, 0x1a,
-	0x83, 0x7b, 0x8d, 0x83, 0xa3, 0x68, 0x7e, 0x24, 0x58, 0x6b, 0x05, 0x60, 0xcd, 0x1f, 0x3b, 0x96,
-	0x26, 0x1e, 0x3b, 0x7e, 0x80, 0x95, 0xd0, 0x32, 0x1d, 0xda, 0x65, 0xa5, 0x56, 0x37, 0xba, 0x08,
-	0x68, 0x78, 0xe1, 0x39, 0x3d, 0x81, 0xe6, 0x53, 0x32, 0x19, 0xc1, 0x61, 0x07, 0xde, 0x1b, 0xf7,
-	0x34, 0x1e, 0x54, 0x8c, 0xa6, 0xcb, 0x1f, 0x81, 0xa6, 0x2b, 0x37, 0xa1, 0xe9, 0x16, 0xd4, 0x7a,
-	0x34, 0xb4, 0x02, 0xdb, 0x67, 0x9b, 0x68, 0xae, 0x72, 0x77, 0xa6, 0x44, 0x79, 0xfc, 0x5c, 0x9b,
-	0xc4, 0xcf, 0xbb, 0x00, 0x96, 0x69, 0x5d, 0x88, 0x92, 0x69, 0x9d, 0xc7, 0x0f, 0x4a, 0x58, 0xc9,
-	0x34, 0x01, 0x71, 0xcd, 0x9b, 0x21, 0x6e, 0xa3, 0x08, 0xe2, 0xee, 0x14, 0x43, 0xdc, 0x67, 0x99,
-	0x18, 0xfe, 0x02, 0x1a, 0x43, 0xf3, 0x6d, 0x37, 0x55, 0xba, 0xdd, 0xc5, 0xf4, 0x5f, 0x1f, 0x9a,
-	0x6f, 0xff, 0x28, 0xae, 0xde, 0xd2, 0x9c, 0xee, 0xde, 0x34, 0x4e, 0x57, 0x00, 0x98, 0x9b, 0x1f,
-	0x07, 0x98, 0x5b, 0xb7, 0x06, 0xcc, 0xfb, 0x9f, 0x04, 0x98, 0xfa, 0x6d, 0x00, 0xf3, 0x29, 0xd4,
-	0x06, 0x76, 0x74, 0xe1, 0x79, 0x97, 0xdd, 0x51, 0xe0, 0x70, 0xd2, 0xb0, 0xd7, 0xb8, 0x7e, 0xbf,
-	0x09, 0x2f, 0xb9, 0xf8, 0xcc, 0x38, 0x36, 0x40, 0xa8, 0x9c, 0x05, 0x4e, 0x3e, 0x69, 0x7f, 0x31,
-	0x35, 0x69, 0x7f, 0x5a, 0xe2, 0x6c, 0xcb, 0x8a, 0xa4, 0xc9, 0x09, 0x64, 0xb7, 0xb4, 0x3b, 0xfa,
-	0xcb, 0x34, 0x2c, 0x32, 0xc4, 0x7d, 0x0e, 0x0b, 0x09, 0x9b, 0x4f, 0xc1, 0xee, 0xd2, 0x44, 0xb2,
-	0x31, 0xea, 0x7e, 0xaa, 0xa5, 0xff, 0x77, 0x09, 0xb4, 0x7d, 0x4c, 0x7e, 0xac, 0x48, 0xe2, 0xc1,
-	0xf2, 0x49, 0x15, 0xf6, 0xc6, 0x8c, 0xea, 0x26, 0x77, 0x98, 0x92, 0x56, 0x6e, 0xcb, 0x0a, 0x68,
-	0x35, 0xfe, 0xe0, 0xdf, 0x96, 0x15, 0x55, 0x83, 0xb6, 0xac, 0x28, 0x9a, 0xda, 0x96, 0x95, 0xba,
-	0xb6, 0xd0, 0x96, 0x95, 0x9a, 0x56, 0x6f, 0xcb, 0xca, 0x82, 0xd6, 0x68, 0xcb, 0x4a, 0x43, 0x5b,
-	0x6c, 0xcb, 0xca, 0xaa, 0xb6, 0xd6, 0x96, 0x95, 0x45, 0x4d, 0x6b, 0xcb, 0x8a, 0xa6, 0x2d, 0xb5,
-	0x65, 0x65, 0x49, 0x23, 0x6d, 0x59, 0x21, 0xda, 0x72, 0x5b, 0x56, 0x96, 0xb5, 0x95, 0xb6, 0xac,
-	0xac, 0x68, 0xab, 0x6d, 0x59, 0x59, 0xd3, 0xd6, 0xdb, 0xb2, 0xb2, 0xae, 0x35, 0xdb, 0xb2, 0xd2,
-	0xd4, 0x36, 0xf4, 0x0e, 0x2c, 0x1d, 0xb9, 0xcc, 0x31, 0x51, 0xea, 0xbc, 0xd3, 0xca, 0xd5, 0x4d,
-	0xa8, 0x9d, 0x3b, 0x9e, 0x75, 0xd9, 0x1d, 0x93, 0x20, 0xc5, 0x00, 0x14, 0x21, 0x1a, 0xe8, 0x7f,
-	0x53, 0x82, 0xc6, 0xb1, 0x1d, 0x46, 0x37, 0xd8, 0x6f, 0x06, 0xae, 0x6d, 0x43, 0x1d, 0xb3, 0xcf,
-	0x18, 0xfc, 0xa5, 0x09, 0xc2, 0x8d, 0x0a, 0x02, 0xfd, 0x6f, 0xfd, 0xa2, 0xa1, 0xbf, 0x86, 0xc5,
-	0x17, 0xce, 0x28, 0xbc, 0x48, 0xed, 0xef, 0x01, 0x54, 0xf9, 0xe8, 0x50, 0x5c, 0x93, 0xcc, 0xf0,
-	0xb8, 0x8f, 0x7c, 0x0d, 0xf5, 0xc8, 0xeb, 0xc6, 0x5b, 0x8d, 0x7f, 0x56, 0xc8, 0x1d, 0xa5, 0x16,
-	0x79, 0xf1, 0x77, 0xa8, 0x6f, 0x83, 0x76, 0x40, 0x1d, 0x9a, 0xb9, 0x4c, 0x53, 0x8c, 0xab, 0x7f,
-	0x05, 0x8d, 0x93, 0xc8, 0xf3, 0x3f, 0x50, 0xfb, 0xbf, 0x4a, 0xd0, 0x78, 0x49, 0xa3, 0x63, 0x6f,
-	0x10, 0x7e, 0x88, 0xe7, 0x6e, 0x71, 0x8b, 0xe3, 0x32, 0xa6, 0x6f, 0x3b, 0x11, 0x0d, 0x38, 0xab,
-	0x52, 0x79, 0x19, 0xf3, 0x82, 0x8b, 0xf0, 0x2d, 0xcb, 0x0c, 0x23, 0x1a, 0x20, 0x2b, 0x52, 0x0c,
-	0xd1, 0x1a, 0xbf, 0xd3, 0xcf, 0xdf, 0xf4, 0x4e, 0xbf, 0x06, 0xf3, 0x7d, 0xcf, 0x71, 0xbc, 0x37,
-	0xe2, 0xd7, 0x49, 0xd1, 0x62, 0x99, 0x3e, 0x32, 0x6d, 0x47, 0x3c, 0xf0, 0xe0, 0x37, 0x0f, 0x0b,
-	0xfd, 0x9f, 0xcb, 0x00, 0xc7, 0xde, 0xe0, 0x0f, 0x69, 0x18, 0x9a, 0x03, 0xfc, 0x6d, 0x30, 0x89,
-	0xed, 0x14, 0x43, 0x4e, 0x02, 0xf9, 0x15, 0x23, 0xa9, 0xe3, 0x17, 0x45, 0x69, 0xc6, 0x8b, 0xa2,
-	0x3c, 0xe5, 0x45, 0xf1, 0x09, 0x94, 0x93, 0x87, 0xc1, 0x69, 0x84, 0xa9, 0x1c, 0x85, 0x8c, 0x5a,
-	0x0c, 0xf9, 0x0e, 0xf1, 0xec, 0xaa, 0x11, 0x37, 0xb3, 0x0f, 0xa1, 0xd5, 0xa9, 0x0f, 0xa1, 0x04,
-	0xe4, 0x51, 0x48, 0x03, 0xf1, 0x03, 0x20, 0x7e, 0x93, 0x87, 0xa0, 0x70, 0x1c, 0xb0, 0x7b, 0xf8,
-	0x58, 0xa2, 0xee, 0xd5, 0xae, 0xdf, 0x6f, 0x56, 0xf9, 0x6f, 0x23, 0x07, 0x46, 0x15, 0x3b, 0x8f,
-	0x7a, 0x29, 0x97, 0x40, 0xda, 0x25, 0xfa, 0x29, 0x2c, 0x1b, 0xfc, 0x05, 0x80, 0xfb, 0xe1, 0x03,
-	0xee, 0x4a, 0xfe, 0x02, 0x94, 0x27, 0x2e, 0x80, 0xfe, 0xbb, 0xb0, 0x2c, 0x32, 0x47, 0x66, 0xd6,
-	0x99, 0xbf, 0xd3, 0xe8, 0x5d, 0xd0, 0x58, 0x7e, 0xf8, 0xe0, 0xbd, 0xdc, 0x01, 0xd5, 0x37, 0x07,
-	0x02, 0xba, 0xcb, 0x78, 0x39, 0x14, 0x26, 0x40, 0xd8, 0xc6, 0x5f, 0xa2, 0x06, 0x54, 0xbc, 0x9d,
-	0xe2, 0xb7, 0xfe, 0x0e, 0x96, 0x52, 0x0b, 0x84, 0xbe, 0xe7, 0x86, 0xf8, 0x70, 0x2e, 0x8c, 0xc8,
-	0xf0, 0x41, 0xc4, 0x79, 0x63, 0xbc, 0x3b, 0xc4, 0x02, 0xe8, 0xc5, 0x9f, 0x21, 0x4b, 0x74, 0xf8,
-	0x00, 0xd2, 0x65, 0x73, 0x86, 0x62, 0x61, 0x40, 0x51, 0x87, 0x49, 0x0a, 0x97, 0xfe, 0x53, 0x58,
-	0x4f, 0x96, 0x3e, 0x89, 0x02, 0x6a, 0x8e, 0x37, 0xf0, 0x33, 0x80, 0xf1, 0x06, 0x32, 0x3f, 0x0f,
-	0x8c, 0xd7, 0x57, 0x93, 0xf5, 0x3f, 0x6e, 0xf9, 0x3d, 0x50, 0x13, 0x26, 0xc1, 0xae, 0x83, 0x3b,
-	0x1a, 0x9e, 0xd3, 0x40, 0xfc, 0xce, 0x24, 0x5a, 0x8c, 0x93, 0x31, 0x53, 0x8a, 0x87, 0x7d, 0x3e,
-	0xb1, 0xca, 0x24, 0xfc, 0x19, 0xff, 0xdf, 0xab, 0xb0, 0xca, 0x11, 0x30, 0x49, 0x0c, 0xb7, 0x4f,
-	0xe3, 0xb7, 0x2b, 0x4f, 0xd6, 0x60, 0x7e, 0xe4, 0xf7, 0x18, 0x9c, 0x88, 0x5c, 0xc2, 0x5b, 0x85,
-	0x6c, 0xbf, 0x7a, 0x1b, 0xb6, 0x3f, 0xe6, 0xf4, 0xea, 0x2d, 0x38, 0x3d, 0x14, 0x70, 0xfa, 0x9b,
-	0xb8, 0x7b, 0xed, 0xff, 0x8d, 0xbb, 0xd7, 0x3f, 0x82, 0xbb, 0x2f, 0x7c, 0x20, 0x77, 0x6f, 0xcc,
-	0xe4, 0xee, 0x8b, 0xb3, 0xb8, 0xbb, 0x36, 0x8b, 0xbb, 0x2f, 0x4d, 0x72, 0xf7, 0xcf, 0x40, 0x0d,
-	0xa8, 0x78, 0x6c, 0xc4, 0x2a, 0x47, 0x31, 0xc6, 0x82, 0x31, 0x8b, 0x5f, 0x4e, 0xb3, 0xf8, 0x49,
-	0xb6, 0xbe, 0x32, 0x9d, 0xad, 0xaf, 0xde, 0x92, 0xad, 0xaf, 0x7d, 0x1c, 0x5b, 0x5f, 0xbf, 0x35,
-	0x5b, 0x6f, 0x7e, 0x12, 0x5b, 0xdf, 0xb8, 0x0d, 0x5b, 0x8f, 0x8b, 0xa4, 0xd6, 0xb8, 0x48, 0x4a,
-	0xf3, 0x4a, 0x7d, 0x1f, 0xd6, 0x44, 0xae, 0xfe, 0xf8, 0x98, 0xd6, 0x57, 0x61, 0x99, 0xe5, 0xb6,
-	0xdc, 0x0c, 0xfa, 0x9f, 0xc0, 0x2a, 0xe7, 0x38, 0x9f, 0x90, 0x2e, 0x34, 0x90, 0x4c, 0xc7, 0x11,
-	0x0f, 0x4f, 0xec, 0xb3, 0x2d, 0x2b, 0x65, 0x4d, 0xe2, 0x67, 0xd0, 0x77, 0x61, 0xe5, 0x84, 0xa1,
-	0xd7, 0x27, 0xec, 0xfd, 0x57, 0xb0, 0xcc, 0x88, 0xd5, 0x27, 0xcc, 0xf0, 0xe7, 0x25, 0x58, 0x31,
-	0x68, 0x30, 0x72, 0x3f, 0xe1, 0x98, 0x0f, 0xa0, 0x4a, 0xdf, 0x5a, 0xce, 0xa8, 0x47, 0x8b, 0x78,
-	0x6d, 0xdc, 0xc7, 0xd4, 0x6c, 0x97, 0xab, 0x49, 0x05, 0x6a, 0xa2, 0x4f, 0x5f, 0x87, 0xd5, 0x97,
-	0x66, 0x70, 0x6e, 0x0e, 0xe8, 0xbe, 0xe7, 0x38, 0xd4, 0x8a, 0x62, 0x8f, 0x34, 0x61, 0x2d, 0xdf,
-	0xc1, 0x31, 0x88, 0xb9, 0x70, 0xd7, 0x8a, 0xec, 0x2b, 0x33, 0xa2, 0xbb, 0xa3, 0xe8, 0x22, 0x1e,
-	0xb0, 0x06, 0x2b, 0x59, 0x31, 0x57, 0x7f, 0xd2, 0xc5, 0x27, 0x4c, 0xfe, 0x5f, 0x0e, 0x1a, 0xd4,
-	0xdb, 0xbf, 0xde, 0xeb, 0x9e, 0x9c, 0xee, 0x1a, 0xa7, 0x47, 0xaf, 0x5e, 0x6a, 0x73, 0x64, 0x11,
-	0x6a, 0x4c, 0x62, 0x9c, 0xbd, 0x7a, 0xc5, 0x04, 0xa5, 0x58, 0xf0, 0x62, 0xf7, 0xe8, 0xf8, 0xcc,
-	0x38, 0xd4, 0xca, 0xb1, 0xe0, 0xe4, 0x6c, 0x7f, 0xff, 0xf0, 0xe4, 0x44, 0x93, 0x48, 0x03, 0x80,
-	0x09, 0x7e, 0x38, 0x3a, 0x3e, 0x3e, 0x3c, 0xd0, 0xe4, 0x27, 0xbf, 0x02, 0x18, 0xff, 0x83, 0x06,
-	0x01, 0x98, 0x67, 0x63, 0x0f, 0x0f, 0xb4, 0x39, 0x52, 0x83, 0x6a, 0x3c, 0xac, 0x84, 0x8d, 0x1f,
-	0x8e, 0x3a, 0x9d, 0xc3, 0x03, 0xad, 0x4c, 0xea, 0xa0, 0x24, 0x9b, 0x90, 0x9e, 0x7c, 0x0f, 0xb5,
-	0xd4, 0xdb, 0x2b, 0x5b, 0xb1, 0xf3, 0xeb, 0x83, 0x64, 0x4f, 0x73, 0xb1, 0x60, 0x3c, 0x57, 0x03,
-	0x80, 0x09, 0xc4, 0x42, 0xe5, 0x27, 0x7f, 0x96, 0x7a, 0x51, 0xe5, 0x73, 0xac, 0xc2, 0x52, 0xe7,
-	0xa8, 0x73, 0x78, 0x7c, 0xf4, 0xea, 0x30, 0x7d, 0xdc, 0x15, 0xd0, 0x12, 0xf1, 0xf8, 0xcc, 0xeb,
-	0xb0, 0x3c, 0x96, 0x1e, 0x26, 0xea, 0xe5, 0x8c, 0x7a, 0x6c, 0x11, 0x89, 0x2c, 0xc3, 0x62, 0x22,
-	0xed, 0xec, 0x9e, 0x9d, 0x30, 0x2b, 0xec, 0xfc, 0x0f, 0x80, 0xb4, 0xdb, 0x39, 0x22, 0xdb, 0xa0,
-	0x26, 0xa5, 0x27, 0x59, 0x15, 0xff, 0x6a, 0x94, 0x2d, 0x45, 0x5b, 0x09, 0x37, 0xd2, 0xe7, 0xc8,
-	0x2f, 0x00, 0xc6, 0xb5, 0x1b, 0x59, 0x13, 0x28, 0x90, 0x2b, 0xe6, 0x5a, 0x99, 0x97, 0x66, 0x7d,
-	0x8e, 0x3c, 0x85, 0xaa, 0x28, 0xcf, 0xc8, 0x32, 0x76, 0x65, 0x8b, 0xb5, 0xd6, 0x42, 0x5a, 0x3f,
-	0xd4, 0xe7, 0x58, 0x29, 0x2d, 0x54, 0x38, 0xa3, 0x29, 0x1e, 0x96, 0x5b, 0xe6, 0xeb, 0x12, 0xd9,
-	0x01, 0x25, 0x2e, 0xb4, 0x08, 0xc7, 0xeb, 0x5c, 0xdd, 0x55, 0x30, 0xe6, 0x5b, 0x50, 0x93, 0x82,
-	0x49, 0x98, 0x20, 0x5f, 0x40, 0xb5, 0xd6, 0x26, 0x72, 0xe2, 0xe1, 0xd0, 0x8f, 0xde, 0xe9, 0x73,
-	0xe4, 0x97, 0x50, 0x15, 0xe5, 0x93, 0xd8, 0x63, 0xb6, 0x98, 0x9a, 0x32, 0xf2, 0x1b, 0xa8, 0xa7,
-	0xc9, 0x2c, 0x69, 0xa6, 0x8d, 0x99, 0x66, 0xaa, 0xad, 0x1c, 0x65, 0xd3, 0xe7, 0xd8, 0x9e, 0x13,
-	0xce, 0x27, 0xf6, 0x9c, 0xe7, 0xb7, 0xad, 0xb5, 0xbc, 0x58, 0x04, 0xe4, 0x1c, 0x69, 0xc3, 0x62,
-	0x8e, 0x31, 0xde, 0x34, 0xc7, 0x67, 0x59, 0x71, 0x96, 0x5e, 0xa2, 0xf5, 0xf6, 0xf0, 0x3f, 0x10,
-	0x12, 0xa2, 0x2f, 0x4e, 0x51, 0xc0, 0xfd, 0xa7, 0x58, 0xe2, 0x05, 0x34, 0xb2, 0xec, 0x8f, 0xb4,
-	0x52, 0x37, 0x31, 0x97, 0xfc, 0xa6, 0xcc, 0xb3, 0x0f, 0x8b, 0x39, 0xc8, 0x21, 0x77, 0xd2, 0x46,
-	0xcd, 0xcf, 0x34, 0xf9, 0x32, 0xa3, 0xcf, 0x91, 0xef, 0xa0, 0x9e, 0x86, 0x1c, 0x71, 0xa0, 0x02,
-	0x14, 0x6a, 0x91, 0x89, 0xe1, 0x21, 0x3f, 0x4c, 0x16, 0x9b, 0xc4, 0x61, 0x0a, 0x01, 0x6b, 0xca,
-	0x61, 0x0e, 0x60, 0x21, 0x83, 0x40, 0x64, 0x43, 0x5c, 0xaf, 0x49, 0x54, 0x9a, 0x32, 0xcb, 0x1e,
-	0xd4, 0xd3, 0x20, 0x24, 0x4e, 0x53, 0x80, 0x4b, 0xd3, 0x77, 0x92, 0x41, 0x21, 0xb1, 0x93, 0x22,
-	0x64, 0x9a, 0x32, 0xcb, 0x1f, 0xc4, 0x61, 0xb6, 0xeb, 0x38, 0xe4, 0x06, 0xb5, 0x29, 0xc3, 0x9f,
-	0x41, 0x55, 0xbc, 0x3b, 0x88, 0x38, 0xcb, 0xbe, 0x42, 0xb4, 0xf8, 0xbf, 0xde, 0x8d, 0x2b, 0x76,
-	0xbc, 0x9c, 0x3f, 0x40, 0x23, 0x8b, 0x4a, 0xc2, 0x17, 0x85, 0x18, 0xd6, 0xba, 0x53, 0xd8, 0x97,
-	0x44, 0xcd, 0x21, 0xd4, 0xd3, 0x88, 0x25, 0x4c, 0x59, 0x80, 0x6d, 0xad, 0x8d, 0x82, 0x9e, 0x78,
-	0x9a, 0x3d, 0xed, 0xa7, 0xeb, 0x7b, 0xa5, 0x7f, 0xbb, 0xbe, 0x57, 0xfa, 0x8f, 0xeb, 0x7b, 0xa5,
-	0xbf, 0xfa, 0xcf, 0x7b, 0x73, 0xe7, 0xf3, 0x78, 0xd8, 0x67, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff,
-	0x15, 0xbe, 0x08, 0x96, 0x1b, 0x2f, 0x00, 0x00,
+	// 4443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xcd, 0x6f, 0xdb, 0x5a,
+	0x76, 0xb7, 0x44, 0x4a, 0xa2, 0x8e, 0x64, 0x99, 0xbe, 0xfe, 0xa2, 0x95, 0xe7, 0x8f, 0x30, 0x2f,
+	0x9f, 0xcd, 0x38, 0x19, 0x67, 0x9a, 0x4e, 0x5f, 0x5f, 0xdf, 0x1b, 0x7f, 0x25, 0x63, 0x3d, 0x37,
+	0x4f, 0x43, 0x3b, 0x33, 0x4b, 0x96, 0x96, 0xae, 0x64, 0xc6, 0x14, 0xc9, 0x21, 0xaf, 0x9c, 0xf8,
+	0xf5, 0x03, 0xdd, 0x76, 0x55, 0x14, 0xdd, 0x14, 0x05, 0x8a, 0x2e, 0xba, 0x2c, 0x50, 0x74, 0x5d,
+	0x74, 0xd1, 0x4d, 0x8b, 0x02, 0xdd, 0x74, 0xd1, 0x75, 0x50, 0xa4, 0x83, 0x6e, 0x8a, 0xfe, 0x09,
+	0x05, 0x8a, 0xfb, 0x41, 0x8a, 0xa4, 0x68, 0xc9, 0x4e, 0x1e, 0xba, 0x10, 0xc0, 0x7b, 0xee, 0xb9,
+	0x5f, 0xe7, 0x9c, 0x7b, 0xce, 0xef, 0x9c, 0x2b, 0x58, 0xec, 0x38, 0x36, 0x76, 0xc9, 0x13, 0xdf,
+	0x0f, 0xe9, 0x6f, 0xcb, 0x0f, 0x3c, 0xe2, 0x21, 0xc9, 0xf7, 0xc3, 0xe6, 0xad, 0xbe, 0xe7, 0xf5,
+	0x1d, 0xfc, 0x84, 0x91, 0x4e, 0x87, 0xbd, 0x27, 0x78, 0xe0, 0x93, 0x4b, 0xce, 0xd1, 0xdc, 0xc8,
+	0x76, 0x12, 0x7b, 0x80, 0x43, 0x62, 0x0d, 0x7c, 0xc1, 0xb0, 0x9e, 0x65, 0xe8, 0x0e, 0x03, 0x8b,
+	0xd8, 0x9e, 0x2b, 0xfa, 0x17, 0xfb, 0x5e, 0xdf, 0x63, 0x9f, 0x4f, 0xe8, 0x57, 0x44, 0x8d, 0xb6,
+	0xd3, 0x0b, 0xe9, 0x8f, 0x53, 0xf5, 0x1e, 0x94, 0x8f, 0x71, 0x27, 0xc0, 0x04, 0x21, 0x90, 0x5d,
+	0x6b, 0x80, 0xb5, 0xc2, 0x66, 0xe1, 0x41, 0xd5, 0x60, 0xdf, 0x68, 0x0d, 0x60, 0xe0, 0x0d, 0x5d,
+	0x62, 0xfa, 0x16, 0x39, 0xd3, 0x8a, 0xac, 0xa7, 0xca, 0x28, 0x6d, 0x8b, 0x9c, 0xa1, 0x15, 0xa8,
+	0x60, 0xf7, 0xc2, 0xbc, 0xb0, 0x02, 0x4d, 0x62, 0x7d, 0x65, 0xec, 0x5e, 0xfc, 0xdc, 0x0a, 0x90,
+	0x0a, 0xd2, 0x39, 0xbe, 0xd4, 0x64, 0x46, 0xa4, 0x9f, 0xfa, 0x3f, 0x15, 0xa1, 0x7a, 0x12, 0x58,
+	0x6e, 0xd8, 0xf3, 0x82, 0x01, 0x5a, 0x84, 0x92, 0x3d, 0xb0, 0xfa, 0xd1, 0x62, 0xbc, 0x41, 0x47,
+	0x75, 0x06, 0x5d, 0xad, 0xb8, 0x29, 0xd1, 0x51, 0x9d, 0x41, 0x17, 0x3d, 0x04, 0x09, 0xbb, 0x17,
+	0x9a, 0xb4, 0x29, 0x3d, 0xa8, 0x6d, 0xaf, 0x6c, 0x51, 0x29, 0xc6, 0x93, 0x6c, 0x1d, 0xb8, 0x17,
+	0x07, 0x2e, 0x09, 0x2e, 0x0d, 0xca, 0x83, 0xee, 0x42, 0x25, 0x64, 0x07, 0x09, 0x35, 0x99, 0xb1,
+	0xd7, 0x18, 0x3b, 0x3f, 0x9c, 0x11, 0xf5, 0xd1, 0x95, 0x43, 0xd2, 0xb5, 0x5d, 0xad, 0xc4, 0x56,
+	0xe1, 0x0d, 0xf4, 0x18, 0x90, 0xd5, 0xe9, 0x60, 0x9f, 0x98, 0x01, 0x26, 0xc3, 0xc0, 0x35, 0x3b,
+	0x5e, 0x17, 0x6b, 0xe5, 0x4d, 0xe9, 0x81, 0x64, 0xa8, 0xbc, 0xc7, 0x60, 0x1d, 0x7b, 0x5e, 0x17,
+	0xd3, 0x39, 0xba, 0xf8, 0x74, 0xd8, 0xd7, 0x2a, 0x9b, 0x85, 0x07, 0x8a, 0xc1, 0x1b, 0x74, 0x0e,
+	0x76, 0x0c, 0xd3, 0x1f, 0x3a, 0x8e, 0x19, 0xed, 0xa5, 0xca, 0x96, 0x51, 0x59, 0x4f, 0x7b, 0xe8,
+	0x38, 0x7c, 0x3f, 0x61, 0xf3, 0x39, 0x28, 0xd1, 0xfe, 0x23, 0x69, 0x15, 0x62, 0x69, 0xd1, 0x15,
+	0x2e, 0x2c, 0x67, 0x88, 0x85, 0xc8, 0x79, 0xe3, 0x8b, 0xe2, 0x8f, 0x0b, 0x7a, 0x13, 0xca, 0x07,
+	0xfd, 0x00, 0x87, 0x21, 0x1d, 0xf5, 0xda, 0x38, 0x8a, 0x46, 0xbd, 0x36, 0x8e, 0xf4, 0x35, 0x90,
+	0x5a, 0xde, 0x29, 0x5a, 0x86, 0xa2, 0xdd, 0xe5, 0xf4, 0xdd, 0xf2, 0x87, 0xf7, 0x1b, 0xc5, 0xc3,
+	0x7d, 0xa3, 0x68, 0x77, 0xf5, 0x73, 0xa8, 0x1c, 0xe3, 0xe0, 0xc2, 0xee, 0x60, 0x74, 0x07, 0x66,
+	0x6d, 0x97, 0xe0, 0xc0, 0xb5, 0x1c, 0xd3, 0xf7, 0x02, 0xc2, 0xb8, 0x4b, 0x46, 0x3d, 0x22, 0xb6,
+	0xbd, 0x80, 0x50, 0x26, 0xfc, 0x2e, 0xc9, 0x54, 0xe4, 0x4c, 0xf8, 0x5d, 0x82, 0x89, 0x2e, 0xe6,
+	0x6b, 0x52, 0x62, 0xb1, 0xb6, 0x51, 0xb4, 0x7d, 0xfd, 0x5f, 0x0b, 0x50, 0xdd, 0x21, 0xde, 0xe0,
+	0xd0, 0xf5, 0x87, 0xf9, 0xb6, 0x85, 0x40, 0x0e, 0xb0, 0xef, 0x89, 0x23, 0xb2, 0x6f, 0xb4, 0x0c,
+	0xe5, 0xd3, 0xc0, 0x72, 0x3b, 0x67, 0x91, 0x3d, 0xf1, 0x16, 0xa5, 0x77, 0xbc, 0xc1, 0xc0, 0x26,
+	0xc2, 0xa4, 0x44, 0x8b, 0xce, 0xd1, 0x77, 0xbc, 0x53, 0xad, 0xc4, 0xe7, 0xa0, 0xdf, 0x94, 0xe6,
+	0x58, 0xdf, 0x5d, 0x6a, 0x65, 0xa6, 0x1c, 0xf6, 0x8d, 0x36, 0xa0, 0xc6, 0x6e, 0x98, 0xd9, 0xb3,
+	0x1d, 0x1c, 0x6a, 0x0a, 0xeb, 0x02, 0x46, 0x7a, 0x41, 0x29, 0xd4, 0x92, 0xdf, 0x78, 0xb6, 0x6b,
+	0x7a, 0xae, 0x56, 0xe5, 0x2b, 0xd0, 0xe6, 0xb7, 0x6e, 0x4b, 0x56, 0x2a, 0xaa, 0xa2, 0xff, 0x69,
+	0x01, 0xaa, 0x7b, 0x81, 0xe7, 0xde, 0xf8, 0x34, 0x62, 0xd7, 0x52, 0x76, 0xd7, 0xa1, 0x8f, 0x3b,
+	0xe2, 0x2c, 0xec, 0x1b, 0x3d, 0xa5, 0x76, 0x69, 0x05, 0x84, 0x1d, 0xa5, 0xb6, 0xdd, 0xdc, 0xe2,
+	0x77, 0x7c, 0x2b, 0xba, 0xe3, 0x5b, 0x27, 0x91, 0x13, 0x30, 0x38, 0xa3, 0x6e, 0x83, 0xf2, 0xd2,
+	0x26, 0x57, 0xef, 0x68, 0x15, 0xa4, 0x61, 0xe0, 0xf0, 0x0d, 0xed, 0x56, 0x3e, 0xbc, 0xdf, 0xa0,
+	0x36, 0x62, 0x50, 0xda, 0x4d, 0xc5, 0xac, 0xff, 0x7b, 0x01, 0x4a, 0x7c, 0x21, 0x1d, 0x64, 0x8b,
+	0x78, 0x03, 0xb6, 0x50, 0x6d, 0xbb, 0xc1, 0xae, 0x58, 0xac, 0x66, 0x83, 0xf5, 0xa1, 0x4d, 0x28,
+	0x75, 0x02, 0x2f, 0x0c, 0xd9, 0x45, 0xae, 0x6d, 0x03, 0x63, 0xe2, 0x0c, 0xbc, 0x83, 0x72, 0x0c,
+	0x5d, 0xdb, 0x73, 0x35, 0x69, 0x9c, 0x83, 0x75, 0xd0, 0x75, 0x3a, 0x81, 0xe7, 0x6a, 0x72, 0x62,
+	0x9d, 0x58, 0x01, 0x06, 0xeb, 0x43, 0x1b, 0x20, 0xf5, 0xed, 0x48, 0x60, 0xb3, 0x8c, 0x25, 0x12,
+	0x88, 0x41, 0x7b, 0xd0, 0x3a, 0xc8, 0x54, 0x8b, 0x5a, 0x79, 0x6c, 0x15, 0x46, 0xd7, 0xcf, 0x41,
+	0x69, 0x79, 0xa7, 0xfc, 0x60, 0x77, 0xe2, 0xa3, 0xf3, 0xa3, 0xd5, 0xb6, 0xa8, 0x8f, 0xdc, 0x63,
+	0xa4, 0x31, 0x73, 0x2b, 0xe6, 0x98, 0x9b, 0x94, 0x30, 0xb7, 0x48, 0x1d, 0xf2, 0x48, 0x1d, 0xfa,
+	0x1f, 0x17, 0x60, 0xae, 0x6d, 0x05, 0x96, 0xe3, 0x60, 0xc7, 0x0e, 0x07, 0xc7, 0x54, 0xe9, 0x4d,
+	0x50, 0x3a, 0x9e, 0x1b, 0x12, 0xcb, 0xe5, 0x97, 0x4b, 0x36, 0xe2, 0x36, 0xda, 0x84, 0x5a, 0xc7,
+	0xc3, 0xbd, 0x9e, 0xdd, 0xa1, 0x5e, 0x9b, 0x4d, 0x5f, 0x30, 0x92, 0x24, 0xb4, 0x0d, 0x35, 0x6b,
+	0x48, 0xbc, 0xb0, 0x63, 0x39, 0xb6, 0xdb, 0x17, 0xa2, 0x52, 0xb9, 0x4a, 0x46, 0x74, 0x23, 0xc9,
+	0xd4, 0x92, 0x95, 0x82, 0x5a, 0xd4, 0x87, 0x50, 0x4b, 0x70, 0x50, 0x4f, 0x32, 0xb0, 0x5d, 0x76,
+	0x70, 0xd9, 0xa0, 0x9f, 0x8c, 0x62, 0xbd, 0x13, 0x7b, 0xa2, 0x9f, 0x68, 0x17, 0xe6, 0x88, 0x15,
+	0xf4, 0x31, 0x31, 0xa3, 0x60, 0xc3, 0xb6, 0x54, 0xdb, 0x5e, 0x1d, 0xb3, 0xd4, 0x7d, 0xc1, 0x60,
+	0x34, 0xf8, 0x88, 0xa8, 0xad, 0xff, 0x1e, 0xcc, 0x27, 0x96, 0x3d, 0x26, 0x16, 0x19, 0x86, 0x48,
+	0x83, 0xca, 0x5b, 0x2f, 0x38, 0xc7, 0x41, 0xc8, 0x36, 0x20, 0x19, 0x51, 0x93, 0x5a, 0x63, 0x80,
+	0xad, 0xd0, 0x73, 0x85, 0xbc, 0x45, 0x0b, 0xfd, 0x08, 0x2a, 0x43, 0xbf, 0x6b, 0x11, 0xdc, 0xd5,
+	0xa4, 0xa9, 0x97, 0x25, 0x62, 0xd5, 0x9f, 0x41, 0x95, 0x69, 0x9a, 0xde, 0x77, 0xaa, 0x20, 0x16,
+	0xd1, 0x84, 0x82, 0xe8, 0x37, 0xa5, 0x9d, 0x59, 0xe1, 0x19, 0xb3, 0xa7, 0xba, 0xc1, 0xbe, 0xf5,
+	0xdf, 0x82, 0xd2, 0xbe, 0x45, 0x86, 0x83, 0xab, 0x7c, 0x2a, 0x6a, 0x82, 0xf4, 0x46, 0x18, 0x44,
+	0x6d, 0x5b, 0x61, 0xb2, 0x6f, 0x79, 0xa7, 0x06, 0x25, 0xea, 0xbf, 0x2a, 0x40, 0x95, 0x8d, 0x3e,
+	0x74, 0x7b, 0x1e, 0xb5, 0xf9, 0x2e, 0x6d, 0x08, 0xfb, 0xe2, 0xd6, 0xc8, 0xba, 0x0d, 0xde, 0x81,
+	0xee, 0x32, 0x17, 0x40, 0xb8, 0xd3, 0x6f, 0x6c, 0xcf, 0x8d, 0x38, 0xa8, 0xa8, 0xb0, 0xc1, 0x7b,
+	0xd1, 0x7d, 0xce, 0x16, 0x8a, 0xc3, 0xcf, 0x33, 0xb6, 0x76, 0xe0, 0x75, 0x70, 0x18, 0x52, 0xc6,
+	0x90, 0x33, 0x86, 0xe8, 0x1e, 0x54, 0xfd, 0x5e, 0x68, 0xf2, 0x39, 0xb9, 0x75, 0x54, 0x99, 0x55,
+	0x53, 0x11, 0x18, 0x8a, 0xdf, 0x63, 0xec, 0x18, 0xdd, 0x06, 0xb9, 0x6b, 0x11, 0x8b, 0x45, 0x44,
+	0x76, 0x91, 0x04, 0x0b, 0xdd, 0xb6, 0xc1, 0xba, 0xa8, 0xa1, 0x5a, 0x84, 0x50, 0x7f, 0x19, 0x32,
+	0xbf, 0x2a, 0x19, 0x71, 0x5b, 0xff, 0x3b, 0xea, 0xe9, 0xfb, 0xfd, 0x00, 0xf7, 0xe9, 0x64, 0x8b,
+	0x50, 0xea, 0x50, 0x7c, 0x20, 0x94, 0xc9, 0x1b, 0x54, 0xb6, 0x03, 0x6c, 0x71, 0x45, 0x16, 0x0c,
+	0xf6, 0x4d, 0xd5, 0x1b, 0x92, 0x6e, 0x17, 0x5f, 0x08, 0xdb, 0x16, 0x2d, 0xf4, 0x10, 0xd4, 0x9e,
+	0xdd, 0x23, 0x67, 0xa6, 0x8f, 0x83, 0x0e, 0x76, 0x89, 0xed, 0xf0, 0xdd, 0x17, 0x8c, 0x39, 0x46,
+	0x6f, 0xc7, 0x64, 0xf4, 0x1c, 0x56, 0x5c, 0xdb, 0xc5, 0xcc, 0xaf, 0x67, 0x46, 0x94, 0xd8, 0x88,
+	0x25, 0xde, 0xfd, 0x22, 0x3d, 0x4e, 0xff, 0xdb, 0x22, 0xd4, 0x93, 0x12, 0x43, 0x5f, 0xc1, 0x6c,
+	0xd7, 0x7b, 0xeb, 0x3a, 0x9e, 0xd5, 0x35, 0x29, 0xda, 0xd2, 0x0a, 0xd3, 0x6c, 0xbb, 0x1e, 0xf1,
+	0x53, 0x53, 0x43, 0x5f, 0x42, 0xdd, 0xe7, 0xf3, 0xf1, 0xe1, 0xc5, 0x69, 0xc3, 0x6b, 0x82, 0x9d,
+	0x8d, 0xfe, 0x02, 0x6a, 0x43, 0x7f, 0xb4, 0xf6, 0xd4, 0x7b, 0x05, 0x9c, 0x9b, 0x8d, 0xbd, 0x0b,
+	0x8d, 0x78, 0xe7, 0xa7, 0x97, 0x04, 0x87, 0x4c, 0x56, 0xb2, 0x11, 0x9f, 0x67, 0x97, 0x12, 0xd1,
+	0x6d, 0xa8, 0x0f, 0xfd, 0x04, 0x53, 0x89, 0x31, 0x89, 0x65, 0x39, 0xcb, 0x24, 0x1d, 0xff, 0x45,
+	0x11, 0x96, 0x62, 0x1d, 0xa7, 0x24, 0xf7, 0x2c, 0x5f, 0x72, 0x22, 0x32, 0x44, 0x43, 0x32, 0xe2,
+	0xfa, 0x61, 0xae, 0xb8, 0xb2, 0x63, 0x52, 0x32, 0x7a, 0x92, 0x27, 0xa3, 0xec, 0x88, 0xa4, 0x60,
+	0x7e, 0x3d, 0x57, 0x30, 0xe3, 0x63, 0x32, 0x82, 0xfa, 0x61, 0x8e, 0xa0, 0x72, 0xb6, 0x96, 0x10,
+	0x9c, 0xfe, 0xbf, 0x05, 0xa8, 0xff, 0x82, 0xf9, 0x2c, 0xe1, 0xd2, 0x1e, 0x42, 0x95, 0xfb, 0x30,
+	0x33, 0xf6, 0x19, 0xf5, 0x0f, 0xef, 0x37, 0x14, 0xce, 0x74, 0xb8, 0x6f, 0x28, 0xbc, 0xfb, 0xb0,
+	0x8b, 0x36, 0xa1, 0xfc, 0xc6, 0x3b, 0xa5, 0x7c, 0x3c, 0x4e, 0x57, 0x3f, 0xbc, 0xdf, 0x28, 0xd1,
+	0xa0, 0xb4, 0x6f, 0x94, 0xde, 0x78, 0xa7, 0x87, 0x5d, 0x1a, 0x09, 0xd9, 0xed, 0xe4, 0xa1, 0xb2,
+	0x31, 0x0a, 0x62, 0xec, 0x16, 0xb3, 0x3e, 0xea, 0x11, 0x19, 0x26, 0xc0, 0x5d, 0x4d, 0x9e, 0xee,
+	0x11, 0x05, 0xeb, 0xc8, 0x91, 0x94, 0xa6, 0x38, 0x92, 0x35, 0x80, 0x5f, 0x0e, 0xf1, 0x10, 0x9b,
+	0xa1, 0xfd, 0x1d, 0x16, 0xb6, 0x51, 0x65, 0x94, 0x63, 0xfb, 0x3b, 0xac, 0xb7, 0xa0, 0x6e, 0xe0,
+	0xd0, 0x1b, 0x06, 0x1d, 0xcc, 0xa2, 0x1a, 0x85, 0xf1, 0xfe, 0x90, 0x1d, 0xbc, 0x68, 0xd0, 0x4f,
+	0x7a, 0xd5, 0x07, 0x78, 0xe0, 0x05, 0x97, 0x91, 0x27, 0xe7, 0x2d, 0xca, 0xd9, 0xf7, 0x87, 0x4c,
+	0x99, 0x92, 0x41, 0x3f, 0xf5, 0xbf, 0x91, 0xa1, 0x76, 0x40, 0x3a, 0x5d, 0x16, 0x97, 0x7b, 0x5e,
+	0xe4, 0x5f, 0x0b, 0x39, 0xfe, 0x15, 0x3d, 0x04, 0xc5, 0xb7, 0x7d, 0xec, 0xd8, 0x6e, 0x64, 0x41,
+	0x1c, 0x04, 0xb4, 0x05, 0xd1, 0x88, 0xbb, 0xd1, 0x53, 0x98, 0xf5, 0x86, 0xc4, 0x1f, 0x12, 0x33,
+	0x01, 0xc8, 0x32, 0x41, 0xbe, 0xce, 0x39, 0x78, 0x8b, 0x86, 0xa5, 0x00, 0x73, 0x44, 0xc6, 0x2f,
+	0x54, 0xd4, 0x64, 0x37, 0xce, 0x22, 0x96, 0x29, 0xac, 0x13, 0x77, 0x99, 0xfc, 0x24, 0x63, 0x96,
+	0x52, 0xdb, 0x11, 0x91, 0xde, 0x38, 0xc6, 0x16, 0x9e, 0xdb, 0xbe, 0x8f, 0xbb, 0x42, 0x6c, 0x35,
+	0x4a, 0x3b, 0xe6, 0x24, 0x2a, 0x57, 0xc6, 0x42, 0x3c, 0x62, 0x39, 0x2c, 0x99, 0x90, 0x8c, 0x2a,
+	0xa5, 0x9c, 0x50, 0x02, 0x05, 0xad, 0xac, 0xbb, 0x67, 0xd9, 0x0e, 0xee, 0x32, 0xd0, 0x2a, 0x19,
+	0x6c, 0xc4, 0x0b, 0x46, 0x19, 0x29, 0xb0, 0x3a, 0x45, 0x81, 0x5b, 0x50, 0x67, 0x1f, 0xd1, 0xe9,
+	0x61, 0xfc, 0xf4, 0x35, 0xc6, 0x20, 0x0e, 0x7f, 0x27, 0x8a, 0x44, 0x35, 0x16, 0x89, 0x66, 0x23,
+	0xb9, 0xa7, 0xe2, 0xd0, 0x28, 0x3c, 0xd7, 0x53, 0xe1, 0x79, 0x11, 0x4a, 0x01, 0x0e, 0x86, 0xae,
+	0x36, 0xcb, 0xb3, 0x23, 0xd6, 0xa0, 0x1a, 0xe0, 0xe7, 0x30, 0x59, 0xb0, 0x0b, 0xb5, 0x46, 0x62,
+	0x0f, 0xdf, 0x9e, 0xbe, 0xc1, 0x1d, 0x62, 0xd4, 0x39, 0x07, 0x0b, 0x79, 0xcc, 0x65, 0x91, 0xc0,
+	0xea, 0x60, 0xd3, 0xb7, 0x02, 0x8a, 0x80, 0xe6, 0xd8, 0x2a, 0x35, 0x46, 0x6b, 0x33, 0x92, 0xfe,
+	0x57, 0xb3, 0x50, 0xb9, 0x8e, 0xa5, 0x3c, 0x86, 0x2a, 0x89, 0xd2, 0xc6, 0x94, 0xb3, 0x89, 0x93,
+	0x49, 0x63, 0xc4, 0x90, 0xb2, 0x2b, 0x69, 0xb2, 0x5d, 0xdd, 0x07, 0xe0, 0xbb, 0x33, 0xe9, 0xda,
+	0xe5, 0xcc, 0xda, 0x55, 0xde, 0x47, 0x73, 0xb2, 0xc4, 0x0d, 0xad, 0x5c, 0xff, 0x86, 0x3e, 0x07,
+	0xa5, 0x67, 0xbb, 0x76, 0x78, 0x26, 0xd4, 0x3f, 0x79, 0x58, 0xcc, 0x3b, 0x6e, 0xee, 0xd5, 0x69,
+	0xe6, 0x1e, 0x6b, 0x1c, 0x26, 0x68, 0xfc, 0x6b, 0x50, 0xfd, 0x11, 0x82, 0x35, 0x59, 0x0e, 0x53,
+	0x67, 0x33, 0x2f, 0x72, 0x01, 0xa5, 0xe1, 0xad, 0x31, 0xe7, 0xa7, 0x09, 0x34, 0xb4, 0x47, 0xa2,
+	0x33, 0x2f, 0x70, 0x10, 0xda, 0x1e, 0xb7, 0x12, 0xd9, 0x98, 0x8b, 0xe8, 0x3f, 0xe7, 0x64, 0x74,
+	0x8f, 0xa6, 0xf3, 0x2c, 0x59, 0x15, 0x96, 0x52, 0x17, 0xe9, 0x3c, 0xa3, 0x19, 0x51, 0x27, 0xc5,
+	0xed, 0x98, 0xe5, 0xc3, 0xda, 0x5c, 0x74, 0x46, 0x3f, 0xdc, 0xe2, 0x29, 0xb2, 0x21, 0xba, 0x68,
+	0x26, 0x2b, 0xe4, 0x21, 0xd2, 0x9e, 0x79, 0x66, 0x4b, 0x42, 0x04, 0xbb, 0x8c, 0x86, 0x1e, 0x41,
+	0x4d, 0x30, 0xb1, 0x44, 0x0e, 0x25, 0x00, 0x93, 0x81, 0x7d, 0xcf, 0x00, 0xde, 0x4b, 0xbf, 0x93,
+	0xde, 0x61, 0x71, 0x9a, 0x77, 0x58, 0xce, 0xf3, 0x0e, 0xe9, 0xab, 0xbf, 0x92, 0xbd, 0xfa, 0xcf,
+	0x61, 0x56, 0x44, 0x90, 0x90, 0x85, 0x14, 0x4d, 0xdb, 0x94, 0xe2, 0x1b, 0x9e, 0x8c, 0x35, 0x46,
+	0xfd, 0x6d, 0xa2, 0x85, 0xbe, 0x82, 0xf9, 0x40, 0xb8, 0x62, 0x33, 0xc0, 0xbf, 0x1c, 0xe2, 0x90,
+	0x84, 0xda, 0x6a, 0xc2, 0x3b, 0x24, 0x1d, 0xb5, 0xa1, 0x46, 0xbc, 0x86, 0x60, 0xa5, 0x20, 0xd5,
+	0xa6, 0xb1, 0x45, 0x6b, 0x26, 0x40, 0xaa, 0x48, 0xcc, 0x58, 0x07, 0xda, 0x02, 0x70, 0xf1, 0xdb,
+	0x48, 0x8e, 0xb7, 0x18, 0xdb, 0x1c, 0x13, 0x12, 0x17, 0x23, 0x03, 0x8d, 0x55, 0x17, 0xbf, 0xe5,
+	0x4d, 0x9a, 0xc6, 0xd8, 0x6e, 0x27, 0xc0, 0x03, 0xec, 0xd2, 0x93, 0x7e, 0xc6, 0x7c, 0x42, 0x92,
+	0x34, 0xe6, 0x9c, 0xd6, 0xa6, 0x38, 0xa7, 0xac, 0x63, 0x5d, 0x1f, 0x77, 0xac, 0xb1, 0x63, 0xdc,
+	0x98, 0xe2, 0x18, 0x6f, 0x43, 0x1d, 0xbb, 0xd6, 0xa9, 0x83, 0x4d, 0xce, 0xbf, 0xc9, 0xb7, 0xc7,
+	0x69, 0x8c, 0x93, 0x25, 0xeb, 0x96, 0x43, 0xb4, 0xdb, 0x22, 0x59, 0xb7, 0x1c, 0x42, 0x5d, 0xdc,
+	0xa9, 0x45, 0x3a, 0x67, 0x9a, 0xce, 0x5d, 0x1c, 0x6b, 0x24, 0x1c, 0xe2, 0x9d, 0x94, 0x43, 0xfc,
+	0x02, 0xe6, 0x62, 0xa5, 0x38, 0xf6, 0xc0, 0x26, 0xa1, 0xf6, 0xf9, 0x55, 0x2a, 0x69, 0x44, 0x9c,
+	0x47, 0x8c, 0x11, 0xfd, 0x00, 0xa0, 0x73, 0x36, 0x74, 0xcf, 0xf9, 0x65, 0xbb, 0x9b, 0xcc, 0x86,
+	0x29, 0x99, 0x8d, 0xa9, 0x76, 0xa2, 0x4f, 0x86, 0x63, 0xa9, 0xf7, 0x64, 0x20, 0xc9, 0x1b, 0x12,
+	0xed, 0xde, 0x74, 0x1c, 0x4b, 0xf9, 0x4f, 0x38, 0x3b, 0x45, 0xa2, 0x14, 0x8e, 0x44, 0xa3, 0xef,
+	0x4f, 0x1b, 0x0d, 0x6f, 0xbc, 0xd3, 0x68, 0x6c, 0x26, 0x5c, 0x3d, 0x18, 0x0b, 0x57, 0x71, 0x60,
+	0x78, 0x98, 0x0c, 0x0c, 0x7c, 0x18, 0xdd, 0x72, 0x60, 0xe3, 0x50, 0x7b, 0x14, 0x0f, 0x1b, 0x0e,
+	0x4e, 0x28, 0x65, 0x74, 0xa6, 0x53, 0xab, 0x73, 0xee, 0xf5, 0x7a, 0xda, 0xaf, 0x5d, 0xef, 0x4c,
+	0xbb, 0x9c, 0x9d, 0xd6, 0xe5, 0xa8, 0xa9, 0x98, 0xe9, 0xf0, 0xf3, 0x98, 0xed, 0x41, 0xa5, 0x3d,
+	0x2f, 0x92, 0x51, 0xa7, 0x09, 0x8a, 0x1f, 0xd8, 0x5e, 0x60, 0x93, 0x4b, 0xed, 0x07, 0x1c, 0x05,
+	0x47, 0x6d, 0x7a, 0xb7, 0x39, 0x0e, 0xf2, 0xbd, 0xd0, 0x66, 0x29, 0xf0, 0x16, 0xbf, 0xdb, 0x8c,
+	0xda, 0x16, 0xc4, 0x96, 0xac, 0xc8, 0x6a, 0xa9, 0x25, 0x2b, 0x25, 0xb5, 0xac, 0xef, 0x43, 0x99,
+	0x5f, 0xd7, 0xdc, 0x12, 0xcd, 0xbd, 0x74, 0xc6, 0xa7, 0x66, 0xae, 0x77, 0xe4, 0x78, 0xf5, 0x67,
+	0xa2, 0x50, 0xd1, 0xf3, 0x42, 0x74, 0x1f, 0x14, 0x86, 0x18, 0xdd, 0x9e, 0xa7, 0x15, 0x36, 0xa5,
+	0xd8, 0x33, 0x0a, 0x06, 0xa3, 0xf2, 0x86, 0x7f, 0xe8, 0xeb, 0xa0, 0x44, 0x11, 0x2b, 0x6f, 0x71,
+	0xfd, 0xaf, 0x0b, 0x30, 0x1b, 0x31, 0xf0, 0x1a, 0xc8, 0x9a, 0xa8, 0x61, 0x15, 0xb2, 0xae, 0x2f,
+	0x5b, 0x9c, 0x2b, 0xa6, 0xaa, 0x46, 0x51, 0x55, 0x44, 0xca, 0xa9, 0x8a, 0xc8, 0x39, 0x55, 0x91,
+	0x52, 0x42, 0x02, 0x1b, 0x20, 0xf7, 0x02, 0x6f, 0xa0, 0x95, 0xc7, 0x2f, 0x3d, 0xeb, 0xd0, 0xff,
+	0xb9, 0x00, 0xf3, 0x02, 0x10, 0xfe, 0x8c, 0x4a, 0x99, 0x57, 0x4c, 0xbf, 0x27, 0x58, 0x98, 0x54,
+	0xb6, 0x94, 0x51, 0xf6, 0x36, 0x94, 0x99, 0x5a, 0xaf, 0x03, 0xa9, 0x05, 0x27, 0x9d, 0xcf, 0xea,
+	0x0e, 0x6c, 0x42, 0x04, 0x28, 0x54, 0x8c, 0xb8, 0xad, 0xff, 0x77, 0x11, 0x54, 0x7a, 0x90, 0x91,
+	0xc8, 0x7b, 0x1e, 0x7a, 0x10, 0x19, 0x40, 0x81, 0x19, 0x00, 0x4a, 0x6d, 0x34, 0x15, 0x7b, 0x1f,
+	0x43, 0x8d, 0xba, 0x80, 0xc8, 0x49, 0x16, 0xc7, 0xe5, 0x05, 0xb4, 0x9f, 0x7f, 0xa3, 0x3d, 0xa0,
+	0x37, 0xd3, 0x64, 0xc9, 0x77, 0x28, 0x52, 0x87, 0xcf, 0x79, 0x64, 0xcc, 0x6c, 0x81, 0xca, 0x6d,
+	0x8f, 0xb1, 0xf1, 0x5a, 0x7a, 0xf5, 0x4d, 0xd4, 0x4e, 0xf8, 0x33, 0x39, 0xe5, 0xcf, 0xd6, 0x00,
+	0xac, 0x21, 0x39, 0x33, 0x89, 0x77, 0x8e, 0x5d, 0xa1, 0xcd, 0x2a, 0xa5, 0x9c, 0x50, 0x02, 0x3a,
+	0x00, 0x94, 0xa8, 0x38, 0x45, 0x01, 0x8c, 0x2b, 0x78, 0x39, 0x5b, 0x9d, 0x12, 0x51, 0x6c, 0xde,
+	0xca, 0x92, 0x9a, 0x5f, 0x42, 0x23, 0xbd, 0xb5, 0x64, 0x99, 0xbc, 0x94, 0x53, 0x26, 0x2f, 0x25,
+	0xcb, 0xe4, 0x7f, 0x5f, 0x87, 0x7a, 0x4a, 0xd2, 0x49, 0xab, 0x28, 0x4c, 0xb6, 0x8a, 0x9b, 0xa1,
+	0xc5, 0xdf, 0x04, 0xe8, 0x04, 0x98, 0x96, 0x98, 0x4c, 0x8b, 0x68, 0xe5, 0xa9, 0xb6, 0x52, 0x15,
+	0xdc, 0x3b, 0x64, 0xa4, 0xfd, 0xca, 0x34, 0xed, 0xdf, 0x86, 0x7a, 0x80, 0x69, 0xf5, 0xc2, 0xc4,
+	0x41, 0xe0, 0x05, 0x0c, 0x0c, 0x56, 0x8d, 0x1a, 0xa7, 0x1d, 0x50, 0x12, 0xfa, 0x3a, 0xa5, 0xf2,
+	0x2a, 0x53, 0xf9, 0x66, 0x6a, 0xc6, 0x29, 0xea, 0xce, 0x43, 0x77, 0x70, 0x13, 0x74, 0xa7, 0x41,
+	0x25, 0x02, 0x75, 0x35, 0x0e, 0x8a, 0x44, 0xf3, 0x23, 0x41, 0x9a, 0x9a, 0x03, 0xd2, 0x78, 0x1d,
+	0x6e, 0x7e, 0xac, 0x0e, 0xf7, 0x0d, 0x2c, 0x52, 0xf3, 0xc1, 0x26, 0xcd, 0xe6, 0x4d, 0x72, 0x16,
+	0xe0, 0xf0, 0xcc, 0x73, 0xba, 0x1a, 0x9a, 0x16, 0x2b, 0x10, 0x1b, 0xb6, 0xef, 0xbd, 0x75, 0x4f,
+	0xa2, 0x41, 0xf9, 0x28, 0x6a, 0xe1, 0x23, 0x50, 0xd4, 0xe2, 0x55, 0x28, 0x6a, 0x13, 0x6a, 0x5d,
+	0x1c, 0x76, 0x02, 0xdb, 0x67, 0x61, 0x64, 0x89, 0xab, 0x33, 0x41, 0xca, 0xe2, 0xa6, 0xe5, 0x71,
+	0xdc, 0xb4, 0x06, 0xd0, 0xb1, 0x3a, 0x67, 0x22, 0x2b, 0x5f, 0xe1, 0xd7, 0x90, 0x51, 0x68, 0x56,
+	0x3e, 0x06, 0x6d, 0xb4, 0xab, 0xa1, 0xcd, 0x6a, 0x1e, 0xb4, 0xb9, 0x95, 0x0f, 0x6d, 0x3e, 0x4b,
+	0xb9, 0x82, 0xcf, 0xa1, 0x31, 0xb0, 0xde, 0x99, 0x89, 0xea, 0xc0, 0x1a, 0x73, 0xa3, 0xf5, 0x81,
+	0xf5, 0xee, 0x67, 0x51, 0x81, 0x20, 0x89, 0xe5, 0xd7, 0x27, 0x61, 0xf9, 0x1c, 0xa0, 0xb4, 0xf1,
+	0x71, 0x40, 0x69, 0xf3, 0xc6, 0x40, 0xe9, 0xf6, 0x27, 0x01, 0x25, 0xfd, 0x26, 0x40, 0xe9, 0x09,
+	0xd4, 0xfa, 0x36, 0x39, 0xf3, 0xbc, 0x73, 0x93, 0x3e, 0xd0, 0x30, 0xb0, 0xb8, 0xdb, 0xf8, 0xf0,
+	0x7e, 0x03, 0x5e, 0x72, 0x32, 0x7d, 0xa7, 0x01, 0xc1, 0xf2, 0x3a, 0x70, 0xb2, 0xbe, 0xff, 0xf3,
+	0xc9, 0xbe, 0x3f, 0x03, 0xa8, 0xee, 0x4e, 0x07, 0x54, 0xf7, 0xbe, 0x0f, 0x40, 0x75, 0xff, 0x1a,
+	0x80, 0xea, 0x41, 0x26, 0xc6, 0xe6, 0x87, 0x8a, 0x87, 0xff, 0xaf, 0xa1, 0xa2, 0x25, 0x2b, 0x92,
+	0x2a, 0xc7, 0xa0, 0xad, 0xa9, 0xde, 0xd2, 0x5f, 0x26, 0x81, 0x11, 0xc5, 0x5c, 0xcf, 0x61, 0x36,
+	0xce, 0x5b, 0x13, 0xc0, 0x6b, 0x7e, 0xcc, 0xbd, 0x1a, 0x75, 0x3f, 0xd1, 0xd2, 0xff, 0xa7, 0x00,
+	0xea, 0x1e, 0x73, 0xf7, 0x14, 0x9d, 0x70, 0xf7, 0xf0, 0x49, 0x65, 0xab, 0xd5, 0x29, 0x79, 0x7c,
+	0xe6, 0x30, 0x05, 0xb5, 0xd8, 0x92, 0x15, 0x50, 0x6b, 0xfc, 0x19, 0xb3, 0x25, 0x2b, 0x55, 0x15,
+	0x5a, 0xb2, 0xa2, 0xa8, 0xd5, 0x96, 0xac, 0xd4, 0xd5, 0xd9, 0x96, 0xac, 0xd4, 0xd4, 0x7a, 0x4b,
+	0x56, 0x66, 0xd5, 0x46, 0x4b, 0x56, 0x1a, 0xea, 0x5c, 0x4b, 0x56, 0x96, 0xd4, 0xe5, 0x96, 0xac,
+	0xcc, 0xa9, 0x6a, 0x4b, 0x56, 0x54, 0x75, 0xbe, 0x25, 0x2b, 0xf3, 0x2a, 0x6a, 0xc9, 0x0a, 0x52,
+	0x17, 0x5a, 0xb2, 0xb2, 0xa0, 0x2e, 0xb6, 0x64, 0x65, 0x51, 0x5d, 0x6a, 0xc9, 0xca, 0xb2, 0xba,
+	0xd2, 0x92, 0x95, 0x15, 0x55, 0x6b, 0xc9, 0x8a, 0xa6, 0xae, 0xea, 0x6d, 0x98, 0x3f, 0x74, 0xa9,
+	0x29, 0x92, 0xc4, 0x79, 0x27, 0x61, 0xb5, 0x0d, 0xa8, 0x9d, 0x3a, 0x5e, 0xe7, 0xdc, 0x1c, 0xc1,
+	0x60, 0xc5, 0x00, 0x46, 0x62, 0xf1, 0x4f, 0xff, 0xcb, 0x02, 0x34, 0x8e, 0xec, 0x90, 0x5c, 0x21,
+	0xbf, 0x29, 0x91, 0x7c, 0x0b, 0xea, 0xb6, 0x9b, 0x10, 0x5f, 0x71, 0x53, 0xca, 0x8a, 0xaf, 0xc6,
+	0x18, 0x78, 0xe3, 0xe6, 0x65, 0x42, 0xfd, 0x0d, 0xcc, 0xbd, 0x70, 0x86, 0xe1, 0x59, 0x62, 0x7f,
+	0x77, 0xa1, 0xc2, 0x47, 0x87, 0xc2, 0x4c, 0x52, 0xc3, 0xa3, 0x3e, 0xf4, 0x14, 0xea, 0xc4, 0x33,
+	0xa3, 0xad, 0x46, 0x8f, 0xa5, 0x99, 0xa3, 0xd4, 0x88, 0x17, 0x7d, 0x87, 0xfa, 0x16, 0xa8, 0xfb,
+	0xd8, 0xc1, 0x04, 0x5f, 0x4f, 0xb8, 0xfa, 0x63, 0x68, 0x1c, 0x13, 0xcf, 0xbf, 0x26, 0xf7, 0x7f,
+	0x15, 0xa0, 0xf1, 0x12, 0x93, 0x23, 0xaf, 0x1f, 0x5e, 0x47, 0x73, 0x37, 0xb0, 0xe2, 0x28, 0x61,
+	0xef, 0xd9, 0x0e, 0xc1, 0x01, 0x87, 0xa3, 0x55, 0x9e, 0xb0, 0xbf, 0xe0, 0x24, 0x56, 0x20, 0xb6,
+	0x42, 0x82, 0x03, 0x01, 0x9b, 0x45, 0x6b, 0xf4, 0x68, 0x56, 0xbe, 0xea, 0xd1, 0x6c, 0x19, 0xca,
+	0x3d, 0xcf, 0x71, 0xbc, 0xb7, 0xe2, 0xcf, 0x18, 0xa2, 0x45, 0x63, 0x1b, 0xb1, 0x6c, 0x47, 0x54,
+	0x4d, 0xd9, 0x37, 0xbf, 0x16, 0xfa, 0x3f, 0x14, 0x01, 0x8e, 0xbc, 0xfe, 0xef, 0xe0, 0x30, 0xa4,
+	0x7f, 0x3a, 0xb9, 0x93, 0xb8, 0xdb, 0x89, 0x1c, 0x29, 0xbe, 0xc8, 0xaf, 0x68, 0x9a, 0x32, 0x2a,
+	0xd3, 0x4b, 0x53, 0xca, 0xf4, 0xf2, 0x84, 0x32, 0xfd, 0x23, 0x28, 0xc6, 0xd5, 0xf6, 0x49, 0x10,
+	0xb1, 0x48, 0xd8, 0xb3, 0xe8, 0x80, 0xef, 0x90, 0x9d, 0xbd, 0x6a, 0x44, 0xcd, 0xf4, 0xeb, 0x42,
+	0x65, 0xe2, 0xeb, 0x02, 0x02, 0x79, 0x18, 0xe2, 0x40, 0xfc, 0xdf, 0x81, 0x7d, 0xa3, 0x7b, 0xa0,
+	0x70, 0xef, 0x6f, 0x77, 0xf9, 0x5f, 0x1d, 0x76, 0x6b, 0x1f, 0xde, 0x6f, 0x54, 0xf8, 0x43, 0xe5,
+	0xbe, 0x51, 0x61, 0x9d, 0x87, 0xdd, 0x84, 0x4a, 0x20, 0xa9, 0x12, 0xfd, 0x04, 0x16, 0x0c, 0x5e,
+	0xeb, 0xe2, 0x7a, 0xb8, 0x86, 0xad, 0x64, 0x0d, 0xa0, 0x38, 0x66, 0x00, 0xfa, 0x6f, 0xc0, 0x82,
+	0xf0, 0x1c, 0xa9, 0x59, 0xa7, 0x3e, 0x9a, 0xea, 0x6f, 0x41, 0xa5, 0xfe, 0xe1, 0xda, 0x7b, 0xb9,
+	0x05, 0x55, 0xdf, 0xea, 0x0b, 0xb0, 0x52, 0x14, 0xf1, 0xc8, 0xea, 0x73, 0xa0, 0xc2, 0x9e, 0x85,
+	0xfb, 0x58, 0xe4, 0x82, 0xec, 0x9b, 0x19, 0x18, 0xaf, 0x68, 0xc8, 0xc2, 0xc0, 0x58, 0x4b, 0xbf,
+	0x84, 0xf9, 0xc4, 0xc2, 0xa1, 0xef, 0xb9, 0x21, 0x7b, 0xa5, 0x12, 0xc2, 0xa5, 0x71, 0x43, 0x2b,
+	0x24, 0x8c, 0x21, 0x7e, 0x09, 0x16, 0xb1, 0x98, 0x47, 0x96, 0x0d, 0xa8, 0xb1, 0x12, 0xa0, 0x49,
+	0xd7, 0x0a, 0xc5, 0x86, 0x80, 0x91, 0xda, 0x94, 0x92, 0xb7, 0x25, 0xfd, 0x0f, 0x60, 0x25, 0x5e,
+	0xfa, 0x98, 0x04, 0xd8, 0x1a, 0x6d, 0xe0, 0x07, 0x00, 0xa3, 0x0d, 0xa4, 0xde, 0xe2, 0x46, 0xeb,
+	0x57, 0xe3, 0xf5, 0x3f, 0x6e, 0xf9, 0x5d, 0xa8, 0xc6, 0x98, 0x8a, 0x8a, 0xc7, 0x1d, 0x0e, 0x4e,
+	0x71, 0x20, 0x1e, 0x7c, 0x45, 0x8b, 0xa2, 0x53, 0x2a, 0x62, 0xf1, 0x8a, 0xc6, 0x27, 0xae, 0x52,
+	0x0a, 0x7f, 0x33, 0xfb, 0xc7, 0x2a, 0x2c, 0xf1, 0xc8, 0x18, 0x3b, 0x8c, 0x9b, 0xbb, 0xf7, 0x9b,
+	0x25, 0x6a, 0xcb, 0x50, 0xe6, 0xff, 0x05, 0x88, 0x7c, 0x0c, 0x6f, 0xe5, 0xe6, 0x3d, 0x95, 0x9b,
+	0xe4, 0x3d, 0xa3, 0xec, 0xa6, 0x7a, 0x83, 0xec, 0x06, 0x72, 0xb2, 0x9b, 0xab, 0xb2, 0x98, 0xda,
+	0xf7, 0x96, 0xc5, 0xd4, 0x3f, 0x22, 0x8b, 0x99, 0xbd, 0x66, 0x16, 0xd3, 0x98, 0x9a, 0xc5, 0xcc,
+	0x4d, 0xcb, 0x62, 0xd4, 0x69, 0x59, 0xcc, 0xfc, 0x78, 0x16, 0xf3, 0x19, 0x54, 0x03, 0x2c, 0xca,
+	0xed, 0x2c, 0xdf, 0x53, 0x8c, 0x11, 0x61, 0x94, 0xcf, 0x2c, 0x24, 0xf3, 0x99, 0xf1, 0xbc, 0x65,
+	0x71, 0x72, 0xde, 0xb2, 0x74, 0xc3, 0xbc, 0x65, 0xf9, 0xe3, 0xf2, 0x96, 0x95, 0x1b, 0xe7, 0x2d,
+	0xda, 0x27, 0xe5, 0x2d, 0xab, 0x37, 0xc9, 0x5b, 0xa2, 0x74, 0xb1, 0x99, 0x48, 0x17, 0x33, 0xc9,
+	0xc6, 0xad, 0xe9, 0xc9, 0xc6, 0x67, 0xdf, 0x47, 0xb2, 0xb1, 0x76, 0x8d, 0x64, 0x63, 0x3d, 0x93,
+	0x6c, 0xac, 0x40, 0xa5, 0x1b, 0x5c, 0x9a, 0xb4, 0x00, 0xbd, 0xc1, 0x1d, 0x40, 0x37, 0xb8, 0x34,
+	0x86, 0x2e, 0x7a, 0x08, 0xf3, 0xa2, 0xc3, 0xa4, 0x4f, 0x1b, 0x01, 0xf6, 0x3d, 0xfa, 0x12, 0x40,
+	0x63, 0x54, 0x83, 0xb3, 0xbc, 0xc2, 0x6f, 0x0d, 0x4a, 0x4d, 0xc2, 0x6b, 0x7d, 0x0f, 0x96, 0x45,
+	0xc8, 0xfa, 0x78, 0x17, 0xa6, 0x2f, 0xc1, 0x02, 0x75, 0xe5, 0x99, 0x19, 0xf4, 0xdf, 0x85, 0x25,
+	0x0e, 0xf5, 0x3e, 0xc1, 0x3b, 0xaa, 0x20, 0x59, 0x8e, 0x23, 0xa2, 0x16, 0xfd, 0x6c, 0xc9, 0x4a,
+	0x51, 0x95, 0xf8, 0x19, 0xf4, 0x1d, 0x58, 0x3c, 0xa6, 0x41, 0xfc, 0x13, 0xf6, 0xfe, 0x13, 0x58,
+	0xa0, 0xf8, 0xf2, 0x13, 0x66, 0xf8, 0x93, 0x02, 0x2c, 0x1a, 0xf4, 0x15, 0xe0, 0x13, 0x8e, 0x79,
+	0x17, 0x2a, 0xf8, 0x5d, 0xc7, 0x19, 0x76, 0x71, 0x1e, 0xbc, 0x8f, 0xfa, 0x28, 0x9b, 0xed, 0x72,
+	0x36, 0x29, 0x87, 0x4d, 0xf4, 0xe9, 0x2f, 0xa1, 0x99, 0xd4, 0xc7, 0x4f, 0xed, 0x90, 0x78, 0xc1,
+	0xe5, 0x47, 0x1c, 0xed, 0x57, 0xf4, 0xef, 0x7e, 0x99, 0x37, 0xcd, 0x44, 0x81, 0xac, 0x90, 0x2e,
+	0x90, 0xa5, 0x8b, 0x88, 0xc5, 0x9b, 0x14, 0x11, 0x97, 0xa1, 0x4c, 0x6b, 0xaf, 0x5e, 0xfc, 0x17,
+	0x6c, 0xde, 0xca, 0x16, 0x0d, 0xe4, 0xc9, 0x45, 0x83, 0xb1, 0x0c, 0x37, 0xf5, 0x9f, 0x90, 0xab,
+	0x33, 0xdc, 0x3d, 0x98, 0xcb, 0xc8, 0x0a, 0x3d, 0x05, 0x45, 0x1c, 0x2b, 0x02, 0x40, 0x8b, 0xa9,
+	0x59, 0x84, 0x34, 0x8c, 0x98, 0x4b, 0xff, 0x7d, 0x58, 0x31, 0x3c, 0xc7, 0xa1, 0x1e, 0xe2, 0x13,
+	0x0c, 0x21, 0x21, 0xdd, 0x62, 0x5a, 0xba, 0xa9, 0x08, 0x21, 0x65, 0x22, 0x84, 0xbe, 0x02, 0x4b,
+	0x2f, 0xad, 0xe0, 0xd4, 0xea, 0xe3, 0x3d, 0xcf, 0x71, 0x70, 0x87, 0x44, 0x97, 0x50, 0x83, 0xe5,
+	0x6c, 0x07, 0x47, 0x59, 0xf4, 0xd6, 0xee, 0x74, 0x88, 0x7d, 0x61, 0x11, 0xbc, 0x33, 0x24, 0x67,
+	0xd1, 0x80, 0x65, 0x58, 0x4c, 0x93, 0x39, 0xfb, 0xa3, 0x3f, 0x64, 0xcf, 0x37, 0xfc, 0xcf, 0x76,
+	0x2a, 0xd4, 0x5b, 0xdf, 0xee, 0x9a, 0xc7, 0x27, 0x3b, 0xc6, 0xc9, 0xe1, 0xab, 0x97, 0xea, 0x0c,
+	0x9a, 0x83, 0x1a, 0xa5, 0x18, 0xaf, 0x5f, 0xbd, 0xa2, 0x84, 0x42, 0x44, 0x78, 0xb1, 0x73, 0x78,
+	0xf4, 0xda, 0x38, 0x50, 0x8b, 0x11, 0xe1, 0xf8, 0xf5, 0xde, 0xde, 0xc1, 0xf1, 0xb1, 0x2a, 0xa1,
+	0x06, 0x00, 0x25, 0x7c, 0x73, 0x78, 0x74, 0x74, 0xb0, 0xaf, 0xca, 0x68, 0x0d, 0x56, 0x13, 0x0c,
+	0xe6, 0x2f, 0x0e, 0x4f, 0x7e, 0x1a, 0x0d, 0x3f, 0x56, 0x4b, 0x8f, 0x7e, 0x02, 0x30, 0xfa, 0x1b,
+	0x21, 0x02, 0x28, 0xd3, 0xbe, 0x83, 0x7d, 0x75, 0x06, 0xd5, 0xa0, 0x12, 0xcd, 0x5a, 0x60, 0x8d,
+	0x6f, 0x0e, 0xdb, 0xed, 0x83, 0x7d, 0xb5, 0x88, 0xea, 0xa0, 0xc4, 0x7b, 0x94, 0x1e, 0x7d, 0x0d,
+	0xb5, 0xc4, 0xb3, 0x14, 0xdd, 0x50, 0xfb, 0xdb, 0xfd, 0x78, 0xcb, 0x33, 0x11, 0x61, 0x34, 0x57,
+	0x03, 0x80, 0x12, 0xc4, 0x42, 0xc5, 0x47, 0x7f, 0x94, 0x78, 0x6c, 0xe2, 0x73, 0x2c, 0xc1, 0x7c,
+	0xfb, 0xb0, 0x7d, 0x70, 0x74, 0xf8, 0xea, 0x20, 0x29, 0x8d, 0x45, 0x50, 0x63, 0xf2, 0x48, 0x24,
+	0x2b, 0xb0, 0x30, 0xa2, 0x1e, 0xc4, 0xec, 0xc5, 0x14, 0x7b, 0x24, 0x30, 0x09, 0x2d, 0xc0, 0x5c,
+	0x4c, 0x6d, 0xef, 0xbc, 0x3e, 0xa6, 0x42, 0xda, 0xfe, 0xb3, 0x3a, 0x48, 0x3b, 0xed, 0x43, 0xb4,
+	0x45, 0xff, 0xca, 0x2d, 0x6a, 0x32, 0x68, 0x49, 0xfc, 0xb3, 0x38, 0x5d, 0xa3, 0x69, 0xc6, 0x49,
+	0x83, 0x3e, 0x83, 0x7e, 0x04, 0x30, 0x2a, 0x6a, 0xa0, 0x65, 0x01, 0x83, 0x32, 0x55, 0x8e, 0x66,
+	0xea, 0x11, 0x4e, 0x9f, 0x41, 0x4f, 0xa0, 0x22, 0xea, 0x16, 0x68, 0x81, 0x75, 0xa5, 0xab, 0x18,
+	0xcd, 0xd9, 0x24, 0x7f, 0xa8, 0xcf, 0xd0, 0x1b, 0x28, 0x58, 0x38, 0xa4, 0xcf, 0x1f, 0x96, 0x59,
+	0xe6, 0x69, 0x01, 0x6d, 0x83, 0x12, 0x55, 0x20, 0x10, 0xbf, 0x68, 0x99, 0x82, 0x44, 0xce, 0x98,
+	0x2f, 0xa1, 0x1a, 0x57, 0x12, 0x84, 0x08, 0xb2, 0x95, 0x85, 0xe6, 0xf2, 0x98, 0xfb, 0x39, 0xa0,
+	0x7f, 0x97, 0xd7, 0x67, 0xd0, 0x8f, 0xa1, 0x22, 0xea, 0x0a, 0x62, 0x8f, 0xe9, 0x2a, 0xc3, 0x84,
+	0x91, 0x5f, 0x40, 0x3d, 0x99, 0xe5, 0x21, 0x2d, 0x29, 0xcc, 0x64, 0x0a, 0xd7, 0xcc, 0xe4, 0x2c,
+	0xfa, 0x0c, 0xdd, 0x73, 0x9c, 0xf4, 0x88, 0x3d, 0x67, 0x13, 0xbf, 0xe6, 0x72, 0x96, 0x2c, 0xee,
+	0xeb, 0x0c, 0x6a, 0xc1, 0x5c, 0x26, 0x65, 0xba, 0x6a, 0x8e, 0xcf, 0xd2, 0xe4, 0x74, 0x7e, 0xc5,
+	0xa4, 0xb7, 0xcb, 0xfe, 0xef, 0x16, 0x67, 0xc0, 0xe2, 0x14, 0x39, 0x49, 0xf1, 0x04, 0x49, 0xbc,
+	0x80, 0x46, 0x3a, 0xfd, 0x41, 0xcd, 0x84, 0x25, 0x66, 0xbc, 0xe0, 0x84, 0x79, 0xf6, 0x60, 0x2e,
+	0x03, 0x42, 0xd0, 0xad, 0xa4, 0x50, 0xb3, 0x33, 0x8d, 0x3b, 0x74, 0x7d, 0x06, 0x7d, 0x05, 0xf5,
+	0x64, 0xd0, 0x13, 0x07, 0xca, 0xc1, 0x25, 0x4d, 0x34, 0x36, 0x3c, 0xe4, 0x87, 0x49, 0xa3, 0x15,
+	0x71, 0x98, 0x5c, 0x08, 0x33, 0xe1, 0x30, 0xfb, 0x30, 0x9b, 0xc2, 0x24, 0x68, 0x55, 0x98, 0xd7,
+	0x38, 0x4e, 0x99, 0x30, 0xcb, 0x2e, 0xd4, 0x93, 0xb0, 0x44, 0x9c, 0x26, 0x07, 0xa9, 0x4c, 0xde,
+	0x49, 0x0a, 0x97, 0x88, 0x9d, 0xe4, 0x61, 0x95, 0x09, 0xb3, 0xbc, 0x4a, 0x83, 0xbb, 0x28, 0x40,
+	0x6e, 0x8c, 0x89, 0x37, 0x0d, 0x33, 0x9a, 0xe9, 0x78, 0x29, 0x3a, 0x99, 0x11, 0xab, 0xd9, 0x38,
+	0x89, 0xb8, 0xb9, 0x5e, 0x11, 0x3e, 0x27, 0xec, 0xed, 0xb7, 0x23, 0x17, 0xb0, 0xe3, 0x38, 0xe8,
+	0x0a, 0xb6, 0x09, 0xc3, 0x9f, 0x41, 0x45, 0x14, 0x0b, 0x85, 0x0f, 0x48, 0x97, 0x0e, 0x9b, 0xfc,
+	0xcf, 0xeb, 0xa3, 0x32, 0x1b, 0xbb, 0x38, 0xdf, 0x40, 0x23, 0x1d, 0x50, 0x85, 0x9d, 0xe4, 0x86,
+	0xdf, 0xe6, 0xad, 0xdc, 0xbe, 0xf8, 0x46, 0x1f, 0x40, 0x3d, 0x19, 0x6c, 0x85, 0x9a, 0x73, 0xc2,
+	0x72, 0x73, 0x35, 0xa7, 0x27, 0x9a, 0x66, 0x57, 0xfd, 0x97, 0x0f, 0xeb, 0x85, 0x7f, 0xfb, 0xb0,
+	0x5e, 0xf8, 0x8f, 0x0f, 0xeb, 0x85, 0x3f, 0xff, 0xcf, 0xf5, 0x99, 0xd3, 0x32, 0x3b, 0xec, 0xb3,
+	0xff, 0x1b, 0x00, 0xe4, 0x0a, 0xc6, 0xae, 0xbf, 0x37, 0x00, 0x00,
 }
@@ -21,6 +21,10 @@ func VisitInput(input *Input, f func(*Input)) {
 		for _, input := range input.Union {
 			VisitInput(input, f)
 		}
+	case input.Join != nil:
+		for _, input := range input.Join {
+			VisitInput(input, f)
+		}
 	}
 	f(input)
 }
@@ -38,6 +42,10 @@ func InputName(input *Input) string {
 		if len(input.Union) > 0 {
 			return InputName(input.Union[0])
 		}
+	case input.Join != nil:
+		if len(input.Join) > 0 {
+			return InputName(input.Join[0])
+		}
 	}
 	return ""
 }
@@ -53,6 +61,8 @@ func SortInput(input *Input) {
 			SortInputs(input.Cross)
 		case input.Union != nil:
 			SortInputs(input.Union)
+		case input.Join != nil:
+			SortInputs(input.Join)
 		}
 	})
 }
@@ -1,12 +1,17 @@
 package cmds
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"os"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/golang/snappy"
 	"github.com/spf13/cobra"
 )
@@ -21,34 +26,98 @@ func Cmds(noMetrics *bool) []*cobra.Command {
 	metrics := !*noMetrics
 
 	var noObjects bool
+	var noRepos bool
+	var noPipelines bool
+	var noAuth bool
+	var includeEnterprise bool
+	var repos cmdutil.RepeatedStringArg
+	var pipelines cmdutil.RepeatedStringArg
+	var since string
+	var markerFile string
 	var url string
 	extract := &cobra.Command{
 		Use:   "extract",
 		Short: "Extract Pachyderm state to stdout or an object store bucket.",
 		Long: `Extract Pachyderm state to stdout or an object store bucket.
+
+Extracts can be limited to some repos and pipelines, and can be incremental:
+an extract with --since only includes what was created after the extract
+that wrote the marker file passed to --since. Restoring a full extract and
+then each of its incremental extracts, in order, restores the cluster.
 ` + codestart + `# Extract into a local file:
 pachctl extract >backup
 
 # Extract to s3:
-pachctl extract -u s3://bucket/backup` + codeend,
+pachctl extract -u s3://bucket/backup
+
+# Extract only the repo "images" and the pipeline "edges":
+pachctl extract -r images -p edges >backup
+
+# Take a full backup, then a nightly incremental one:
+pachctl extract --marker marker.json >backup-0
+pachctl extract --since marker.json --marker marker.json >backup-1` + codeend,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
 			c, err := client.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return err
 			}
-			if url != "" {
-				return c.ExtractURL(url)
+			request := &admin.ExtractRequest{
+				URL:         url,
+				NoObjects:   noObjects,
+				NoRepos:     noRepos,
+				NoPipelines: noPipelines,
+				NoAuth:      noAuth,
+				Enterprise:  includeEnterprise,
+				Repos:       repos,
+				Pipelines:   pipelines,
+				Marker:      markerFile != "",
+			}
+			if since != "" {
+				request.Since = &admin.BackupMarker{}
+				if err := readMarker(since, request.Since); err != nil {
+					return err
+				}
+			}
+			var marker *admin.BackupMarker
+			var writer pbutil.Writer
+			if url == "" {
+				w := snappy.NewBufferedWriter(os.Stdout)
+				defer func() {
+					if err := w.Close(); err != nil && retErr == nil {
+						retErr = err
+					}
+				}()
+				writer = pbutil.NewWriter(w)
+			}
+			if err := c.ExtractWithRequest(request, func(op *admin.Op) error {
+				if op.Op1_7 != nil && op.Op1_7.Marker != nil {
+					marker = op.Op1_7.Marker
+				}
+				if writer == nil {
+					return nil
+				}
+				return writer.Write(op)
+			}); err != nil {
+				return err
 			}
-			w := snappy.NewBufferedWriter(os.Stdout)
-			defer func() {
-				if err := w.Close(); err != nil && retErr == nil {
-					retErr = err
+			if markerFile != "" {
+				if marker == nil {
+					return fmt.Errorf("extract didn't return a backup marker")
 				}
-			}()
-			return c.ExtractWriter(!noObjects, w)
+				return writeMarker(markerFile, marker)
+			}
+			return nil
 		}),
 	}
 	extract.Flags().BoolVar(&noObjects, "no-objects", false, "don't extract from object storage, only extract data from etcd")
+	extract.Flags().BoolVar(&noRepos, "no-repos", false, "don't extract repos, commits or branches")
+	extract.Flags().BoolVar(&noPipelines, "no-pipelines", false, "don't extract pipelines")
+	extract.Flags().BoolVar(&noAuth, "no-auth", false, "don't extract admins, groups or ACLs")
+	extract.Flags().BoolVar(&includeEnterprise, "enterprise", false, "also extract the enterprise activation code (which is a secret, so keep the extract safe)")
+	extract.Flags().VarP(&repos, "repo", "r", "Extract only this repo (and pipelines passed to --pipeline); may be repeated.")
+	extract.Flags().VarP(&pipelines, "pipeline", "p", "Extract only this pipeline (and repos passed to --repo); may be repeated.")
+	extract.Flags().StringVar(&since, "since", "", "Extract only what was created since the extract that wrote this marker file.")
+	extract.Flags().StringVar(&markerFile, "marker", "", "Write this extract's backup marker to this file, for use with --since.")
 	extract.Flags().StringVarP(&url, "url", "u", "", "An object storage url (i.e. s3://...) to extract to.")
 	restore := &cobra.Command{
 		Use:   "restore",
@@ -90,3 +159,22 @@ pachctl restore -u s3://bucket/backup` + codeend,
 	}
 	return []*cobra.Command{extract, restore, inspectCluster}
 }
+
+func readMarker(path string, marker *admin.BackupMarker) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(data), marker); err != nil {
+		return fmt.Errorf("error parsing backup marker %s: %v", path, err)
+	}
+	return nil
+}
+
+func writeMarker(path string, marker *admin.BackupMarker) error {
+	data, err := (&jsonpb.Marshaler{Indent: "  "}).MarshalToString(marker)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, []byte(data+"\n"), 0644)
+}
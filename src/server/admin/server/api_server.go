@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,11 +15,14 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/admin"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/pbutil"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 )
@@ -39,57 +44,122 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
 	pachClient := a.getPachClient()
 	pachClient = pachClient.WithCtx(extractServer.Context())
-	handleOp := extractServer.Send
-	if request.URL != "" {
-		url, err := obj.ParseURL(request.URL)
-		if err != nil {
-			return fmt.Errorf("error parsing url %v: %v", request.URL, err)
-		}
-		objClient, err := obj.NewClientFromURLAndSecret(extractServer.Context(), url)
-		if err != nil {
+	// The marker is taken before anything is read, so that anything created
+	// while this extract runs is also in the next incremental extract.
+	now, err := types.TimestampProto(time.Now())
+	if err != nil {
+		return err
+	}
+	marker := &admin.Op{Op1_7: &admin.Op1_7{Marker: &admin.BackupMarker{
+		ClusterID: a.clusterInfo.ID,
+		Time:      now,
+	}}}
+	if request.URL == "" {
+		if err := a.extract(pachClient, request, extractServer.Send); err != nil {
 			return err
 		}
-		objW, err := objClient.Writer(url.Object)
+		return extractServer.Send(marker)
+	}
+	url, err := obj.ParseURL(request.URL)
+	if err != nil {
+		return fmt.Errorf("error parsing url %v: %v", request.URL, err)
+	}
+	objClient, err := obj.NewClientFromURLAndSecret(extractServer.Context(), url)
+	if err != nil {
+		return err
+	}
+	objW, err := objClient.Writer(url.Object)
+	if err != nil {
+		return err
+	}
+	snappyW := snappy.NewBufferedWriter(objW)
+	w := pbutil.NewWriter(snappyW)
+	handleOp := func(op *admin.Op) error { return w.Write(op) }
+	if err := a.extract(pachClient, request, handleOp); err != nil {
+		snappyW.Close()
+		objW.Close()
+		return err
+	}
+	if err := handleOp(marker); err != nil {
+		return err
+	}
+	if err := snappyW.Close(); err != nil {
+		return err
+	}
+	if err := objW.Close(); err != nil {
+		return err
+	}
+	// Return the marker to the caller if it asked for it, so that it can
+	// request an incremental extract later
+	if !request.Marker {
+		return nil
+	}
+	return extractServer.Send(marker)
+}
+
+// extract calls handleOp with the ops that restore the state selected by
+// 'request'
+func (a *apiServer) extract(pachClient *client.APIClient, request *admin.ExtractRequest, handleOp func(*admin.Op) error) error {
+	var since time.Time
+	if request.Since != nil {
+		if request.Since.ClusterID != a.clusterInfo.ID {
+			return fmt.Errorf("backup marker is from cluster %s, but this is cluster %s", request.Since.ClusterID, a.clusterInfo.ID)
+		}
+		var err error
+		if since, err = types.TimestampFromProto(request.Since.Time); err != nil {
+			return fmt.Errorf("invalid backup marker: %v", err)
+		}
+	}
+	// after returns true if 'ts' is at or after 'since', i.e. whether
+	// something created at 'ts' belongs in this extract
+	after := func(ts *types.Timestamp) bool {
+		if request.Since == nil {
+			return true
+		}
+		t, err := types.TimestampFromProto(ts)
+		return err != nil || !t.Before(since)
+	}
+	scoped := len(request.Repos) > 0 || len(request.Pipelines) > 0
+
+	if request.Enterprise {
+		resp, err := pachClient.Enterprise.GetActivationCode(pachClient.Ctx(), &enterprise.GetActivationCodeRequest{})
 		if err != nil {
-			return err
+			return fmt.Errorf("error getting enterprise activation code: %v", grpcutil.ScrubGRPC(err))
 		}
-		snappyW := snappy.NewBufferedWriter(objW)
-		defer func() {
-			if err := snappyW.Close(); err != nil && retErr == nil {
-				retErr = err
+		if resp.State != enterprise.State_NONE {
+			if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{
+				Enterprise: &enterprise.ActivateRequest{
+					ActivationCode: resp.ActivationCode,
+					Expires:        resp.Info.Expires,
+				},
+			}}); err != nil {
+				return err
 			}
-		}()
-		w := pbutil.NewWriter(snappyW)
-		handleOp = func(op *admin.Op) error { return w.Write(op) }
+		}
 	}
-	if !request.NoObjects {
-		w := extractObjectWriter(handleOp)
-		if err := pachClient.ListObject(func(object *pfs.Object) error {
-			if err := pachClient.GetObject(object.Hash, w); err != nil {
+
+	// Collect the repos, pipelines and commits to extract first, as they
+	// determine which objects are extracted if the extract is scoped or
+	// incremental
+	var ris []*pfs.RepoInfo
+	if scoped {
+		for _, name := range request.Repos {
+			ri, err := pachClient.InspectRepo(name)
+			if err != nil {
 				return err
 			}
-			// empty PutObjectRequest to indicate EOF
-			return handleOp(&admin.Op{Op1_7: &admin.Op1_7{Object: &pfs.PutObjectRequest{}}})
-		}); err != nil {
-			return err
+			ris = append(ris, ri)
 		}
-		if err := pachClient.ListTag(func(resp *pfs.ListTagsResponse) error {
-			return handleOp(&admin.Op{Op1_7: &admin.Op1_7{
-				Tag: &pfs.TagObjectRequest{
-					Object: resp.Object,
-					Tags:   []*pfs.Tag{resp.Tag},
-				},
-			}})
-		}); err != nil {
+	} else {
+		var err error
+		if ris, err = pachClient.ListRepo(); err != nil {
 			return err
 		}
 	}
-	var repos []*pfs.Repo
+	var repos []*pfs.RepoInfo
+	commits := make(map[string][]*pfs.BuildCommitRequest)
+	branches := make(map[string][]*pfs.BranchInfo)
 	if !request.NoRepos {
-		ris, err := pachClient.ListRepo()
-		if err != nil {
-			return err
-		}
 	repos:
 		for _, ri := range ris {
 			bis, err := pachClient.ListBranch(ri.Repo.Name)
@@ -101,46 +171,84 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 					continue repos
 				}
 			}
-			if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{
-				Repo: &pfs.CreateRepoRequest{
-					Repo:        ri.Repo,
-					Description: ri.Description,
-				}},
-			}); err != nil {
+			cis, err := pachClient.ListCommit(ri.Repo.Name, "", "", 0)
+			if err != nil {
 				return err
 			}
-			repos = append(repos, ri.Repo)
+			included := make(map[string]bool)
+			for _, ci := range cis {
+				if ci.Finished == nil || after(ci.Started) || after(ci.Finished) {
+					included[ci.Commit.ID] = true
+				}
+			}
+			// Branch names are inferred from every commit, so filter afterwards
+			for _, bcr := range buildCommitRequests(cis, bis) {
+				if included[bcr.ID] {
+					commits[ri.Repo.Name] = append(commits[ri.Repo.Name], bcr)
+				}
+			}
+			repos = append(repos, ri)
+			branches[ri.Repo.Name] = bis
 		}
 	}
+	var pis []*pps.PipelineInfo
 	if !request.NoPipelines {
-		pis, err := pachClient.ListPipeline()
-		if err != nil {
-			return err
+		if scoped {
+			for _, name := range request.Pipelines {
+				pi, err := pachClient.InspectPipeline(name)
+				if err != nil {
+					return err
+				}
+				pis = append(pis, pi)
+			}
+		} else {
+			var err error
+			if pis, err = pachClient.ListPipeline(); err != nil {
+				return err
+			}
 		}
-		pis = sortPipelineInfos(pis)
-		for _, pi := range pis {
-			if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{Pipeline: pipelineInfoToRequest(pi)}}); err != nil {
+	}
+
+	if !request.NoObjects {
+		if scoped || request.Since != nil {
+			if err := extractReferencedObjects(pachClient, repos, commits, handleOp); err != nil {
 				return err
 			}
+		} else if err := extractAllObjects(pachClient, handleOp); err != nil {
+			return err
 		}
 	}
-	// We send the actual commits last, that way pipelines will have already
-	// been created and will recreate output commits for historical outputs.
-	for _, repo := range repos {
-		cis, err := pachClient.ListCommit(repo.Name, "", "", 0)
-		if err != nil {
+	for _, ri := range repos {
+		if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{
+			Repo: &pfs.CreateRepoRequest{
+				Repo:        ri.Repo,
+				Description: ri.Description,
+			}},
+		}); err != nil {
 			return err
 		}
-		bis, err := pachClient.ListBranch(repo.Name)
-		if err != nil {
+	}
+	for _, pi := range sortPipelineInfos(pis) {
+		if !after(pi.CreatedAt) {
+			continue
+		}
+		req := pipelineInfoToRequest(pi)
+		// Pipelines that have been updated may exist in the cluster that
+		// the previous extract was restored to
+		req.Update = request.Since != nil && pi.Version > 1
+		if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{Pipeline: req}}); err != nil {
 			return err
 		}
-		for _, bcr := range buildCommitRequests(cis, bis) {
+	}
+	// We send the actual commits last, that way pipelines will have already
+	// been created and will recreate output commits for historical outputs.
+	for _, ri := range repos {
+		for _, bcr := range commits[ri.Repo.Name] {
 			if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{Commit: bcr}}); err != nil {
 				return err
 			}
 		}
-		for _, bi := range bis {
+		for _, bi := range branches[ri.Repo.Name] {
 			if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{
 				Branch: &pfs.CreateBranchRequest{
 					Head:   bi.Head,
@@ -151,6 +259,191 @@ func (a *apiServer) Extract(request *admin.ExtractRequest, extractServer admin.A
 			}
 		}
 	}
+	if !request.NoAuth {
+		// ACLs are extracted for every repo in scope, including pipelines'
+		// output repos, which restoring the pipelines recreates
+		var aclRepos []string
+		for _, ri := range ris {
+			aclRepos = append(aclRepos, ri.Repo.Name)
+		}
+		if scoped {
+			for _, pi := range pis {
+				aclRepos = append(aclRepos, pi.Pipeline.Name)
+			}
+		}
+		if err := extractAuth(pachClient, !scoped, aclRepos, handleOp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractAllObjects extracts every object and tag in the cluster
+func extractAllObjects(pachClient *client.APIClient, handleOp func(*admin.Op) error) error {
+	w := extractObjectWriter(handleOp)
+	if err := pachClient.ListObject(func(object *pfs.Object) error {
+		if err := pachClient.GetObject(object.Hash, w); err != nil {
+			return err
+		}
+		// empty PutObjectRequest to indicate EOF
+		return handleOp(&admin.Op{Op1_7: &admin.Op1_7{Object: &pfs.PutObjectRequest{}}})
+	}); err != nil {
+		return err
+	}
+	return pachClient.ListTag(func(resp *pfs.ListTagsResponse) error {
+		return handleOp(&admin.Op{Op1_7: &admin.Op1_7{
+			Tag: &pfs.TagObjectRequest{
+				Object: resp.Object,
+				Tags:   []*pfs.Tag{resp.Tag},
+			},
+		}})
+	})
+}
+
+// extractReferencedObjects extracts the objects that 'commits' reference
+// (their trees, and the files in their trees), along with the tags that
+// point at them. Objects that a commit's parent also references are skipped,
+// as they're extracted with the parent (or were, by the extract that this one
+// is incremental to).
+func extractReferencedObjects(pachClient *client.APIClient, repos []*pfs.RepoInfo, commits map[string][]*pfs.BuildCommitRequest, handleOp func(*admin.Op) error) error {
+	objects := make(map[string]bool)
+	var hashes []string
+	add := func(hash string) {
+		if !objects[hash] {
+			objects[hash] = true
+			hashes = append(hashes, hash)
+		}
+	}
+	for _, ri := range repos {
+		for _, bcr := range commits[ri.Repo.Name] {
+			if bcr.Tree == nil {
+				continue
+			}
+			parentObjects := make(map[string]bool)
+			if bcr.Parent.ID != "" {
+				parentInfo, err := pachClient.InspectCommit(bcr.Parent.Repo.Name, bcr.Parent.ID)
+				if err != nil {
+					return err
+				}
+				if parentInfo.Tree != nil {
+					parentObjects[parentInfo.Tree.Hash] = true
+					if err := walkTreeObjects(pachClient, parentInfo.Tree, func(object *pfs.Object) {
+						parentObjects[object.Hash] = true
+					}); err != nil {
+						return err
+					}
+				}
+			}
+			if !parentObjects[bcr.Tree.Hash] {
+				add(bcr.Tree.Hash)
+			}
+			if err := walkTreeObjects(pachClient, bcr.Tree, func(object *pfs.Object) {
+				if !parentObjects[object.Hash] {
+					add(object.Hash)
+				}
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	w := extractObjectWriter(handleOp)
+	for _, hash := range hashes {
+		if err := pachClient.GetObject(hash, w); err != nil {
+			return err
+		}
+		// empty PutObjectRequest to indicate EOF
+		if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{Object: &pfs.PutObjectRequest{}}}); err != nil {
+			return err
+		}
+	}
+	return pachClient.ListTag(func(resp *pfs.ListTagsResponse) error {
+		if !objects[resp.Object.Hash] {
+			return nil
+		}
+		return handleOp(&admin.Op{Op1_7: &admin.Op1_7{
+			Tag: &pfs.TagObjectRequest{
+				Object: resp.Object,
+				Tags:   []*pfs.Tag{resp.Tag},
+			},
+		}})
+	})
+}
+
+// walkTreeObjects calls f with every object that the files in 'tree'
+// reference
+func walkTreeObjects(pachClient *client.APIClient, tree *pfs.Object, f func(*pfs.Object)) error {
+	var buf bytes.Buffer
+	if err := pachClient.GetObject(tree.Hash, &buf); err != nil {
+		return err
+	}
+	t, err := hashtree.Deserialize(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return t.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode != nil {
+			for _, object := range node.FileNode.Objects {
+				f(object)
+			}
+		}
+		return nil
+	})
+}
+
+// extractAuth extracts the ACLs of 'repos', and the groups that they
+// reference. If 'admins' is true, the cluster's admins are extracted too.
+// Nothing is extracted if auth isn't activated.
+func extractAuth(pachClient *client.APIClient, admins bool, repos []string, handleOp func(*admin.Op) error) error {
+	ctx := pachClient.Ctx()
+	adminsResp, err := pachClient.GetAdmins(ctx, &auth.GetAdminsRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return fmt.Errorf("error getting admins: %v", grpcutil.ScrubGRPC(err))
+	}
+	if admins {
+		if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{
+			Admins: &auth.ModifyAdminsRequest{Add: adminsResp.Admins},
+		}}); err != nil {
+			return err
+		}
+	}
+	var acls []*auth.SetACLRequest
+	groups := make(map[string]bool)
+	for _, repo := range repos {
+		resp, err := pachClient.GetACL(ctx, &auth.GetACLRequest{Repo: repo})
+		if err != nil {
+			return fmt.Errorf("error getting ACL of %s: %v", repo, grpcutil.ScrubGRPC(err))
+		}
+		for _, entry := range resp.Entries {
+			if strings.HasPrefix(entry.Username, auth.GroupPrefix) {
+				groups[entry.Username] = true
+			}
+		}
+		acls = append(acls, &auth.SetACLRequest{Repo: repo, Entries: resp.Entries})
+	}
+	var groupNames []string
+	for group := range groups {
+		groupNames = append(groupNames, group)
+	}
+	sort.Strings(groupNames)
+	for _, group := range groupNames {
+		resp, err := pachClient.GetUsers(ctx, &auth.GetUsersRequest{Group: group})
+		if err != nil {
+			return fmt.Errorf("error getting members of %s: %v", group, grpcutil.ScrubGRPC(err))
+		}
+		if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{
+			Group: &auth.ModifyMembersRequest{Group: group, Add: resp.Usernames},
+		}}); err != nil {
+			return err
+		}
+	}
+	for _, acl := range acls {
+		if err := handleOp(&admin.Op{Op1_7: &admin.Op1_7{Acl: acl}}); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -268,6 +561,10 @@ func pipelineInfoToRequest(pi *pps.PipelineInfo) *pps.CreatePipelineRequest {
 		ChunkSpec:          pi.ChunkSpec,
 		DatumTimeout:       pi.DatumTimeout,
 		JobTimeout:         pi.JobTimeout,
+		DatumTries:         pi.DatumTries,
+		DatumBackoff:       pi.DatumBackoff,
+		SkipFailedDatums:   pi.SkipFailedDatums,
+		Priority:           pi.Priority,
 		Salt:               pi.Salt,
 	}
 }
@@ -343,8 +640,13 @@ func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error
 				return fmt.Errorf("error creating repo: %v", grpcutil.ScrubGRPC(err))
 			}
 		case op.Op1_7 != nil && op.Op1_7.Commit != nil:
-			if _, err := pachClient.PfsAPIClient.BuildCommit(ctx, op.Op1_7.Commit); err != nil && !errutil.IsAlreadyExistError(err) {
-				return fmt.Errorf("error creating commit: %v", grpcutil.ScrubGRPC(err))
+			if _, err := pachClient.PfsAPIClient.BuildCommit(ctx, op.Op1_7.Commit); err != nil {
+				if !errutil.IsAlreadyExistError(err) {
+					return fmt.Errorf("error creating commit: %v", grpcutil.ScrubGRPC(err))
+				}
+				if err := finishRestoredCommit(pachClient, op.Op1_7.Commit); err != nil {
+					return err
+				}
 			}
 		case op.Op1_7 != nil && op.Op1_7.Branch != nil:
 			if op.Op1_7.Branch.Branch == nil {
@@ -354,13 +656,74 @@ func (a *apiServer) Restore(restoreServer admin.API_RestoreServer) (retErr error
 				return fmt.Errorf("error creating branch: %v", grpcutil.ScrubGRPC(err))
 			}
 		case op.Op1_7 != nil && op.Op1_7.Pipeline != nil:
-			if _, err := pachClient.PpsAPIClient.CreatePipeline(ctx, op.Op1_7.Pipeline); err != nil && !errutil.IsAlreadyExistError(err) {
+			_, err := pachClient.PpsAPIClient.CreatePipeline(ctx, op.Op1_7.Pipeline)
+			if err != nil && op.Op1_7.Pipeline.Update && errutil.IsNotFoundError(err) {
+				// The pipeline was created and updated since the previous
+				// extract, so there's nothing to update
+				op.Op1_7.Pipeline.Update = false
+				_, err = pachClient.PpsAPIClient.CreatePipeline(ctx, op.Op1_7.Pipeline)
+			}
+			if err != nil && !errutil.IsAlreadyExistError(err) {
 				return fmt.Errorf("error creating pipeline: %v", grpcutil.ScrubGRPC(err))
 			}
+		case op.Op1_7 != nil && op.Op1_7.Enterprise != nil:
+			state, err := pachClient.Enterprise.GetState(ctx, &enterprise.GetStateRequest{})
+			if err != nil {
+				return fmt.Errorf("error getting enterprise state: %v", grpcutil.ScrubGRPC(err))
+			}
+			if state.State != enterprise.State_ACTIVE {
+				if _, err := pachClient.Enterprise.Activate(ctx, op.Op1_7.Enterprise); err != nil {
+					return fmt.Errorf("error activating enterprise: %v", grpcutil.ScrubGRPC(err))
+				}
+			}
+		case op.Op1_7 != nil && op.Op1_7.Admins != nil:
+			if _, err := pachClient.AuthAPIClient.ModifyAdmins(ctx, op.Op1_7.Admins); err != nil {
+				return fmt.Errorf("error adding admins: %v", authRestoreError(err))
+			}
+		case op.Op1_7 != nil && op.Op1_7.Group != nil:
+			if _, err := pachClient.AuthAPIClient.ModifyMembers(ctx, op.Op1_7.Group); err != nil {
+				return fmt.Errorf("error adding members to %s: %v", op.Op1_7.Group.Group, authRestoreError(err))
+			}
+		case op.Op1_7 != nil && op.Op1_7.Acl != nil:
+			if _, err := pachClient.AuthAPIClient.SetACL(ctx, op.Op1_7.Acl); err != nil {
+				return fmt.Errorf("error setting ACL of %s: %v", op.Op1_7.Acl.Repo, authRestoreError(err))
+			}
 		}
 	}
 }
 
+// finishRestoredCommit handles a commit that already exists when it's
+// restored. If the commit was open when it was extracted, and has since been
+// finished (in a later incremental extract), it's finished with its tree.
+func finishRestoredCommit(pachClient *client.APIClient, bcr *pfs.BuildCommitRequest) error {
+	if bcr.Tree == nil {
+		return nil
+	}
+	commit := client.NewCommit(bcr.Parent.Repo.Name, bcr.ID)
+	ci, err := pachClient.InspectCommit(commit.Repo.Name, commit.ID)
+	if err != nil {
+		return err
+	}
+	if ci.Finished != nil {
+		return nil
+	}
+	if _, err := pachClient.PfsAPIClient.FinishCommit(pachClient.Ctx(), &pfs.FinishCommitRequest{
+		Commit: commit,
+		Tree:   bcr.Tree,
+	}); err != nil {
+		return fmt.Errorf("error finishing commit: %v", grpcutil.ScrubGRPC(err))
+	}
+	return nil
+}
+
+// authRestoreError explains the most likely reason for auth ops to fail
+func authRestoreError(err error) error {
+	if auth.IsErrNotActivated(err) {
+		return fmt.Errorf("auth must be activated before restoring an extract that includes ACLs (or extract with --no-auth)")
+	}
+	return grpcutil.ScrubGRPC(err)
+}
+
 func (a *apiServer) getPachClient() *client.APIClient {
 	a.pachClientOnce.Do(func() {
 		var err error
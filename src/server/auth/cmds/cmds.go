@@ -5,14 +5,19 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"text/tabwriter"
 	"text/template"
+	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pkg/config"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/auth/pretty"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 )
 
@@ -33,6 +38,18 @@ func githubLogin() (string, error) {
 	return strings.TrimSpace(token), nil // drop trailing newline
 }
 
+func oidcLogin() (string, error) {
+	fmt.Println("Please log in to your OpenID Connect identity provider, and " +
+		"paste the ID token that you receive (the ID token is issued to " +
+		"Pachyderm's client ID and will give you an externally verified " +
+		"account in this Pachyderm cluster) here:")
+	token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("error reading token: %v", err)
+	}
+	return strings.TrimSpace(token), nil // drop trailing newline
+}
+
 func writePachTokenToCfg(token string) error {
 	cfg, err := config.Read()
 	if err != nil {
@@ -48,25 +65,38 @@ func writePachTokenToCfg(token string) error {
 
 // ActivateCmd returns a cobra.Command to activate Pachyderm's auth system
 func ActivateCmd() *cobra.Command {
+	var oidcConfig auth.OIDCConfig
 	activate := &cobra.Command{
 		Use:   "activate",
 		Short: "Activate Pachyderm's auth system",
-		Long:  "Activate Pachyderm's auth system, and restrict access to existing data to cluster admins",
+		Long: "Activate Pachyderm's auth system, and restrict access to existing " +
+			"data to cluster admins. By default, users authenticate with GitHub. " +
+			"If --oidc-issuer is set, users instead authenticate with that OpenID " +
+			"Connect identity provider.",
 		Run: cmdutil.Run(func(args []string) error {
-			token, err := githubLogin()
-			if err != nil {
-				return err
+			req := &auth.ActivateRequest{}
+			if oidcConfig.Issuer != "" {
+				token, err := oidcLogin()
+				if err != nil {
+					return err
+				}
+				req.OIDCConfig = &oidcConfig
+				req.IDToken = token
+			} else {
+				token, err := githubLogin()
+				if err != nil {
+					return err
+				}
+				req.GitHubToken = token
 			}
 			fmt.Println("Retrieving Pachyderm token...")
 
-			// Exchange GitHub token for Pachyderm token
+			// Exchange GitHub/ID token for Pachyderm token
 			c, err := client.NewOnUserMachine(true, "user")
 			if err != nil {
 				return fmt.Errorf("could not connect: %v", err)
 			}
-			resp, err := c.Activate(
-				c.Ctx(),
-				&auth.ActivateRequest{GitHubToken: token})
+			resp, err := c.Activate(c.Ctx(), req)
 			if err != nil {
 				return fmt.Errorf("error activating Pachyderm auth: %v",
 					grpcutil.ScrubGRPC(err))
@@ -74,6 +104,13 @@ func ActivateCmd() *cobra.Command {
 			return writePachTokenToCfg(resp.PachToken)
 		}),
 	}
+	activate.PersistentFlags().StringVar(&oidcConfig.Issuer, "oidc-issuer", "",
+		"The issuer URL of an OpenID Connect identity provider, with which users "+
+			"will authenticate (instead of GitHub)")
+	activate.PersistentFlags().StringVar(&oidcConfig.ClientID, "oidc-client-id", "",
+		"Pachyderm's client ID with the OpenID Connect identity provider")
+	activate.PersistentFlags().StringVar(&oidcConfig.UsernameClaim, "oidc-username-claim", "",
+		"The ID token claim that contains users' usernames (\"sub\" if unset)")
 	return activate
 }
 
@@ -105,30 +142,42 @@ func DeactivateCmd() *cobra.Command {
 }
 
 // LoginCmd returns a cobra.Command to login to a Pachyderm cluster with your
-// GitHub account. Any resources that have been restricted to the email address
+// GitHub account (or with your account at the cluster's OpenID Connect identity
+// provider). Any resources that have been restricted to the email address
 // registered with your GitHub account will subsequently be accessible.
 func LoginCmd() *cobra.Command {
+	var useOIDC bool
 	login := &cobra.Command{
 		Use:   "login",
 		Short: "Login to Pachyderm with your GitHub account",
 		Long: "Login to Pachyderm with your GitHub account. Any resources that " +
 			"have been restricted to the email address registered with your GitHub " +
-			"account will subsequently be accessible.",
+			"account will subsequently be accessible. If the cluster uses an " +
+			"OpenID Connect identity provider, pass --oidc to login with an ID " +
+			"token from that provider instead.",
 		Run: cmdutil.Run(func([]string) error {
-			token, err := githubLogin()
-			if err != nil {
-				return err
+			req := &auth.AuthenticateRequest{}
+			if useOIDC {
+				token, err := oidcLogin()
+				if err != nil {
+					return err
+				}
+				req.IDToken = token
+			} else {
+				token, err := githubLogin()
+				if err != nil {
+					return err
+				}
+				req.GitHubToken = token
 			}
 			fmt.Println("Retrieving Pachyderm token...")
 
-			// Exchange GitHub token for Pachyderm token
+			// Exchange GitHub/ID token for Pachyderm token
 			c, err := client.NewOnUserMachine(true, "user")
 			if err != nil {
 				return fmt.Errorf("could not connect: %v", err)
 			}
-			resp, err := c.Authenticate(
-				c.Ctx(),
-				&auth.AuthenticateRequest{GitHubToken: token})
+			resp, err := c.Authenticate(c.Ctx(), req)
 			if err != nil {
 				return fmt.Errorf("error authenticating with Pachyderm cluster: %v",
 					grpcutil.ScrubGRPC(err))
@@ -136,6 +185,9 @@ func LoginCmd() *cobra.Command {
 			return writePachTokenToCfg(resp.PachToken)
 		}),
 	}
+	login.PersistentFlags().BoolVar(&useOIDC, "oidc", false,
+		"Login with an ID token issued by the cluster's OpenID Connect identity "+
+			"provider, rather than with GitHub")
 	return login
 }
 
@@ -201,7 +253,8 @@ func CheckCmd() *cobra.Command {
 			"if the you have at least \"reader\" access to the repo " +
 			"\"private-data\" (you could be a reader, writer, or owner). Unlike " +
 			"`pachctl get-acl`, you do not need to have access to 'repo' to " +
-			"discover your own acess level.",
+			"discover your own acess level. 'repo' may also be a branch, written " +
+			"'repo@branch'.",
 		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
 			scope, err := auth.ParseScope(args[0])
 			if err != nil {
@@ -284,8 +337,11 @@ func SetScopeCmd() *cobra.Command {
 			"way (the default). Similarly, 'pachctl auth set github-alice reader " +
 			"private-data' would let \"github-alice\" read from \"private-data\" but " +
 			"not create commits (writer) or modify the repo's access permissions " +
-			"(owner). Currently all Pachyderm authentication uses GitHub OAuth, so " +
-			"'username' must be a GitHub username",
+			"(owner). 'repo' may also be a branch, written 'repo@branch' (e.g. " +
+			"'pachctl auth set release-bot writer private-data@master'). If a " +
+			"branch has its own ACL, only principals on that ACL (and the repo's " +
+			"owners) may commit to it. Currently all Pachyderm authentication " +
+			"uses GitHub OAuth, so 'username' must be a GitHub username",
 		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
 			scope, err := auth.ParseScope(args[1])
 			if err != nil {
@@ -361,6 +417,115 @@ func ModifyAdminsCmd() *cobra.Command {
 	return modifyAdmins
 }
 
+// ModifyMembersCmd returns a cobra command that adds users to and removes
+// users from a group
+func ModifyMembersCmd() *cobra.Command {
+	var add []string
+	var remove []string
+	modifyMembers := &cobra.Command{
+		Use:   "modify-members group",
+		Short: "Modify the members of a group",
+		Long: "Modify the members of a group. --add accepts a comma-separated " +
+			"list of users to add to the group, and --remove accepts a " +
+			"comma-separated list of users to remove from the group. Groups are " +
+			"created when their first member is added, and deleted when their " +
+			"last member is removed. This can only be called by cluster admins",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			_, err = c.ModifyMembers(c.Ctx(), &auth.ModifyMembersRequest{
+				Group:  args[0],
+				Add:    add,
+				Remove: remove,
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	modifyMembers.PersistentFlags().StringSliceVar(&add, "add", []string{},
+		"Comma-separated list of users to add to the group")
+	modifyMembers.PersistentFlags().StringSliceVar(&remove, "remove", []string{},
+		"Comma-separated list of users to remove from the group")
+	return modifyMembers
+}
+
+// DeleteGroupCmd returns a cobra command that deletes a group
+func DeleteGroupCmd() *cobra.Command {
+	deleteGroup := &cobra.Command{
+		Use:   "delete-group group",
+		Short: "Delete a group",
+		Long: "Delete a group, removing all of its members. This can only be " +
+			"called by cluster admins",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			_, err = c.DeleteGroup(c.Ctx(), &auth.DeleteGroupRequest{
+				Group: args[0],
+			})
+			return grpcutil.ScrubGRPC(err)
+		}),
+	}
+	return deleteGroup
+}
+
+// GetGroupsCmd returns a cobra command that lists all groups, or the groups
+// that a user belongs to
+func GetGroupsCmd() *cobra.Command {
+	getGroups := &cobra.Command{
+		Use:   "get-groups [username]",
+		Short: "List all groups, or the groups that 'username' belongs to",
+		Long:  "List all groups, or the groups that 'username' belongs to",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			req := &auth.GetGroupsRequest{}
+			if len(args) == 1 {
+				req.Username = args[0]
+			}
+			resp, err := c.GetGroups(c.Ctx(), req)
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			for _, group := range resp.Groups {
+				fmt.Println(group)
+			}
+			return nil
+		}),
+	}
+	return getGroups
+}
+
+// GetUsersCmd returns a cobra command that lists the members of a group
+func GetUsersCmd() *cobra.Command {
+	getUsers := &cobra.Command{
+		Use:   "get-users group",
+		Short: "List the members of a group",
+		Long:  "List the members of a group",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			resp, err := c.GetUsers(c.Ctx(), &auth.GetUsersRequest{
+				Group: args[0],
+			})
+			if err != nil {
+				return grpcutil.ScrubGRPC(err)
+			}
+			for _, user := range resp.Usernames {
+				fmt.Println(user)
+			}
+			return nil
+		}),
+	}
+	return getUsers
+}
+
 // GetAuthTokenCmd returns a cobra command that lets a user get a pachyderm
 // token on behalf of themselves or another user
 func GetAuthTokenCmd() *cobra.Command {
@@ -418,6 +583,95 @@ func UseAuthTokenCmd() *cobra.Command {
 	return setScope
 }
 
+// parseAuditTime parses the argument of 'pachctl audit --since/--until',
+// which is either an RFC 3339 time or a duration (interpreted as that long
+// ago)
+func parseAuditTime(s string) (*types.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		d, durationErr := time.ParseDuration(s)
+		if durationErr != nil {
+			return nil, fmt.Errorf("could not parse \"%s\" as a time (e.g. "+
+				"2018-01-02T15:04:05Z) or duration (e.g. 24h)", s)
+		}
+		t = time.Now().Add(-d)
+	}
+	return types.TimestampProto(t)
+}
+
+// AuditCmd returns a cobra command that prints the cluster's audit log
+func AuditCmd() *cobra.Command {
+	var since, until, user string
+	var raw bool
+	audit := &cobra.Command{
+		Use:   "audit",
+		Short: "Print the record of state-changing calls made to the cluster",
+		Long: "Print the record of state-changing calls made to the cluster " +
+			"(e.g. creating repos, putting files, and updating pipelines), " +
+			"including the user who made each call, its key arguments, and " +
+			"whether it succeeded. Calls are kept forever, unless pachd's " +
+			"AUDIT_LOG_RETENTION is set. This can only be called by cluster " +
+			"admins",
+		Example: `
+# print every call made by alice in the last day
+$ pachctl audit --user alice --since 24h
+
+# print every call made in January 2018
+$ pachctl audit --since 2018-01-01T00:00:00Z --until 2018-02-01T00:00:00Z`,
+		Run: cmdutil.RunFixedArgs(0, func([]string) error {
+			req := &auth.GetAuditLogRequest{Username: user}
+			var err error
+			if since != "" {
+				if req.Start, err = parseAuditTime(since); err != nil {
+					return err
+				}
+			}
+			if until != "" {
+				if req.End, err = parseAuditTime(until); err != nil {
+					return err
+				}
+			}
+			c, err := client.NewOnUserMachine(true, "user")
+			if err != nil {
+				return fmt.Errorf("could not connect: %v", err)
+			}
+			marshaller := &jsonpb.Marshaler{Indent: "  "}
+			writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+			if !raw {
+				pretty.PrintAuditEventHeader(writer)
+			}
+			// Read the log one page at a time
+			for {
+				resp, err := c.GetAuditLog(c.Ctx(), req)
+				if err != nil {
+					return grpcutil.ScrubGRPC(err)
+				}
+				for _, event := range resp.Events {
+					if raw {
+						if err := marshaller.Marshal(os.Stdout, event); err != nil {
+							return err
+						}
+					} else {
+						pretty.PrintAuditEvent(writer, event)
+					}
+				}
+				if resp.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.NextPageToken
+			}
+			return writer.Flush()
+		}),
+	}
+	audit.Flags().StringVar(&since, "since", "", "Only print calls made at or "+
+		"after this time (an RFC 3339 time, or a duration such as 24h)")
+	audit.Flags().StringVar(&until, "until", "", "Only print calls made before "+
+		"this time (an RFC 3339 time, or a duration such as 1h)")
+	audit.Flags().StringVarP(&user, "user", "u", "", "Only print calls made by this user")
+	audit.Flags().BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	return audit
+}
+
 // Cmds returns a list of cobra commands for authenticating and authorizing
 // users in an auth-enabled Pachyderm cluster.
 func Cmds() []*cobra.Command {
@@ -436,7 +690,11 @@ func Cmds() []*cobra.Command {
 	auth.AddCommand(GetCmd())
 	auth.AddCommand(ListAdminsCmd())
 	auth.AddCommand(ModifyAdminsCmd())
+	auth.AddCommand(ModifyMembersCmd())
+	auth.AddCommand(DeleteGroupCmd())
+	auth.AddCommand(GetGroupsCmd())
+	auth.AddCommand(GetUsersCmd())
 	auth.AddCommand(GetAuthTokenCmd())
 	auth.AddCommand(UseAuthTokenCmd())
-	return []*cobra.Command{auth}
+	return []*cobra.Command{auth, AuditCmd()}
 }
@@ -0,0 +1,37 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+)
+
+// PrintAuditEventHeader prints an audit event header.
+func PrintAuditEventHeader(w io.Writer) {
+	fmt.Fprint(w, "TIME\tUSER\tMETHOD\tREPO\tBRANCH\tCOMMIT\tPIPELINE\tRESULT\t\n")
+}
+
+// PrintAuditEvent pretty-prints an audit event.
+func PrintAuditEvent(w io.Writer, event *auth.AuditEvent) {
+	fmt.Fprintf(w, "%s\t", types.TimestampString(event.Time))
+	username := event.Username
+	if username == "" {
+		username = "-"
+	}
+	fmt.Fprintf(w, "%s\t", username)
+	fmt.Fprintf(w, "%s\t", event.Method)
+	fmt.Fprintf(w, "%s\t", event.Repo)
+	fmt.Fprintf(w, "%s\t", event.Branch)
+	fmt.Fprintf(w, "%s\t", event.Commit)
+	fmt.Fprintf(w, "%s\t", event.Pipeline)
+	if event.Pending {
+		fmt.Fprint(w, "pending\t")
+	} else if event.Error != "" {
+		fmt.Fprintf(w, "error: %s\t", event.Error)
+	} else {
+		fmt.Fprint(w, "ok\t")
+	}
+	fmt.Fprintln(w)
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path"
 	"regexp"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -41,9 +42,17 @@ const (
 	// pachyderm token for any username in the AuthenticateRequest.GitHubToken field
 	DisableAuthenticationEnvVar = "PACHYDERM_AUTHENTICATION_DISABLED_FOR_TESTING"
 
-	tokensPrefix = "/tokens"
-	aclsPrefix   = "/acls"
-	adminsPrefix = "/admins"
+	tokensPrefix  = "/tokens"
+	aclsPrefix    = "/acls"
+	adminsPrefix  = "/admins"
+	groupsPrefix  = "/groups"
+	membersPrefix = "/members"
+	configPrefix  = "/config"
+
+	// oidcConfigKey is the key of the cluster's OIDCConfig in the 'oidcConfig'
+	// collection (if the cluster uses GitHub to authenticate users, the key is
+	// absent)
+	oidcConfigKey = "oidc"
 
 	defaultTokenTTLSecs = 14 * 24 * 60 * 60 // two weeks
 
@@ -88,6 +97,23 @@ type apiServer struct {
 	// admins is a collection of username -> Empty mappings (keys indicate which
 	// github users are cluster admins)
 	admins col.Collection
+	// groups is a collection of groupName -> Users mappings
+	groups col.Collection
+	// members is a collection of username -> Groups mappings (the inverse of
+	// 'groups', which is used to resolve a user's effective scope)
+	members col.Collection
+	// auditEvents is the audit log: a collection of AuditEvents, recording the
+	// state-changing calls made to pachd
+	auditEvents col.Collection
+	// auditRetention is how long events are kept in auditEvents (or forever,
+	// if it's 0)
+	auditRetention time.Duration
+	// oidcConfig contains the cluster's OIDCConfig, if the cluster was activated
+	// with an OIDC identity provider
+	oidcConfig col.Collection
+
+	oidcMu       sync.Mutex    // synchronizes access to oidcProvider
+	oidcProvider *oidcProvider // cache of the cluster's OIDC identity provider
 
 	// This is a cache of the PPS master token. It's set once on startup and then
 	// never updated
@@ -128,8 +154,9 @@ func (a *apiServer) getPachClient() *client.APIClient {
 	return a.pachClient
 }
 
-// NewAuthServer returns an implementation of authclient.APIServer.
-func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string) (authclient.APIServer, error) {
+// NewAuthServer returns an implementation of authclient.APIServer. Events in
+// the audit log expire after 'auditRetention', unless it's 0.
+func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string, auditRetention time.Duration) (APIServer, error) {
 	etcdClient, err := etcd.New(etcd.Config{
 		Endpoints:   []string{etcdAddress},
 		DialOptions: client.EtcdDialOptions(),
@@ -164,6 +191,35 @@ func NewAuthServer(pachdAddress string, etcdAddress string, etcdPrefix string) (
 			&types.BoolValue{}, // smallest value that etcd actually stores
 			nil,
 		),
+		groups: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, groupsPrefix),
+			nil,
+			&authclient.Users{},
+			nil,
+		),
+		members: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, membersPrefix),
+			nil,
+			&authclient.Groups{},
+			nil,
+		),
+		auditEvents: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, auditPrefix),
+			[]col.Index{auditUsernameIndex},
+			&authclient.AuditEvent{},
+			nil,
+		),
+		auditRetention: auditRetention,
+		oidcConfig: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, configPrefix),
+			nil,
+			&authclient.OIDCConfig{},
+			nil,
+		),
 	}
 	go s.getPachClient() // initialize connection to Pachd
 	go s.watchAdmins(path.Join(etcdPrefix, adminsPrefix))
@@ -295,6 +351,17 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 		return nil, fmt.Errorf("already activated")
 	}
 
+	// Choose the identity provider that the caller (and all subsequent users)
+	// will authenticate with
+	idp, err := a.newIdentityProvider(req.OIDCConfig)
+	if err != nil {
+		return nil, err
+	}
+	token := req.GitHubToken
+	if req.OIDCConfig != nil {
+		token = req.IDToken
+	}
+
 	// Hack: set the cluster admins to just {magicUser}. This ensures that no
 	// pipelines can be created while PPS is granting all existing pipelines auth
 	// tokens and adjusting the ACLs of input/output repos
@@ -309,8 +376,8 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 		return nil, err
 	}
 
-	// Determine caller's Pachyderm/GitHub username
-	username, err := GitHubTokenToUsername(ctx, req.GitHubToken)
+	// Determine caller's Pachyderm username
+	username, err := idp.authenticate(ctx, token)
 	if err != nil {
 		return nil, err
 	}
@@ -324,6 +391,13 @@ func (a *apiServer) Activate(ctx context.Context, req *authclient.ActivateReques
 		if err := admins.Delete(magicUser); err != nil {
 			return err
 		}
+		if req.OIDCConfig != nil {
+			if err := a.oidcConfig.ReadWrite(stm).Put(oidcConfigKey, req.OIDCConfig); err != nil {
+				return err
+			}
+		} else {
+			a.oidcConfig.ReadWrite(stm).DeleteAll()
+		}
 		if err := admins.Put(username, epsilon); err != nil {
 			return err
 		}
@@ -379,6 +453,9 @@ func (a *apiServer) Deactivate(ctx context.Context, req *authclient.DeactivateRe
 		a.acls.ReadWrite(stm).DeleteAll()
 		a.tokens.ReadWrite(stm).DeleteAll()
 		a.admins.ReadWrite(stm).DeleteAll() // watchAdmins() will see the write
+		a.groups.ReadWrite(stm).DeleteAll()
+		a.members.ReadWrite(stm).DeleteAll()
+		a.oidcConfig.ReadWrite(stm).DeleteAll()
 		return nil
 	})
 	if err != nil {
@@ -493,7 +570,7 @@ func (a *apiServer) validateModifyAdminsRequest(add []string, remove []string) e
 	// and fix a broken cluster.
 	hasHumanAdmin := false
 	for user := range m {
-		if strings.HasPrefix(user, authclient.GitHubPrefix) {
+		if strings.HasPrefix(user, authclient.GitHubPrefix) || strings.HasPrefix(user, authclient.OIDCPrefix) {
 			hasHumanAdmin = true
 			break
 		}
@@ -531,7 +608,7 @@ func (a *apiServer) ModifyAdmins(ctx context.Context, req *authclient.ModifyAdmi
 	for i, user := range req.Add {
 		i, user := i, user
 		eg.Go(func() error {
-			user, err = lenientCanonicalizeSubject(ctx, user)
+			user, err = a.lenientCanonicalizeSubject(ctx, user)
 			if err != nil {
 				return err
 			}
@@ -543,7 +620,7 @@ func (a *apiServer) ModifyAdmins(ctx context.Context, req *authclient.ModifyAdmi
 	for i, user := range req.Remove {
 		i, user := i, user
 		eg.Go(func() error {
-			user, err = lenientCanonicalizeSubject(ctx, user)
+			user, err = a.lenientCanonicalizeSubject(ctx, user)
 			if err != nil {
 				return err
 			}
@@ -588,8 +665,16 @@ func (a *apiServer) Authenticate(ctx context.Context, req *authclient.Authentica
 		return nil, authclient.ErrNotActivated
 	}
 
-	// Determine caller's Pachyderm/GitHub username
-	username, err := GitHubTokenToUsername(ctx, req.GitHubToken)
+	// Determine caller's Pachyderm username
+	idp, err := a.getIdentityProvider(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token := req.GitHubToken
+	if _, ok := idp.(*oidcProvider); ok {
+		token = req.IDToken
+	}
+	username, err := idp.authenticate(ctx, token)
 	if err != nil {
 		return nil, err
 	}
@@ -642,7 +727,7 @@ func (a *apiServer) Authorize(ctx context.Context, req *authclient.AuthorizeRequ
 		return &authclient.AuthorizeResponse{Authorized: true}, nil
 	}
 
-	if req.Repo == ppsconsts.SpecRepo {
+	if repo, _ := authclient.ParseRepoBranch(req.Repo); repo == ppsconsts.SpecRepo {
 		// All users are authorized to read from the spec repo, but only admins are
 		// authorized to write to it (writing to it may break your cluster)
 		return &authclient.AuthorizeResponse{
@@ -663,14 +748,12 @@ func (a *apiServer) Authorize(ctx context.Context, req *authclient.AuthorizeRequ
 			"auth is deactivated, only cluster admins can perform any operations)")
 	}
 
-	// Get ACL to check
-	var acl authclient.ACL
-	if err := a.acls.ReadOnly(ctx).Get(req.Repo, &acl); err != nil && !col.IsErrNotFound(err) {
-		return nil, fmt.Errorf("error getting ACL for repo \"%s\": %v", req.Repo, err)
+	scope, err := a.getTargetScope(ctx, callerInfo.Subject, req.Repo)
+	if err != nil {
+		return nil, err
 	}
-
 	return &authclient.AuthorizeResponse{
-		Authorized: req.Scope <= acl.Entries[callerInfo.Subject],
+		Authorized: req.Scope <= scope,
 	}, nil
 }
 
@@ -727,6 +810,7 @@ func (a *apiServer) SetScope(ctx context.Context, req *authclient.SetScopeReques
 	if err != nil {
 		return nil, err
 	}
+	repo, branch := authclient.ParseRepoBranch(req.Repo)
 
 	_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
 		acls := a.acls.ReadWrite(stm)
@@ -738,8 +822,9 @@ func (a *apiServer) SetScope(ctx context.Context, req *authclient.SetScopeReques
 			// ACL not found. Check that repo exists (return error if not). Note that
 			// this is not consistent with the rest of the transaction, but users
 			// should not be able to send a SetScope request that races with
-			// CreateRepo
-			_, err := pachClient.InspectRepo(req.Repo)
+			// CreateRepo. Branches needn't exist, so that they can be protected
+			// before they're created
+			_, err := pachClient.InspectRepo(repo)
 			if err != nil {
 				return err
 			}
@@ -748,6 +833,15 @@ func (a *apiServer) SetScope(ctx context.Context, req *authclient.SetScopeReques
 			acl.Entries = make(map[string]authclient.Scope)
 		}
 
+		// Only the repo's owners may modify its ACL or its branches' ACLs
+		ownerACL := &acl
+		if branch != "" {
+			ownerACL = &authclient.ACL{}
+			if err := acls.Get(repo, ownerACL); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+		}
+
 		// Check if the caller is authorized
 		authorized, err := func() (bool, error) {
 			if a.isAdmin(callerInfo.Subject) {
@@ -765,11 +859,12 @@ func (a *apiServer) SetScope(ctx context.Context, req *authclient.SetScopeReques
 					"cluster (only a cluster admin can set a scope)")
 			}
 
-			// Check if the user is on the ACL directly
-			if acl.Entries[callerInfo.Subject] == authclient.Scope_OWNER {
-				return true, nil
+			// Check if the user is on the ACL, directly or through a group
+			scope, err := a.getScope(ctx, callerInfo.Subject, ownerACL)
+			if err != nil {
+				return false, err
 			}
-			return false, nil
+			return scope == authclient.Scope_OWNER, nil
 		}()
 		if err != nil {
 			return err
@@ -777,13 +872,13 @@ func (a *apiServer) SetScope(ctx context.Context, req *authclient.SetScopeReques
 		if !authorized {
 			return &authclient.ErrNotAuthorized{
 				Subject:  callerInfo.Subject,
-				Repo:     req.Repo,
+				Repo:     repo,
 				Required: authclient.Scope_OWNER,
 			}
 		}
 
 		// Scope change is authorized. Make the change
-		principal, err := lenientCanonicalizeSubject(ctx, req.Username)
+		principal, err := a.lenientCanonicalizeSubject(ctx, req.Username)
 		if err != nil {
 			return err
 		}
@@ -830,34 +925,34 @@ func (a *apiServer) GetScope(ctx context.Context, req *authclient.GetScopeReques
 	// their effective access scope for all repos--the caller may want to know
 	// what will happen if the user's admin privileges are revoked
 
-	// Read repo ACL from etcd
-	acls := a.acls.ReadOnly(ctx)
 	resp = new(authclient.GetScopeResponse)
-
 	for _, repo := range req.Repos {
-		var acl authclient.ACL
-		if err := acls.Get(repo, &acl); err != nil && !col.IsErrNotFound(err) {
+		// ACL read is authorized
+		callerScope, err := a.getTargetScope(ctx, callerInfo.Subject, repo)
+		if err != nil {
 			return nil, err
 		}
-
-		// ACL read is authorized
 		if req.Username == "" {
-			resp.Scopes = append(resp.Scopes, acl.Entries[callerInfo.Subject])
+			resp.Scopes = append(resp.Scopes, callerScope)
 		} else {
 			// Caller is getting another user's scopes. Check if the caller is
 			// authorized to view this repo's ACL
-			if !a.isAdmin(callerInfo.Subject) && acl.Entries[callerInfo.Subject] < authclient.Scope_READER {
+			if !a.isAdmin(callerInfo.Subject) && callerScope < authclient.Scope_READER {
 				return nil, &authclient.ErrNotAuthorized{
 					Subject:  callerInfo.Subject,
 					Repo:     repo,
 					Required: authclient.Scope_READER,
 				}
 			}
-			principal, err := lenientCanonicalizeSubject(ctx, req.Username)
+			principal, err := a.lenientCanonicalizeSubject(ctx, req.Username)
+			if err != nil {
+				return nil, err
+			}
+			scope, err := a.getTargetScope(ctx, principal, repo)
 			if err != nil {
 				return nil, err
 			}
-			resp.Scopes = append(resp.Scopes, acl.Entries[principal])
+			resp.Scopes = append(resp.Scopes, scope)
 		}
 	}
 	return resp, nil
@@ -940,7 +1035,7 @@ func (a *apiServer) SetACL(ctx context.Context, req *authclient.SetACLRequest) (
 	for _, entry := range req.Entries {
 		user, scope := entry.Username, entry.Scope
 		eg.Go(func() error {
-			principal, err := lenientCanonicalizeSubject(ctx, user)
+			principal, err := a.lenientCanonicalizeSubject(ctx, user)
 			if err != nil {
 				return err
 			}
@@ -955,6 +1050,7 @@ func (a *apiServer) SetACL(ctx context.Context, req *authclient.SetACLRequest) (
 	}
 
 	// Read repo ACL from etcd
+	repo, branch := authclient.ParseRepoBranch(req.Repo)
 	_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
 		acls := a.acls.ReadWrite(stm)
 
@@ -975,30 +1071,33 @@ func (a *apiServer) SetACL(ctx context.Context, req *authclient.SetACLRequest) (
 					"cluster (only a cluster admin can modify an ACL)")
 			}
 
-			// Check if there is an existing ACL, and if the user is on it
+			// Check if there is an existing ACL, and if the user is on it. Only the
+			// repo's owners may modify its ACL or its branches' ACLs
 			var acl authclient.ACL
-			if err := acls.Get(req.Repo, &acl); err != nil {
+			if err := acls.Get(repo, &acl); err != nil {
 				// ACL not found -- construct empty ACL proto
 				acl.Entries = make(map[string]authclient.Scope)
 			}
 			if len(acl.Entries) > 0 {
-				// ACL is present; caller must be authorized directly
-				if acl.Entries[callerInfo.Subject] == authclient.Scope_OWNER {
-					return true, nil
+				// ACL is present; caller must be authorized directly or through a
+				// group
+				scope, err := a.getScope(ctx, callerInfo.Subject, &acl)
+				if err != nil {
+					return false, err
 				}
-				return false, nil
+				return scope == authclient.Scope_OWNER, nil
 			}
 
 			// No ACL -- check if the repo being modified exists
-			_, err = a.getPachClient().WithCtx(ctx).InspectRepo(req.Repo)
+			_, err = a.getPachClient().WithCtx(ctx).InspectRepo(repo)
 			err = grpcutil.ScrubGRPC(err)
 			if err == nil {
 				// Repo exists -- user isn't authorized
 				return false, nil
 			} else if !strings.HasSuffix(err.Error(), "not found") {
 				// Unclear if repo exists -- return error
-				return false, fmt.Errorf("could not inspect \"%s\": %v", req.Repo, err)
-			} else if len(newACL.Entries) == 1 &&
+				return false, fmt.Errorf("could not inspect \"%s\": %v", repo, err)
+			} else if branch == "" && len(newACL.Entries) == 1 &&
 				newACL.Entries[callerInfo.Subject] == authclient.Scope_OWNER {
 				// Special case: Repo doesn't exist, but user is creating a new Repo, and
 				// making themself the owner, e.g. for CreateRepo or CreatePipeline, then
@@ -1013,14 +1112,21 @@ func (a *apiServer) SetACL(ctx context.Context, req *authclient.SetACLRequest) (
 		if !authorized {
 			return &authclient.ErrNotAuthorized{
 				Subject:  callerInfo.Subject,
-				Repo:     req.Repo,
+				Repo:     repo,
 				Required: authclient.Scope_OWNER,
 			}
 		}
 
-		// Set new ACL
+		// Set new ACL. Clearing a repo's ACL (e.g. because the repo is being
+		// deleted) also clears its branches' ACLs
 		if len(newACL.Entries) == 0 {
-			return acls.Delete(req.Repo)
+			if err := acls.Delete(req.Repo); err != nil {
+				return err
+			}
+			if branch == "" {
+				stm.DelAll(a.acls.Path(repo) + authclient.BranchSeparator)
+			}
+			return nil
 		}
 		return acls.Put(req.Repo, newACL)
 	})
@@ -1030,6 +1136,306 @@ func (a *apiServer) SetACL(ctx context.Context, req *authclient.SetACLRequest) (
 	return &authclient.SetACLResponse{}, nil
 }
 
+// getScope returns the scope that 'subject' has on 'acl', either directly or
+// through one of the groups that 'subject' is a member of
+func (a *apiServer) getScope(ctx context.Context, subject string, acl *authclient.ACL) (authclient.Scope, error) {
+	scope := acl.Entries[subject]
+	if scope == authclient.Scope_OWNER || strings.HasPrefix(subject, authclient.GroupPrefix) {
+		return scope, nil
+	}
+	var groups authclient.Groups
+	if err := a.members.ReadOnly(ctx).Get(subject, &groups); err != nil && !col.IsErrNotFound(err) {
+		return authclient.Scope_NONE, fmt.Errorf("error getting groups of \"%s\": %v", subject, err)
+	}
+	for group := range groups.Groups {
+		if groupScope := acl.Entries[authclient.GroupPrefix+group]; groupScope > scope {
+			scope = groupScope
+		}
+	}
+	return scope, nil
+}
+
+// getTargetScope returns the scope that 'subject' has on 'target', which is
+// either a repo or a branch of a repo ("repo@branch"). A branch's scope is
+// determined by the branch's ACL if it has one (though the repo's owners are
+// always owners of its branches), and by the repo's ACL otherwise
+func (a *apiServer) getTargetScope(ctx context.Context, subject string, target string) (authclient.Scope, error) {
+	repo, branch := authclient.ParseRepoBranch(target)
+	acls := a.acls.ReadOnly(ctx)
+	var repoACL authclient.ACL
+	if err := acls.Get(repo, &repoACL); err != nil && !col.IsErrNotFound(err) {
+		return authclient.Scope_NONE, fmt.Errorf("error getting ACL for repo \"%s\": %v", repo, err)
+	}
+	repoScope, err := a.getScope(ctx, subject, &repoACL)
+	if err != nil || branch == "" || repoScope == authclient.Scope_OWNER {
+		return repoScope, err
+	}
+	var branchACL authclient.ACL
+	if err := acls.Get(target, &branchACL); err != nil && !col.IsErrNotFound(err) {
+		return authclient.Scope_NONE, fmt.Errorf("error getting ACL for branch \"%s\": %v", target, err)
+	}
+	if len(branchACL.Entries) == 0 {
+		return repoScope, nil
+	}
+	return a.getScope(ctx, subject, &branchACL)
+}
+
+// canonicalizeGroup strips the group prefix from 'group' (if present), and
+// validates the resulting group name
+func canonicalizeGroup(group string) (string, error) {
+	group = strings.TrimPrefix(group, authclient.GroupPrefix)
+	if group == "" {
+		return "", fmt.Errorf("invalid request: must set group")
+	}
+	if strings.ContainsAny(group, ":/") {
+		return "", fmt.Errorf("invalid group name \"%s\": group names may not contain ':' or '/'", group)
+	}
+	return group, nil
+}
+
+func (a *apiServer) ModifyMembers(ctx context.Context, req *authclient.ModifyMembersRequest) (resp *authclient.ModifyMembersResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if !a.isActivated() {
+		return nil, authclient.ErrNotActivated
+	}
+
+	// Get calling user. The user must be an admin to modify groups
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !a.isAdmin(callerInfo.Subject) {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "ModifyMembers",
+		}
+	}
+	group, err := canonicalizeGroup(req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	// Canonicalize usernames in request
+	eg := &errgroup.Group{}
+	canonicalizedToAdd := make([]string, len(req.Add))
+	for i, user := range req.Add {
+		i, user := i, user
+		eg.Go(func() error {
+			user, err := a.lenientCanonicalizeSubject(ctx, user)
+			if err != nil {
+				return err
+			}
+			canonicalizedToAdd[i] = user
+			return nil
+		})
+	}
+	canonicalizedToRemove := make([]string, len(req.Remove))
+	for i, user := range req.Remove {
+		i, user := i, user
+		eg.Go(func() error {
+			user, err := a.lenientCanonicalizeSubject(ctx, user)
+			if err != nil {
+				return err
+			}
+			canonicalizedToRemove[i] = user
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	for _, user := range canonicalizedToAdd {
+		if strings.HasPrefix(user, authclient.GroupPrefix) {
+			return nil, fmt.Errorf("invalid request: groups cannot be members of other groups")
+		}
+	}
+
+	// Update group and membership collections
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		groups := a.groups.ReadWrite(stm)
+		members := a.members.ReadWrite(stm)
+		var users authclient.Users
+		if err := groups.Get(group, &users); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		if users.Usernames == nil {
+			users.Usernames = make(map[string]bool)
+		}
+		for _, user := range canonicalizedToAdd {
+			users.Usernames[user] = true
+			var userGroups authclient.Groups
+			if err := members.Get(user, &userGroups); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			if userGroups.Groups == nil {
+				userGroups.Groups = make(map[string]bool)
+			}
+			userGroups.Groups[group] = true
+			if err := members.Put(user, &userGroups); err != nil {
+				return err
+			}
+		}
+		for _, user := range canonicalizedToRemove {
+			delete(users.Usernames, user)
+			if err := a.removeFromGroup(members, user, group); err != nil {
+				return err
+			}
+		}
+		if len(users.Usernames) == 0 {
+			if err := groups.Delete(group); err != nil && !col.IsErrNotFound(err) {
+				return err
+			}
+			return nil
+		}
+		return groups.Put(group, &users)
+	}); err != nil {
+		return nil, err
+	}
+	return &authclient.ModifyMembersResponse{}, nil
+}
+
+// removeFromGroup removes 'group' from the set of groups that 'user' is a
+// member of
+func (a *apiServer) removeFromGroup(members col.ReadWriteCollection, user string, group string) error {
+	var userGroups authclient.Groups
+	if err := members.Get(user, &userGroups); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	delete(userGroups.Groups, group)
+	if len(userGroups.Groups) == 0 {
+		return members.Delete(user)
+	}
+	return members.Put(user, &userGroups)
+}
+
+func (a *apiServer) DeleteGroup(ctx context.Context, req *authclient.DeleteGroupRequest) (resp *authclient.DeleteGroupResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if !a.isActivated() {
+		return nil, authclient.ErrNotActivated
+	}
+
+	// Get calling user. The user must be an admin to delete groups
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !a.isAdmin(callerInfo.Subject) {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "DeleteGroup",
+		}
+	}
+	group, err := canonicalizeGroup(req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	// Note that ACL entries for the group are not removed, but they no longer
+	// grant anyone access
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		groups := a.groups.ReadWrite(stm)
+		members := a.members.ReadWrite(stm)
+		var users authclient.Users
+		if err := groups.Get(group, &users); err != nil {
+			return err
+		}
+		for user := range users.Usernames {
+			if err := a.removeFromGroup(members, user, group); err != nil {
+				return err
+			}
+		}
+		return groups.Delete(group)
+	}); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, fmt.Errorf("group \"%s\" not found", group)
+		}
+		return nil, err
+	}
+	return &authclient.DeleteGroupResponse{}, nil
+}
+
+func (a *apiServer) GetGroups(ctx context.Context, req *authclient.GetGroupsRequest) (resp *authclient.GetGroupsResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if !a.isActivated() {
+		return nil, authclient.ErrNotActivated
+	}
+
+	// There is no auth check to list groups, other than that the user must log
+	// in (as with GetAdmins)
+	if _, err := a.getAuthenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+
+	resp = &authclient.GetGroupsResponse{}
+	if req.Username != "" {
+		username, err := a.lenientCanonicalizeSubject(ctx, req.Username)
+		if err != nil {
+			return nil, err
+		}
+		var groups authclient.Groups
+		if err := a.members.ReadOnly(ctx).Get(username, &groups); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		for group := range groups.Groups {
+			resp.Groups = append(resp.Groups, group)
+		}
+	} else {
+		iter, err := a.groups.ReadOnly(ctx).List()
+		if err != nil {
+			return nil, err
+		}
+		for {
+			var group string
+			var users authclient.Users
+			ok, err := iter.Next(&group, &users)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			resp.Groups = append(resp.Groups, group)
+		}
+	}
+	sort.Strings(resp.Groups)
+	return resp, nil
+}
+
+func (a *apiServer) GetUsers(ctx context.Context, req *authclient.GetUsersRequest) (resp *authclient.GetUsersResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
+	if !a.isActivated() {
+		return nil, authclient.ErrNotActivated
+	}
+	if _, err := a.getAuthenticatedUser(ctx); err != nil {
+		return nil, err
+	}
+	group, err := canonicalizeGroup(req.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	var users authclient.Users
+	if err := a.groups.ReadOnly(ctx).Get(group, &users); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, fmt.Errorf("group \"%s\" not found", group)
+		}
+		return nil, err
+	}
+	resp = &authclient.GetUsersResponse{}
+	for user := range users.Usernames {
+		resp.Usernames = append(resp.Usernames, user)
+	}
+	sort.Strings(resp.Usernames)
+	return resp, nil
+}
+
 func (a *apiServer) GetAuthToken(ctx context.Context, req *authclient.GetAuthTokenRequest) (resp *authclient.GetAuthTokenResponse, retErr error) {
 	a.LogReq(req)
 	defer func(start time.Time) { a.LogResp(req, resp, retErr, time.Since(start)) }(time.Now())
@@ -1054,7 +1460,7 @@ func (a *apiServer) GetAuthToken(ctx context.Context, req *authclient.GetAuthTok
 			AdminOp: "GetAuthToken on behalf of another user",
 		}
 	}
-	subject, err := lenientCanonicalizeSubject(ctx, req.Subject)
+	subject, err := a.lenientCanonicalizeSubject(ctx, req.Subject)
 	if err != nil {
 		return nil, err
 	}
@@ -1218,10 +1624,15 @@ func (a *apiServer) getAuthenticatedUser(ctx context.Context) (*authclient.Token
 }
 
 // lenientCanonicalizeSubject is like 'canonicalizeUsername', except that if
-// 'subject' has no prefix, they are assumed to be a GitHub user.
-func lenientCanonicalizeSubject(ctx context.Context, subject string) (string, error) {
+// 'subject' has no prefix, they are assumed to be a user of the cluster's
+// identity provider (e.g. a GitHub user).
+func (a *apiServer) lenientCanonicalizeSubject(ctx context.Context, subject string) (string, error) {
 	if strings.Index(subject, ":") < 0 {
-		subject = authclient.GitHubPrefix + subject
+		idp, err := a.getIdentityProvider(ctx)
+		if err != nil {
+			return "", err
+		}
+		subject = idp.prefix() + subject
 	}
 	return canonicalizeSubject(ctx, subject)
 }
@@ -1238,6 +1649,12 @@ func canonicalizeSubject(ctx context.Context, subject string) (string, error) {
 		if err != nil {
 			return "", err
 		}
+	case strings.HasPrefix(subject, authclient.GroupPrefix):
+		if _, err := canonicalizeGroup(subject); err != nil {
+			return "", err
+		}
+	case strings.HasPrefix(subject, authclient.OIDCPrefix):
+		fallthrough
 	case strings.HasPrefix(subject, authclient.PipelinePrefix):
 		fallthrough
 	case strings.HasPrefix(subject, authclient.RobotPrefix):
@@ -1245,7 +1662,7 @@ func canonicalizeSubject(ctx context.Context, subject string) (string, error) {
 	case colonIdx > 0:
 		return "", fmt.Errorf("subject has unrecognized prefix: %s", subject[:colonIdx+1])
 	default:
-		return "", fmt.Errorf("subject must have one of the prefixes \"github:\" or \"pachyderm_robot:\"")
+		return "", fmt.Errorf("subject must have one of the prefixes \"github:\", \"oidc:\", \"group:\" or \"pachyderm_robot:\"")
 	}
 	return subject, nil
 }
@@ -0,0 +1,379 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	logrus "github.com/sirupsen/logrus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+const (
+	auditPrefix = "/audit"
+
+	// defaultAuditLogLimit is the number of events returned by GetAuditLog if
+	// the request doesn't set a limit
+	defaultAuditLogLimit = 1000
+)
+
+var (
+	// auditUsernameIndex indexes audit events by the user that made the call,
+	// so that one user's calls can be read without scanning the whole log
+	auditUsernameIndex = col.Index{Field: "Username"}
+
+	// auditedServices are the gRPC services whose calls are recorded in the
+	// audit log. Internal services (e.g. pfs.ObjectAPI, which workers use to
+	// upload data) aren't audited
+	auditedServices = []string{"/pfs.API/", "/pps.API/", "/auth.API/", "/admin.API/", "/enterprise.API/", "/notify.API/"}
+
+	// auditedMethodPrefixes identify the RPCs that change the state of the
+	// cluster (or expose it wholesale). Read-only RPCs aren't audited, as they
+	// vastly outnumber writes
+	auditedMethodPrefixes = []string{
+		"Activate", "Build", "Copy", "Create", "Deactivate", "Delete", "Extract",
+		"Finish", "GarbageCollect", "GetAuthToken", "Modify", "Put", "Rerun",
+		"Restart", "Restore", "Revoke", "Rollback", "Set", "Squash", "Start",
+		"Stop",
+	}
+)
+
+// APIServer is the auth API server. In addition to the auth API, it records
+// an audit log of the state-changing calls made to every pachd service, via
+// the gRPC interceptors it returns
+type APIServer interface {
+	authclient.APIServer
+
+	// AuditUnaryInterceptor and AuditStreamInterceptor record calls in the
+	// audit log, and should be installed on pachd's gRPC server
+	AuditUnaryInterceptor() grpc.UnaryServerInterceptor
+	AuditStreamInterceptor() grpc.StreamServerInterceptor
+}
+
+// isAudited returns true if calls to 'fullMethod' are recorded in the audit
+// log
+func isAudited(fullMethod string) bool {
+	for _, service := range auditedServices {
+		if !strings.HasPrefix(fullMethod, service) {
+			continue
+		}
+		method := strings.TrimPrefix(fullMethod, service)
+		for _, prefix := range auditedMethodPrefixes {
+			if strings.HasPrefix(method, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isDryRun returns true if 'req' only validates its arguments (e.g. a
+// CreatePipelineRequest with DryRun set), so it changes nothing and isn't
+// audited
+func isDryRun(req interface{}) bool {
+	if req, ok := req.(*pps.CreatePipelineRequest); ok {
+		return req.DryRun
+	}
+	return false
+}
+
+// auditArgs sets the key arguments of 'event' (repo, branch, commit and
+// pipeline) from the request 'req'
+func auditArgs(req interface{}, event *authclient.AuditEvent) {
+	setCommit := func(commit *pfs.Commit) {
+		if commit == nil {
+			return
+		}
+		if commit.Repo != nil {
+			event.Repo = commit.Repo.Name
+		}
+		event.Commit = commit.ID
+	}
+	setFile := func(file *pfs.File) {
+		if file != nil {
+			setCommit(file.Commit)
+		}
+	}
+	switch r := req.(type) {
+	case interface{ GetRepo() *pfs.Repo }:
+		if r.GetRepo() != nil {
+			event.Repo = r.GetRepo().Name
+		}
+	case interface{ GetRepo() string }:
+		event.Repo = r.GetRepo()
+	}
+	if r, ok := req.(interface{ GetCommit() *pfs.Commit }); ok {
+		setCommit(r.GetCommit())
+	}
+	if r, ok := req.(interface{ GetParent() *pfs.Commit }); ok {
+		setCommit(r.GetParent())
+	}
+	if r, ok := req.(interface{ GetHead() *pfs.Commit }); ok {
+		setCommit(r.GetHead())
+	}
+	if r, ok := req.(interface{ GetFile() *pfs.File }); ok {
+		setFile(r.GetFile())
+	}
+	if r, ok := req.(interface{ GetDst() *pfs.File }); ok {
+		setFile(r.GetDst())
+	}
+	switch r := req.(type) {
+	case interface{ GetBranch() *pfs.Branch }:
+		if branch := r.GetBranch(); branch != nil {
+			if branch.Repo != nil {
+				event.Repo = branch.Repo.Name
+			}
+			event.Branch = branch.Name
+		}
+	case interface{ GetBranch() string }:
+		event.Branch = r.GetBranch()
+	}
+	if r, ok := req.(interface{ GetReplication() *pfs.Replication }); ok {
+		if replication := r.GetReplication(); replication != nil && replication.Branch != nil {
+			if replication.Branch.Repo != nil {
+				event.Repo = replication.Branch.Repo.Name
+			}
+			event.Branch = replication.Branch.Name
+		}
+	}
+	if r, ok := req.(interface{ GetPipeline() *pps.Pipeline }); ok && r.GetPipeline() != nil {
+		event.Pipeline = r.GetPipeline().Name
+	}
+}
+
+// newAuditEvent returns an audit event for a call to 'fullMethod' made in
+// 'ctx'. The caller is looked up before the call is handled, so that calls
+// which invalidate the caller's token (e.g. Deactivate) are still attributed
+// correctly. newAuditEvent returns nil if the call shouldn't be recorded
+func (a *apiServer) newAuditEvent(ctx context.Context, fullMethod string) *authclient.AuditEvent {
+	if !a.isActivated() || !isAudited(fullMethod) {
+		return nil
+	}
+	event := &authclient.AuditEvent{Method: fullMethod}
+	if callerInfo, err := a.getAuthenticatedUser(ctx); err == nil {
+		event.Username = callerInfo.Subject
+	}
+	return event
+}
+
+// startAuditEvent writes 'event' to the audit log as pending, before the
+// call that it records is handled, so that the call is recorded even if pachd
+// stops while handling it. It returns the key of the event, which
+// finishAuditEvent updates. If the event can't be written, the call must not
+// be handled
+func (a *apiServer) startAuditEvent(event *authclient.AuditEvent) (string, error) {
+	ts, err := types.TimestampProto(time.Now())
+	if err != nil {
+		return "", err
+	}
+	event.Time = ts
+	event.Pending = true
+	key := auditKey(event)
+	if err := a.writeAuditEvent(key, event); err != nil {
+		return "", fmt.Errorf("could not record the call to %s in the audit log: %v", event.Method, err)
+	}
+	return key, nil
+}
+
+// finishAuditEvent updates the event at 'key' in the audit log with the
+// outcome of the call that it records
+func (a *apiServer) finishAuditEvent(key string, event *authclient.AuditEvent, callErr error) error {
+	event.Pending = false
+	if callErr != nil {
+		event.Error = callErr.Error()
+	}
+	if err := a.writeAuditEvent(key, event); err != nil {
+		return fmt.Errorf("the call to %s was handled, but its outcome could not be recorded in the audit log: %v", event.Method, err)
+	}
+	return nil
+}
+
+// writeAuditEvent writes 'event' to the audit log at 'key'. Events expire
+// from the log after a.auditRetention, unless it's 0 (the default)
+func (a *apiServer) writeAuditEvent(key string, event *authclient.AuditEvent) error {
+	ttl := int64(a.auditRetention / time.Second)
+	// The event is written even if the caller has gone away, so it doesn't
+	// use the call's context
+	_, err := col.NewSTM(context.Background(), a.etcdClient, func(stm col.STM) error {
+		return a.auditEvents.ReadWrite(stm).PutTTL(key, event, ttl)
+	})
+	return err
+}
+
+// auditKey returns the key of 'event' in the audit log. Keys sort by time, so
+// that the log can be read in order, and a range of keys holds the events
+// from a range of time (see auditTimeKey). The random suffix distinguishes
+// calls made at the same instant
+func auditKey(event *authclient.AuditEvent) string {
+	return fmt.Sprintf("%s-%s", auditTimeKey(event.Time), uuid.NewWithoutDashes())
+}
+
+// auditTimeKey returns a key that sorts after the keys of the events that
+// happened before 't', and before the keys of the events that happened at or
+// after 't'
+func auditTimeKey(t *types.Timestamp) string {
+	return fmt.Sprintf("%020d", t.Seconds*int64(time.Second)+int64(t.Nanos))
+}
+
+func (a *apiServer) AuditUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var event *authclient.AuditEvent
+		if !isDryRun(req) {
+			event = a.newAuditEvent(ctx, info.FullMethod)
+		}
+		if event == nil {
+			return handler(ctx, req)
+		}
+		auditArgs(req, event)
+		key, err := a.startAuditEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := handler(ctx, req)
+		if auditErr := a.finishAuditEvent(key, event, err); auditErr != nil {
+			if err != nil {
+				// The call already failed, so return its error
+				logrus.Error(auditErr)
+				return nil, err
+			}
+			return nil, auditErr
+		}
+		return resp, err
+	}
+}
+
+// auditServerStream wraps a grpc.ServerStream and saves the key arguments of
+// the first message received on the stream (e.g. the first PutFileRequest)
+type auditServerStream struct {
+	grpc.ServerStream
+	event    *authclient.AuditEvent
+	received bool
+}
+
+func (s *auditServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.received {
+		s.received = true
+		auditArgs(m, s.event)
+	}
+	return nil
+}
+
+func (a *apiServer) AuditStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		event := a.newAuditEvent(stream.Context(), info.FullMethod)
+		if event == nil {
+			return handler(srv, stream)
+		}
+		// The key arguments of a streaming call are only known once its first
+		// message is received, so they're recorded when the call finishes
+		key, err := a.startAuditEvent(event)
+		if err != nil {
+			return err
+		}
+		err = handler(srv, &auditServerStream{ServerStream: stream, event: event})
+		if auditErr := a.finishAuditEvent(key, event, err); auditErr != nil {
+			if err != nil {
+				logrus.Error(auditErr)
+				return err
+			}
+			return auditErr
+		}
+		return err
+	}
+}
+
+func (a *apiServer) GetAuditLog(ctx context.Context, req *authclient.GetAuditLogRequest) (resp *authclient.GetAuditLogResponse, retErr error) {
+	a.LogReq(req)
+	defer func(start time.Time) { a.LogResp(req, nil, retErr, time.Since(start)) }(time.Now())
+	if !a.isActivated() {
+		return nil, authclient.ErrNotActivated
+	}
+
+	// Get calling user. The user must be an admin to read the audit log
+	callerInfo, err := a.getAuthenticatedUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !a.isAdmin(callerInfo.Subject) {
+		return nil, &authclient.ErrNotAuthorized{
+			Subject: callerInfo.Subject,
+			AdminOp: "GetAuditLog",
+		}
+	}
+
+	// Event keys sort by time, so the events in [req.Start, req.End) are the
+	// ones whose keys are in [startKey, endKey)
+	var startKey, endKey string
+	if req.Start != nil {
+		if _, err := types.TimestampFromProto(req.Start); err != nil {
+			return nil, fmt.Errorf("invalid start time: %v", err)
+		}
+		startKey = auditTimeKey(req.Start)
+	}
+	if req.End != nil {
+		if _, err := types.TimestampFromProto(req.End); err != nil {
+			return nil, fmt.Errorf("invalid end time: %v", err)
+		}
+		endKey = auditTimeKey(req.End)
+	}
+	if req.PageToken != "" {
+		if req.PageToken < startKey {
+			return nil, fmt.Errorf("invalid page token %q", req.PageToken)
+		}
+		startKey = req.PageToken + "\x00" // the key right after the token
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultAuditLogLimit
+	}
+
+	// Read one event more than the limit, to find out if there's another page
+	auditEvents := a.auditEvents.ReadOnly(ctx)
+	var iter col.Iterator
+	if req.Username != "" {
+		username, err := a.lenientCanonicalizeSubject(ctx, req.Username)
+		if err != nil {
+			return nil, err
+		}
+		iter, err = auditEvents.GetByIndexRange(auditUsernameIndex, username, startKey, endKey, limit+1)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		iter, err = auditEvents.ListRange(startKey, endKey, limit+1)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp = &authclient.GetAuditLogResponse{}
+	var lastKey string
+	for {
+		var key string
+		event := &authclient.AuditEvent{}
+		ok, err := iter.Next(&key, event)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if int64(len(resp.Events)) == limit {
+			resp.NextPageToken = lastKey
+			break
+		}
+		resp.Events = append(resp.Events, event)
+		lastKey = key
+	}
+	return resp, nil
+}
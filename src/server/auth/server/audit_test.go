@@ -0,0 +1,88 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestIsAudited(t *testing.T) {
+	require.True(t, isAudited("/pfs.API/PutFile"))
+	require.True(t, isAudited("/pfs.API/CreateRepo"))
+	require.True(t, isAudited("/pps.API/CreatePipeline"))
+	require.True(t, isAudited("/auth.API/SetScope"))
+	require.True(t, isAudited("/auth.API/GetAuthToken"))
+	require.True(t, isAudited("/admin.API/Extract"))
+	require.True(t, isAudited("/notify.API/CreateSink"))
+	require.True(t, isAudited("/pfs.API/SquashCommits"))
+	require.True(t, isAudited("/pps.API/RollbackPipeline"))
+	require.False(t, isAudited("/pfs.API/GetFile"))
+	require.False(t, isAudited("/pfs.API/InspectCommit"))
+	require.False(t, isAudited("/pps.API/ListJob"))
+	require.False(t, isAudited("/auth.API/GetAuditLog"))
+	require.False(t, isAudited("/pfs.ObjectAPI/PutObject"))
+	require.False(t, isAudited("/grpc.health.v1.Health/Check"))
+}
+
+func TestIsDryRun(t *testing.T) {
+	require.True(t, isDryRun(&pps.CreatePipelineRequest{DryRun: true}))
+	require.False(t, isDryRun(&pps.CreatePipelineRequest{}))
+	require.False(t, isDryRun(&pfs.CreateRepoRequest{}))
+}
+
+func TestAuditArgs(t *testing.T) {
+	event := &auth.AuditEvent{}
+	auditArgs(&pfs.PutFileRequest{File: client.NewFile("repo", "master", "/file")}, event)
+	require.Equal(t, "repo", event.Repo)
+	require.Equal(t, "master", event.Commit)
+
+	event = &auth.AuditEvent{}
+	auditArgs(&pfs.StartCommitRequest{Parent: client.NewCommit("repo", ""), Branch: "master"}, event)
+	require.Equal(t, "repo", event.Repo)
+	require.Equal(t, "master", event.Branch)
+
+	event = &auth.AuditEvent{}
+	auditArgs(&pfs.CreateBranchRequest{
+		Head:   client.NewCommit("repo", "abc"),
+		Branch: client.NewBranch("repo", "feature"),
+	}, event)
+	require.Equal(t, "repo", event.Repo)
+	require.Equal(t, "feature", event.Branch)
+	require.Equal(t, "abc", event.Commit)
+
+	event = &auth.AuditEvent{}
+	auditArgs(&pfs.CreateReplicationRequest{Replication: &pfs.Replication{
+		Branch: client.NewBranch("repo", "master"),
+		Remote: "dr:650",
+	}}, event)
+	require.Equal(t, "repo", event.Repo)
+	require.Equal(t, "master", event.Branch)
+
+	event = &auth.AuditEvent{}
+	auditArgs(&pps.CreatePipelineRequest{Pipeline: client.NewPipeline("pipeline")}, event)
+	require.Equal(t, "pipeline", event.Pipeline)
+
+	event = &auth.AuditEvent{}
+	auditArgs(&auth.SetScopeRequest{Repo: "repo", Username: "alice"}, event)
+	require.Equal(t, "repo", event.Repo)
+}
+
+func TestAuditKey(t *testing.T) {
+	event := func(seconds int64, nanos int32) *auth.AuditEvent {
+		return &auth.AuditEvent{Time: &types.Timestamp{Seconds: seconds, Nanos: nanos}}
+	}
+	// Keys sort by time, and time keys separate the events before a time from
+	// those at or after it
+	require.True(t, auditKey(event(100, 5)) < auditKey(event(100, 6)))
+	require.True(t, auditKey(event(99, 999999999)) < auditKey(event(100, 0)))
+	require.True(t, auditKey(event(9, 0)) < auditKey(event(10, 0)))
+	require.True(t, auditKey(event(99, 999999999)) < auditTimeKey(event(100, 0).Time))
+	require.True(t, auditTimeKey(event(100, 0).Time) < auditKey(event(100, 0)))
+	require.NotEqual(t, auditKey(event(100, 0)), auditKey(event(100, 0)))
+}
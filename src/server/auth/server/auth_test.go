@@ -1380,6 +1380,157 @@ func TestGetScopeRequiresReader(t *testing.T) {
 	require.Matches(t, "not authorized", err.Error())
 }
 
+// TestGroupACL tests that users get the scope granted to groups that they're
+// members of, and lose it when they're removed from the group
+func TestGroupACL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	alice, bob := tu.UniqueString("alice"), tu.UniqueString("bob")
+	aliceClient, bobClient := getPachClient(t, alice), getPachClient(t, bob)
+	adminClient := getPachClient(t, admin)
+	group := tu.UniqueString("group")
+
+	// alice creates a repo and makes 'group' a reader
+	repo := tu.UniqueString("TestGroupACL")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	_, err := aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     repo,
+		Scope:    auth.Scope_READER,
+		Username: auth.GroupPrefix + group,
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t,
+		entries(alice, "owner", auth.GroupPrefix+group, "reader"), GetACL(t, aliceClient, repo))
+
+	// bob isn't in the group yet, so he can't read the repo
+	resp, err := bobClient.GetScope(bobClient.Ctx(), &auth.GetScopeRequest{
+		Repos: []string{repo},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []auth.Scope{auth.Scope_NONE}, resp.Scopes)
+
+	// bob can't add himself to the group (only admins can modify groups)
+	_, err = bobClient.ModifyMembers(bobClient.Ctx(), &auth.ModifyMembersRequest{
+		Group: group,
+		Add:   []string{bob},
+	})
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+
+	// admin adds bob to the group; now bob can read the repo
+	_, err = adminClient.ModifyMembers(adminClient.Ctx(), &auth.ModifyMembersRequest{
+		Group: group,
+		Add:   []string{bob},
+	})
+	require.NoError(t, err)
+	groupsResp, err := bobClient.GetGroups(bobClient.Ctx(), &auth.GetGroupsRequest{
+		Username: bob,
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{group}, groupsResp.Groups)
+	usersResp, err := bobClient.GetUsers(bobClient.Ctx(), &auth.GetUsersRequest{
+		Group: group,
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, []string{gh(bob)}, usersResp.Usernames)
+	resp, err = bobClient.GetScope(bobClient.Ctx(), &auth.GetScopeRequest{
+		Repos: []string{repo},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []auth.Scope{auth.Scope_READER}, resp.Scopes)
+	authResp, err := bobClient.Authorize(bobClient.Ctx(), &auth.AuthorizeRequest{
+		Repo:  repo,
+		Scope: auth.Scope_READER,
+	})
+	require.NoError(t, err)
+	require.True(t, authResp.Authorized)
+
+	// admin deletes the group; bob can no longer read the repo
+	_, err = adminClient.DeleteGroup(adminClient.Ctx(), &auth.DeleteGroupRequest{
+		Group: group,
+	})
+	require.NoError(t, err)
+	groupsResp, err = bobClient.GetGroups(bobClient.Ctx(), &auth.GetGroupsRequest{
+		Username: bob,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(groupsResp.Groups))
+	resp, err = bobClient.GetScope(bobClient.Ctx(), &auth.GetScopeRequest{
+		Repos: []string{repo},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []auth.Scope{auth.Scope_NONE}, resp.Scopes)
+}
+
+// TestBranchACL tests that a branch ACL ("repo@branch") restricts writes to
+// that branch, while other branches of the repo still use the repo's ACL
+func TestBranchACL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	deleteAll(t)
+	alice, bob, carol := tu.UniqueString("alice"), tu.UniqueString("bob"), tu.UniqueString("carol")
+	aliceClient, bobClient, carolClient := getPachClient(t, alice), getPachClient(t, bob), getPachClient(t, carol)
+
+	// alice creates a repo, and makes bob and carol writers
+	repo := tu.UniqueString("TestBranchACL")
+	require.NoError(t, aliceClient.CreateRepo(repo))
+	for _, user := range []string{bob, carol} {
+		_, err := aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+			Repo:     repo,
+			Scope:    auth.Scope_WRITER,
+			Username: user,
+		})
+		require.NoError(t, err)
+	}
+
+	// bob can't protect master, as he isn't an owner of the repo
+	_, err := bobClient.SetScope(bobClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     auth.RepoBranch(repo, "master"),
+		Scope:    auth.Scope_WRITER,
+		Username: bob,
+	})
+	require.YesError(t, err)
+
+	// alice protects master, so that only carol can write to it
+	_, err = aliceClient.SetScope(aliceClient.Ctx(), &auth.SetScopeRequest{
+		Repo:     auth.RepoBranch(repo, "master"),
+		Scope:    auth.Scope_WRITER,
+		Username: carol,
+	})
+	require.NoError(t, err)
+	require.ElementsEqual(t, entries(carol, "writer"), GetACL(t, aliceClient, auth.RepoBranch(repo, "master")))
+
+	// bob can write to dev, but not to master
+	_, err = bobClient.StartCommit(repo, "master")
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	commit, err := bobClient.StartCommit(repo, "dev")
+	require.NoError(t, err)
+	_, err = bobClient.PutFile(repo, commit.ID, "/file", strings.NewReader("lorem ipsum"))
+	require.NoError(t, err)
+	require.NoError(t, bobClient.FinishCommit(repo, commit.ID))
+	require.YesError(t, bobClient.SetBranch(repo, commit.ID, "master"))
+
+	// carol can move master, and alice (an owner) can still write to master
+	require.NoError(t, carolClient.SetBranch(repo, commit.ID, "master"))
+	_, err = bobClient.PutFile(repo, "master", "/file", strings.NewReader("lorem ipsum"))
+	require.YesError(t, err)
+	require.Matches(t, "not authorized", err.Error())
+	commit, err = aliceClient.StartCommit(repo, "master")
+	require.NoError(t, err)
+	require.NoError(t, aliceClient.FinishCommit(repo, commit.ID))
+
+	// bob's scope on master is NONE, but his scope on the repo is unchanged
+	resp, err := bobClient.GetScope(bobClient.Ctx(), &auth.GetScopeRequest{
+		Repos: []string{repo, auth.RepoBranch(repo, "master"), auth.RepoBranch(repo, "dev")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []auth.Scope{auth.Scope_WRITER, auth.Scope_NONE, auth.Scope_WRITER}, resp.Scopes)
+}
+
 // TestListRepoNotLoggedInError makes sure that if a user isn't logged in, and
 // they call ListRepo(), they get an error.
 func TestListRepoNotLoggedInError(t *testing.T) {
@@ -0,0 +1,70 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+	"golang.org/x/net/context"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+)
+
+// identityProvider is an external service that authenticates Pachyderm users.
+// Exactly one identity provider is used by a cluster, and it's chosen when
+// auth is activated
+type identityProvider interface {
+	// prefix is the subject prefix of users authenticated by this provider
+	// (e.g. authclient.GitHubPrefix)
+	prefix() string
+
+	// authenticate verifies 'token' (a credential issued by the identity
+	// provider) and returns the Pachyderm subject (including prefix) of the
+	// user that it was issued to
+	authenticate(ctx context.Context, token string) (string, error)
+}
+
+// githubProvider authenticates users with GitHub OAuth access tokens. This is
+// the default identity provider
+type githubProvider struct{}
+
+func (githubProvider) prefix() string {
+	return authclient.GitHubPrefix
+}
+
+func (githubProvider) authenticate(ctx context.Context, token string) (string, error) {
+	return GitHubTokenToUsername(ctx, token)
+}
+
+// newIdentityProvider returns the identity provider configured by
+// 'oidcConfig' (the GitHub provider if 'oidcConfig' is nil). OIDC providers
+// are cached, so that the provider's signing keys are only retrieved once
+func (a *apiServer) newIdentityProvider(oidcConfig *authclient.OIDCConfig) (identityProvider, error) {
+	if oidcConfig == nil {
+		return githubProvider{}, nil
+	}
+	a.oidcMu.Lock()
+	defer a.oidcMu.Unlock()
+	if a.oidcProvider != nil && proto.Equal(a.oidcProvider.config, oidcConfig) {
+		return a.oidcProvider, nil
+	}
+	p, err := newOIDCProvider(oidcConfig)
+	if err != nil {
+		return nil, err
+	}
+	a.oidcProvider = p
+	return p, nil
+}
+
+// getIdentityProvider returns the identity provider that the cluster was
+// activated with
+func (a *apiServer) getIdentityProvider(ctx context.Context) (identityProvider, error) {
+	oidcConfig := &authclient.OIDCConfig{}
+	if err := a.oidcConfig.ReadOnly(ctx).Get(oidcConfigKey, oidcConfig); err != nil {
+		if col.IsErrNotFound(err) {
+			return a.newIdentityProvider(nil)
+		}
+		return nil, fmt.Errorf("could not read identity provider config: %v", err)
+	}
+	return a.newIdentityProvider(oidcConfig)
+}
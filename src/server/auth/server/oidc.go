@@ -0,0 +1,355 @@
+package server
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+	"golang.org/x/net/context/ctxhttp"
+
+	authclient "github.com/pachyderm/pachyderm/src/client/auth"
+)
+
+const (
+	// defaultUsernameClaim is the ID token claim used as a user's username if
+	// OIDCConfig.UsernameClaim is unset
+	defaultUsernameClaim = "sub"
+
+	// oidcClockSkew is how far the identity provider's clock may be from
+	// pachd's clock when checking an ID token's expiration
+	oidcClockSkew = 2 * time.Minute
+)
+
+// oidcProvider authenticates users with ID tokens issued by an OpenID Connect
+// identity provider
+type oidcProvider struct {
+	config     *authclient.OIDCConfig
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	jwksURI string                      // from the discovery document
+	keys    map[string]crypto.PublicKey // signing keys, by key ID
+}
+
+func newOIDCProvider(config *authclient.OIDCConfig) (*oidcProvider, error) {
+	if config.Issuer == "" {
+		return nil, fmt.Errorf("OIDC issuer must be set")
+	}
+	if config.ClientID == "" {
+		return nil, fmt.Errorf("OIDC client ID must be set")
+	}
+	return &oidcProvider{
+		config:     config,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+func (p *oidcProvider) prefix() string {
+	return authclient.OIDCPrefix
+}
+
+// idTokenHeader is the JOSE header of an ID token
+type idTokenHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid"`
+}
+
+func (p *oidcProvider) authenticate(ctx context.Context, token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", fmt.Errorf("malformed ID token: expected 3 parts but got %d", len(parts))
+	}
+	var header idTokenHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return "", fmt.Errorf("malformed ID token header: %v", err)
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("malformed ID token signature: %v", err)
+	}
+	key, err := p.key(ctx, header.Kid)
+	if err != nil {
+		return "", err
+	}
+	if err := verifySignature(header.Alg, key, []byte(parts[0]+"."+parts[1]), signature); err != nil {
+		return "", fmt.Errorf("invalid ID token: %v", err)
+	}
+
+	// The signature is valid; check the token's claims
+	claims := make(map[string]interface{})
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return "", fmt.Errorf("malformed ID token claims: %v", err)
+	}
+	if err := p.checkClaims(claims, time.Now()); err != nil {
+		return "", fmt.Errorf("invalid ID token: %v", err)
+	}
+	usernameClaim := p.config.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+	username, ok := claims[usernameClaim].(string)
+	if !ok || username == "" {
+		return "", fmt.Errorf("ID token has no \"%s\" claim", usernameClaim)
+	}
+	return authclient.OIDCPrefix + username, nil
+}
+
+// checkClaims checks that an ID token with the claims 'claims' was issued by
+// p's identity provider for Pachyderm, and is valid at time 'now'
+func (p *oidcProvider) checkClaims(claims map[string]interface{}, now time.Time) error {
+	if iss, _ := claims["iss"].(string); iss != p.config.Issuer {
+		return fmt.Errorf("token was issued by \"%s\", not \"%s\"", iss, p.config.Issuer)
+	}
+	var audiences []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		audiences = append(audiences, aud)
+	case []interface{}:
+		for _, a := range aud {
+			if a, ok := a.(string); ok {
+				audiences = append(audiences, a)
+			}
+		}
+	}
+	hasAudience := false
+	for _, aud := range audiences {
+		if aud == p.config.ClientID {
+			hasAudience = true
+			break
+		}
+	}
+	if !hasAudience {
+		return fmt.Errorf("token audience %v does not include \"%s\"", audiences, p.config.ClientID)
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return fmt.Errorf("token has no expiration")
+	}
+	if now.Add(-oidcClockSkew).After(time.Unix(int64(exp), 0)) {
+		return fmt.Errorf("token expired at %v", time.Unix(int64(exp), 0))
+	}
+	if nbf, ok := claims["nbf"].(float64); ok && now.Add(oidcClockSkew).Before(time.Unix(int64(nbf), 0)) {
+		return fmt.Errorf("token is not valid until %v", time.Unix(int64(nbf), 0))
+	}
+	return nil
+}
+
+// key returns the identity provider's signing key with ID 'kid'. If the key
+// isn't cached (e.g. because the provider has rotated its keys), p's keys are
+// refreshed
+func (p *oidcProvider) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if err := p.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("ID token was signed with unknown key \"%s\"", kid)
+}
+
+// lookupKey returns the cached key with ID 'kid'. If 'kid' is empty, the
+// provider must have exactly one key. p.mu must be held
+func (p *oidcProvider) lookupKey(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// discoveryDocument contains the fields of an OpenID Connect discovery
+// document that Pachyderm uses
+type discoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+// jsonWebKey is a public key in a JSON Web Key Set
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// refreshKeys retrieves the identity provider's discovery document (if it
+// hasn't been retrieved yet) and signing keys. p.mu must be held
+func (p *oidcProvider) refreshKeys(ctx context.Context) error {
+	if p.jwksURI == "" {
+		var doc discoveryDocument
+		discoveryURL := strings.TrimSuffix(p.config.Issuer, "/") + "/.well-known/openid-configuration"
+		if err := p.getJSON(ctx, discoveryURL, &doc); err != nil {
+			return fmt.Errorf("could not retrieve OIDC discovery document: %v", err)
+		}
+		if doc.Issuer != p.config.Issuer {
+			return fmt.Errorf("OIDC discovery document is for issuer \"%s\", not \"%s\"", doc.Issuer, p.config.Issuer)
+		}
+		if doc.JWKSURI == "" {
+			return fmt.Errorf("OIDC discovery document has no jwks_uri")
+		}
+		p.jwksURI = doc.JWKSURI
+	}
+	var keySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := p.getJSON(ctx, p.jwksURI, &keySet); err != nil {
+		return fmt.Errorf("could not retrieve OIDC signing keys: %v", err)
+	}
+	keys := make(map[string]crypto.PublicKey)
+	for _, jwk := range keySet.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			return fmt.Errorf("invalid OIDC signing key \"%s\": %v", jwk.Kid, err)
+		}
+		if key != nil {
+			keys[jwk.Kid] = key
+		}
+	}
+	p.keys = keys
+	return nil
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, v interface{}) error {
+	resp, err := ctxhttp.Get(ctx, p.httpClient, url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s returned %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// publicKey converts 'k' to an *rsa.PublicKey or *ecdsa.PublicKey. Keys of
+// other types are ignored (publicKey returns nil)
+func (k *jsonWebKey) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() || e.Int64() > 1<<31-1 {
+			return nil, fmt.Errorf("invalid RSA exponent")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve \"%s\"", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	}
+	return nil, nil
+}
+
+// verifySignature checks that 'signature' is a valid signature of 'signed'
+// by 'key', using the JWS algorithm 'alg'. Only asymmetric algorithms are
+// supported, as ID tokens signed with a shared secret (or unsigned) can't be
+// verified by Pachyderm
+func verifySignature(alg string, key crypto.PublicKey, signed []byte, signature []byte) error {
+	if len(alg) != 5 {
+		return fmt.Errorf("unsupported signing algorithm \"%s\"", alg)
+	}
+	var hash crypto.Hash
+	switch alg[2:] {
+	case "256":
+		hash = crypto.SHA256
+	case "384":
+		hash = crypto.SHA384
+	case "512":
+		hash = crypto.SHA512
+	}
+	if hash == 0 {
+		return fmt.Errorf("unsupported signing algorithm \"%s\"", alg)
+	}
+	h := hash.New()
+	h.Write(signed)
+	digest := h.Sum(nil)
+	switch alg[:2] {
+	case "RS":
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("signing algorithm \"%s\" does not match key type", alg)
+		}
+		return rsa.VerifyPKCS1v15(rsaKey, hash, digest, signature)
+	case "ES":
+		ecKey, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("signing algorithm \"%s\" does not match key type", alg)
+		}
+		size := (ecKey.Curve.Params().BitSize + 7) / 8
+		if len(signature) != 2*size {
+			return fmt.Errorf("invalid signature length")
+		}
+		r := new(big.Int).SetBytes(signature[:size])
+		s := new(big.Int).SetBytes(signature[size:])
+		if !ecdsa.Verify(ecKey, digest, r, s) {
+			return fmt.Errorf("invalid signature")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported signing algorithm \"%s\"", alg)
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(data), nil
+}
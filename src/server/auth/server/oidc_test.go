@@ -0,0 +1,138 @@
+package server
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// mockOIDCServer is a minimal OpenID Connect identity provider, which serves a
+// discovery document and a single RSA signing key
+type mockOIDCServer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	kid string
+}
+
+func newMockOIDCServer(t *testing.T) *mockOIDCServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	m := &mockOIDCServer{key: key, kid: "test-key"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   m.URL,
+			"jwks_uri": m.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": m.kid,
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(m.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(m.key.E)).Bytes()),
+			}},
+		})
+	})
+	m.Server = httptest.NewServer(mux)
+	return m
+}
+
+// idToken returns an ID token with the claims 'claims', signed by 'key'
+func (m *mockOIDCServer) idToken(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+	signed := encode(map[string]string{"alg": "RS256", "kid": m.kid}) + "." + encode(claims)
+	digest := sha256.Sum256([]byte(signed))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func (m *mockOIDCServer) claims() map[string]interface{} {
+	return map[string]interface{}{
+		"iss":   m.URL,
+		"aud":   "pachyderm",
+		"sub":   "1234",
+		"email": "alice@example.com",
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestOIDCAuthenticate(t *testing.T) {
+	m := newMockOIDCServer(t)
+	defer m.Close()
+	p, err := newOIDCProvider(&auth.OIDCConfig{Issuer: m.URL, ClientID: "pachyderm"})
+	require.NoError(t, err)
+
+	subject, err := p.authenticate(context.Background(), m.idToken(t, m.key, m.claims()))
+	require.NoError(t, err)
+	require.Equal(t, auth.OIDCPrefix+"1234", subject)
+
+	// Custom username claim
+	p, err = newOIDCProvider(&auth.OIDCConfig{Issuer: m.URL, ClientID: "pachyderm", UsernameClaim: "email"})
+	require.NoError(t, err)
+	subject, err = p.authenticate(context.Background(), m.idToken(t, m.key, m.claims()))
+	require.NoError(t, err)
+	require.Equal(t, auth.OIDCPrefix+"alice@example.com", subject)
+}
+
+func TestOIDCAuthenticateInvalid(t *testing.T) {
+	m := newMockOIDCServer(t)
+	defer m.Close()
+	p, err := newOIDCProvider(&auth.OIDCConfig{Issuer: m.URL, ClientID: "pachyderm"})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	// Wrong audience
+	claims := m.claims()
+	claims["aud"] = []string{"someone-else"}
+	_, err = p.authenticate(ctx, m.idToken(t, m.key, claims))
+	require.YesError(t, err)
+
+	// Wrong issuer
+	claims = m.claims()
+	claims["iss"] = "https://example.com"
+	_, err = p.authenticate(ctx, m.idToken(t, m.key, claims))
+	require.YesError(t, err)
+
+	// Expired
+	claims = m.claims()
+	claims["exp"] = time.Now().Add(-time.Hour).Unix()
+	_, err = p.authenticate(ctx, m.idToken(t, m.key, claims))
+	require.YesError(t, err)
+
+	// Signed by a different key
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, err = p.authenticate(ctx, m.idToken(t, otherKey, m.claims()))
+	require.YesError(t, err)
+
+	// Missing username claim
+	p, err = newOIDCProvider(&auth.OIDCConfig{Issuer: m.URL, ClientID: "pachyderm", UsernameClaim: "preferred_username"})
+	require.NoError(t, err)
+	_, err = p.authenticate(ctx, m.idToken(t, m.key, m.claims()))
+	require.YesError(t, err)
+
+	// Malformed
+	_, err = p.authenticate(ctx, "not-a-token")
+	require.YesError(t, err)
+}
@@ -67,6 +67,31 @@ func (a *InactiveAPIServer) SetACL(ctx context.Context, req *auth.SetACLRequest)
 	return nil, auth.ErrNotActivated
 }
 
+// ModifyMembers implements the ModifyMembers RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) ModifyMembers(ctx context.Context, req *auth.ModifyMembersRequest) (resp *auth.ModifyMembersResponse, retErr error) {
+	return nil, auth.ErrNotActivated
+}
+
+// DeleteGroup implements the DeleteGroup RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) DeleteGroup(ctx context.Context, req *auth.DeleteGroupRequest) (resp *auth.DeleteGroupResponse, retErr error) {
+	return nil, auth.ErrNotActivated
+}
+
+// GetGroups implements the GetGroups RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetGroups(ctx context.Context, req *auth.GetGroupsRequest) (resp *auth.GetGroupsResponse, retErr error) {
+	return nil, auth.ErrNotActivated
+}
+
+// GetUsers implements the GetUsers RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetUsers(ctx context.Context, req *auth.GetUsersRequest) (resp *auth.GetUsersResponse, retErr error) {
+	return nil, auth.ErrNotActivated
+}
+
+// GetAuditLog implements the GetAuditLog RPC, but just returns NotActivatedError
+func (a *InactiveAPIServer) GetAuditLog(ctx context.Context, req *auth.GetAuditLogRequest) (resp *auth.GetAuditLogResponse, retErr error) {
+	return nil, auth.ErrNotActivated
+}
+
 // GetAuthToken implements the GetAuthToken RPC, but just returns NotActivatedError
 func (a *InactiveAPIServer) GetAuthToken(ctx context.Context, req *auth.GetAuthTokenRequest) (resp *auth.GetAuthTokenResponse, retErr error) {
 	return nil, auth.ErrNotActivated
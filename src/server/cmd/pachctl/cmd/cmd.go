@@ -25,7 +25,9 @@ import (
 	admincmds "github.com/pachyderm/pachyderm/src/server/admin/cmds"
 	authcmds "github.com/pachyderm/pachyderm/src/server/auth/cmds"
 	enterprisecmds "github.com/pachyderm/pachyderm/src/server/enterprise/cmds"
+	notifycmds "github.com/pachyderm/pachyderm/src/server/notify/cmds"
 	pfscmds "github.com/pachyderm/pachyderm/src/server/pfs/cmds"
+	"github.com/pachyderm/pachyderm/src/server/pfs/s3"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	deploycmds "github.com/pachyderm/pachyderm/src/server/pkg/deploy/cmds"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
@@ -192,6 +194,10 @@ Environment variables:
 	for _, cmd := range adminCmds {
 		rootCmd.AddCommand(cmd)
 	}
+	notifyCmds := notifycmds.Cmds(&noMetrics)
+	for _, cmd := range notifyCmds {
+		rootCmd.AddCommand(cmd)
+	}
 
 	var clientOnly bool
 	versionCmd := &cobra.Command{
@@ -315,6 +321,7 @@ This resets the cluster to its initial state.`,
 		}),
 	}
 	var port int
+	var s3gatewayPort int
 	var uiPort int
 	var uiWebsocketPort int
 	var kubeCtlFlags string
@@ -360,6 +367,21 @@ kubectl %s port-forward "$pod" %d:650
 				return nil
 			})
 
+			eg.Go(func() error {
+				fmt.Printf("Forwarding the S3 gateway port to http://localhost:%v ...\n", s3gatewayPort)
+				stdin := strings.NewReader(fmt.Sprintf(`
+pod=$(kubectl %s get pod -l app=pachd  --output='jsonpath={.items[0].metadata.name}')
+kubectl %s port-forward "$pod" %d:%d
+`, kubeCtlFlags, kubeCtlFlags, s3gatewayPort, s3.S3Port))
+				if err := cmdutil.RunIO(cmdutil.IO{
+					Stdin:  stdin,
+					Stderr: os.Stderr,
+				}, "sh"); err != nil {
+					return fmt.Errorf("Could not forward S3 gateway port")
+				}
+				return nil
+			})
+
 			eg.Go(func() error {
 				stdin := strings.NewReader(fmt.Sprintf(`
 pod=$(kubectl %s get pod -l app=dash --output='jsonpath={.items[0].metadata.name}')
@@ -394,6 +416,7 @@ kubectl %v port-forward "$pod" %d:8081
 		}),
 	}
 	portForward.Flags().IntVarP(&port, "port", "p", 30650, "The local port to bind to.")
+	portForward.Flags().IntVarP(&s3gatewayPort, "s3gateway-port", "s", 30600, "The local port to bind to for the S3 gateway.")
 	portForward.Flags().IntVarP(&uiPort, "ui-port", "u", 30080, "The local port to bind to.")
 	portForward.Flags().IntVarP(&uiWebsocketPort, "proxy-port", "x", 30081, "The local port to bind to.")
 	portForward.Flags().StringVarP(&kubeCtlFlags, "kubectlflags", "k", "", "Any kubectl flags to proxy, e.g. --kubectlflags='--kubeconfig /some/path/kubeconfig'")
@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/server/cmd/pachctl/cmd"
 	"github.com/spf13/pflag"
 )
@@ -13,11 +14,24 @@ func main() {
 	// Remove kubernetes client flags from the spf13 flag set
 	// (we link the kubernetes client, so otherwise they're in 'pachctl --help')
 	pflag.CommandLine = pflag.NewFlagSet(os.Args[0], pflag.ExitOnError)
-	err := func() error {
+	err := func() (retErr error) {
+		if err := tracing.InstallExporterFromEnv("pachctl"); err != nil {
+			return err
+		}
+		defer tracing.Flush()
 		rootCmd, err := cmd.PachctlCmd()
 		if err != nil {
 			return err
 		}
+		// Trace the whole command, so that every RPC it sends is in one trace.
+		// Only the command's name is recorded, as its arguments and flags may
+		// contain secrets (e.g. passwords)
+		command := rootCmd.CommandPath()
+		if subCmd, _, err := rootCmd.Find(os.Args[1:]); err == nil {
+			command = subCmd.CommandPath()
+		}
+		span := tracing.StartBackgroundSpan("pachctl", "command", command)
+		defer func() { span.Finish(retErr) }()
 		return rootCmd.Execute()
 	}()
 	if err != nil {
@@ -18,10 +18,12 @@ import (
 	deployclient "github.com/pachyderm/pachyderm/src/client/deploy"
 	eprsclient "github.com/pachyderm/pachyderm/src/client/enterprise"
 	healthclient "github.com/pachyderm/pachyderm/src/client/health"
+	notifyclient "github.com/pachyderm/pachyderm/src/client/notify"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/discovery"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/client/pkg/shard"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	adminserver "github.com/pachyderm/pachyderm/src/server/admin/server"
@@ -30,12 +32,15 @@ import (
 	eprsserver "github.com/pachyderm/pachyderm/src/server/enterprise/server"
 	"github.com/pachyderm/pachyderm/src/server/health"
 	pach_http "github.com/pachyderm/pachyderm/src/server/http"
+	notifyserver "github.com/pachyderm/pachyderm/src/server/notify/server"
+	pfs_s3 "github.com/pachyderm/pachyderm/src/server/pfs/s3"
 	pfs_server "github.com/pachyderm/pachyderm/src/server/pfs/server"
 	cache_pb "github.com/pachyderm/pachyderm/src/server/pkg/cache/groupcachepb"
 	cache_server "github.com/pachyderm/pachyderm/src/server/pkg/cache/server"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/netutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 	pps_server "github.com/pachyderm/pachyderm/src/server/pps/server"
 	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
@@ -71,12 +76,14 @@ type appEnv struct {
 	PFSEtcdPrefix         string `env:"PFS_ETCD_PREFIX,default=pachyderm_pfs"`
 	AuthEtcdPrefix        string `env:"PACHYDERM_AUTH_ETCD_PREFIX,default=pachyderm_auth"`
 	EnterpriseEtcdPrefix  string `env:"PACHYDERM_ENTERPRISE_ETCD_PREFIX,default=pachyderm_enterprise"`
+	NotifyEtcdPrefix      string `env:"PACHYDERM_NOTIFY_ETCD_PREFIX,default=pachyderm_notify"`
 	KubeAddress           string `env:"KUBERNETES_PORT_443_TCP_ADDR,required"`
 	EtcdAddress           string `env:"ETCD_PORT_2379_TCP_ADDR,required"`
 	Namespace             string `env:"NAMESPACE,default=default"`
 	Metrics               bool   `env:"METRICS,default=true"`
 	Init                  bool   `env:"INIT,default=false"`
 	BlockCacheBytes       string `env:"BLOCK_CACHE_BYTES,default=1G"`
+	BlockCompression      string `env:"BLOCK_COMPRESSION,default=none"`
 	PFSCacheSize          string `env:"PFS_CACHE_SIZE,default=0"`
 	WorkerImage           string `env:"WORKER_IMAGE,default="`
 	WorkerSidecarImage    string `env:"WORKER_SIDECAR_IMAGE,default="`
@@ -84,6 +91,8 @@ type appEnv struct {
 	LogLevel              string `env:"LOG_LEVEL,default=info"`
 	IAMRole               string `env:"IAM_ROLE,default="`
 	ImagePullSecret       string `env:"IMAGE_PULL_SECRET,default="`
+	MaxConcurrentJobs     int64  `env:"MAX_CONCURRENT_JOBS,default=0"`
+	AuditLogRetention     string `env:"AUDIT_LOG_RETENTION,default=0"`
 }
 
 func main() {
@@ -117,6 +126,12 @@ func doSidecarMode(appEnvObj interface{}) error {
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
+	go func() {
+		log.Println(prom.ListenAndServe(prom.PachdPort))
+	}()
+	if err := tracing.InstallExporterFromEnv("pachd-sidecar"); err != nil {
+		return err
+	}
 	appEnv := appEnvObj.(*appEnv)
 	switch appEnv.LogLevel {
 	case "debug":
@@ -160,7 +175,7 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	pfsAPIServer, err := pfs_server.NewAPIServer(address, []string{etcdAddress}, path.Join(appEnv.EtcdPrefix, appEnv.PFSEtcdPrefix), int64(pfsCacheSize))
+	pfsAPIServer, err := pfs_server.NewSidecarAPIServer(address, []string{etcdAddress}, path.Join(appEnv.EtcdPrefix, appEnv.PFSEtcdPrefix), int64(pfsCacheSize))
 	if err != nil {
 		return err
 	}
@@ -178,12 +193,16 @@ func doSidecarMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress)
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress, appEnv.BlockCompression)
 	if err != nil {
 		return err
 	}
 	healthServer := health.NewHealthServer()
-	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, path.Join(appEnv.EtcdPrefix, appEnv.AuthEtcdPrefix))
+	auditLogRetention, err := time.ParseDuration(appEnv.AuditLogRetention)
+	if err != nil {
+		return fmt.Errorf("invalid AUDIT_LOG_RETENTION: %v", err)
+	}
+	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, path.Join(appEnv.EtcdPrefix, appEnv.AuthEtcdPrefix), auditLogRetention)
 	if err != nil {
 		return err
 	}
@@ -203,6 +222,14 @@ func doSidecarMode(appEnvObj interface{}) error {
 		grpcutil.ServeOptions{
 			Version:    version.Version,
 			MaxMsgSize: grpcutil.MaxMsgSize,
+			UnaryInterceptors: []grpc.UnaryServerInterceptor{
+				prom.UnaryServerInterceptor(),
+				authAPIServer.AuditUnaryInterceptor(),
+			},
+			StreamInterceptors: []grpc.StreamServerInterceptor{
+				prom.StreamServerInterceptor(),
+				authAPIServer.AuditStreamInterceptor(),
+			},
 		},
 		grpcutil.ServeEnv{
 			GRPCPort: appEnv.Port,
@@ -216,6 +243,12 @@ func doFullMode(appEnvObj interface{}) error {
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
+	go func() {
+		log.Println(prom.ListenAndServe(prom.PachdPort))
+	}()
+	if err := tracing.InstallExporterFromEnv("pachd"); err != nil {
+		return err
+	}
 	switch appEnv.LogLevel {
 	case "debug":
 		log.SetLevel(log.DebugLevel)
@@ -292,6 +325,7 @@ func doFullMode(appEnvObj interface{}) error {
 		appEnv.StorageHostPath,
 		appEnv.IAMRole,
 		appEnv.ImagePullSecret,
+		appEnv.MaxConcurrentJobs,
 		reporter,
 	)
 	if err != nil {
@@ -311,12 +345,16 @@ func doFullMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
-	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress)
+	blockAPIServer, err := pfs_server.NewBlockAPIServer(appEnv.StorageRoot, blockCacheBytes, appEnv.StorageBackend, etcdAddress, appEnv.BlockCompression)
 	if err != nil {
 		return err
 	}
 
-	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, path.Join(appEnv.EtcdPrefix, appEnv.AuthEtcdPrefix))
+	auditLogRetention, err := time.ParseDuration(appEnv.AuditLogRetention)
+	if err != nil {
+		return fmt.Errorf("invalid AUDIT_LOG_RETENTION: %v", err)
+	}
+	authAPIServer, err := authserver.NewAuthServer(address, etcdAddress, path.Join(appEnv.EtcdPrefix, appEnv.AuthEtcdPrefix), auditLogRetention)
 	if err != nil {
 		return err
 	}
@@ -325,6 +363,16 @@ func doFullMode(appEnvObj interface{}) error {
 		return err
 	}
 	adminAPIServer := adminserver.NewAPIServer(address, &adminclient.ClusterInfo{clusterID})
+	notifyAPIServer, err := notifyserver.NewAPIServer(
+		address,
+		etcdAddress,
+		path.Join(appEnv.EtcdPrefix, appEnv.NotifyEtcdPrefix),
+		path.Join(appEnv.EtcdPrefix, appEnv.PFSEtcdPrefix),
+		path.Join(appEnv.EtcdPrefix, appEnv.PPSEtcdPrefix),
+	)
+	if err != nil {
+		return err
+	}
 
 	healthServer := health.NewHealthServer()
 
@@ -334,10 +382,17 @@ func doFullMode(appEnvObj interface{}) error {
 	if err != nil {
 		return err
 	}
+	s3Server, err := pfs_s3.NewS3Server(address)
+	if err != nil {
+		return err
+	}
 	var eg errgroup.Group
 	eg.Go(func() error {
 		return http.ListenAndServe(fmt.Sprintf(":%v", pach_http.HTTPPort), httpServer)
 	})
+	eg.Go(func() error {
+		return http.ListenAndServe(fmt.Sprintf(":%v", pfs_s3.S3Port), s3Server)
+	})
 	eg.Go(func() error {
 		return githook.RunGitHookServer(address, etcdAddress, path.Join(appEnv.EtcdPrefix, appEnv.PPSEtcdPrefix))
 	})
@@ -353,10 +408,19 @@ func doFullMode(appEnvObj interface{}) error {
 				eprsclient.RegisterAPIServer(s, enterpriseAPIServer)
 				deployclient.RegisterAPIServer(s, deployServer)
 				adminclient.RegisterAPIServer(s, adminAPIServer)
+				notifyclient.RegisterAPIServer(s, notifyAPIServer)
 			},
 			grpcutil.ServeOptions{
 				Version:    version.Version,
 				MaxMsgSize: grpcutil.MaxMsgSize,
+				UnaryInterceptors: []grpc.UnaryServerInterceptor{
+					prom.UnaryServerInterceptor(),
+					authAPIServer.AuditUnaryInterceptor(),
+				},
+				StreamInterceptors: []grpc.StreamServerInterceptor{
+					prom.StreamServerInterceptor(),
+					authAPIServer.AuditStreamInterceptor(),
+				},
 			},
 			grpcutil.ServeEnv{
 				GRPCPort: appEnv.Port,
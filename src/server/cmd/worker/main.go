@@ -13,10 +13,12 @@ import (
 	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	"github.com/pachyderm/pachyderm/src/server/worker"
 	"google.golang.org/grpc"
 
@@ -86,6 +88,12 @@ func do(appEnvObj interface{}) error {
 	go func() {
 		log.Println(http.ListenAndServe(":651", nil))
 	}()
+	go func() {
+		log.Println(prom.ListenAndServe(prom.WorkerPort, prom.SidecarGatherer()))
+	}()
+	if err := tracing.InstallExporterFromEnv("pachyderm-worker"); err != nil {
+		return err
+	}
 
 	appEnv := appEnvObj.(*appEnv)
 
@@ -126,8 +134,10 @@ func do(appEnvObj interface{}) error {
 				close(ready)
 			},
 			grpcutil.ServeOptions{
-				Version:    version.Version,
-				MaxMsgSize: grpcutil.MaxMsgSize,
+				Version:            version.Version,
+				MaxMsgSize:         grpcutil.MaxMsgSize,
+				UnaryInterceptors:  []grpc.UnaryServerInterceptor{prom.UnaryServerInterceptor()},
+				StreamInterceptors: []grpc.StreamServerInterceptor{prom.StreamServerInterceptor()},
 			},
 			grpcutil.ServeEnv{
 				GRPCPort: client.PPSWorkerPort,
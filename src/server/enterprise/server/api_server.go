@@ -20,6 +20,7 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
 	ec "github.com/pachyderm/pachyderm/src/client/enterprise"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -306,6 +307,48 @@ func (a *apiServer) GetState(ctx context.Context, req *ec.GetStateRequest) (resp
 	return resp, nil
 }
 
+// GetActivationCode returns the current cluster's enterprise activation code,
+// which admin.API.Extract includes in backups. If auth is activated, only
+// cluster admins may call it.
+func (a *apiServer) GetActivationCode(ctx context.Context, req *ec.GetActivationCodeRequest) (resp *ec.GetActivationCodeResponse, retErr error) {
+	a.LogReq(req)
+	// don't log the response, which contains the activation code
+	defer func(start time.Time) { a.pachLogger.Log(req, nil, retErr, time.Since(start)) }(time.Now())
+
+	pachClient := a.getPachClient().WithCtx(ctx)
+	whoAmI, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return nil, err
+	}
+	if err == nil && !whoAmI.IsAdmin {
+		return nil, &auth.ErrNotAuthorized{
+			Subject: whoAmI.Username,
+			AdminOp: "GetActivationCode",
+		}
+	}
+
+	var record ec.EnterpriseRecord
+	if err := a.enterpriseToken.ReadOnly(ctx).Get(enterpriseTokenKey, &record); err != nil {
+		if col.IsErrNotFound(err) {
+			return &ec.GetActivationCodeResponse{State: ec.State_NONE}, nil
+		}
+		return nil, err
+	}
+	expiration, err := types.TimestampFromProto(record.Expires)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse expiration timestamp: %v", err)
+	}
+	resp = &ec.GetActivationCodeResponse{
+		State:          ec.State_ACTIVE,
+		Info:           &ec.TokenInfo{Expires: record.Expires},
+		ActivationCode: record.ActivationCode,
+	}
+	if time.Now().After(expiration) {
+		resp.State = ec.State_EXPIRED
+	}
+	return resp, nil
+}
+
 // Deactivate deletes the current cluster's enterprise token, and puts the
 // cluster in the "NONE" enterprise state. It also deletes all data in the
 // cluster, to avoid invalid cluster states. This call only makes sense for
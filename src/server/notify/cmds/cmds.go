@@ -0,0 +1,262 @@
+package cmds
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/spf13/cobra"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/server/notify/pretty"
+	"github.com/pachyderm/pachyderm/src/server/pkg/cmdutil"
+)
+
+const (
+	codestart = "```sh\n\n"
+	codeend   = "\n```"
+)
+
+// Cmds returns a slice containing notify commands.
+func Cmds(noMetrics *bool) []*cobra.Command {
+	metrics := !*noMetrics
+	raw := false
+	rawFlag := func(cmd *cobra.Command) {
+		cmd.Flags().BoolVar(&raw, "raw", false, "disable pretty printing, print raw json")
+	}
+	marshaller := &jsonpb.Marshaler{Indent: "  "}
+
+	printSinks := func() error {
+		client, err := client.NewOnUserMachine(metrics, "user")
+		if err != nil {
+			return err
+		}
+		sinkInfos, err := client.ListSink()
+		if err != nil {
+			return err
+		}
+		if raw {
+			for _, sinkInfo := range sinkInfos {
+				if err := marshaller.Marshal(os.Stdout, sinkInfo); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+		pretty.PrintSinkHeader(writer)
+		for _, sinkInfo := range sinkInfos {
+			pretty.PrintSinkInfo(writer, sinkInfo)
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		pretty.PrintSinkFailures(os.Stdout, sinkInfos)
+		return nil
+	}
+
+	sink := &cobra.Command{
+		Use:   "sink",
+		Short: "Show the sinks that pachd delivers events to.",
+		Long: `Show the sinks that pachd delivers events to, and manage them with the
+sink subcommands.
+
+pachd sends an event to each matching sink when a commit is finished, when a
+job succeeds or fails, when datums fail, and when a pipeline fails. Events
+are delivered at least once: an event that a sink doesn't accept stays
+pending, and is retried with backoff. The PENDING column shows how many events
+are waiting to be delivered to each sink.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return printSinks()
+		}),
+	}
+	rawFlag(sink)
+
+	var webhookURL string
+	var secret string
+	var kafkaBrokers cmdutil.RepeatedStringArg
+	var kafkaTopic string
+	var kafkaTLS bool
+	var kafkaSASLMechanism string
+	var kafkaSASLUsername string
+	var kafkaSASLPassword string
+	var natsURL string
+	var natsSubject string
+	var repos cmdutil.RepeatedStringArg
+	var branches cmdutil.RepeatedStringArg
+	var pipelines cmdutil.RepeatedStringArg
+	var events cmdutil.RepeatedStringArg
+	sinkFlags := func(cmd *cobra.Command) {
+		cmd.Flags().StringVar(&webhookURL, "webhook", "", "POST events to this http(s) URL.")
+		cmd.Flags().StringVar(&secret, "secret", "", "Sign webhook requests with this secret (see X-Pachyderm-Signature).")
+		cmd.Flags().Var(&kafkaBrokers, "kafka-broker", "Produce events to Kafka, through this broker (host:port). Can be repeated.")
+		cmd.Flags().StringVar(&kafkaTopic, "kafka-topic", "", "The Kafka topic that events are produced to.")
+		cmd.Flags().BoolVar(&kafkaTLS, "kafka-tls", false, "Connect to the Kafka brokers with TLS.")
+		cmd.Flags().StringVar(&kafkaSASLMechanism, "kafka-sasl-mechanism", "", "Authenticate with the Kafka brokers with this SASL mechanism: PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512.")
+		cmd.Flags().StringVar(&kafkaSASLUsername, "kafka-sasl-username", "", "The username that's used to authenticate with the Kafka brokers.")
+		cmd.Flags().StringVar(&kafkaSASLPassword, "kafka-sasl-password", "", "The password that's used to authenticate with the Kafka brokers.")
+		cmd.Flags().StringVar(&natsURL, "nats", "", "Publish events to this NATS server (nats://[user:password@]host:port, or tls://... to connect with TLS).")
+		cmd.Flags().StringVar(&natsSubject, "nats-subject", "", "The NATS subject that events are published to.")
+		cmd.Flags().VarP(&repos, "repo", "r", "Only send events about this repo (or the pipeline that outputs to it). Can be repeated.")
+		cmd.Flags().VarP(&branches, "branch", "b", "Only send events whose commit is the head of this branch. Can be repeated.")
+		cmd.Flags().VarP(&pipelines, "pipeline", "p", "Only send events about this pipeline's jobs and datums, or the pipeline itself. Can be repeated.")
+		cmd.Flags().VarP(&events, "event", "e", "Only send events of this type: commit-finished, job-success, job-failure, pipeline-failure or datum-failure. Can be repeated.")
+	}
+	newSink := func(name string) (*notify.Sink, error) {
+		sink := &notify.Sink{
+			Name: name,
+			Filter: &notify.Filter{
+				Repos:     repos,
+				Branches:  branches,
+				Pipelines: pipelines,
+			},
+		}
+		for _, event := range events {
+			eventType, err := pretty.ParseEventType(event)
+			if err != nil {
+				return nil, err
+			}
+			sink.Filter.Types = append(sink.Filter.Types, eventType)
+		}
+		if webhookURL != "" {
+			sink.Webhook = &notify.Webhook{URL: webhookURL, Secret: secret}
+		} else if secret != "" {
+			return nil, fmt.Errorf("--secret can only be used with --webhook")
+		}
+		if len(kafkaBrokers) > 0 || kafkaTopic != "" {
+			sink.Kafka = &notify.Kafka{Brokers: kafkaBrokers, Topic: kafkaTopic, TLS: kafkaTLS}
+			if kafkaSASLMechanism != "" {
+				sink.Kafka.SASL = &notify.KafkaSASL{
+					Mechanism: kafkaSASLMechanism,
+					Username:  kafkaSASLUsername,
+					Password:  kafkaSASLPassword,
+				}
+			}
+		}
+		if sink.Kafka == nil && (kafkaTLS || kafkaSASLMechanism != "") {
+			return nil, fmt.Errorf("--kafka-tls and --kafka-sasl-mechanism can only be used with --kafka-broker")
+		}
+		if natsURL != "" || natsSubject != "" {
+			sink.NATS = &notify.NATS{URL: natsURL, Subject: natsSubject}
+		}
+		return sink, nil
+	}
+
+	createSink := &cobra.Command{
+		Use:   "create sink-name",
+		Short: "Create a sink that pachd delivers events to.",
+		Long: `Create a sink that pachd delivers events to. A sink is a webhook
+(--webhook), a Kafka topic (--kafka-broker and --kafka-topic) or a NATS subject
+(--nats and --nats-subject). Each event is sent as JSON. Kafka sinks can
+connect with TLS (--kafka-tls) and authenticate with SASL
+(--kafka-sasl-mechanism); NATS sinks connect with TLS if the URL's scheme is
+tls://.
+
+By default a sink receives every event. The --repo, --branch, --pipeline and
+--event flags limit it to the events that match each of the flags given.
+
+Webhook requests carry the event type in the X-Pachyderm-Event header and the
+event's ID in the X-Pachyderm-Delivery header. If --secret is set, the
+X-Pachyderm-Signature header is "sha256=" followed by the hex-encoded
+HMAC-SHA256 of the request body, keyed with the secret.
+
+Examples:
+
+` + codestart + `# POST every failed job and pipeline to a webhook
+$ pachctl sink create alerts --webhook https://example.com/hooks/pachyderm --secret s3cr3t -e job-failure -e pipeline-failure
+
+# publish commits finished on branch "master" of repo "images" to NATS
+$ pachctl sink create images --nats nats://nats:4222 --nats-subject pachyderm.images -r images -b master
+
+# produce every event about pipeline "edges" to Kafka
+$ pachctl sink create edges --kafka-broker kafka-0:9092 --kafka-broker kafka-1:9092 --kafka-topic pachyderm -p edges
+
+# produce every event to Kafka over TLS, authenticating with SCRAM
+$ pachctl sink create all --kafka-broker kafka-0:9093 --kafka-topic pachyderm --kafka-tls --kafka-sasl-mechanism SCRAM-SHA-512 --kafka-sasl-username pachd --kafka-sasl-password s3cr3t
+` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			sink, err := newSink(args[0])
+			if err != nil {
+				return err
+			}
+			return client.CreateSink(sink, false)
+		}),
+	}
+	sinkFlags(createSink)
+	sink.AddCommand(createSink)
+
+	updateSink := &cobra.Command{
+		Use:   "update sink-name",
+		Short: "Replace a sink's destination and filter.",
+		Long: `Replace a sink's destination and filter, which are set with the same flags
+as 'sink create'. Events that are pending are delivered to the new destination.`,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			sink, err := newSink(args[0])
+			if err != nil {
+				return err
+			}
+			return client.CreateSink(sink, true)
+		}),
+	}
+	sinkFlags(updateSink)
+	sink.AddCommand(updateSink)
+
+	inspectSink := &cobra.Command{
+		Use:   "inspect sink-name",
+		Short: "Return info about a sink.",
+		Long:  "Return info about a sink, including its delivery status.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			sinkInfo, err := client.InspectSink(args[0])
+			if err != nil {
+				return err
+			}
+			if raw {
+				return marshaller.Marshal(os.Stdout, sinkInfo)
+			}
+			return pretty.PrintDetailedSinkInfo(sinkInfo)
+		}),
+	}
+	rawFlag(inspectSink)
+	sink.AddCommand(inspectSink)
+
+	listSink := &cobra.Command{
+		Use:   "list",
+		Short: "Show the sinks that pachd delivers events to.",
+		Long:  "Show the sinks that pachd delivers events to, with their filters and delivery status.",
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return printSinks()
+		}),
+	}
+	rawFlag(listSink)
+	sink.AddCommand(listSink)
+
+	deleteSink := &cobra.Command{
+		Use:   "delete sink-name",
+		Short: "Delete a sink.",
+		Long:  "Delete a sink. Events that haven't been delivered to it yet are dropped.",
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.DeleteSink(args[0])
+		}),
+	}
+	sink.AddCommand(deleteSink)
+
+	return []*cobra.Command{sink}
+}
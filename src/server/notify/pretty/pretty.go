@@ -0,0 +1,141 @@
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
+)
+
+// EventTypeName returns the name of 'eventType' used by pachctl, e.g.
+// "job-failure"
+func EventTypeName(eventType notify.EventType) string {
+	return strings.ToLower(strings.Replace(eventType.String(), "_", "-", -1))
+}
+
+// ParseEventType parses an event type name returned by EventTypeName
+func ParseEventType(name string) (notify.EventType, error) {
+	eventType, ok := notify.EventType_value[strings.ToUpper(strings.Replace(name, "-", "_", -1))]
+	if !ok {
+		var names []string
+		for i := 0; i < len(notify.EventType_name); i++ {
+			names = append(names, EventTypeName(notify.EventType(i)))
+		}
+		return 0, fmt.Errorf("unknown event type %q, must be one of %s", name, strings.Join(names, ", "))
+	}
+	return notify.EventType(eventType), nil
+}
+
+// PrintSinkHeader prints a sink header.
+func PrintSinkHeader(w io.Writer) {
+	fmt.Fprint(w, "NAME\tTYPE\tDESTINATION\tFILTER\tPENDING\tDELIVERED\tLAST DELIVERED\t\n")
+}
+
+// PrintSinkInfo pretty-prints sink info.
+func PrintSinkInfo(w io.Writer, sinkInfo *notify.SinkInfo) {
+	fmt.Fprintf(w, "%s\t", sinkInfo.Sink.Name)
+	fmt.Fprintf(w, "%s\t", sinkType(sinkInfo.Sink))
+	fmt.Fprintf(w, "%s\t", destination(sinkInfo.Sink))
+	fmt.Fprintf(w, "%s\t", filter(sinkInfo.Sink.Filter))
+	fmt.Fprintf(w, "%d\t", sinkInfo.Pending)
+	fmt.Fprintf(w, "%d\t", sinkInfo.Status.Delivered)
+	if sinkInfo.Status.LastDelivered != nil {
+		fmt.Fprintf(w, "%s\t\n", pretty.Ago(sinkInfo.Status.LastDelivered))
+	} else {
+		fmt.Fprint(w, "-\t\n")
+	}
+}
+
+// PrintSinkFailures prints the last delivery error of each sink whose last
+// delivery failed.
+func PrintSinkFailures(w io.Writer, sinkInfos []*notify.SinkInfo) {
+	for _, sinkInfo := range sinkInfos {
+		if sinkInfo.Status.LastError != "" {
+			fmt.Fprintf(w, "%s: %s\n", sinkInfo.Sink.Name, sinkInfo.Status.LastError)
+		}
+	}
+}
+
+// PrintDetailedSinkInfo pretty-prints detailed sink info.
+func PrintDetailedSinkInfo(sinkInfo *notify.SinkInfo) error {
+	template, err := template.New("SinkInfo").Funcs(funcMap).Parse(
+		`Name: {{.Sink.Name}}
+Created: {{prettyAgo .Sink.Created}}
+Type: {{sinkType .Sink}}
+Destination: {{destination .Sink}}{{if .Sink.Webhook}}{{if .Sink.Webhook.Secret}}
+Signed: true{{end}}{{end}}{{if .Sink.Kafka}}{{if .Sink.Kafka.TLS}}
+TLS: true{{end}}{{if .Sink.Kafka.SASL}}
+SASL: {{.Sink.Kafka.SASL.Mechanism}} ({{.Sink.Kafka.SASL.Username}}){{end}}{{end}}
+Filter: {{filter .Sink.Filter}}
+Pending: {{.Pending}}
+Delivered: {{.Status.Delivered}}{{if .Status.LastDelivered}}
+Last Delivered: {{prettyAgo .Status.LastDelivered}}{{end}}{{if .Status.LastError}}
+Last Error: {{.Status.LastError}}{{end}}
+`)
+	if err != nil {
+		return err
+	}
+	return template.Execute(os.Stdout, sinkInfo)
+}
+
+func sinkType(sink *notify.Sink) string {
+	switch {
+	case sink.Webhook != nil:
+		return "webhook"
+	case sink.Kafka != nil:
+		return "kafka"
+	case sink.NATS != nil:
+		return "nats"
+	}
+	return "-"
+}
+
+func destination(sink *notify.Sink) string {
+	switch {
+	case sink.Webhook != nil:
+		return sink.Webhook.URL
+	case sink.Kafka != nil:
+		return fmt.Sprintf("%s/%s", strings.Join(sink.Kafka.Brokers, ","), sink.Kafka.Topic)
+	case sink.NATS != nil:
+		return fmt.Sprintf("%s/%s", sink.NATS.URL, sink.NATS.Subject)
+	}
+	return "-"
+}
+
+func filter(filter *notify.Filter) string {
+	if filter == nil {
+		return "-"
+	}
+	var parts []string
+	if len(filter.Repos) > 0 {
+		parts = append(parts, "repos="+strings.Join(filter.Repos, ","))
+	}
+	if len(filter.Branches) > 0 {
+		parts = append(parts, "branches="+strings.Join(filter.Branches, ","))
+	}
+	if len(filter.Pipelines) > 0 {
+		parts = append(parts, "pipelines="+strings.Join(filter.Pipelines, ","))
+	}
+	if len(filter.Types) > 0 {
+		var types []string
+		for _, t := range filter.Types {
+			types = append(types, EventTypeName(t))
+		}
+		parts = append(parts, "events="+strings.Join(types, ","))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, " ")
+}
+
+var funcMap = template.FuncMap{
+	"prettyAgo":   pretty.Ago,
+	"sinkType":    sinkType,
+	"destination": destination,
+	"filter":      filter,
+}
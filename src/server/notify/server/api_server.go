@@ -0,0 +1,274 @@
+package server
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"golang.org/x/net/context"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+)
+
+// redactedSecret replaces webhook secrets and credentials in the sinks
+// returned by InspectSink and ListSink
+const redactedSecret = "********"
+
+var validSinkName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+type apiServer struct {
+	log.Logger
+	etcdClient *etcd.Client
+	etcdPrefix string
+	pfsPrefix  string
+	ppsPrefix  string
+
+	// sinks holds each sink's spec, keyed by name
+	sinks col.Collection
+	// statuses holds each sink's SinkStatus, keyed by name
+	statuses col.Collection
+	// cursors holds the etcd revision from which each event source resumes
+	cursors col.Collection
+
+	pachdAddress   string
+	pachClient     *client.APIClient
+	pachClientOnce sync.Once
+}
+
+func (a *apiServer) getPachClient() *client.APIClient {
+	a.pachClientOnce.Do(func() {
+		var err error
+		a.pachClient, err = client.NewFromAddress(a.pachdAddress)
+		if err != nil {
+			panic(fmt.Sprintf("notify API failed to initialize pach client: %v", err))
+		}
+	})
+	return a.pachClient
+}
+
+// checkIsAdmin returns an error unless auth is deactivated or the caller is a
+// cluster admin. Sinks receive events about every repo and pipeline, so only
+// admins can manage them
+func (a *apiServer) checkIsAdmin(ctx context.Context, op string) error {
+	pachClient := a.getPachClient().WithCtx(ctx)
+	me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{})
+	if err != nil {
+		if auth.IsErrNotActivated(err) {
+			return nil
+		}
+		return fmt.Errorf("error during authorization check: %v", err)
+	}
+	if !me.IsAdmin {
+		return &auth.ErrNotAuthorized{
+			Subject: me.Username,
+			AdminOp: op,
+		}
+	}
+	return nil
+}
+
+func validateSinkName(name string) error {
+	if !validSinkName.MatchString(name) {
+		return fmt.Errorf("sink name (%v) invalid: only alphanumeric characters, underscores, and dashes are allowed", name)
+	}
+	return nil
+}
+
+func validateSink(sink *notify.Sink) error {
+	if sink == nil {
+		return fmt.Errorf("sink must be set")
+	}
+	if err := validateSinkName(sink.Name); err != nil {
+		return err
+	}
+	var set int
+	if sink.Webhook != nil {
+		set++
+		u, err := url.Parse(sink.Webhook.URL)
+		if err != nil {
+			return fmt.Errorf("invalid webhook url %q: %v", sink.Webhook.URL, err)
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return fmt.Errorf("invalid webhook url %q: scheme must be http or https", sink.Webhook.URL)
+		}
+	}
+	if sink.Kafka != nil {
+		set++
+		if len(sink.Kafka.Brokers) == 0 {
+			return fmt.Errorf("kafka sinks must have at least one broker")
+		}
+		if sink.Kafka.Topic == "" {
+			return fmt.Errorf("kafka sinks must have a topic")
+		}
+		if _, err := kafkaSASLMechanism(sink.Kafka.SASL); err != nil {
+			return err
+		}
+	}
+	if sink.NATS != nil {
+		set++
+		if _, err := parseNATSURL(sink.NATS.URL); err != nil {
+			return err
+		}
+		if !validNATSSubject.MatchString(sink.NATS.Subject) {
+			return fmt.Errorf("invalid NATS subject %q", sink.NATS.Subject)
+		}
+	}
+	if set != 1 {
+		return fmt.Errorf("exactly one of webhook, kafka and nats must be set")
+	}
+	return nil
+}
+
+func (a *apiServer) CreateSink(ctx context.Context, request *notify.CreateSinkRequest) (response *types.Empty, retErr error) {
+	// The request isn't logged, as it may contain a webhook secret
+	func() { a.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(nil, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.checkIsAdmin(ctx, "CreateSink"); err != nil {
+		return nil, err
+	}
+	if err := validateSink(request.Sink); err != nil {
+		return nil, err
+	}
+	sink := proto.Clone(request.Sink).(*notify.Sink)
+	now, err := types.TimestampProto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	sink.Created = now
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		sinks := a.sinks.ReadWrite(stm)
+		if !request.Update {
+			if err := sinks.Create(sink.Name, sink); err != nil {
+				if col.IsErrExists(err) {
+					return fmt.Errorf("sink %s already exists", sink.Name)
+				}
+				return err
+			}
+			return nil
+		}
+		existing := &notify.Sink{}
+		if err := sinks.Get(sink.Name, existing); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("sink %s not found", sink.Name)
+			}
+			return err
+		}
+		sink.Created = existing.Created
+		return sinks.Put(sink.Name, sink)
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) InspectSink(ctx context.Context, request *notify.InspectSinkRequest) (response *notify.SinkInfo, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	if err := a.checkIsAdmin(ctx, "InspectSink"); err != nil {
+		return nil, err
+	}
+	sink := &notify.Sink{}
+	if err := a.sinks.ReadOnly(ctx).Get(request.Name, sink); err != nil {
+		if col.IsErrNotFound(err) {
+			return nil, fmt.Errorf("sink %s not found", request.Name)
+		}
+		return nil, err
+	}
+	return a.sinkInfo(ctx, sink)
+}
+
+func (a *apiServer) ListSink(ctx context.Context, request *notify.ListSinkRequest) (response *notify.SinkInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, nil, retErr, time.Since(start)) }(time.Now())
+	if err := a.checkIsAdmin(ctx, "ListSink"); err != nil {
+		return nil, err
+	}
+	iter, err := a.sinks.ReadOnly(ctx).ListPaginated()
+	if err != nil {
+		return nil, err
+	}
+	response = &notify.SinkInfos{}
+	for {
+		var name string
+		sink := &notify.Sink{}
+		ok, err := iter.Next(&name, sink)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		sinkInfo, err := a.sinkInfo(ctx, sink)
+		if err != nil {
+			return nil, err
+		}
+		response.SinkInfo = append(response.SinkInfo, sinkInfo)
+	}
+	return response, nil
+}
+
+// sinkInfo returns the SinkInfo of 'sink', with its secrets redacted
+func (a *apiServer) sinkInfo(ctx context.Context, sink *notify.Sink) (*notify.SinkInfo, error) {
+	if sink.Webhook != nil && sink.Webhook.Secret != "" {
+		sink.Webhook.Secret = redactedSecret
+	}
+	if sink.Kafka != nil && sink.Kafka.SASL != nil && sink.Kafka.SASL.Password != "" {
+		sink.Kafka.SASL.Password = redactedSecret
+	}
+	if sink.NATS != nil {
+		if u, err := url.Parse(sink.NATS.URL); err == nil && u.User != nil {
+			if _, ok := u.User.Password(); ok {
+				u.User = url.UserPassword(u.User.Username(), redactedSecret)
+			} else {
+				u.User = url.User(redactedSecret) // the username is a token
+			}
+			sink.NATS.URL = u.String()
+		}
+	}
+	status := &notify.SinkStatus{}
+	if err := a.statuses.ReadOnly(ctx).Get(sink.Name, status); err != nil && !col.IsErrNotFound(err) {
+		return nil, err
+	}
+	pending, err := a.deliveries(sink.Name).ReadOnly(ctx).Count()
+	if err != nil {
+		return nil, err
+	}
+	return &notify.SinkInfo{
+		Sink:    sink,
+		Status:  status,
+		Pending: pending,
+	}, nil
+}
+
+func (a *apiServer) DeleteSink(ctx context.Context, request *notify.DeleteSinkRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	if err := a.checkIsAdmin(ctx, "DeleteSink"); err != nil {
+		return nil, err
+	}
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if err := a.sinks.ReadWrite(stm).Delete(request.Name); err != nil {
+			if col.IsErrNotFound(err) {
+				return fmt.Errorf("sink %s not found", request.Name)
+			}
+			return err
+		}
+		if err := a.statuses.ReadWrite(stm).Delete(request.Name); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		a.deliveries(request.Name).ReadWrite(stm).DeleteAll()
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
@@ -0,0 +1,157 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+// eventID returns the ID of the event of type 'eventType' caused by the
+// change to 'key' at etcd revision 'rev'. IDs are the same each time a change
+// is handled, so that sinks can discard duplicate deliveries. They start with
+// the zero-padded revision, so that they sort in the order the changes were
+// made.
+func eventID(rev int64, key string, eventType notify.EventType) string {
+	sum := sha256.Sum256([]byte(key + "/" + eventType.String()))
+	return fmt.Sprintf("%020d-%s", rev, hex.EncodeToString(sum[:8]))
+}
+
+// isJobSuccess returns true if 'state' is a terminal state in which the job
+// produced its output
+func isJobSuccess(state pps.JobState) bool {
+	return state == pps.JobState_JOB_SUCCESS || state == pps.JobState_JOB_SUCCESS_WITH_FAILURES
+}
+
+// commitEvents returns the events caused by the change of the commit stored
+// at 'key' (at etcd revision 'rev') from 'prev' (nil if the commit was just
+// created) to 'commitInfo'
+func commitEvents(rev int64, key string, prev, commitInfo *pfs.CommitInfo) []*notify.Event {
+	if commitInfo.Finished == nil || (prev != nil && prev.Finished != nil) {
+		return nil
+	}
+	return []*notify.Event{{
+		ID:     eventID(rev, key, notify.EventType_COMMIT_FINISHED),
+		Type:   notify.EventType_COMMIT_FINISHED,
+		Time:   commitInfo.Finished,
+		Commit: commitInfo.Commit,
+	}}
+}
+
+// jobEvents returns the events caused by the change of the job stored at
+// 'key' (at etcd revision 'rev') from 'prev' (nil if the job was just
+// created) to 'jobInfo'
+func jobEvents(rev int64, key string, prev, jobInfo *pps.EtcdJobInfo) []*notify.Event {
+	var prevState pps.JobState
+	var prevDataFailed int64
+	if prev != nil {
+		prevState, prevDataFailed = prev.State, prev.DataFailed
+	}
+	newEvent := func(eventType notify.EventType) *notify.Event {
+		return &notify.Event{
+			ID:         eventID(rev, key, eventType),
+			Type:       eventType,
+			Time:       now(),
+			Commit:     jobInfo.OutputCommit,
+			Job:        jobInfo.Job,
+			Pipeline:   jobInfo.Pipeline,
+			JobState:   jobInfo.State,
+			Reason:     jobInfo.Reason,
+			DataFailed: jobInfo.DataFailed,
+		}
+	}
+	var events []*notify.Event
+	// Datum failures are reported before the job's outcome, as they happened
+	// first
+	if jobInfo.DataFailed > prevDataFailed {
+		event := newEvent(notify.EventType_DATUM_FAILURE)
+		event.NewDataFailed = jobInfo.DataFailed - prevDataFailed
+		events = append(events, event)
+	}
+	if prev == nil || prevState != jobInfo.State {
+		switch {
+		case isJobSuccess(jobInfo.State):
+			events = append(events, newEvent(notify.EventType_JOB_SUCCESS))
+		case jobInfo.State == pps.JobState_JOB_FAILURE:
+			events = append(events, newEvent(notify.EventType_JOB_FAILURE))
+		}
+	}
+	return events
+}
+
+// pipelineEvents returns the events caused by the change of the pipeline
+// 'name' (at etcd revision 'rev') from 'prev' (nil if the pipeline was just
+// created) to 'pipelineInfo'
+func pipelineEvents(rev int64, name string, prev, pipelineInfo *pps.EtcdPipelineInfo) []*notify.Event {
+	if pipelineInfo.State != pps.PipelineState_PIPELINE_FAILURE ||
+		(prev != nil && prev.State == pps.PipelineState_PIPELINE_FAILURE) {
+		return nil
+	}
+	return []*notify.Event{{
+		ID:            eventID(rev, name, notify.EventType_PIPELINE_FAILURE),
+		Type:          notify.EventType_PIPELINE_FAILURE,
+		Time:          now(),
+		Pipeline:      &pps.Pipeline{Name: name},
+		PipelineState: pipelineInfo.State,
+		Reason:        pipelineInfo.Reason,
+	}}
+}
+
+// eventRepo returns the repo that 'event' is about: the repo of its commit,
+// or its pipeline's output repo
+func eventRepo(event *notify.Event) string {
+	if event.Commit != nil && event.Commit.Repo != nil {
+		return event.Commit.Repo.Name
+	}
+	if event.Pipeline != nil {
+		return event.Pipeline.Name
+	}
+	return ""
+}
+
+// matches returns true if 'event' passes 'filter' (see notify.Filter)
+func matches(filter *notify.Filter, event *notify.Event) bool {
+	if filter == nil {
+		return true
+	}
+	contains := func(list []string, values ...string) bool {
+		for _, l := range list {
+			for _, v := range values {
+				if l == v {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	if len(filter.Types) > 0 {
+		var ok bool
+		for _, t := range filter.Types {
+			ok = ok || t == event.Type
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(filter.Repos) > 0 && !contains(filter.Repos, eventRepo(event)) {
+		return false
+	}
+	if len(filter.Branches) > 0 && !contains(filter.Branches, event.Branches...) {
+		return false
+	}
+	if len(filter.Pipelines) > 0 && (event.Pipeline == nil || !contains(filter.Pipelines, event.Pipeline.Name)) {
+		return false
+	}
+	return true
+}
+
+func now() *types.Timestamp {
+	ts, _ := types.TimestampProto(time.Now())
+	return ts
+}
@@ -0,0 +1,129 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+)
+
+func TestCommitEvents(t *testing.T) {
+	commit := client.NewCommit("repo", "c1")
+	open := &pfs.CommitInfo{Commit: commit}
+	finished := &pfs.CommitInfo{Commit: commit, Finished: now()}
+
+	require.Equal(t, 0, len(commitEvents(1, "repo/c1", nil, open)))
+	events := commitEvents(2, "repo/c1", open, finished)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, notify.EventType_COMMIT_FINISHED, events[0].Type)
+	require.Equal(t, commit, events[0].Commit)
+	require.Equal(t, finished.Finished, events[0].Time)
+	// Commits created finished (e.g. by BuildCommit) cause events too
+	require.Equal(t, 1, len(commitEvents(2, "repo/c1", nil, finished)))
+	// Later changes to a finished commit don't
+	require.Equal(t, 0, len(commitEvents(3, "repo/c1", finished, finished)))
+	// The same change always causes an event with the same ID
+	require.Equal(t, events[0].ID, commitEvents(2, "repo/c1", open, finished)[0].ID)
+	require.NotEqual(t, events[0].ID, commitEvents(2, "repo/c2", open, finished)[0].ID)
+}
+
+func TestJobEvents(t *testing.T) {
+	jobInfo := func(state pps.JobState, dataFailed int64) *pps.EtcdJobInfo {
+		return &pps.EtcdJobInfo{
+			Job:          client.NewJob("j1"),
+			Pipeline:     client.NewPipeline("edges"),
+			OutputCommit: client.NewCommit("edges", "c1"),
+			State:        state,
+			DataFailed:   dataFailed,
+		}
+	}
+	types := func(events []*notify.Event) []notify.EventType {
+		var result []notify.EventType
+		for _, event := range events {
+			result = append(result, event.Type)
+		}
+		return result
+	}
+	starting := jobInfo(pps.JobState_JOB_STARTING, 0)
+	running := jobInfo(pps.JobState_JOB_RUNNING, 0)
+	require.Equal(t, 0, len(jobEvents(1, "j1", nil, starting)))
+	require.Equal(t, 0, len(jobEvents(2, "j1", starting, running)))
+
+	events := jobEvents(3, "j1", running, jobInfo(pps.JobState_JOB_RUNNING, 2))
+	require.Equal(t, []notify.EventType{notify.EventType_DATUM_FAILURE}, types(events))
+	require.Equal(t, int64(2), events[0].DataFailed)
+	require.Equal(t, int64(2), events[0].NewDataFailed)
+	require.Equal(t, "edges", events[0].Pipeline.Name)
+
+	events = jobEvents(4, "j1", jobInfo(pps.JobState_JOB_RUNNING, 2), jobInfo(pps.JobState_JOB_SUCCESS_WITH_FAILURES, 3))
+	require.Equal(t, []notify.EventType{notify.EventType_DATUM_FAILURE, notify.EventType_JOB_SUCCESS}, types(events))
+	require.Equal(t, int64(1), events[0].NewDataFailed)
+	require.Equal(t, pps.JobState_JOB_SUCCESS_WITH_FAILURES, events[1].JobState)
+	require.NotEqual(t, events[0].ID, events[1].ID)
+
+	events = jobEvents(5, "j1", running, jobInfo(pps.JobState_JOB_FAILURE, 0))
+	require.Equal(t, []notify.EventType{notify.EventType_JOB_FAILURE}, types(events))
+	// Killed jobs don't cause events
+	require.Equal(t, 0, len(jobEvents(6, "j1", running, jobInfo(pps.JobState_JOB_KILLED, 0))))
+	// Neither do updates to a finished job
+	success := jobInfo(pps.JobState_JOB_SUCCESS, 0)
+	require.Equal(t, 0, len(jobEvents(7, "j1", success, success)))
+}
+
+func TestPipelineEvents(t *testing.T) {
+	running := &pps.EtcdPipelineInfo{State: pps.PipelineState_PIPELINE_RUNNING}
+	failed := &pps.EtcdPipelineInfo{State: pps.PipelineState_PIPELINE_FAILURE, Reason: "bad image"}
+	require.Equal(t, 0, len(pipelineEvents(1, "edges", nil, running)))
+	events := pipelineEvents(2, "edges", running, failed)
+	require.Equal(t, 1, len(events))
+	require.Equal(t, notify.EventType_PIPELINE_FAILURE, events[0].Type)
+	require.Equal(t, "edges", events[0].Pipeline.Name)
+	require.Equal(t, "bad image", events[0].Reason)
+	require.Equal(t, 0, len(pipelineEvents(3, "edges", failed, failed)))
+}
+
+func TestMatches(t *testing.T) {
+	commitFinished := &notify.Event{
+		Type:     notify.EventType_COMMIT_FINISHED,
+		Commit:   client.NewCommit("images", "c1"),
+		Branches: []string{"master"},
+	}
+	jobFailure := &notify.Event{
+		Type:     notify.EventType_JOB_FAILURE,
+		Commit:   client.NewCommit("edges", "c2"),
+		Pipeline: client.NewPipeline("edges"),
+	}
+	pipelineFailure := &notify.Event{
+		Type:     notify.EventType_PIPELINE_FAILURE,
+		Pipeline: client.NewPipeline("edges"),
+	}
+
+	for _, event := range []*notify.Event{commitFinished, jobFailure, pipelineFailure} {
+		require.True(t, matches(nil, event))
+		require.True(t, matches(&notify.Filter{}, event))
+	}
+
+	filter := &notify.Filter{Repos: []string{"edges"}}
+	require.False(t, matches(filter, commitFinished))
+	require.True(t, matches(filter, jobFailure))
+	require.True(t, matches(filter, pipelineFailure)) // the pipeline's output repo
+
+	filter = &notify.Filter{Branches: []string{"master", "dev"}}
+	require.True(t, matches(filter, commitFinished))
+	require.False(t, matches(filter, jobFailure))
+
+	filter = &notify.Filter{Pipelines: []string{"edges"}}
+	require.False(t, matches(filter, commitFinished))
+	require.True(t, matches(filter, jobFailure))
+	require.True(t, matches(filter, pipelineFailure))
+
+	filter = &notify.Filter{
+		Repos: []string{"edges"},
+		Types: []notify.EventType{notify.EventType_JOB_SUCCESS, notify.EventType_JOB_FAILURE},
+	}
+	require.True(t, matches(filter, jobFailure))
+	require.False(t, matches(filter, pipelineFailure))
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+
+	kafka "github.com/segmentio/kafka-go"
+	"github.com/segmentio/kafka-go/sasl"
+	"github.com/segmentio/kafka-go/sasl/plain"
+	"github.com/segmentio/kafka-go/sasl/scram"
+
+	"github.com/pachyderm/pachyderm/src/client/notify"
+)
+
+const (
+	kafkaClientID = "pachd"
+	// kafkaMaxAttempts is how many times the Kafka writer tries to produce an
+	// event before the send fails (and is retried with backoff)
+	kafkaMaxAttempts = 3
+)
+
+// kafkaSASLMechanism returns the SASL mechanism of a Kafka sink, or nil if
+// the sink doesn't use SASL
+func kafkaSASLMechanism(s *notify.KafkaSASL) (sasl.Mechanism, error) {
+	if s == nil {
+		return nil, nil
+	}
+	switch s.Mechanism {
+	case "PLAIN":
+		return plain.Mechanism{Username: s.Username, Password: s.Password}, nil
+	case "SCRAM-SHA-256":
+		return scram.Mechanism(scram.SHA256, s.Username, s.Password)
+	case "SCRAM-SHA-512":
+		return scram.Mechanism(scram.SHA512, s.Username, s.Password)
+	default:
+		return nil, fmt.Errorf("unsupported SASL mechanism %q (must be PLAIN, SCRAM-SHA-256 or SCRAM-SHA-512)", s.Mechanism)
+	}
+}
+
+// kafkaSender produces events with a Kafka writer, which keeps its
+// connections to the topic's partition leaders open between events. Each
+// event is produced to the partition chosen by hashing the event's id, and is
+// acknowledged by all in-sync replicas.
+type kafkaSender struct {
+	kafka  *notify.Kafka
+	writer *kafka.Writer
+}
+
+func (s *kafkaSender) send(ctx context.Context, event *notify.Event) error {
+	payload, err := marshalEvent(event)
+	if err != nil {
+		return err
+	}
+	if s.writer == nil {
+		mechanism, err := kafkaSASLMechanism(s.kafka.SASL)
+		if err != nil {
+			return err
+		}
+		dialer := &kafka.Dialer{
+			ClientID:      kafkaClientID,
+			Timeout:       sendTimeout,
+			DualStack:     true,
+			SASLMechanism: mechanism,
+		}
+		if s.kafka.TLS {
+			dialer.TLS = &tls.Config{}
+		}
+		s.writer = kafka.NewWriter(kafka.WriterConfig{
+			Brokers:      s.kafka.Brokers,
+			Topic:        s.kafka.Topic,
+			Dialer:       dialer,
+			Balancer:     &kafka.Hash{},
+			MaxAttempts:  kafkaMaxAttempts,
+			BatchSize:    1, // each event is sent on its own
+			ReadTimeout:  sendTimeout,
+			WriteTimeout: sendTimeout,
+			RequiredAcks: -1, // all in-sync replicas
+		})
+	}
+	return s.writer.WriteMessages(ctx, kafka.Message{
+		Key:   []byte(event.ID),
+		Value: payload,
+	})
+}
+
+func (s *kafkaSender) close() error {
+	if s.writer == nil {
+		return nil
+	}
+	return s.writer.Close()
+}
@@ -0,0 +1,454 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/pfsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+const (
+	masterLockPath = "_master_lock"
+
+	// cursorInterval is how often a source's cursor is saved while none of
+	// the changes to it cause events. Changes since the last save are
+	// handled again if the master restarts
+	cursorInterval = 10 * time.Second
+)
+
+// source is a prefix in etcd whose changes cause events
+type source struct {
+	name     string
+	prefix   string
+	template proto.Message
+	// events returns the events caused by the change 'ev'
+	events func(ctx context.Context, ev *watch.Event) ([]*notify.Event, error)
+}
+
+// sources returns the pfs and pps collections that events are derived from
+func (a *apiServer) sources() []*source {
+	// A collection's Path("") is its prefix without the trailing slash. The
+	// pfs and pps collections are read straight from etcd.
+	store := col.NewEtcdStore(a.etcdClient)
+	commitsPrefix := pfsdb.Commits(store, a.pfsPrefix, "").Path("") + "/"
+	jobsPrefix := ppsdb.Jobs(store, a.ppsPrefix).Path("") + "/"
+	pipelinesPrefix := ppsdb.Pipelines(store, a.ppsPrefix).Path("") + "/"
+	return []*source{{
+		name:     "commits",
+		prefix:   commitsPrefix,
+		template: &pfs.CommitInfo{},
+		events: func(ctx context.Context, ev *watch.Event) ([]*notify.Event, error) {
+			// Commit keys are <repo>/<commit ID>. Keys under <repo>__index_*
+			// belong to the commits collections' indexes
+			parts := strings.Split(string(ev.Key), "/")
+			if ev.Type != watch.EventPut || len(parts) != 2 || strings.Contains(parts[0], "__index_") {
+				return nil, nil
+			}
+			var key string
+			commitInfo, prev := &pfs.CommitInfo{}, &pfs.CommitInfo{}
+			if err := ev.Unmarshal(&key, commitInfo); err != nil {
+				return nil, err
+			}
+			if ev.PrevValue == nil {
+				prev = nil
+			} else if err := ev.UnmarshalPrev(&key, prev); err != nil {
+				return nil, err
+			}
+			return a.withBranches(ctx, ev.Rev, commitEvents(ev.Rev, key, prev, commitInfo))
+		},
+	}, {
+		name:     "jobs",
+		prefix:   jobsPrefix,
+		template: &pps.EtcdJobInfo{},
+		events: func(ctx context.Context, ev *watch.Event) ([]*notify.Event, error) {
+			if ev.Type != watch.EventPut {
+				return nil, nil
+			}
+			var key string
+			jobInfo, prev := &pps.EtcdJobInfo{}, &pps.EtcdJobInfo{}
+			if err := ev.Unmarshal(&key, jobInfo); err != nil {
+				return nil, err
+			}
+			if ev.PrevValue == nil {
+				prev = nil
+			} else if err := ev.UnmarshalPrev(&key, prev); err != nil {
+				return nil, err
+			}
+			return a.withBranches(ctx, ev.Rev, jobEvents(ev.Rev, key, prev, jobInfo))
+		},
+	}, {
+		name:     "pipelines",
+		prefix:   pipelinesPrefix,
+		template: &pps.EtcdPipelineInfo{},
+		events: func(ctx context.Context, ev *watch.Event) ([]*notify.Event, error) {
+			if ev.Type != watch.EventPut {
+				return nil, nil
+			}
+			var name string
+			pipelineInfo, prev := &pps.EtcdPipelineInfo{}, &pps.EtcdPipelineInfo{}
+			if err := ev.Unmarshal(&name, pipelineInfo); err != nil {
+				return nil, err
+			}
+			if ev.PrevValue == nil {
+				prev = nil
+			} else if err := ev.UnmarshalPrev(&name, prev); err != nil {
+				return nil, err
+			}
+			return pipelineEvents(ev.Rev, name, prev, pipelineInfo), nil
+		},
+	}}
+}
+
+// withBranches sets the branches of each of 'events' that has a commit to the
+// branches whose head was that commit at etcd revision 'rev'
+func (a *apiServer) withBranches(ctx context.Context, rev int64, events []*notify.Event) ([]*notify.Event, error) {
+	for _, event := range events {
+		if event.Commit == nil || event.Commit.Repo == nil {
+			continue
+		}
+		prefix := pfsdb.Branches(col.NewEtcdStore(a.etcdClient), a.pfsPrefix, event.Commit.Repo.Name).Path("") + "/"
+		resp, err := a.etcdClient.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithRev(rev))
+		if watch.IsErrCompacted(err) {
+			// Fall back to the current branches
+			resp, err = a.etcdClient.Get(ctx, prefix, etcd.WithPrefix())
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, kv := range resp.Kvs {
+			branchInfo := &pfs.BranchInfo{}
+			if err := branchInfo.Unmarshal(kv.Value); err != nil {
+				return nil, err
+			}
+			if branchInfo.Branch != nil && branchInfo.Head != nil && branchInfo.Head.ID == event.Commit.ID {
+				event.Branches = append(event.Branches, branchInfo.Branch.Name)
+			}
+		}
+	}
+	return events, nil
+}
+
+// master derives events from the changes made to pfs and pps, and delivers
+// them to sinks. It runs in one pachd at a time
+func (a *apiServer) master() {
+	lock := dlock.NewDLock(a.etcdClient, path.Join(a.etcdPrefix, masterLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel() // stops all watches and deliveries
+		ctx, err := lock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer lock.Unlock(ctx)
+
+		eg, ctx := errgroup.WithContext(ctx)
+		for _, s := range a.sources() {
+			s := s
+			eg.Go(func() error {
+				return a.watchSource(ctx, s)
+			})
+		}
+		eg.Go(func() error {
+			return a.watchSinks(ctx)
+		})
+		return eg.Wait()
+	}, backoff.NewInfiniteBackOff(), func(err error, t time.Duration) error {
+		logrus.Errorf("error in notify master: %v; retrying in %v", err, t)
+		return nil
+	})
+}
+
+// cursor returns the etcd revision from which 's' should be watched. The
+// first time 's' is watched, that's the next revision, so that existing
+// state doesn't cause events
+func (a *apiServer) cursor(ctx context.Context, s *source) (int64, error) {
+	cursor := &types.Int64Value{}
+	if err := a.cursors.ReadOnly(ctx).Get(s.name, cursor); err == nil {
+		return cursor.Value, nil
+	} else if !col.IsErrNotFound(err) {
+		return 0, err
+	}
+	resp, err := a.etcdClient.Get(ctx, s.prefix, etcd.WithPrefix(), etcd.WithCountOnly())
+	if err != nil {
+		return 0, err
+	}
+	cursor.Value = resp.Header.Revision + 1
+	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		return a.cursors.ReadWrite(stm).Put(s.name, cursor)
+	}); err != nil {
+		return 0, err
+	}
+	return cursor.Value, nil
+}
+
+// watchSource watches 's' from its cursor, and enqueues the events caused by
+// each change for delivery. The cursor is saved with the deliveries, so each
+// change that causes events is handled at least once
+func (a *apiServer) watchSource(ctx context.Context, s *source) error {
+	rev, err := a.cursor(ctx, s)
+	if err != nil {
+		return err
+	}
+	watcher, err := watch.NewWatcherFromRevision(ctx, a.etcdClient, s.prefix, s.prefix, rev, s.template)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	ticker := time.NewTicker(cursorInterval)
+	defer ticker.Stop()
+	saved := rev
+	for {
+		select {
+		case ev, ok := <-watcher.Watch():
+			if !ok {
+				return fmt.Errorf("%s watch closed unexpectedly", s.name)
+			}
+			if ev.Type == watch.EventError {
+				if watch.IsErrCompacted(ev.Err) {
+					// The changes since the cursor are gone, so resume from
+					// the current revision
+					logrus.Errorf("notify: changes to %s since revision %d have been compacted; events caused by them will not be delivered", s.name, rev)
+					if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+						return a.cursors.ReadWrite(stm).Delete(s.name)
+					}); err != nil {
+						return err
+					}
+				}
+				return ev.Err
+			}
+			events, err := s.events(ctx, ev)
+			if err != nil {
+				return err
+			}
+			// Changes made in the same transaction share a revision, so the
+			// cursor is the revision of the last change handled (not the
+			// next one), and that change may be handled twice
+			rev = ev.Rev
+			if len(events) > 0 {
+				if err := a.enqueue(ctx, s.name, rev, events); err != nil {
+					return err
+				}
+				saved = rev
+			}
+		case <-ticker.C:
+			if rev == saved {
+				continue
+			}
+			if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+				return a.cursors.ReadWrite(stm).Put(s.name, &types.Int64Value{Value: rev})
+			}); err != nil {
+				return err
+			}
+			saved = rev
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// enqueue creates a delivery of each of 'events' for each sink whose filter
+// matches it, and saves the cursor of 'source' as 'rev'
+func (a *apiServer) enqueue(ctx context.Context, source string, rev int64, events []*notify.Event) error {
+	// Sinks are listed outside of the transaction, and read again inside it,
+	// so that a sink that's deleted or updated concurrently gets the right
+	// deliveries
+	var names []string
+	iter, err := a.sinks.ReadOnly(ctx).ListPaginated()
+	if err != nil {
+		return err
+	}
+	for {
+		var name string
+		if ok, err := iter.Next(&name, &notify.Sink{}); err != nil {
+			return err
+		} else if !ok {
+			break
+		}
+		names = append(names, name)
+	}
+	_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		sinks := a.sinks.ReadWrite(stm)
+		for _, name := range names {
+			sink := &notify.Sink{}
+			if err := sinks.Get(name, sink); err != nil {
+				if col.IsErrNotFound(err) {
+					continue
+				}
+				return err
+			}
+			deliveries := a.deliveries(name).ReadWrite(stm)
+			for _, event := range events {
+				if !matches(sink.Filter, event) {
+					continue
+				}
+				// Don't reset the attempts of a delivery that's already
+				// pending, if this change is being handled again
+				if err := deliveries.Get(event.ID, &notify.Delivery{}); err == nil {
+					continue
+				} else if !col.IsErrNotFound(err) {
+					return err
+				}
+				if err := deliveries.Put(event.ID, &notify.Delivery{Event: event}); err != nil {
+					return err
+				}
+			}
+		}
+		return a.cursors.ReadWrite(stm).Put(source, &types.Int64Value{Value: rev})
+	})
+	return err
+}
+
+// watchSinks runs a deliverer for each sink, and restarts it when the sink is
+// updated
+func (a *apiServer) watchSinks(ctx context.Context) error {
+	watcher, err := a.sinks.ReadOnly(ctx).Watch()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	cancels := make(map[string]func())
+	for {
+		var ev *watch.Event
+		var ok bool
+		select {
+		case ev, ok = <-watcher.Watch():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if !ok {
+			return fmt.Errorf("sink watch closed unexpectedly")
+		}
+		switch ev.Type {
+		case watch.EventError:
+			return ev.Err
+		case watch.EventPut:
+			var name string
+			sink := &notify.Sink{}
+			if err := ev.Unmarshal(&name, sink); err != nil {
+				return err
+			}
+			if cancel, ok := cancels[name]; ok {
+				cancel()
+			}
+			delivererCtx, cancel := context.WithCancel(ctx)
+			cancels[name] = cancel
+			go a.deliver(delivererCtx, sink)
+		case watch.EventDelete:
+			name := string(ev.Key)
+			if cancel, ok := cancels[name]; ok {
+				cancel()
+				delete(cancels, name)
+			}
+		}
+	}
+}
+
+// deliver delivers the events pending for 'sink', oldest first, until 'ctx'
+// is cancelled. An event is only removed from the queue once it's delivered,
+// so a failing sink holds up its later events until it recovers
+func (a *apiServer) deliver(ctx context.Context, sink *notify.Sink) {
+	s := newSender(sink)
+	defer s.close()
+	b := backoff.NewInfiniteBackOff()
+	backoff.RetryNotify(func() error {
+		// The watch is only used to learn when there are new deliveries
+		watcher, err := a.deliveries(sink.Name).ReadOnly(ctx).Watch()
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+		for {
+			if err := a.deliverPending(ctx, sink, s, b); err != nil {
+				return err
+			}
+			select {
+			case ev, ok := <-watcher.Watch():
+				if !ok {
+					return fmt.Errorf("delivery watch closed unexpectedly")
+				}
+				if ev.Type == watch.EventError {
+					return ev.Err
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}, b, func(err error, t time.Duration) error {
+		if ctx.Err() != nil {
+			return ctx.Err() // the sink was updated or deleted
+		}
+		logrus.Errorf("error delivering events to sink %s: %v; retrying in %v", sink.Name, err, t)
+		return nil
+	})
+}
+
+// deliverPending delivers each of the events pending for 'sink'. 'b' is reset
+// after each successful delivery
+func (a *apiServer) deliverPending(ctx context.Context, sink *notify.Sink, s sender, b backoff.BackOff) error {
+	deliveries := a.deliveries(sink.Name)
+	iter, err := deliveries.ReadOnly(ctx).ListPaginated()
+	if err != nil {
+		return err
+	}
+	for {
+		var id string
+		delivery := &notify.Delivery{}
+		ok, err := iter.Next(&id, delivery)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		sendErr := s.send(ctx, delivery.Event)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			if err := a.sinks.ReadWrite(stm).Get(sink.Name, &notify.Sink{}); err != nil {
+				return err
+			}
+			status := &notify.SinkStatus{}
+			if err := a.statuses.ReadWrite(stm).Upsert(sink.Name, status, func() error {
+				if sendErr != nil {
+					status.LastError = sendErr.Error()
+					return nil
+				}
+				status.Delivered++
+				status.LastDelivered = now()
+				status.LastError = ""
+				return nil
+			}); err != nil {
+				return err
+			}
+			if sendErr != nil {
+				delivery.Attempts++
+				return deliveries.ReadWrite(stm).Put(id, delivery)
+			}
+			return deliveries.ReadWrite(stm).Delete(id)
+		}); err != nil {
+			return err
+		}
+		if sendErr != nil {
+			return fmt.Errorf("error delivering event %s: %v", id, sendErr)
+		}
+		b.Reset()
+	}
+}
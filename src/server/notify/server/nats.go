@@ -0,0 +1,71 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	nats "github.com/nats-io/go-nats"
+
+	"github.com/pachyderm/pachyderm/src/client/notify"
+)
+
+// validNATSSubject matches NATS subjects that events can be published to
+// (subjects without wildcards)
+var validNATSSubject = regexp.MustCompile(`^[^.*>\s]+(\.[^.*>\s]+)*$`)
+
+func parseNATSURL(natsURL string) (*url.URL, error) {
+	u, err := url.Parse(natsURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid NATS url %q: %v", natsURL, err)
+	}
+	if (u.Scheme != "nats" && u.Scheme != "tls") || u.Host == "" {
+		return nil, fmt.Errorf("invalid NATS url %q: must be of the form nats://host:port or tls://host:port", natsURL)
+	}
+	return u, nil
+}
+
+// natsSender publishes events with a NATS client, whose connection is kept
+// open between events. After each event is published, the connection is
+// flushed, which waits for the server's reply to a PING. NATS processes a
+// client's messages in order, so the reply means it has accepted the event.
+type natsSender struct {
+	nats *notify.NATS
+	conn *nats.Conn
+}
+
+func (s *natsSender) send(ctx context.Context, event *notify.Event) (retErr error) {
+	payload, err := marshalEvent(event)
+	if err != nil {
+		return err
+	}
+	if s.conn == nil {
+		// The connection is re-established by the next attempt after an
+		// error, rather than by the client, so that events aren't buffered
+		// while it's reconnecting
+		conn, err := nats.Connect(s.nats.URL, nats.Name("pachd"), nats.Timeout(sendTimeout), nats.NoReconnect())
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	defer func() {
+		if retErr != nil {
+			s.close() // reconnect on the next attempt
+		}
+	}()
+	if err := s.conn.Publish(s.nats.Subject, payload); err != nil {
+		return err
+	}
+	return s.conn.FlushTimeout(sendTimeout)
+}
+
+func (s *natsSender) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	s.conn.Close()
+	s.conn = nil
+	return nil
+}
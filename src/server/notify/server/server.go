@@ -0,0 +1,88 @@
+package server
+
+import (
+	"fmt"
+	"path"
+
+	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/log"
+)
+
+const (
+	sinksPrefix      = "/sinks"
+	statusesPrefix   = "/statuses"
+	deliveriesPrefix = "/deliveries"
+	cursorsPrefix    = "/cursors"
+)
+
+// APIServer represents a notify APIServer
+type APIServer interface {
+	notify.APIServer
+}
+
+// NewAPIServer returns a new notify.APIServer. Events are derived from the
+// pfs and pps state stored under 'pfsPrefix' and 'ppsPrefix', and sinks and
+// pending deliveries are stored under 'etcdPrefix'. The notify master, which
+// derives events and delivers them, runs in one pachd at a time.
+func NewAPIServer(pachdAddress, etcdAddress, etcdPrefix, pfsPrefix, ppsPrefix string) (APIServer, error) {
+	etcdClient, err := etcd.New(etcd.Config{
+		Endpoints:   []string{etcdAddress},
+		DialOptions: client.EtcdDialOptions(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error constructing etcdClient: %v", err)
+	}
+	a := newAPIServer(pachdAddress, etcdClient, etcdPrefix, pfsPrefix, ppsPrefix)
+	go a.master()
+	return a, nil
+}
+
+func newAPIServer(pachdAddress string, etcdClient *etcd.Client, etcdPrefix, pfsPrefix, ppsPrefix string) *apiServer {
+	return &apiServer{
+		Logger:       log.NewLogger("notify.API"),
+		pachdAddress: pachdAddress,
+		etcdClient:   etcdClient,
+		etcdPrefix:   etcdPrefix,
+		pfsPrefix:    pfsPrefix,
+		ppsPrefix:    ppsPrefix,
+		sinks: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, sinksPrefix),
+			nil,
+			&notify.Sink{},
+			validateSinkName,
+		),
+		statuses: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, statusesPrefix),
+			nil,
+			&notify.SinkStatus{},
+			nil,
+		),
+		cursors: col.NewCollection(
+			etcdClient,
+			path.Join(etcdPrefix, cursorsPrefix),
+			nil,
+			&types.Int64Value{},
+			nil,
+		),
+	}
+}
+
+// deliveries returns the collection of events waiting to be delivered to the
+// sink 'sink'. Keys start with the etcd revision of the change that caused
+// the event, so listing the collection returns the oldest events first.
+func (a *apiServer) deliveries(sink string) col.Collection {
+	return col.NewCollection(
+		a.etcdClient,
+		path.Join(a.etcdPrefix, deliveriesPrefix, sink),
+		nil,
+		&notify.Delivery{},
+		nil,
+	)
+}
@@ -0,0 +1,104 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/pachyderm/pachyderm/src/client/notify"
+)
+
+const (
+	// sendTimeout bounds each attempt to deliver an event
+	sendTimeout = 30 * time.Second
+
+	// Headers set on webhook requests
+	eventHeader     = "X-Pachyderm-Event"
+	deliveryHeader  = "X-Pachyderm-Delivery"
+	signatureHeader = "X-Pachyderm-Signature"
+)
+
+// sender delivers events to one sink
+type sender interface {
+	// send delivers 'event', returning nil once the sink has accepted it
+	send(ctx context.Context, event *notify.Event) error
+	// close releases any connections held by the sender
+	close() error
+}
+
+func newSender(sink *notify.Sink) sender {
+	switch {
+	case sink.Webhook != nil:
+		return &webhookSender{
+			webhook: sink.Webhook,
+			client:  &http.Client{Timeout: sendTimeout},
+		}
+	case sink.Kafka != nil:
+		return &kafkaSender{kafka: sink.Kafka}
+	default:
+		return &natsSender{nats: sink.NATS}
+	}
+}
+
+// marshalEvent returns the JSON encoding of 'event' that's sent to sinks
+func marshalEvent(event *notify.Event) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := (&jsonpb.Marshaler{}).Marshal(&buf, event); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sign returns the value of the signature header of a webhook request whose
+// body is 'body'
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+type webhookSender struct {
+	webhook *notify.Webhook
+	client  *http.Client
+}
+
+func (s *webhookSender) send(ctx context.Context, event *notify.Event) error {
+	body, err := marshalEvent(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest("POST", s.webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(eventHeader, event.Type.String())
+	req.Header.Set(deliveryHeader, event.ID)
+	if s.webhook.Secret != "" {
+		req.Header.Set(signatureHeader, sign(s.webhook.Secret, body))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook returned %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	return nil
+}
+
+func (s *webhookSender) close() error {
+	return nil
+}
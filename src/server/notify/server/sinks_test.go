@@ -0,0 +1,198 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/notify"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func testEvent() *notify.Event {
+	return &notify.Event{
+		ID:       eventID(42, "images/c1", notify.EventType_COMMIT_FINISHED),
+		Type:     notify.EventType_COMMIT_FINISHED,
+		Time:     now(),
+		Commit:   client.NewCommit("images", "c1"),
+		Branches: []string{"master"},
+	}
+}
+
+func requireEvent(t *testing.T, expected *notify.Event, payload []byte) {
+	event := &notify.Event{}
+	require.NoError(t, jsonpb.UnmarshalString(string(payload), event))
+	require.Equal(t, expected, event)
+}
+
+func TestValidateSink(t *testing.T) {
+	require.NoError(t, validateSink(&notify.Sink{
+		Name:    "hook",
+		Webhook: &notify.Webhook{URL: "https://example.com/hook"},
+	}))
+	require.NoError(t, validateSink(&notify.Sink{
+		Name: "nats",
+		NATS: &notify.NATS{URL: "nats://nats", Subject: "pachyderm.events"},
+	}))
+	require.NoError(t, validateSink(&notify.Sink{
+		Name:  "kafka",
+		Kafka: &notify.Kafka{Brokers: []string{"kafka:9092"}, Topic: "events"},
+	}))
+	require.YesError(t, validateSink(&notify.Sink{Name: "none"}))
+	require.YesError(t, validateSink(&notify.Sink{
+		Name:    "two",
+		Webhook: &notify.Webhook{URL: "https://example.com/hook"},
+		Kafka:   &notify.Kafka{Brokers: []string{"kafka:9092"}, Topic: "events"},
+	}))
+	require.YesError(t, validateSink(&notify.Sink{
+		Name:    "bad name",
+		Webhook: &notify.Webhook{URL: "https://example.com/hook"},
+	}))
+	require.YesError(t, validateSink(&notify.Sink{
+		Name:    "hook",
+		Webhook: &notify.Webhook{URL: "ftp://example.com/hook"},
+	}))
+	require.YesError(t, validateSink(&notify.Sink{
+		Name: "nats",
+		NATS: &notify.NATS{URL: "nats://nats", Subject: "pachyderm.*"},
+	}))
+	require.NoError(t, validateSink(&notify.Sink{
+		Name: "nats",
+		NATS: &notify.NATS{URL: "tls://nats:4222", Subject: "pachyderm.events"},
+	}))
+	require.NoError(t, validateSink(&notify.Sink{
+		Name: "kafka",
+		Kafka: &notify.Kafka{Brokers: []string{"kafka:9093"}, Topic: "events", TLS: true,
+			SASL: &notify.KafkaSASL{Mechanism: "SCRAM-SHA-512", Username: "pachd", Password: "s3cr3t"}},
+	}))
+	require.YesError(t, validateSink(&notify.Sink{
+		Name:  "kafka",
+		Kafka: &notify.Kafka{Brokers: []string{"kafka:9092"}, Topic: "events", SASL: &notify.KafkaSASL{Mechanism: "GSSAPI"}},
+	}))
+}
+
+func TestWebhook(t *testing.T) {
+	event := testEvent()
+	var fail bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, "POST", r.Method)
+		require.Equal(t, "COMMIT_FINISHED", r.Header.Get(eventHeader))
+		require.Equal(t, event.ID, r.Header.Get(deliveryHeader))
+		mac := hmac.New(sha256.New, []byte("secret"))
+		mac.Write(body)
+		require.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), r.Header.Get(signatureHeader))
+		requireEvent(t, event, body)
+		if fail {
+			http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	s := newSender(&notify.Sink{Webhook: &notify.Webhook{URL: server.URL, Secret: "secret"}})
+	require.NoError(t, s.send(context.Background(), event))
+	fail = true
+	err := s.send(context.Background(), event)
+	require.YesError(t, err)
+	require.Matches(t, "503", err.Error())
+}
+
+// serveOnce accepts one connection on a new listener and passes it to 'f'. It
+// returns the listener's address
+func serveOnce(t *testing.T, f func(conn net.Conn)) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() {
+		defer l.Close()
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		f(conn)
+	}()
+	return l.Addr().String()
+}
+
+func TestNATS(t *testing.T) {
+	event := testEvent()
+	received := make(chan []byte, 1)
+	addr := serveOnce(t, func(conn net.Conn) {
+		r := bufio.NewReader(conn)
+		fmt.Fprint(conn, "INFO {\"server_id\":\"test\",\"auth_required\":true,\"max_payload\":1048576}\r\n")
+		line, _ := r.ReadString('\n')
+		if !strings.HasPrefix(line, "CONNECT ") || !strings.Contains(line, `"user":"pach"`) || !strings.Contains(line, `"pass":"word"`) {
+			fmt.Fprint(conn, "-ERR 'Authorization Violation'\r\n")
+			return
+		}
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			fields := strings.Fields(line)
+			switch {
+			case len(fields) == 1 && fields[0] == "PING":
+				fmt.Fprint(conn, "PONG\r\n")
+			case len(fields) == 3 && fields[0] == "PUB" && fields[1] == "pachyderm.events":
+				n, _ := strconv.Atoi(fields[2])
+				payload := make([]byte, n+2)
+				io.ReadFull(r, payload)
+				received <- payload[:n]
+			default:
+				fmt.Fprint(conn, "-ERR 'Unknown Protocol Operation'\r\n")
+				return
+			}
+		}
+	})
+
+	// Two events are published on the same connection
+	s := newSender(&notify.Sink{NATS: &notify.NATS{URL: "nats://pach:word@" + addr, Subject: "pachyderm.events"}})
+	defer s.close()
+	for i := 0; i < 2; i++ {
+		require.NoError(t, s.send(context.Background(), event))
+		requireEvent(t, event, <-received)
+	}
+}
+
+func TestNATSError(t *testing.T) {
+	addr := serveOnce(t, func(conn net.Conn) {
+		r := bufio.NewReader(conn)
+		fmt.Fprint(conn, "INFO {}\r\n")
+		r.ReadString('\n') // CONNECT
+		fmt.Fprint(conn, "-ERR 'Authorization Violation'\r\n")
+	})
+	s := newSender(&notify.Sink{NATS: &notify.NATS{URL: "nats://" + addr, Subject: "pachyderm.events"}})
+	defer s.close()
+	err := s.send(context.Background(), testEvent())
+	require.YesError(t, err)
+	require.Matches(t, "authorization violation", err.Error())
+}
+
+func TestKafkaSASLMechanism(t *testing.T) {
+	mechanism, err := kafkaSASLMechanism(nil)
+	require.NoError(t, err)
+	require.True(t, mechanism == nil)
+	for _, name := range []string{"PLAIN", "SCRAM-SHA-256", "SCRAM-SHA-512"} {
+		mechanism, err := kafkaSASLMechanism(&notify.KafkaSASL{Mechanism: name, Username: "pachd", Password: "s3cr3t"})
+		require.NoError(t, err)
+		require.Equal(t, name, mechanism.Name())
+	}
+	_, err = kafkaSASLMechanism(&notify.KafkaSASL{Mechanism: "GSSAPI"})
+	require.YesError(t, err)
+}
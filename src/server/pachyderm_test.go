@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/admin"
 	"github.com/pachyderm/pachyderm/src/client/auth"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/require"
@@ -41,6 +42,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
 
 	"github.com/gogo/protobuf/types"
+	minio "github.com/minio/minio-go"
 	apps "k8s.io/api/apps/v1beta2"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -2164,6 +2166,133 @@ func TestStopPipeline(t *testing.T) {
 	require.Equal(t, "foo\n", buffer.String())
 }
 
+func TestRollbackPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	dataRepo := tu.UniqueString("TestRollbackPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	pipelineName := tu.UniqueString("pipeline")
+	for i, output := range []string{"foo", "bar"} {
+		require.NoError(t, c.CreatePipeline(
+			pipelineName,
+			"",
+			[]string{"bash"},
+			[]string{fmt.Sprintf("echo %s >/pfs/out/file", output)},
+			&pps.ParallelismSpec{
+				Constant: 1,
+			},
+			client.NewAtomInput(dataRepo, "/*"),
+			"",
+			i > 0,
+		))
+	}
+
+	// Both versions are in the pipeline's history, newest first
+	versions, err := c.ListPipelineHistory(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(versions))
+	require.Equal(t, uint64(2), versions[0].Version)
+	require.Equal(t, uint64(1), versions[1].Version)
+	require.Equal(t, "echo bar >/pfs/out/file", versions[0].PipelineInfo.Transform.Stdin[0])
+	require.Equal(t, "echo foo >/pfs/out/file", versions[1].PipelineInfo.Transform.Stdin[0])
+
+	// Rolling back to version 1 creates version 3, with version 1's spec
+	require.YesError(t, c.RollbackPipeline(pipelineName, 2, false))
+	require.YesError(t, c.RollbackPipeline(pipelineName, 4, false))
+	require.NoError(t, c.RollbackPipeline(pipelineName, 1, true))
+	pipelineInfo, err := c.InspectPipeline(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), pipelineInfo.Version)
+	require.Equal(t, "echo foo >/pfs/out/file", pipelineInfo.Transform.Stdin[0])
+	versions, err = c.ListPipelineHistory(pipelineName)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(versions))
+
+	_, err = c.PutFile(dataRepo, "master", "file", strings.NewReader("1"))
+	require.NoError(t, err)
+	iter, err := c.FlushCommit([]*pfs.Commit{client.NewCommit(dataRepo, "master")}, nil)
+	require.NoError(t, err)
+	collectCommitInfos(t, iter)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, "master", "file", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+}
+
+func TestRerunPipeline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+	// create repos
+	dataRepo := tu.UniqueString("TestRerunPipeline_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	// create pipeline
+	pipelineName := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipelineName,
+		"",
+		[]string{"cp", path.Join("/pfs", dataRepo, "file"), "/pfs/out/file"},
+		nil,
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewAtomInput(dataRepo, "/*"),
+		"",
+		false,
+	))
+
+	// Make two commits, and wait for both to be processed
+	var commits []*pfs.Commit
+	for _, content := range []string{"foo\n", "bar\n"} {
+		commit, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		require.NoError(t, c.DeleteFile(dataRepo, commit.ID, "file"))
+		_, err = c.PutFile(dataRepo, commit.ID, "file", strings.NewReader(content))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+		commitIter, err := c.FlushCommit([]*pfs.Commit{commit}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(collectCommitInfos(t, commitIter)))
+		commits = append(commits, commit)
+	}
+
+	// Rerunning with no matching jobs is an error
+	require.YesError(t, c.RerunPipeline(pipelineName, []*pfs.Commit{commits[0]}, []*pfs.Commit{commits[0]}))
+
+	// Rerun the first job only
+	require.NoError(t, c.RerunPipeline(pipelineName, []*pfs.Commit{commits[0]}, nil))
+	jobInfos, err := c.ListJob(pipelineName, nil, nil)
+	require.NoError(t, err)
+	var rerunJobs []*pps.JobInfo
+	for _, jobInfo := range jobInfos {
+		if jobInfo.Rerun {
+			rerunJobs = append(rerunJobs, jobInfo)
+		}
+	}
+	require.Equal(t, 1, len(rerunJobs))
+	jobInfo, err := c.InspectJob(rerunJobs[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataProcessed)
+	var buffer bytes.Buffer
+	require.NoError(t, c.GetFile(pipelineName, jobInfo.OutputCommit.ID, "file", 0, 0, &buffer))
+	require.Equal(t, "foo\n", buffer.String())
+
+	// The output branch still has the output of the second commit
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commits[1]}, []*pfs.Repo{client.NewRepo(pipelineName)})
+	require.NoError(t, err)
+	collectCommitInfos(t, commitIter)
+	buffer.Reset()
+	require.NoError(t, c.GetFile(pipelineName, "master", "file", 0, 0, &buffer))
+	require.Equal(t, "bar\n", buffer.String())
+}
+
 func TestPipelineAutoScaledown(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -4002,6 +4131,87 @@ func TestUnionInput(t *testing.T) {
 	})
 }
 
+func TestJoinInput(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	users := tu.UniqueString("TestJoinInput_users")
+	require.NoError(t, c.CreateRepo(users))
+	events := tu.UniqueString("TestJoinInput_events")
+	require.NoError(t, c.CreateRepo(events))
+
+	var commits []*pfs.Commit
+	for repo, files := range map[string][]string{
+		users:  {"1.json", "2.json", "3.json"},
+		events: {"1.csv", "2.csv", "4.csv", "README"},
+	} {
+		commit, err := c.StartCommit(repo, "master")
+		require.NoError(t, err)
+		commits = append(commits, commit)
+		for _, file := range files {
+			_, err = c.PutFile(repo, "master", file, strings.NewReader(file+"\n"))
+			require.NoError(t, err)
+		}
+		require.NoError(t, c.FinishCommit(repo, "master"))
+	}
+
+	// Each datum pairs a user with the events that have the same ID
+	pipeline := tu.UniqueString("pipeline")
+	require.NoError(t, c.CreatePipeline(
+		pipeline,
+		"",
+		[]string{"bash"},
+		[]string{
+			fmt.Sprintf("id=$(basename /pfs/%s/*.json .json)", users),
+			fmt.Sprintf("cat /pfs/%s/* /pfs/%s/* > /pfs/out/$id", users, events),
+		},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewJoinInput(
+			client.NewJoinAtomInput(users, "/*", `/([^/]*)\.json`),
+			client.NewJoinAtomInput(events, "/*", `/([^/]*)\.csv`),
+		),
+		"",
+		false,
+	))
+
+	commitIter, err := c.FlushCommit(commits, []*pfs.Repo{client.NewRepo(pipeline)})
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+	outCommit := commitInfos[0].Commit
+	fileInfos, err := c.ListFile(outCommit.Repo.Name, outCommit.ID, "")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(fileInfos))
+	for _, id := range []string{"1", "2"} {
+		var buf bytes.Buffer
+		require.NoError(t, c.GetFile(outCommit.Repo.Name, outCommit.ID, id, 0, 0, &buf))
+		require.Equal(t, fmt.Sprintf("%s.json\n%s.csv\n", id, id), buf.String())
+	}
+
+	// A join's inputs must set join_on
+	require.YesError(t, c.CreatePipeline(
+		tu.UniqueString("pipeline"),
+		"",
+		[]string{"bash"},
+		[]string{""},
+		&pps.ParallelismSpec{
+			Constant: 1,
+		},
+		client.NewJoinInput(
+			client.NewAtomInput(users, "/*"),
+			client.NewJoinAtomInput(events, "/*", `/([^/]*)\.csv`),
+		),
+		"",
+		false,
+	))
+}
+
 func TestIncrementalOverwritePipeline(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -5480,6 +5690,94 @@ func TestHTTPGetFile(t *testing.T) {
 	require.Equal(t, "image/gif", contentDisposition)
 }
 
+func TestS3Gateway(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+	c := getPachClient(t)
+
+	// S3 bucket names must be lowercase
+	dataRepo := strings.ToLower(tu.UniqueString("tests3gateway-data"))
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit.ID, "dir/file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit.ID))
+
+	host, _, err := net.SplitHostPort(c.GetAddress())
+	require.NoError(t, err)
+	port, ok := os.LookupEnv("PACHD_SERVICE_PORT_API_S3_PORT")
+	if !ok {
+		port = "30600" // default NodePort port for Pachd's S3 gateway
+	}
+	s3Client, err := minio.New(net.JoinHostPort(host, port), "", "", false)
+	require.NoError(t, err)
+	bucket := "master." + dataRepo
+
+	buckets, err := s3Client.ListBuckets()
+	require.NoError(t, err)
+	found := false
+	for _, b := range buckets {
+		found = found || b.Name == bucket
+	}
+	require.True(t, found)
+
+	// Read a file written through PFS
+	object, err := s3Client.GetObject(bucket, "dir/file")
+	require.NoError(t, err)
+	contents, err := ioutil.ReadAll(object)
+	require.NoError(t, err)
+	require.Equal(t, "foo", string(contents))
+
+	// Write a file through the gateway, and read it through PFS
+	_, err = s3Client.PutObject(bucket, "dir/file2", strings.NewReader("bar"), "text/plain")
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(dataRepo, "master", "dir/file2", 0, 0, &buf))
+	require.Equal(t, "bar", buf.String())
+	commitInfos, err := c.ListCommit(dataRepo, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+
+	// Ranged reads
+	object, err = s3Client.GetObject(bucket, "dir/file2")
+	require.NoError(t, err)
+	b := make([]byte, 2)
+	_, err = object.ReadAt(b, 1)
+	require.NoError(t, err)
+	require.Equal(t, "ar", string(b))
+
+	var keys []string
+	for objectInfo := range s3Client.ListObjectsV2(bucket, "dir/", true, nil) {
+		require.NoError(t, objectInfo.Err)
+		keys = append(keys, objectInfo.Key)
+	}
+	require.ElementsEqual(t, []string{"dir/file", "dir/file2"}, keys)
+	keys = nil
+	for objectInfo := range s3Client.ListObjects(bucket, "", false, nil) {
+		require.NoError(t, objectInfo.Err)
+		keys = append(keys, objectInfo.Key)
+	}
+	require.ElementsEqual(t, []string{"dir/"}, keys)
+
+	objectInfo, err := s3Client.StatObject(bucket, "dir/file")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), objectInfo.Size)
+	_, err = s3Client.StatObject(bucket, "nonexistent")
+	require.YesError(t, err)
+
+	require.NoError(t, s3Client.RemoveObject(bucket, "dir/file"))
+	_, err = c.InspectFile(dataRepo, "master", "dir/file")
+	require.YesError(t, err)
+
+	// Nonexistent buckets
+	_, err = s3Client.GetObject("nonexistent."+dataRepo, "dir/file2")
+	require.NoError(t, err) // minio doesn't make a request until the object is read
+	_, err = s3Client.StatObject("nonexistent."+dataRepo, "dir/file2")
+	require.YesError(t, err)
+}
+
 func TestService(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -6453,6 +6751,304 @@ func TestPipelineWithDatumTimeout(t *testing.T) {
 	require.Equal(t, timeout, seconds)
 }
 
+func TestPipelineWithDatumTries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineWithDatumTries_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit1.ID, "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	// The user code fails the first two times it runs, and succeeds the third
+	pipeline := tu.UniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					"echo x >> /tmp/attempts",
+					"if [ $(wc -l < /tmp/attempts) -lt 3 ]; then exit 1; fi",
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			Input:        client.NewAtomInput(dataRepo, "/*"),
+			EnableStats:  true,
+			DatumTries:   3,
+			DatumBackoff: types.DurationProto(100 * time.Millisecond),
+		},
+	)
+	require.NoError(t, err)
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	jobs, err := c.ListJob(pipeline, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	jobInfo, err := c.InspectJob(jobs[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(3), jobInfo.DatumTries)
+	require.Equal(t, int64(3), jobInfo.Stats.Attempts)
+
+	resp, err := c.ListDatum(jobs[0].Job.ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.DatumInfos))
+	datum, err := c.InspectDatum(jobs[0].Job.ID, resp.DatumInfos[0].Datum.ID)
+	require.NoError(t, err)
+	require.Equal(t, pps.DatumState_SUCCESS, datum.State)
+	require.Equal(t, int64(3), datum.Attempts)
+
+	// A pipeline that can't succeed within its tries fails its job
+	pipeline2 := tu.UniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline2),
+			Transform: &pps.Transform{
+				Cmd:   []string{"bash"},
+				Stdin: []string{"exit 1"},
+			},
+			Input:        client.NewAtomInput(dataRepo, "/*"),
+			EnableStats:  true,
+			DatumTries:   2,
+			DatumBackoff: types.DurationProto(0),
+		},
+	)
+	require.NoError(t, err)
+	commitIter, err = c.FlushCommit([]*pfs.Commit{commit1}, []*pfs.Repo{client.NewRepo(pipeline2)})
+	require.NoError(t, err)
+	collectCommitInfos(t, commitIter)
+	jobs, err = c.ListJob(pipeline2, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	jobInfo, err = c.InspectJob(jobs[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_FAILURE, jobInfo.State)
+	resp, err = c.ListDatum(jobs[0].Job.ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.DatumInfos))
+	datum, err = c.InspectDatum(jobs[0].Job.ID, resp.DatumInfos[0].Datum.ID)
+	require.NoError(t, err)
+	require.Equal(t, pps.DatumState_FAILED, datum.State)
+	require.Equal(t, int64(2), datum.Attempts)
+}
+
+func TestPipelineSkipFailedDatums(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineSkipFailedDatums_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		_, err = c.PutFile(dataRepo, commit1.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo"))
+		require.NoError(t, err)
+	}
+	_, err = c.PutFile(dataRepo, commit1.ID, "corrupt", strings.NewReader("bar"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	// The user code fails on the "corrupt" file
+	pipeline := tu.UniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					fmt.Sprintf("if [ -f /pfs/%s/corrupt ]; then exit 1; fi", dataRepo),
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			Input:            client.NewAtomInput(dataRepo, "/*"),
+			DatumTries:       1,
+			SkipFailedDatums: true,
+		},
+	)
+	require.NoError(t, err)
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+	commitInfos := collectCommitInfos(t, commitIter)
+	require.Equal(t, 1, len(commitInfos))
+
+	// The output commit contains the output of every datum except the failed one
+	fileInfos, err := c.ListFile(pipeline, commitInfos[0].Commit.ID, "")
+	require.NoError(t, err)
+	require.Equal(t, 10, len(fileInfos))
+	for _, fileInfo := range fileInfos {
+		require.NotEqual(t, "/corrupt", fileInfo.File.Path)
+	}
+
+	jobs, err := c.ListJob(pipeline, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	jobInfo, err := c.InspectJob(jobs[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS_WITH_FAILURES, jobInfo.State)
+	require.Equal(t, int64(1), jobInfo.DataFailed)
+	require.Equal(t, int64(10), jobInfo.DataProcessed-jobInfo.DataFailed)
+
+	resp, err := c.ListFailedDatum(jobs[0].Job.ID, 0, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resp.DatumInfos))
+	require.Equal(t, pps.DatumState_FAILED, resp.DatumInfos[0].State)
+	require.Equal(t, 1, len(resp.DatumInfos[0].Data))
+	require.Equal(t, "/corrupt", resp.DatumInfos[0].Data[0].File.Path)
+}
+
+func TestPipelinePriority(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelinePriority_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	_, err = c.PutFile(dataRepo, commit1.ID, "file", strings.NewReader("foo"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	pipeline := tu.UniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd:   []string{"bash"},
+				Stdin: []string{fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo)},
+			},
+			Input:    client.NewAtomInput(dataRepo, "/*"),
+			Priority: 10,
+		},
+	)
+	require.NoError(t, err)
+	pipelineInfo, err := c.InspectPipeline(pipeline)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), pipelineInfo.Priority)
+
+	// Jobs pass through the job queue, and leave it when they finish
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collectCommitInfos(t, commitIter)))
+	jobs, err := c.ListJob(pipeline, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	jobInfo, err := c.InspectJob(jobs[0].Job.ID, true)
+	require.NoError(t, err)
+	require.Equal(t, pps.JobState_JOB_SUCCESS, jobInfo.State)
+	require.Equal(t, int64(10), jobInfo.Priority)
+	require.Equal(t, int64(0), jobInfo.QueuePosition)
+}
+
+func TestPipelineAutoscaling(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestPipelineAutoscaling_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	commit1, err := c.StartCommit(dataRepo, "master")
+	require.NoError(t, err)
+	numFiles := 10
+	for i := 0; i < numFiles; i++ {
+		_, err = c.PutFile(dataRepo, commit1.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, c.FinishCommit(dataRepo, commit1.ID))
+
+	// Autoscaling can't be combined with another parallelism strategy
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(tu.UniqueString("invalid")),
+			Transform: &pps.Transform{
+				Cmd: []string{"true"},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Constant:    2,
+				Autoscaling: &pps.Autoscaling{Max: 3},
+			},
+			Input: client.NewAtomInput(dataRepo, "/*"),
+		},
+	)
+	require.YesError(t, err)
+
+	pipeline := tu.UniqueString("pipeline")
+	_, err = c.PpsAPIClient.CreatePipeline(
+		context.Background(),
+		&pps.CreatePipelineRequest{
+			Pipeline: client.NewPipeline(pipeline),
+			Transform: &pps.Transform{
+				Cmd: []string{"bash"},
+				Stdin: []string{
+					"sleep 10",
+					fmt.Sprintf("cp /pfs/%s/* /pfs/out/", dataRepo),
+				},
+			},
+			ParallelismSpec: &pps.ParallelismSpec{
+				Autoscaling: &pps.Autoscaling{Max: 3},
+			},
+			ChunkSpec: &pps.ChunkSpec{Number: 1},
+			Input:     client.NewAtomInput(dataRepo, "/*"),
+		},
+	)
+	require.NoError(t, err)
+
+	// The pipeline has more outstanding chunks than 'max', so it should scale
+	// up to 'max' workers
+	require.NoError(t, backoff.Retry(func() error {
+		pipelineInfo, err := c.InspectPipeline(pipeline)
+		require.NoError(t, err)
+		if pipelineInfo.AutoscalingStatus == nil || pipelineInfo.AutoscalingStatus.Workers != 3 {
+			return fmt.Errorf("expected pipeline to scale up to 3 workers, got: %v", pipelineInfo.AutoscalingStatus)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	commitIter, err := c.FlushCommit([]*pfs.Commit{commit1}, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(collectCommitInfos(t, commitIter)))
+
+	// Once the job is done, the pipeline scales back down to one worker
+	require.NoError(t, backoff.Retry(func() error {
+		pipelineInfo, err := c.InspectPipeline(pipeline)
+		require.NoError(t, err)
+		if pipelineInfo.AutoscalingStatus.Workers != 1 {
+			return fmt.Errorf("expected pipeline to scale down to 1 worker, got: %v", pipelineInfo.AutoscalingStatus)
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+}
+
 func TestPipelineWithDatumTimeoutControl(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -6890,6 +7486,76 @@ func TestExtractRestore(t *testing.T) {
 	require.Equal(t, numPipelines, len(commitInfos))
 }
 
+func TestIncrementalExtractRestore(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getPachClient(t)
+	defer require.NoError(t, c.DeleteAll())
+
+	dataRepo := tu.UniqueString("TestIncrementalExtractRestore_data")
+	require.NoError(t, c.CreateRepo(dataRepo))
+	otherRepo := tu.UniqueString("TestIncrementalExtractRestore_other")
+	require.NoError(t, c.CreateRepo(otherRepo))
+	putCommit := func(i int) {
+		_, err := c.StartCommit(dataRepo, "master")
+		require.NoError(t, err)
+		_, err = c.PutFile(dataRepo, "master", fmt.Sprintf("file-%d", i), strings.NewReader(fmt.Sprintf("%d\n", i)))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit(dataRepo, "master"))
+	}
+	extract := func(since *admin.BackupMarker) ([]*admin.Op, *admin.BackupMarker) {
+		var ops []*admin.Op
+		require.NoError(t, c.ExtractWithRequest(&admin.ExtractRequest{
+			Repos: []string{dataRepo},
+			Since: since,
+		}, func(op *admin.Op) error {
+			ops = append(ops, op)
+			return nil
+		}))
+		marker := ops[len(ops)-1].Op1_7.Marker
+		require.NotNil(t, marker)
+		for _, op := range ops {
+			if op.Op1_7.Repo != nil {
+				require.Equal(t, dataRepo, op.Op1_7.Repo.Repo.Name)
+			}
+		}
+		return ops, marker
+	}
+	countCommits := func(ops []*admin.Op) int {
+		var n int
+		for _, op := range ops {
+			if op.Op1_7.Commit != nil {
+				n++
+			}
+		}
+		return n
+	}
+
+	putCommit(0)
+	putCommit(1)
+	full, marker := extract(nil)
+	require.Equal(t, 2, countCommits(full))
+	putCommit(2)
+	putCommit(3)
+	incremental, _ := extract(marker)
+	require.Equal(t, 2, countCommits(incremental))
+
+	require.NoError(t, c.DeleteAll())
+	require.NoError(t, c.Restore(full))
+	require.NoError(t, c.Restore(incremental))
+
+	commitInfos, err := c.ListCommit(dataRepo, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(commitInfos))
+	var buf bytes.Buffer
+	require.NoError(t, c.GetFile(dataRepo, "master", "file-3", 0, 0, &buf))
+	require.Equal(t, "3\n", buf.String())
+	_, err = c.InspectRepo(otherRepo)
+	require.YesError(t, err)
+}
+
 // TestCancelJob creates a long-running job and then kills it, testing that the
 // user process is killed.
 func TestCancelJob(t *testing.T) {
@@ -503,6 +503,41 @@ $ pachctl subscribe-commit test master --new
 		}),
 	}
 
+	squashCommit := &cobra.Command{
+		Use:   "squash-commit repo-name from-commit to-commit",
+		Short: "Merge a run of finished commits into a single commit.",
+		Long: `Merge the finished commits from "from-commit" to "to-commit"
+(inclusive) into a single commit. "from-commit" must be an ancestor of
+"to-commit". The result is "to-commit" (which already contains the data of
+every commit in the run), with the parent of "from-commit" as its parent; the
+other commits are deleted, and their data can be reclaimed by
+'pachctl garbage-collect'. Only commits in input repos (which have no
+provenance) may be squashed, and no commit but "to-commit" may be the head of a
+branch.
+
+Examples:
+
+` + codestart + `# squash every commit on branch "master" of repo "cron" except the
+# first into a single commit
+$ pachctl squash-commit cron master~9 master
+
+# squash commits XXX through YYY in repo "test"
+$ pachctl squash-commit test XXX YYY
+` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			commit, err := client.SquashCommits(args[0], args[1], args[2])
+			if err != nil {
+				return err
+			}
+			fmt.Println(commit.ID)
+			return nil
+		}),
+	}
+
 	var branchProvenance cmdutil.RepeatedStringArg
 	var head string
 	createBranch := &cobra.Command{
@@ -591,6 +626,115 @@ $ pachctl set-branch foo test master` + codeend,
 		}),
 	}
 
+	printReplications := func(repoName string) error {
+		client, err := client.NewOnUserMachine(metrics, "user")
+		if err != nil {
+			return err
+		}
+		replicationInfos, err := client.ListReplication(repoName)
+		if err != nil {
+			return err
+		}
+		if raw {
+			for _, replicationInfo := range replicationInfos {
+				if err := marshaller.Marshal(os.Stdout, replicationInfo); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+		pretty.PrintReplicationHeader(writer)
+		for _, replicationInfo := range replicationInfos {
+			pretty.PrintReplicationInfo(writer, replicationInfo)
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		pretty.PrintReplicationFailures(os.Stdout, replicationInfos)
+		return nil
+	}
+
+	replication := &cobra.Command{
+		Use:   "replication",
+		Short: "Show the status of repo replication to other clusters.",
+		Long: `Show the status of each replication, and manage replications with the
+replication subcommands.
+
+A replication continuously copies the commits on a branch to a remote pachd
+(e.g. a disaster recovery cluster). Commits keep their IDs and provenance in
+the remote cluster. The LAG column shows how many commits on the branch haven't
+been replicated yet, and how long ago the oldest of them was finished.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			return printReplications("")
+		}),
+	}
+	rawFlag(replication)
+
+	var remoteRepo string
+	var remoteAuthToken string
+	createReplication := &cobra.Command{
+		Use:   "create repo-name branch remote",
+		Short: "Start replicating a branch to a remote pachd.",
+		Long: `Start replicating a branch to a remote pachd. 'remote' is the remote
+pachd's address, as host:port or pfs://host:port.
+
+Existing commits on the branch are replicated first, and new commits are
+replicated as they're finished. If the remote branch already has commits,
+replication resumes after the remote branch's head. A commit's provenance is
+only kept for branches that are replicated to the same remote, and a commit is
+only replicated after that provenance, so replicate an output repo's input
+branches first. Only the repo's owners can replicate it.
+
+Examples:
+
+` + codestart + `# replicate branch "master" of repo "images" to the DR cluster
+$ pachctl replication create images master dr-pachd.example.com:650
+
+# replicate it to a repo with a different name
+$ pachctl replication create images master dr-pachd.example.com:650 --remote-repo images-prod
+` + codeend,
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.CreateReplication(args[0], args[1], args[2], remoteRepo, remoteAuthToken)
+		}),
+	}
+	createReplication.Flags().StringVar(&remoteRepo, "remote-repo", "", "The repo in the remote cluster that commits are replicated to (defaults to the same name).")
+	createReplication.Flags().StringVar(&remoteAuthToken, "remote-auth-token", "", "An auth token for the remote cluster, if auth is activated there.")
+	replication.AddCommand(createReplication)
+
+	listReplication := &cobra.Command{
+		Use:   "list [repo-name]",
+		Short: "Show the status of each replication.",
+		Long:  "Show the status of each replication, or only of the replications of a repo's branches.",
+		Run: cmdutil.RunBoundedArgs(0, 1, func(args []string) error {
+			var repoName string
+			if len(args) > 0 {
+				repoName = args[0]
+			}
+			return printReplications(repoName)
+		}),
+	}
+	rawFlag(listReplication)
+	replication.AddCommand(listReplication)
+
+	deleteReplication := &cobra.Command{
+		Use:   "delete repo-name branch remote",
+		Short: "Stop replicating a branch to a remote pachd.",
+		Long:  "Stop replicating a branch to a remote pachd. Only the replication's creator and the repo's owners can stop it. Commits that have already been replicated are left in the remote cluster.",
+		Run: cmdutil.RunFixedArgs(3, func(args []string) error {
+			client, err := client.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			return client.DeleteReplication(args[0], args[1], args[2])
+		}),
+	}
+	replication.AddCommand(deleteReplication)
+
 	file := &cobra.Command{
 		Use:   "file",
 		Short: "Docs for files.",
@@ -760,7 +904,7 @@ want to consider using commit IDs directly.
 	putFile.Flags().StringVarP(&inputFile, "input-file", "i", "", "Read filepaths or URLs from a file.  If - is used, paths are read from the standard input.")
 	putFile.Flags().BoolVarP(&recursive, "recursive", "r", false, "Recursively put the files in a directory.")
 	putFile.Flags().UintVarP(&parallelism, "parallelism", "p", DefaultParallelism, "The maximum number of files that can be uploaded in parallel.")
-	putFile.Flags().StringVar(&split, "split", "", "Split the input file into smaller files, subject to the constraints of --target-file-datums and --target-file-bytes. Permissible values are `json` and `line`.")
+	putFile.Flags().StringVar(&split, "split", "", "Split the input file into smaller files, subject to the constraints of --target-file-datums and --target-file-bytes. Permissible values are `json`, `line`, `csv` (which repeats the header row in each file) and `sql` (which repeats the schema of a pg_dump in each file).")
 	putFile.Flags().UintVar(&targetFileDatums, "target-file-datums", 0, "The upper bound of the number of datums that each file contains, the last file will contain fewer if the datums don't divide evenly; needs to be used with --split.")
 	putFile.Flags().UintVar(&targetFileBytes, "target-file-bytes", 0, "The target upper bound of the number of bytes that each file contains; needs to be used with --split.")
 	putFile.Flags().BoolVarP(&putFileCommit, "commit", "c", false, "Put file(s) in a new commit.")
@@ -1140,10 +1284,12 @@ $ pachctl diff-file foo master path1 bar master path2
 	result = append(result, flushCommit)
 	result = append(result, subscribeCommit)
 	result = append(result, deleteCommit)
+	result = append(result, squashCommit)
 	result = append(result, createBranch)
 	result = append(result, listBranch)
 	result = append(result, setBranch)
 	result = append(result, deleteBranch)
+	result = append(result, replication)
 	result = append(result, file)
 	result = append(result, putFile)
 	result = append(result, copyFile)
@@ -1207,8 +1353,12 @@ func putFileHelper(client *client.APIClient, repo, commit, path, source string,
 			delimiter = pfsclient.Delimiter_LINE
 		case "json":
 			delimiter = pfsclient.Delimiter_JSON
+		case "csv":
+			delimiter = pfsclient.Delimiter_CSV
+		case "sql":
+			delimiter = pfsclient.Delimiter_SQL
 		default:
-			return fmt.Errorf("unrecognized delimiter '%s'; only accepts 'json' or 'line'", split)
+			return fmt.Errorf("unrecognized delimiter '%s'; only accepts 'json', 'line', 'csv' or 'sql'", split)
 		}
 		_, err := client.PutFileSplit(repo, commit, path, delimiter, int64(targetFileDatums), int64(targetFileBytes), overwrite, reader)
 		return err
@@ -324,6 +324,12 @@ func (f *file) delimiter() pfsclient.Delimiter {
 	if strings.HasSuffix(f.File.Path, ".json") {
 		return pfsclient.Delimiter_JSON
 	}
+	if strings.HasSuffix(f.File.Path, ".csv") {
+		return pfsclient.Delimiter_CSV
+	}
+	if strings.HasSuffix(f.File.Path, ".sql") {
+		return pfsclient.Delimiter_SQL
+	}
 	if strings.HasSuffix(f.File.Path, ".bin") {
 		return pfsclient.Delimiter_NONE
 	}
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/docker/go-units"
+	"github.com/fatih/color"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
@@ -41,7 +42,8 @@ func PrintDetailedRepoInfo(repoInfo *pfs.RepoInfo) error {
 		`Name: {{.Repo.Name}}{{if .Description}}
 Description: {{.Description}}{{end}}
 Created: {{prettyAgo .Created}}
-Size: {{prettySize .SizeBytes}}{{if .AuthInfo}}
+Size: {{prettySize .SizeBytes}}
+Physical Size: {{prettySize .PhysicalSizeBytes}}{{if .AuthInfo}}
 Access level: {{ .AuthInfo.AccessLevel.String }}{{end}}
 `)
 	if err != nil {
@@ -69,6 +71,54 @@ func PrintBranch(w io.Writer, branchInfo *pfs.BranchInfo) {
 	}
 }
 
+// PrintReplicationHeader prints a replication header.
+func PrintReplicationHeader(w io.Writer) {
+	fmt.Fprint(w, "BRANCH\tREMOTE\tSTATE\tLAST REPLICATED\tLAG\tREPLICATED\tUPDATED\t\n")
+}
+
+// PrintReplicationInfo pretty-prints replication info.
+func PrintReplicationInfo(w io.Writer, replicationInfo *pfs.ReplicationInfo) {
+	branch := replicationInfo.Replication.Branch
+	fmt.Fprintf(w, "%s@%s\t", branch.Repo.Name, branch.Name)
+	fmt.Fprintf(w, "%s/%s\t", replicationInfo.Replication.Remote, replicationInfo.RemoteRepo)
+	fmt.Fprintf(w, "%s\t", replicationState(replicationInfo.State))
+	if replicationInfo.LastReplicated != nil {
+		fmt.Fprintf(w, "%s\t", replicationInfo.LastReplicated.ID)
+	} else {
+		fmt.Fprint(w, "-\t")
+	}
+	if replicationInfo.LagCommits > 0 {
+		fmt.Fprintf(w, "%d commits (%s)\t", replicationInfo.LagCommits, pretty.Duration(replicationInfo.Lag))
+	} else {
+		fmt.Fprint(w, "none\t")
+	}
+	fmt.Fprintf(w, "%d commits, %s\t", replicationInfo.CommitsReplicated, units.BytesSize(float64(replicationInfo.BytesReplicated)))
+	fmt.Fprintf(w, "%s\t\n", pretty.Ago(replicationInfo.Updated))
+}
+
+// PrintReplicationFailures prints the reason why each failing replication is
+// failing.
+func PrintReplicationFailures(w io.Writer, replicationInfos []*pfs.ReplicationInfo) {
+	for _, replicationInfo := range replicationInfos {
+		if replicationInfo.State == pfs.ReplicationState_REPLICATION_FAILING {
+			branch := replicationInfo.Replication.Branch
+			fmt.Fprintf(w, "%s@%s -> %s: %s\n", branch.Repo.Name, branch.Name, replicationInfo.Replication.Remote, replicationInfo.Reason)
+		}
+	}
+}
+
+func replicationState(state pfs.ReplicationState) string {
+	switch state {
+	case pfs.ReplicationState_REPLICATION_STARTING:
+		return color.New(color.FgYellow).SprintFunc()("starting")
+	case pfs.ReplicationState_REPLICATION_RUNNING:
+		return color.New(color.FgGreen).SprintFunc()("running")
+	case pfs.ReplicationState_REPLICATION_FAILING:
+		return color.New(color.FgRed).SprintFunc()("failing")
+	}
+	return "-"
+}
+
 // PrintCommitInfoHeader prints a commit info header.
 func PrintCommitInfoHeader(w io.Writer) {
 	fmt.Fprint(w, "REPO\tID\tPARENT\tSTARTED\tDURATION\tSIZE\t\n")
@@ -106,7 +156,8 @@ Description: {{.Description}}{{end}}{{if .ParentCommit}}
 Parent: {{.ParentCommit.ID}}{{end}}
 Started: {{prettyAgo .Started}}{{if .Finished}}
 Finished: {{prettyAgo .Finished}} {{end}}
-Size: {{prettySize .SizeBytes}}{{if .Provenance}}
+Size: {{prettySize .SizeBytes}}
+Physical Size: {{prettySize .PhysicalSizeBytes}}{{if .Provenance}}
 Provenance: {{range .Provenance}} {{.Repo.Name}}/{{.ID}} {{end}} {{end}}
 `)
 	if err != nil {
@@ -0,0 +1,341 @@
+package s3
+
+import (
+	"encoding/hex"
+	"encoding/xml"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+const (
+	// xmlns is the XML namespace of S3 API responses
+	xmlns = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+	// defaultMaxKeys is the maximum number of keys returned by ListObjects,
+	// if the client doesn't set max-keys
+	defaultMaxKeys = 1000
+
+	// timeFormat is the format of times in S3 API responses
+	timeFormat = "2006-01-02T15:04:05.000Z"
+)
+
+type bucket struct {
+	Name         string
+	CreationDate string
+}
+
+type listAllMyBucketsResult struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Xmlns   string   `xml:"xmlns,attr"`
+	Owner   owner
+	Buckets []bucket `xml:"Buckets>Bucket"`
+}
+
+type owner struct {
+	ID          string
+	DisplayName string
+}
+
+type locationConstraint struct {
+	XMLName  xml.Name `xml:"LocationConstraint"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string   `xml:",chardata"`
+}
+
+type object struct {
+	Key          string
+	LastModified string
+	ETag         string
+	Size         uint64
+	StorageClass string
+}
+
+type commonPrefix struct {
+	Prefix string
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name `xml:"ListBucketResult"`
+	Xmlns          string   `xml:"xmlns,attr"`
+	Name           string
+	Prefix         string
+	Delimiter      string `xml:",omitempty"`
+	MaxKeys        int
+	IsTruncated    bool
+	Contents       []object
+	CommonPrefixes []commonPrefix
+
+	// ListObjects (V1) only
+	Marker     *string `xml:",omitempty"`
+	NextMarker string  `xml:",omitempty"`
+
+	// ListObjectsV2 only
+	KeyCount              *int   `xml:",omitempty"`
+	StartAfter            string `xml:",omitempty"`
+	ContinuationToken     string `xml:",omitempty"`
+	NextContinuationToken string `xml:",omitempty"`
+}
+
+// formatTime formats the timestamp 't' for an S3 API response
+func formatTime(t *types.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	tm, err := types.TimestampFromProto(t)
+	if err != nil {
+		return ""
+	}
+	return tm.UTC().Format(timeFormat)
+}
+
+// etag returns the ETag of the file 'fileInfo' (the hex-encoded PFS hash of
+// its contents)
+func etag(fileInfo *pfs.FileInfo) string {
+	return "\"" + hex.EncodeToString(fileInfo.Hash) + "\""
+}
+
+func (s *server) listBuckets(c *client.APIClient, w http.ResponseWriter, r *http.Request) error {
+	repoInfos, err := c.ListRepo()
+	if err != nil {
+		return err
+	}
+	result := &listAllMyBucketsResult{Xmlns: xmlns}
+	for _, repoInfo := range repoInfos {
+		for _, branch := range repoInfo.Branches {
+			result.Buckets = append(result.Buckets, bucket{
+				Name:         bucketName(repoInfo.Repo.Name, branch.Name),
+				CreationDate: formatTime(repoInfo.Created),
+			})
+		}
+	}
+	sort.Slice(result.Buckets, func(i, j int) bool {
+		return result.Buckets[i].Name < result.Buckets[j].Name
+	})
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+func (s *server) getBucketLocation(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket string) error {
+	if _, err := inspectBucket(c, bucket); err != nil {
+		return err
+	}
+	// Pachyderm clusters have no region; an empty location means "us-east-1"
+	// to S3 clients
+	writeXML(w, http.StatusOK, &locationConstraint{Xmlns: xmlns})
+	return nil
+}
+
+// createBucket creates the branch that 'bucket' refers to (and its repo, if
+// necessary). The new branch has no commits
+func (s *server) createBucket(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket string) error {
+	repo, branch, err := parseBucket(bucket)
+	if err != nil {
+		return err
+	}
+	if _, err := c.InspectRepo(repo); err != nil {
+		if !errutil.IsNotFoundError(err) {
+			return err
+		}
+		if err := c.CreateRepo(repo); err != nil {
+			return err
+		}
+	}
+	if _, err := c.InspectBranch(repo, branch); err == nil {
+		return errBucketAlreadyOwnedByYou(bucket)
+	} else if !errutil.IsNotFoundError(err) {
+		return err
+	}
+	if err := c.CreateBranch(repo, branch, "", nil); err != nil {
+		return err
+	}
+	w.Header().Set("Location", "/"+bucket)
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// deleteBucket deletes the branch that 'bucket' refers to. The branch's
+// commits (and its repo) aren't deleted
+func (s *server) deleteBucket(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket string) error {
+	branchInfo, err := inspectBucket(c, bucket)
+	if err != nil {
+		return err
+	}
+	if err := c.DeleteBranch(branchInfo.Branch.Repo.Name, branchInfo.Branch.Name); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// listEntry is a key or common prefix returned by ListObjects
+type listEntry struct {
+	key      string
+	fileInfo *pfs.FileInfo // nil for common prefixes
+}
+
+// listObjects implements both ListObjects and ListObjectsV2 (if the request
+// sets list-type=2)
+func (s *server) listObjects(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket string) error {
+	branchInfo, err := inspectBucket(c, bucket)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	prefix := query.Get("prefix")
+	delimiter := query.Get("delimiter")
+	maxKeys := defaultMaxKeys
+	if maxKeysStr := query.Get("max-keys"); maxKeysStr != "" {
+		maxKeys, err = strconv.Atoi(maxKeysStr)
+		if err != nil || maxKeys < 0 {
+			return errInvalidArgument("invalid max-keys %q", maxKeysStr)
+		}
+	}
+	result := &listBucketResult{
+		Xmlns:     xmlns,
+		Name:      bucket,
+		Prefix:    prefix,
+		Delimiter: delimiter,
+		MaxKeys:   maxKeys,
+	}
+	// Keys up to and including 'marker' have already been returned
+	var marker string
+	v2 := query.Get("list-type") == "2"
+	if v2 {
+		result.StartAfter = query.Get("start-after")
+		result.ContinuationToken = query.Get("continuation-token")
+		marker = result.StartAfter
+		if result.ContinuationToken != "" {
+			marker = result.ContinuationToken
+		}
+	} else {
+		marker = query.Get("marker")
+		result.Marker = &marker
+	}
+
+	var entries []listEntry
+	if branchInfo.Head != nil {
+		entries, err = listEntries(c, branchInfo.Head, prefix, delimiter)
+		if err != nil {
+			return err
+		}
+	}
+	// PFS doesn't track when individual files were modified, so every object
+	// is reported as modified by the head commit
+	var modified string
+	if len(entries) > 0 {
+		t, err := lastModified(c, branchInfo.Head)
+		if err != nil {
+			return err
+		}
+		modified = t.UTC().Format(timeFormat)
+	}
+	var lastKey string
+	for _, entry := range entries {
+		if entry.key <= marker {
+			continue
+		}
+		if len(result.Contents)+len(result.CommonPrefixes) == maxKeys {
+			result.IsTruncated = true
+			break
+		}
+		if entry.fileInfo == nil {
+			result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix{Prefix: entry.key})
+		} else {
+			result.Contents = append(result.Contents, object{
+				Key:          entry.key,
+				LastModified: modified,
+				ETag:         etag(entry.fileInfo),
+				Size:         entry.fileInfo.SizeBytes,
+				StorageClass: "STANDARD",
+			})
+		}
+		lastKey = entry.key
+	}
+	if v2 {
+		keyCount := len(result.Contents) + len(result.CommonPrefixes)
+		result.KeyCount = &keyCount
+		if result.IsTruncated {
+			result.NextContinuationToken = lastKey
+		}
+	} else if result.IsTruncated {
+		result.NextMarker = lastKey
+	}
+	writeXML(w, http.StatusOK, result)
+	return nil
+}
+
+// listEntries returns the keys in 'commit' that start with 'prefix', sorted.
+// If 'delimiter' is set, keys that contain 'delimiter' after 'prefix' are
+// rolled up into common prefixes
+func listEntries(c *client.APIClient, commit *pfs.Commit, prefix, delimiter string) ([]listEntry, error) {
+	// Only the directory containing 'prefix' needs to be searched
+	dir := "/" + prefix[:strings.LastIndex(prefix, "/")+1]
+	var fileInfos []*pfs.FileInfo
+	var err error
+	if delimiter == "/" {
+		// Common prefixes are exactly the directories in 'dir', so there's no
+		// need to read the files below them
+		fileInfos, err = c.ListFile(commit.Repo.Name, commit.ID, dir)
+	} else {
+		fileInfos, err = c.GlobFile(commit.Repo.Name, commit.ID, path.Join(dir, "**"))
+	}
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var entries []listEntry
+	seen := make(map[string]bool)
+	for _, fileInfo := range fileInfos {
+		key := strings.TrimPrefix(fileInfo.File.Path, "/")
+		if fileInfo.FileType == pfs.FileType_DIR {
+			if delimiter != "/" {
+				continue
+			}
+			key += "/"
+		}
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := key[:len(prefix)+i+len(delimiter)]
+				if !seen[commonPrefix] {
+					seen[commonPrefix] = true
+					entries = append(entries, listEntry{key: commonPrefix})
+				}
+				continue
+			}
+		}
+		entries = append(entries, listEntry{key: key, fileInfo: fileInfo})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].key < entries[j].key
+	})
+	return entries, nil
+}
+
+// lastModified returns the time at which 'commit' was finished (or started,
+// if it's still open)
+func lastModified(c *client.APIClient, commit *pfs.Commit) (time.Time, error) {
+	commitInfo, err := c.InspectCommit(commit.Repo.Name, commit.ID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	t := commitInfo.Finished
+	if t == nil {
+		t = commitInfo.Started
+	}
+	return types.TimestampFromProto(t)
+}
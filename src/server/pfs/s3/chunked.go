@@ -0,0 +1,97 @@
+package s3
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// streamingPayload is the value of the X-Amz-Content-Sha256 header in
+// requests whose body is sent with the aws-chunked content encoding
+const streamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+// chunkedReader decodes a request body sent with the aws-chunked content
+// encoding, which S3 clients use to sign each chunk of a streaming upload:
+//
+//	<hex size>;chunk-signature=<signature>\r\n
+//	<data>\r\n
+//	...
+//	0;chunk-signature=<signature>\r\n
+//	\r\n
+//
+// Chunk signatures aren't checked, as the gateway doesn't check request
+// signatures in general.
+type chunkedReader struct {
+	r         *bufio.Reader
+	remaining int64 // bytes left in the current chunk
+	done      bool  // true once the final (empty) chunk has been read
+}
+
+func newChunkedReader(r io.Reader) *chunkedReader {
+	return &chunkedReader{r: bufio.NewReader(r)}
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	for c.remaining == 0 {
+		if c.done {
+			return 0, io.EOF
+		}
+		if err := c.readChunkHeader(); err != nil {
+			return 0, err
+		}
+	}
+	if int64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.r.Read(p)
+	c.remaining -= int64(n)
+	if err == io.EOF {
+		return n, io.ErrUnexpectedEOF
+	}
+	if err == nil && c.remaining == 0 {
+		err = c.readCRLF()
+	}
+	return n, err
+}
+
+// readChunkHeader reads the header of the next chunk, and sets c.remaining to
+// the chunk's size
+func (c *chunkedReader) readChunkHeader() error {
+	line, err := c.r.ReadString('\n')
+	if err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	header := strings.TrimRight(line, "\r\n")
+	if i := strings.IndexByte(header, ';'); i >= 0 {
+		header = header[:i]
+	}
+	size, err := strconv.ParseInt(header, 16, 64)
+	if err != nil || size < 0 {
+		return fmt.Errorf("malformed aws-chunked chunk header %q", line)
+	}
+	if size == 0 {
+		c.done = true
+	}
+	c.remaining = size
+	return nil
+}
+
+// readCRLF reads the "\r\n" that terminates a chunk's data
+func (c *chunkedReader) readCRLF() error {
+	var crlf [2]byte
+	if _, err := io.ReadFull(c.r, crlf[:]); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	if string(crlf[:]) != "\r\n" {
+		return fmt.Errorf("malformed aws-chunked chunk: missing CRLF after data")
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+)
+
+// s3Error is an error returned to S3 clients. Its code identifies the error
+// to clients, per the S3 API
+type s3Error struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string
+	Message  string
+	Resource string
+	status   int
+}
+
+func (e *s3Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func newError(status int, code string, format string, args ...interface{}) *s3Error {
+	return &s3Error{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		status:  status,
+	}
+}
+
+func errAccessDenied(err error) *s3Error {
+	return newError(http.StatusForbidden, "AccessDenied", "%v", err)
+}
+
+func errBucketAlreadyOwnedByYou(bucket string) *s3Error {
+	return newError(http.StatusConflict, "BucketAlreadyOwnedByYou", "bucket %s already exists", bucket)
+}
+
+func errInternal(err error) *s3Error {
+	return newError(http.StatusInternalServerError, "InternalError", "%v", err)
+}
+
+func errInvalidArgument(format string, args ...interface{}) *s3Error {
+	return newError(http.StatusBadRequest, "InvalidArgument", format, args...)
+}
+
+func errInvalidBucketName(bucket string) *s3Error {
+	return newError(http.StatusBadRequest, "InvalidBucketName",
+		"bucket name %q must have the form <branch>.<repo>", bucket)
+}
+
+func errInvalidPartOrder() *s3Error {
+	return newError(http.StatusBadRequest, "InvalidPartOrder", "parts must be listed in ascending order")
+}
+
+func errMalformedXML(err error) *s3Error {
+	return newError(http.StatusBadRequest, "MalformedXML", "%v", err)
+}
+
+func errMethodNotAllowed() *s3Error {
+	return newError(http.StatusMethodNotAllowed, "MethodNotAllowed", "the method is not allowed on this resource")
+}
+
+func errNoSuchBucket(bucket string) *s3Error {
+	return newError(http.StatusNotFound, "NoSuchBucket", "bucket %s does not exist", bucket)
+}
+
+func errNoSuchKey(key string) *s3Error {
+	return newError(http.StatusNotFound, "NoSuchKey", "key %s does not exist", key)
+}
+
+func errNotImplemented() *s3Error {
+	return newError(http.StatusNotImplemented, "NotImplemented", "this operation is not supported by the Pachyderm S3 gateway")
+}
@@ -0,0 +1,189 @@
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
+)
+
+// Multipart uploads are stateless in the gateway: each part is stored as a
+// PFS object, tagged with the upload ID and part number, and
+// CompleteMultipartUpload concatenates the tagged objects into the target
+// file. As a result, in-progress uploads can't be listed, and parts of
+// abandoned uploads are only removed by garbage collection.
+
+// maxPartNumber is the largest part number that S3 clients may use
+const maxPartNumber = 10000
+
+type initiateMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Bucket   string
+	Key      string
+	UploadID string `xml:"UploadId"`
+}
+
+type completeMultipartUpload struct {
+	XMLName xml.Name `xml:"CompleteMultipartUpload"`
+	Parts   []struct {
+		PartNumber int
+		ETag       string
+	} `xml:"Part"`
+}
+
+type completeMultipartUploadResult struct {
+	XMLName  xml.Name `xml:"CompleteMultipartUploadResult"`
+	Xmlns    string   `xml:"xmlns,attr"`
+	Location string
+	Bucket   string
+	Key      string
+	ETag     string
+}
+
+type listMultipartUploadsResult struct {
+	XMLName     xml.Name `xml:"ListMultipartUploadsResult"`
+	Xmlns       string   `xml:"xmlns,attr"`
+	Bucket      string
+	Prefix      string
+	MaxUploads  int
+	IsTruncated bool
+}
+
+// partTag returns the tag of the object that holds part 'partNumber' of the
+// upload 'uploadID'
+func partTag(uploadID string, partNumber int) string {
+	return fmt.Sprintf("s3-multipart-%s-%d", uploadID, partNumber)
+}
+
+// uploadParams returns the upload ID and part number set in 'r'
+func uploadParams(r *http.Request) (uploadID string, partNumber int, err error) {
+	query := r.URL.Query()
+	uploadID = query.Get("uploadId")
+	if uploadID == "" {
+		return "", 0, errInvalidArgument("uploadId must be set")
+	}
+	if partNumberStr := query.Get("partNumber"); partNumberStr != "" {
+		partNumber, err = strconv.Atoi(partNumberStr)
+		if err != nil || partNumber < 1 || partNumber > maxPartNumber {
+			return "", 0, errInvalidArgument("partNumber must be an integer between 1 and %d", maxPartNumber)
+		}
+	}
+	return uploadID, partNumber, nil
+}
+
+func (s *server) initiateMultipartUpload(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	if _, err := inspectBucket(c, bucket); err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, &initiateMultipartUploadResult{
+		Xmlns:    xmlns,
+		Bucket:   bucket,
+		Key:      key,
+		UploadID: uuid.NewWithoutDashes(),
+	})
+	return nil
+}
+
+// listMultipartUploads always returns an empty list, as the gateway doesn't
+// track in-progress uploads. Clients that look for an upload to resume will
+// start a new one
+func (s *server) listMultipartUploads(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket string) error {
+	if _, err := inspectBucket(c, bucket); err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, &listMultipartUploadsResult{
+		Xmlns:      xmlns,
+		Bucket:     bucket,
+		Prefix:     r.URL.Query().Get("prefix"),
+		MaxUploads: defaultMaxKeys,
+	})
+	return nil
+}
+
+func (s *server) uploadPart(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	uploadID, partNumber, err := uploadParams(r)
+	if err != nil {
+		return err
+	}
+	if partNumber == 0 {
+		return errInvalidArgument("partNumber must be set")
+	}
+	if _, err := inspectBucket(c, bucket); err != nil {
+		return err
+	}
+	object, _, err := c.PutObject(requestBody(r), partTag(uploadID, partNumber))
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", "\""+object.Hash+"\"")
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// completeMultipartUpload writes the parts of an upload, in order, to the
+// file 'key' in a new commit on the bucket's branch
+func (s *server) completeMultipartUpload(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	uploadID, _, err := uploadParams(r)
+	if err != nil {
+		return err
+	}
+	branchInfo, err := inspectBucket(c, bucket)
+	if err != nil {
+		return err
+	}
+	var request completeMultipartUpload
+	if err := xml.NewDecoder(requestBody(r)).Decode(&request); err != nil {
+		return errMalformedXML(err)
+	}
+	if len(request.Parts) == 0 {
+		return errMalformedXML(fmt.Errorf("no parts were given"))
+	}
+	for i, part := range request.Parts {
+		if i > 0 && part.PartNumber <= request.Parts[i-1].PartNumber {
+			return errInvalidPartOrder()
+		}
+	}
+
+	// Stream the parts into the new file
+	pr, pw := io.Pipe()
+	go func() {
+		for _, part := range request.Parts {
+			if err := c.GetTag(partTag(uploadID, part.PartNumber), pw); err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+		}
+		pw.Close()
+	}()
+	fileInfo, err := putFile(c, branchInfo.Branch, key, pr)
+	pr.CloseWithError(err) // unblock the goroutine above if putFile failed
+	if err != nil {
+		return err
+	}
+	writeXML(w, http.StatusOK, &completeMultipartUploadResult{
+		Xmlns:    xmlns,
+		Location: objectURL(r, bucket, key),
+		Bucket:   bucket,
+		Key:      key,
+		ETag:     etag(fileInfo),
+	})
+	return nil
+}
+
+// abortMultipartUpload is a no-op: the upload's parts are unreferenced PFS
+// objects, which are removed by garbage collection
+func (s *server) abortMultipartUpload(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	if _, _, err := uploadParams(r); err != nil {
+		return err
+	}
+	if _, err := inspectBucket(c, bucket); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
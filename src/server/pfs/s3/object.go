@@ -0,0 +1,123 @@
+package s3
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+)
+
+// getObject implements GetObject and HeadObject. Range and conditional
+// requests are handled by http.ServeContent
+func (s *server) getObject(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	branchInfo, err := inspectBucket(c, bucket)
+	if err != nil {
+		return err
+	}
+	if branchInfo.Head == nil {
+		return errNoSuchKey(key)
+	}
+	repo, commitID := branchInfo.Head.Repo.Name, branchInfo.Head.ID
+	fileInfo, err := c.InspectFile(repo, commitID, key)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return errNoSuchKey(key)
+		}
+		return err
+	}
+	if fileInfo.FileType != pfs.FileType_FILE {
+		return errNoSuchKey(key)
+	}
+	modtime, err := lastModified(c, branchInfo.Head)
+	if err != nil {
+		return err
+	}
+	content, err := c.GetFileReadSeeker(repo, commitID, key)
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", etag(fileInfo))
+	http.ServeContent(w, r, key, modtime, content)
+	return nil
+}
+
+// putObject writes the object 'key' in a new commit on the bucket's branch,
+// replacing any existing file at that path
+func (s *server) putObject(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	if strings.HasSuffix(key, "/") {
+		return errInvalidArgument("PFS does not support empty directories (key %q ends with '/')", key)
+	}
+	branchInfo, err := inspectBucket(c, bucket)
+	if err != nil {
+		return err
+	}
+	fileInfo, err := putFile(c, branchInfo.Branch, key, requestBody(r))
+	if err != nil {
+		return err
+	}
+	w.Header().Set("ETag", etag(fileInfo))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// deleteObject deletes the object 'key' in a new commit on the bucket's
+// branch
+func (s *server) deleteObject(c *client.APIClient, w http.ResponseWriter, r *http.Request, bucket, key string) error {
+	branchInfo, err := inspectBucket(c, bucket)
+	if err != nil {
+		return err
+	}
+	repo := branchInfo.Branch.Repo.Name
+	if err := withCommit(c, branchInfo.Branch, func(commitID string) error {
+		return c.DeleteFile(repo, commitID, key)
+	}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// withCommit calls 'f' with the ID of a new commit on 'branch'. The commit is
+// finished if 'f' succeeds, and deleted otherwise
+func withCommit(c *client.APIClient, branch *pfs.Branch, f func(commitID string) error) (retErr error) {
+	commit, err := c.StartCommit(branch.Repo.Name, branch.Name)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			c.DeleteCommit(commit.Repo.Name, commit.ID)
+			return
+		}
+		retErr = c.FinishCommit(commit.Repo.Name, commit.ID)
+	}()
+	return f(commit.ID)
+}
+
+// putFile writes the contents of 'r' to the file 'key' in a new commit on
+// 'branch', overwriting it if it exists, and returns the new file's info
+func putFile(c *client.APIClient, branch *pfs.Branch, key string, r io.Reader) (*pfs.FileInfo, error) {
+	repo := branch.Repo.Name
+	var commitID string
+	if err := withCommit(c, branch, func(id string) error {
+		commitID = id
+		_, err := c.PutFileOverwrite(repo, commitID, key, r, 0)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return c.InspectFile(repo, commitID, key)
+}
+
+// requestBody returns the payload of 'r', decoding it if it was sent with the
+// aws-chunked content encoding
+func requestBody(r *http.Request) io.Reader {
+	if r.Header.Get("X-Amz-Content-Sha256") == streamingPayload ||
+		strings.Contains(r.Header.Get("Content-Encoding"), "aws-chunked") {
+		return newChunkedReader(r.Body)
+	}
+	return r.Body
+}
@@ -0,0 +1,260 @@
+// Package s3 implements an S3-compatible gateway in front of PFS, so that
+// tools which speak S3 (aws-cli, boto, Spark, etc) can read and write data in
+// Pachyderm.
+//
+// Buckets map to PFS branches: the bucket "master.images" is the branch
+// "master" of the repo "images". Reads are served from the branch's head
+// commit, and every write (PutObject, DeleteObject and
+// CompleteMultipartUpload) is made in a new commit on the branch.
+//
+// Only path-style requests (http://<pachd>:600/<bucket>/<key>) are supported.
+// Clients authenticate by using their Pachyderm auth token as their S3 access
+// key ID. Request signatures aren't checked, as pachd doesn't know clients'
+// secret keys, so the secret key may be set to anything.
+package s3
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+)
+
+// S3Port specifies the port the S3 gateway will listen on
+const S3Port = 600
+
+// bucketSeparator separates the branch and repo in a bucket name
+const bucketSeparator = "."
+
+type server struct {
+	address        string
+	pachClient     *client.APIClient
+	pachClientOnce sync.Once
+}
+
+// NewS3Server returns an http.Handler that serves the S3 API, backed by the
+// pachd at 'address'.
+func NewS3Server(address string) (http.Handler, error) {
+	return &server{address: address}, nil
+}
+
+func (s *server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	c := s.requestClient(r)
+	bucket, key := splitPath(r.URL.Path)
+	query := r.URL.Query()
+	has := func(param string) bool {
+		_, ok := query[param]
+		return ok
+	}
+	var err error
+	switch {
+	case bucket == "":
+		if r.Method != http.MethodGet {
+			err = errMethodNotAllowed()
+			break
+		}
+		err = s.listBuckets(c, w, r)
+	case key == "":
+		switch r.Method {
+		case http.MethodGet:
+			switch {
+			case has("location"):
+				err = s.getBucketLocation(c, w, r, bucket)
+			case has("uploads"):
+				err = s.listMultipartUploads(c, w, r, bucket)
+			default:
+				err = s.listObjects(c, w, r, bucket)
+			}
+		case http.MethodHead:
+			_, err = inspectBucket(c, bucket)
+		case http.MethodPut:
+			err = s.createBucket(c, w, r, bucket)
+		case http.MethodDelete:
+			err = s.deleteBucket(c, w, r, bucket)
+		default:
+			err = errMethodNotAllowed()
+		}
+	default:
+		switch r.Method {
+		case http.MethodGet, http.MethodHead:
+			err = s.getObject(c, w, r, bucket, key)
+		case http.MethodPut:
+			switch {
+			case has("uploadId"):
+				err = s.uploadPart(c, w, r, bucket, key)
+			case r.Header.Get("X-Amz-Copy-Source") != "":
+				err = errNotImplemented()
+			default:
+				err = s.putObject(c, w, r, bucket, key)
+			}
+		case http.MethodPost:
+			switch {
+			case has("uploads"):
+				err = s.initiateMultipartUpload(c, w, r, bucket, key)
+			case has("uploadId"):
+				err = s.completeMultipartUpload(c, w, r, bucket, key)
+			default:
+				err = errMethodNotAllowed()
+			}
+		case http.MethodDelete:
+			if has("uploadId") {
+				err = s.abortMultipartUpload(c, w, r, bucket, key)
+			} else {
+				err = s.deleteObject(c, w, r, bucket, key)
+			}
+		default:
+			err = errMethodNotAllowed()
+		}
+	}
+	if err != nil {
+		writeError(w, r, bucket, key, err)
+	}
+}
+
+// splitPath splits the path of a path-style S3 request into its bucket and
+// object key
+func splitPath(p string) (bucket string, key string) {
+	p = strings.TrimPrefix(p, "/")
+	parts := strings.SplitN(p, "/", 2)
+	if len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return parts[0], ""
+}
+
+// parseBucket splits the bucket name 'bucket' into the repo and branch it
+// refers to. Repo names can't contain '.' (though branch names can), so the
+// last '.' in 'bucket' separates the two
+func parseBucket(bucket string) (repo string, branch string, err error) {
+	i := strings.LastIndex(bucket, bucketSeparator)
+	if i <= 0 || i == len(bucket)-1 {
+		return "", "", errInvalidBucketName(bucket)
+	}
+	return bucket[i+1:], bucket[:i], nil
+}
+
+// bucketName returns the name of the bucket that refers to 'branch' in 'repo'
+func bucketName(repo, branch string) string {
+	return branch + bucketSeparator + repo
+}
+
+// inspectBucket returns the branch that 'bucket' refers to, or a NoSuchBucket
+// error if the branch doesn't exist
+func inspectBucket(c *client.APIClient, bucket string) (*pfs.BranchInfo, error) {
+	repo, branch, err := parseBucket(bucket)
+	if err != nil {
+		return nil, err
+	}
+	branchInfo, err := c.InspectBranch(repo, branch)
+	if err != nil {
+		if errutil.IsNotFoundError(err) {
+			return nil, errNoSuchBucket(bucket)
+		}
+		return nil, err
+	}
+	return branchInfo, nil
+}
+
+// requestClient returns a pachd client that makes calls on behalf of the
+// sender of 'r'
+func (s *server) requestClient(r *http.Request) *client.APIClient {
+	ctx := r.Context()
+	if token := accessKeyID(r); token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(auth.ContextTokenKey, token))
+	}
+	return s.getPachClient().WithCtx(ctx)
+}
+
+// accessKeyID returns the access key ID used to sign 'r' (if any), which the
+// gateway interprets as a Pachyderm auth token. Signature V4 and V2 headers
+// and presigned URLs are all supported
+func accessKeyID(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	switch {
+	case strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 "):
+		// AWS4-HMAC-SHA256 Credential=<key>/<date>/<region>/s3/aws4_request, ...
+		for _, field := range strings.Split(strings.TrimPrefix(authorization, "AWS4-HMAC-SHA256 "), ",") {
+			field = strings.TrimSpace(field)
+			if strings.HasPrefix(field, "Credential=") {
+				return strings.SplitN(strings.TrimPrefix(field, "Credential="), "/", 2)[0]
+			}
+		}
+	case strings.HasPrefix(authorization, "AWS "):
+		// AWS <key>:<signature>
+		return strings.SplitN(strings.TrimPrefix(authorization, "AWS "), ":", 2)[0]
+	}
+	query := r.URL.Query()
+	if credential := query.Get("X-Amz-Credential"); credential != "" {
+		return strings.SplitN(credential, "/", 2)[0]
+	}
+	return query.Get("AWSAccessKeyId")
+}
+
+// writeXML writes 'v' to 'w' as the XML body of a response with status
+// 'status'
+func writeXML(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	if _, err := w.Write([]byte(xml.Header)); err != nil {
+		log.Errorf("could not write S3 response: %v", err)
+		return
+	}
+	if err := xml.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf("could not write S3 response: %v", err)
+	}
+}
+
+// writeError writes 'err' to 'w' as an S3 error response
+func writeError(w http.ResponseWriter, r *http.Request, bucket, key string, err error) {
+	s3Err, ok := err.(*s3Error)
+	if !ok {
+		switch {
+		case auth.IsErrNotAuthorized(err), auth.IsErrNotSignedIn(err), auth.IsErrBadToken(err):
+			s3Err = errAccessDenied(err)
+		case errutil.IsNotFoundError(err) && key != "":
+			s3Err = errNoSuchKey(key)
+		case errutil.IsNotFoundError(err):
+			s3Err = errNoSuchBucket(bucket)
+		default:
+			s3Err = errInternal(err)
+		}
+	}
+	s3Err.Resource = r.URL.Path
+	if r.Method == http.MethodHead {
+		// Responses to HEAD requests have no body
+		w.WriteHeader(s3Err.status)
+		return
+	}
+	writeXML(w, s3Err.status, s3Err)
+}
+
+// objectURL returns the URL of the object 'key' in 'bucket', as served to 'r'
+func objectURL(r *http.Request, bucket, key string) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	u := url.URL{Scheme: scheme, Host: r.Host, Path: "/" + bucket + "/" + key}
+	return u.String()
+}
+
+func (s *server) getPachClient() *client.APIClient {
+	s.pachClientOnce.Do(func() {
+		var err error
+		s.pachClient, err = client.NewFromAddress(s.address)
+		if err != nil {
+			panic(fmt.Sprintf("s3 gateway failed to initialize pach client: %v", err))
+		}
+	})
+	return s.pachClient
+}
@@ -0,0 +1,78 @@
+package s3
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestParseBucket(t *testing.T) {
+	repo, branch, err := parseBucket("master.images")
+	require.NoError(t, err)
+	require.Equal(t, "images", repo)
+	require.Equal(t, "master", branch)
+
+	// Branch names may contain '.', but repo names may not
+	repo, branch, err = parseBucket("v1.0.images")
+	require.NoError(t, err)
+	require.Equal(t, "images", repo)
+	require.Equal(t, "v1.0", branch)
+	require.Equal(t, "v1.0.images", bucketName(repo, branch))
+
+	for _, bucket := range []string{"images", ".images", "master.", ""} {
+		_, _, err = parseBucket(bucket)
+		require.YesError(t, err)
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	bucket, key := splitPath("/")
+	require.Equal(t, "", bucket)
+	require.Equal(t, "", key)
+	bucket, key = splitPath("/master.images/")
+	require.Equal(t, "master.images", bucket)
+	require.Equal(t, "", key)
+	bucket, key = splitPath("/master.images/dir/file")
+	require.Equal(t, "master.images", bucket)
+	require.Equal(t, "dir/file", key)
+}
+
+func TestAccessKeyID(t *testing.T) {
+	r, err := http.NewRequest("GET", "http://localhost:600/", nil)
+	require.NoError(t, err)
+	require.Equal(t, "", accessKeyID(r))
+
+	r.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential=token/20180101/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=abc")
+	require.Equal(t, "token", accessKeyID(r))
+
+	r.Header.Set("Authorization", "AWS token:signature")
+	require.Equal(t, "token", accessKeyID(r))
+
+	r, err = http.NewRequest("GET", "http://localhost:600/master.images/file?X-Amz-Credential=token%2F20180101%2Fus-east-1%2Fs3%2Faws4_request", nil)
+	require.NoError(t, err)
+	require.Equal(t, "token", accessKeyID(r))
+}
+
+func TestChunkedReader(t *testing.T) {
+	body := "5;chunk-signature=abc\r\nhello\r\n" +
+		"6;chunk-signature=def\r\n world\r\n" +
+		"0;chunk-signature=ghi\r\n\r\n"
+	data, err := ioutil.ReadAll(newChunkedReader(strings.NewReader(body)))
+	require.NoError(t, err)
+	require.Equal(t, "hello world", string(data))
+
+	// Truncated
+	_, err = ioutil.ReadAll(newChunkedReader(strings.NewReader("5;chunk-signature=abc\r\nhel")))
+	require.YesError(t, err)
+
+	// Malformed header
+	_, err = ioutil.ReadAll(newChunkedReader(strings.NewReader("zz;chunk-signature=abc\r\nhello\r\n")))
+	require.YesError(t, err)
+
+	// Missing CRLF after data
+	_, err = ioutil.ReadAll(newChunkedReader(strings.NewReader("5;chunk-signature=abc\r\nhelloXX0\r\n\r\n")))
+	require.YesError(t, err)
+}
@@ -39,6 +39,7 @@ func newLocalAPIServer(address string, etcdPrefix string) (*apiServer, error) {
 	if err != nil {
 		return nil, err
 	}
+	go d.replicationMaster()
 	return &apiServer{
 		Logger: log.NewLogger("pfs.API"),
 		driver: d,
@@ -102,7 +103,7 @@ func (a *apiServer) StartCommit(ctx context.Context, request *pfs.StartCommitReq
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance, request.Description)
+	commit, err := a.driver.startCommit(ctx, request.Parent, request.Branch, request.Provenance, request.BranchProvenance, request.Description)
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +219,13 @@ func (a *apiServer) DeleteCommit(ctx context.Context, request *pfs.DeleteCommitR
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) SquashCommits(ctx context.Context, request *pfs.SquashCommitsRequest) (response *pfs.Commit, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.squashCommits(ctx, request.From, request.To)
+}
+
 func (a *apiServer) FlushCommit(request *pfs.FlushCommitRequest, stream pfs.API_FlushCommitServer) (retErr error) {
 	ctx := stream.Context()
 	func() { a.Log(request, nil, nil, 0) }()
@@ -570,6 +578,34 @@ func (a *apiServer) DeleteFile(ctx context.Context, request *pfs.DeleteFileReque
 	return &types.Empty{}, nil
 }
 
+func (a *apiServer) CreateReplication(ctx context.Context, request *pfs.CreateReplicationRequest) (response *types.Empty, retErr error) {
+	// Don't log the request, as it contains the remote cluster's auth token
+	func() { a.Log(nil, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(nil, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.createReplication(ctx, request); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
+func (a *apiServer) ListReplication(ctx context.Context, request *pfs.ListReplicationRequest) (response *pfs.ReplicationInfos, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	return a.driver.listReplication(ctx, request.Repo)
+}
+
+func (a *apiServer) DeleteReplication(ctx context.Context, request *pfs.DeleteReplicationRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+
+	if err := a.driver.deleteReplication(ctx, request.Replication); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
+}
+
 func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
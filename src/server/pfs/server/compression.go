@@ -0,0 +1,110 @@
+package server
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/golang/snappy"
+	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// ParseCompression parses the name of a compression codec (e.g. "gzip"), as
+// used in pachd's BLOCK_COMPRESSION environment variable. The empty string and
+// "none" both mean that blocks aren't compressed.
+func ParseCompression(name string) (pfsclient.Compression, error) {
+	name = strings.ToUpper(name)
+	if name == "" || name == "NONE" {
+		return pfsclient.Compression_UNCOMPRESSED, nil
+	}
+	compression, ok := pfsclient.Compression_value[name]
+	if !ok {
+		return pfsclient.Compression_UNCOMPRESSED, fmt.Errorf("unrecognized compression codec %q (must be one of none, gzip, snappy)", name)
+	}
+	return pfsclient.Compression(compression), nil
+}
+
+// newCompressor returns a writer that compresses the data written to it with
+// 'compression' and writes it to 'w'. The writer must be closed to flush the
+// compressed data, which doesn't close 'w'.
+func newCompressor(compression pfsclient.Compression, w io.Writer) (io.WriteCloser, error) {
+	switch compression {
+	case pfsclient.Compression_UNCOMPRESSED:
+		return nopWriteCloser{w}, nil
+	case pfsclient.Compression_GZIP:
+		return gzip.NewWriter(w), nil
+	case pfsclient.Compression_SNAPPY:
+		return snappy.NewBufferedWriter(w), nil
+	}
+	return nil, fmt.Errorf("unrecognized compression codec %s", compression)
+}
+
+// newDecompressor returns a reader that decompresses the data read from 'r'
+// with 'compression'
+func newDecompressor(compression pfsclient.Compression, r io.Reader) (io.Reader, error) {
+	switch compression {
+	case pfsclient.Compression_UNCOMPRESSED:
+		return r, nil
+	case pfsclient.Compression_GZIP:
+		return gzip.NewReader(r)
+	case pfsclient.Compression_SNAPPY:
+		return snappy.NewReader(r), nil
+	}
+	return nil, fmt.Errorf("unrecognized compression codec %s", compression)
+}
+
+// objectSize returns the size of the (decompressed) object that 'blockRef'
+// points to
+func objectSize(blockRef *pfsclient.BlockRef) uint64 {
+	if blockRef.Compression != pfsclient.Compression_UNCOMPRESSED {
+		return blockRef.SizeBytes
+	}
+	return blockRef.Range.Upper - blockRef.Range.Lower
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+// countWriter counts the bytes written to an underlying writer
+type countWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (w *countWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.count += int64(n)
+	return n, err
+}
+
+// decompressedReadCloser reads a range of a compressed object, and closes
+// the reader of the object's compressed bytes when it's closed
+type decompressedReadCloser struct {
+	io.Reader
+	c io.Closer
+}
+
+func (r decompressedReadCloser) Close() error {
+	return r.c.Close()
+}
+
+// readDecompressed reads 'size' bytes (or the rest of the object, if 'size'
+// is 0), starting at 'offset', of the compressed object in 'r'
+func readDecompressed(compression pfsclient.Compression, r io.ReadCloser, offset uint64, size uint64) (io.ReadCloser, error) {
+	dr, err := newDecompressor(compression, r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(ioutil.Discard, dr, int64(offset)); err != nil {
+		return nil, err
+	}
+	if size > 0 {
+		dr = io.LimitReader(dr, int64(size))
+	}
+	return decompressedReadCloser{Reader: dr, c: r}, nil
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func TestParseCompression(t *testing.T) {
+	for name, expected := range map[string]pfs.Compression{
+		"":       pfs.Compression_UNCOMPRESSED,
+		"none":   pfs.Compression_UNCOMPRESSED,
+		"gzip":   pfs.Compression_GZIP,
+		"SNAPPY": pfs.Compression_SNAPPY,
+	} {
+		compression, err := ParseCompression(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, compression)
+	}
+	_, err := ParseCompression("lzma")
+	require.YesError(t, err)
+}
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := strings.Repeat("0123456789", 10000)
+	for _, compression := range []pfs.Compression{pfs.Compression_UNCOMPRESSED, pfs.Compression_GZIP, pfs.Compression_SNAPPY} {
+		var buf bytes.Buffer
+		cw := &countWriter{w: &buf}
+		w, err := newCompressor(compression, cw)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(data))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		require.Equal(t, int64(buf.Len()), cw.count)
+		if compression != pfs.Compression_UNCOMPRESSED {
+			require.True(t, buf.Len() < len(data)/10)
+		}
+
+		r, err := readDecompressed(compression, ioutil.NopCloser(bytes.NewReader(buf.Bytes())), 0, 0)
+		require.NoError(t, err)
+		result, err := ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, string(result))
+
+		r, err = readDecompressed(compression, ioutil.NopCloser(bytes.NewReader(buf.Bytes())), 15, 10)
+		require.NoError(t, err)
+		result, err = ioutil.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "5678901234", string(result))
+	}
+}
@@ -1,10 +1,8 @@
 package server
 
 import (
-	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"math"
@@ -94,6 +92,9 @@ type driver struct {
 	// etcdClient and prefix write repo and other metadata to etcd
 	etcdClient *etcd.Client
 	prefix     string
+	// store holds the collections below. Transactions that touch them must
+	// be run with store.NewSTM.
+	store col.Store
 
 	// collections
 	repos          col.Collection
@@ -101,6 +102,10 @@ type driver struct {
 	commits        collectionFactory
 	branches       collectionFactory
 	openCommits    col.Collection
+	// replications holds the branches that are replicated to other clusters,
+	// and replicationInfos holds the replicators' statuses
+	replications     col.Collection
+	replicationInfos col.Collection
 
 	// a cache for hashtrees
 	treeCache *lru.Cache
@@ -127,20 +132,24 @@ func newDriver(address string, etcdAddresses []string, etcdPrefix string, treeCa
 		return nil, fmt.Errorf("could not initialize treeCache: %v", err)
 	}
 
+	store := col.NewEtcdStore(etcdClient)
 	d := &driver{
 		address:        address,
 		etcdClient:     etcdClient,
 		prefix:         etcdPrefix,
-		repos:          pfsdb.Repos(etcdClient, etcdPrefix),
-		putFileRecords: pfsdb.PutFileRecords(etcdClient, etcdPrefix),
+		store:          store,
+		repos:          pfsdb.Repos(store, etcdPrefix),
+		putFileRecords: pfsdb.PutFileRecords(store, etcdPrefix),
 		commits: func(repo string) col.Collection {
-			return pfsdb.Commits(etcdClient, etcdPrefix, repo)
+			return pfsdb.Commits(store, etcdPrefix, repo)
 		},
 		branches: func(repo string) col.Collection {
-			return pfsdb.Branches(etcdClient, etcdPrefix, repo)
+			return pfsdb.Branches(store, etcdPrefix, repo)
 		},
-		openCommits: pfsdb.OpenCommits(etcdClient, etcdPrefix),
-		treeCache:   treeCache,
+		openCommits:      pfsdb.OpenCommits(store, etcdPrefix),
+		replications:     pfsdb.Replications(store, etcdPrefix),
+		replicationInfos: pfsdb.ReplicationInfos(store, etcdPrefix),
+		treeCache:        treeCache,
 	}
 	go func() { d.initializePachConn() }() // Begin dialing connection on startup
 	return d, nil
@@ -173,25 +182,157 @@ func (d *driver) initializePachConn() error {
 // checkIsAuthorized returns an error if the current user (in 'ctx') has
 // authorization scope 's' for repo 'r'
 func (d *driver) checkIsAuthorized(ctx context.Context, r *pfs.Repo, s auth.Scope) error {
+	return d.checkIsAuthorizedInBranch(ctx, r, "", s)
+}
+
+// checkIsAuthorizedInBranch returns an error if the current user (in 'ctx')
+// has authorization scope 's' for branch 'branch' of repo 'r'. Branches may
+// have their own ACLs, which take precedence over the repo's ACL. If 'branch'
+// is empty, this is the same as checkIsAuthorized
+func (d *driver) checkIsAuthorizedInBranch(ctx context.Context, r *pfs.Repo, branch string, s auth.Scope) error {
 	d.initializePachConn()
 	me, err := d.pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
 	if auth.IsErrNotActivated(err) {
 		return nil
 	}
+	target := auth.RepoBranch(r.Name, branch)
 	resp, err := d.pachClient.AuthAPIClient.Authorize(auth.In2Out(ctx), &auth.AuthorizeRequest{
-		Repo:  r.Name,
+		Repo:  target,
 		Scope: s,
 	})
 	if err != nil {
 		return fmt.Errorf("error during authorization check for operation on \"%s\": %v",
-			r.Name, grpcutil.ScrubGRPC(err))
+			target, grpcutil.ScrubGRPC(err))
 	}
 	if !resp.Authorized {
-		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: r.Name, Required: s}
+		return &auth.ErrNotAuthorized{Subject: me.Username, Repo: target, Required: s}
 	}
 	return nil
 }
 
+// checkIsAuthorizedInCommit returns an error if the current user (in 'ctx')
+// doesn't have authorization scope 's' for every branch that 'commit' is on
+// (i.e. the branch named by commit.ID, or the branches whose head is
+// 'commit'). If 'commit' isn't on any branch, this checks the user's scope for
+// the commit's repo
+func (d *driver) checkIsAuthorizedInCommit(ctx context.Context, commit *pfs.Commit, s auth.Scope) error {
+	d.initializePachConn()
+	if _, err := d.pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{}); auth.IsErrNotActivated(err) {
+		return nil
+	}
+	branches, err := d.commitBranches(ctx, commit)
+	if err != nil {
+		return err
+	}
+	if len(branches) == 0 {
+		return d.checkIsAuthorized(ctx, commit.Repo, s)
+	}
+	for _, branch := range branches {
+		if err := d.checkIsAuthorizedInBranch(ctx, commit.Repo, branch, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkIsAuthorizedInHistory returns an error if the current user (in 'ctx')
+// doesn't have authorization scope 's' for the repo of 'commit' and for every
+// branch whose history contains 'commit' (i.e. whose head is 'commit' or one
+// of its descendants). Operations that rewrite 'commit' (e.g. deleting it)
+// change the history of all of those branches
+func (d *driver) checkIsAuthorizedInHistory(ctx context.Context, commit *pfs.Commit, s auth.Scope) error {
+	d.initializePachConn()
+	if _, err := d.pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{}); auth.IsErrNotActivated(err) {
+		return nil
+	}
+	if err := d.checkIsAuthorized(ctx, commit.Repo, s); err != nil {
+		return err
+	}
+	var commitInfo *pfs.CommitInfo
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		var err error
+		// resolveCommit modifies its argument, so pass it a copy
+		commitInfo, err = d.resolveCommit(stm, &pfs.Commit{Repo: commit.Repo, ID: commit.ID})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	// Find the descendants of 'commit'
+	commits := d.commits(commit.Repo.Name).ReadOnly(ctx)
+	descendants := map[string]bool{commitInfo.Commit.ID: true}
+	queue := commitInfo.ChildCommits
+	for len(queue) > 0 {
+		var child *pfs.Commit
+		child, queue = queue[0], queue[1:]
+		if descendants[child.ID] {
+			continue
+		}
+		descendants[child.ID] = true
+		childInfo := &pfs.CommitInfo{}
+		if err := commits.Get(child.ID, childInfo); err != nil {
+			return err
+		}
+		queue = append(queue, childInfo.ChildCommits...)
+	}
+
+	// Check the user's scope for each branch whose head is one of them
+	iterator, err := d.branches(commit.Repo.Name).ReadOnly(ctx).List()
+	if err != nil {
+		return err
+	}
+	for {
+		var branchName string
+		branchInfo := &pfs.BranchInfo{}
+		ok, err := iterator.Next(&branchName, branchInfo)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return nil
+		}
+		if branchInfo.Head != nil && descendants[branchInfo.Head.ID] {
+			if err := d.checkIsAuthorizedInBranch(ctx, commit.Repo, branchName, s); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// commitBranches returns the names of the branches that 'commit' is on: the
+// branch named by commit.ID if there is one, and otherwise the branches whose
+// head is 'commit'
+func (d *driver) commitBranches(ctx context.Context, commit *pfs.Commit) ([]string, error) {
+	branches := d.branches(commit.Repo.Name).ReadOnly(ctx)
+	branchInfo := &pfs.BranchInfo{}
+	if !uuid.IsUUIDWithoutDashes(commit.ID) {
+		if err := branches.Get(commit.ID, branchInfo); err == nil {
+			return []string{commit.ID}, nil
+		} else if !col.IsErrNotFound(err) {
+			return nil, err
+		}
+	}
+	iterator, err := branches.List()
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for {
+		var branchName string
+		ok, err := iterator.Next(&branchName, branchInfo)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if branchInfo.Head != nil && branchInfo.Head.ID == commit.ID {
+			result = append(result, branchName)
+		}
+	}
+	return result, nil
+}
+
 func now() *types.Timestamp {
 	t, err := types.TimestampProto(time.Now())
 	if err != nil {
@@ -219,7 +360,7 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, description str
 		return d.updateRepo(ctx, repo, description)
 	}
 
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 
 		// check if 'repo' already exists. If so, return that error. Otherwise,
@@ -268,7 +409,7 @@ func (d *driver) createRepo(ctx context.Context, repo *pfs.Repo, description str
 }
 
 func (d *driver) updateRepo(ctx context.Context, repo *pfs.Repo, description string) error {
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		repoInfo := &pfs.RepoInfo{}
 		if err := repos.Get(repo.Name, repoInfo); err != nil {
@@ -371,7 +512,7 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 	// if repo.Name == ppsconsts.SpecRepo {
 	// 	return fmt.Errorf("cannot delete the special PPS repo %s", ppsconsts.SpecRepo)
 	// }
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(repo.Name).ReadWrite(stm)
 
@@ -417,12 +558,12 @@ func (d *driver) deleteRepo(ctx context.Context, repo *pfs.Repo, force bool) err
 	return nil
 }
 
-func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, description string) (*pfs.Commit, error) {
-	return d.makeCommit(ctx, "", parent, branch, provenance, nil, description)
+func (d *driver) startCommit(ctx context.Context, parent *pfs.Commit, branch string, provenance []*pfs.Commit, branchProvenance []*pfs.Branch, description string) (*pfs.Commit, error) {
+	return d.makeCommit(ctx, "", parent, branch, provenance, branchProvenance, nil, description)
 }
 
 func (d *driver) buildCommit(ctx context.Context, ID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, tree *pfs.Object) (*pfs.Commit, error) {
-	return d.makeCommit(ctx, ID, parent, branch, provenance, tree, "")
+	return d.makeCommit(ctx, ID, parent, branch, provenance, nil, tree, "")
 }
 
 // make commit makes a new commit in 'branch', with the parent 'parent' and the
@@ -435,14 +576,20 @@ func (d *driver) buildCommit(ctx context.Context, ID string, parent *pfs.Commit,
 //   to the new commit
 // - If neither 'parent.ID' nor 'branch' are set, the new commit will have no
 //   parent
-func (d *driver) makeCommit(ctx context.Context, ID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, treeRef *pfs.Object, description string) (*pfs.Commit, error) {
+// - If 'branchProvenance' is set, branchProvenance[i] is the branch that
+//   provenance[i] came from, and the new commit's BranchProvenance is set
+//   (this is how PPS creates output commits outside of the output branch)
+func (d *driver) makeCommit(ctx context.Context, ID string, parent *pfs.Commit, branch string, provenance []*pfs.Commit, branchProvenance []*pfs.Branch, treeRef *pfs.Object, description string) (*pfs.Commit, error) {
 	// Validate arguments:
 	if parent == nil {
 		return nil, fmt.Errorf("parent cannot be nil")
 	}
+	if len(branchProvenance) > 0 && len(branchProvenance) != len(provenance) {
+		return nil, fmt.Errorf("branch provenance must have the same length as provenance (%d != %d)", len(branchProvenance), len(provenance))
+	}
 
 	// Check that caller is authorized
-	if err := d.checkIsAuthorized(ctx, parent.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInBranch(ctx, parent.Repo, branch, auth.Scope_WRITER); err != nil {
 		return nil, err
 	}
 
@@ -479,7 +626,7 @@ func (d *driver) makeCommit(ctx context.Context, ID string, parent *pfs.Commit,
 	}
 
 	// Txn: create the actual commit in etcd and update the branch + parent/child
-	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		repos := d.repos.ReadWrite(stm)
 		commits := d.commits(parent.Repo.Name).ReadWrite(stm)
 		branches := d.branches(parent.Repo.Name).ReadWrite(stm)
@@ -557,25 +704,41 @@ func (d *driver) makeCommit(ctx context.Context, ID string, parent *pfs.Commit,
 		}
 
 		// 'newCommitProv' holds newCommit's provenance (use map for deduping).
-		newCommitProv := make(map[string]*pfs.Commit)
+		newCommitProv := make(map[string]*branchCommit)
 
 		// Build newCommit's full provenance; my provenance's provenance is my
 		// provenance (b/c provenance' is a transitive closure, there's no need to
 		// explore full graph)
-		for _, provCommit := range provenance {
-			newCommitProv[provCommit.ID] = provCommit
+		for i, provCommit := range provenance {
+			newCommitProv[provCommit.ID] = &branchCommit{commit: provCommit}
+			if len(branchProvenance) > 0 {
+				newCommitProv[provCommit.ID].branch = branchProvenance[i]
+			}
 			provCommitInfo := &pfs.CommitInfo{}
 			if err := d.commits(provCommit.Repo.Name).ReadWrite(stm).Get(provCommit.ID, provCommitInfo); err != nil {
 				return err
 			}
-			for _, c := range provCommitInfo.Provenance {
-				newCommitProv[c.ID] = c
+			for j, c := range provCommitInfo.Provenance {
+				if _, ok := newCommitProv[c.ID]; ok {
+					continue
+				}
+				newCommitProv[c.ID] = &branchCommit{commit: c}
+				if j < len(provCommitInfo.BranchProvenance) {
+					newCommitProv[c.ID].branch = provCommitInfo.BranchProvenance[j]
+				}
 			}
 		}
 
 		// Copy newCommitProv into newCommitInfo.Provenance, and update upstream subv
-		for _, provCommit := range newCommitProv {
+		for _, bc := range newCommitProv {
+			provCommit := bc.commit
 			newCommitInfo.Provenance = append(newCommitInfo.Provenance, provCommit)
+			if len(branchProvenance) > 0 {
+				if bc.branch == nil {
+					return fmt.Errorf("could not determine the branch of provenance commit %s/%s", provCommit.Repo.Name, provCommit.ID)
+				}
+				newCommitInfo.BranchProvenance = append(newCommitInfo.BranchProvenance, bc.branch)
+			}
 			provCommitInfo := &pfs.CommitInfo{}
 			if err := d.commits(provCommit.Repo.Name).ReadWrite(stm).Update(provCommit.ID, provCommitInfo, func() error {
 				appendSubvenance(provCommitInfo, newCommitInfo)
@@ -602,7 +765,7 @@ func (d *driver) makeCommit(ctx context.Context, ID string, parent *pfs.Commit,
 }
 
 func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, tree *pfs.Object, empty bool, description string) (retErr error) {
-	if err := d.checkIsAuthorized(ctx, commit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInCommit(ctx, commit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	commitInfo, err := d.inspectCommit(ctx, commit, false)
@@ -650,7 +813,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, tree *pfs
 
 		if tree == nil {
 			var err error
-			finishedTree, err = d.getTreeForOpenCommit(ctx, scratchPrefix, parentTree)
+			finishedTree, commitInfo.PhysicalSizeBytes, err = d.getTreeForOpenCommit(ctx, scratchPrefix, parentTree)
 			if err != nil {
 				return err
 			}
@@ -687,14 +850,14 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, tree *pfs
 
 	commitInfo.Finished = now()
 	sizeChange := sizeChange(finishedTree, parentTree)
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
 		commits := d.commits(commit.Repo.Name).ReadWrite(stm)
 		repos := d.repos.ReadWrite(stm)
 		commits.Put(commit.ID, commitInfo)
 		if err := d.openCommits.ReadWrite(stm).Delete(commit.ID); err != nil {
 			return fmt.Errorf("could not confirm that commit %s is open; this is likely a bug. err: %v", commit.ID, err)
 		}
-		if sizeChange > 0 {
+		if sizeChange > 0 || commitInfo.PhysicalSizeBytes > 0 {
 			// update repo size
 			repoInfo := new(pfs.RepoInfo)
 			if err := repos.Get(commit.Repo.Name, repoInfo); err != nil {
@@ -704,6 +867,7 @@ func (d *driver) finishCommit(ctx context.Context, commit *pfs.Commit, tree *pfs
 			// Increment the repo sizes by the sizes of the files that have
 			// been added in this commit.
 			repoInfo.SizeBytes += sizeChange
+			repoInfo.PhysicalSizeBytes += commitInfo.PhysicalSizeBytes
 			repos.Put(commit.Repo.Name, repoInfo)
 		}
 		return nil
@@ -913,7 +1077,7 @@ func (d *driver) inspectCommit(ctx context.Context, commit *pfs.Commit, block bo
 
 	// Check if the commitID is a branch name
 	var commitInfo *pfs.CommitInfo
-	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		var err error
 		commitInfo, err = d.resolveCommit(stm, commit)
 		return err
@@ -1328,7 +1492,9 @@ func (d *driver) flushRepo(ctx context.Context, repo *pfs.Repo) ([]*pfs.RepoInfo
 }
 
 func (d *driver) deleteCommit(ctx context.Context, userCommit *pfs.Commit) error {
-	if err := d.checkIsAuthorized(ctx, userCommit.Repo, auth.Scope_WRITER); err != nil {
+	// Deleting a commit removes it from the history of every branch that
+	// contains it, so the caller must be able to write to all of them
+	if err := d.checkIsAuthorizedInHistory(ctx, userCommit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	// Main txn: Delete all downstream commits, and update subvenance of upstream commits
@@ -1337,7 +1503,7 @@ func (d *driver) deleteCommit(ctx context.Context, userCommit *pfs.Commit) error
 	deleted := make(map[string]*pfs.CommitInfo) // deleted commits
 	affectedRepos := make(map[string]struct{})  // repos containing deleted commits
 	deleteScratch := false                      // only delete scratch if txn succeeds
-	if _, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		// 1) re-read CommitInfo inside txn
 		userCommitInfo, err := d.resolveCommit(stm, userCommit)
 		if err != nil {
@@ -1380,6 +1546,7 @@ func (d *driver) deleteCommit(ctx context.Context, userCommit *pfs.Commit) error
 				repoInfo := &pfs.RepoInfo{}
 				if err := d.repos.ReadWrite(stm).Update(commit.Repo.Name, repoInfo, func() error {
 					repoInfo.SizeBytes -= commitInfo.SizeBytes
+					repoInfo.PhysicalSizeBytes -= commitInfo.PhysicalSizeBytes
 					return nil
 				}); err != nil {
 					return err
@@ -1608,6 +1775,167 @@ func (d *driver) deleteCommit(ctx context.Context, userCommit *pfs.Commit) error
 	return nil
 }
 
+// squashCommits merges the run of finished commits from 'from' to 'to'
+// (inclusive, where 'from' is an ancestor of 'to') into a single commit. The
+// result is 'to' (whose tree already contains the data of every commit in the
+// run) with 'from's parent as its parent. The other commits in the run are
+// deleted, so that the garbage collector can reclaim their trees, and the
+// commits downstream of them become downstream of 'to'.
+//
+// Like deleteCommit, squashCommits only operates on input commits (commits
+// with no provenance). Also, no commit in the run other than 'to' may be the
+// head of a branch or have children outside of the run.
+func (d *driver) squashCommits(ctx context.Context, from *pfs.Commit, to *pfs.Commit) (*pfs.Commit, error) {
+	if from == nil || to == nil {
+		return nil, fmt.Errorf("must specify the first and last commits to squash")
+	}
+	if from.Repo.Name != to.Repo.Name {
+		return nil, fmt.Errorf("cannot squash commits in different repos (\"%s\" and \"%s\")", from.Repo.Name, to.Repo.Name)
+	}
+	// Squashing rewrites the history of every branch that contains 'to'
+	if err := d.checkIsAuthorizedInHistory(ctx, to, auth.Scope_WRITER); err != nil {
+		return nil, err
+	}
+	var result *pfs.Commit
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		// 1) Resolve 'from' and 'to', and collect the run of commits between them
+		// (newest first)
+		fromInfo, err := d.resolveCommit(stm, from)
+		if err != nil {
+			return err
+		}
+		toInfo, err := d.resolveCommit(stm, to)
+		if err != nil {
+			return err
+		}
+		commits := d.commits(to.Repo.Name).ReadWrite(stm)
+		run := []*pfs.CommitInfo{toInfo}
+		for run[len(run)-1].Commit.ID != fromInfo.Commit.ID {
+			parent := run[len(run)-1].ParentCommit
+			if parent == nil {
+				return fmt.Errorf("commit %s is not an ancestor of %s", fromInfo.Commit.FullID(), toInfo.Commit.FullID())
+			}
+			parentInfo := &pfs.CommitInfo{}
+			if err := commits.Get(parent.ID, parentInfo); err != nil {
+				return err
+			}
+			run = append(run, parentInfo)
+		}
+		result = toInfo.Commit
+		if len(run) == 1 {
+			return nil // nothing to squash
+		}
+
+		// 2) Validate the run of commits
+		deleted := make(map[string]*pfs.CommitInfo) // all commits in 'run' but 'to'
+		for i, commitInfo := range run {
+			if commitInfo.Finished == nil {
+				return fmt.Errorf("cannot squash commit %s because it is not finished", commitInfo.Commit.FullID())
+			}
+			if len(commitInfo.Provenance) > 0 {
+				return fmt.Errorf("cannot squash commit %s because it has non-empty provenance", commitInfo.Commit.FullID())
+			}
+			if i > 0 {
+				if len(commitInfo.ChildCommits) != 1 {
+					return fmt.Errorf("cannot squash commit %s because it has children outside of the commits being squashed", commitInfo.Commit.FullID())
+				}
+				deleted[commitInfo.Commit.ID] = commitInfo
+			}
+		}
+		repoInfo := &pfs.RepoInfo{}
+		if err := d.repos.ReadWrite(stm).Get(to.Repo.Name, repoInfo); err != nil {
+			return err
+		}
+		for _, branch := range repoInfo.Branches {
+			branchInfo := &pfs.BranchInfo{}
+			if err := d.branches(branch.Repo.Name).ReadWrite(stm).Get(branch.Name, branchInfo); err != nil {
+				return err
+			}
+			if branchInfo.Head == nil {
+				continue
+			}
+			if _, ok := deleted[branchInfo.Head.ID]; ok {
+				return fmt.Errorf("cannot squash commit %s because it is the head of branch \"%s\"", branchInfo.Head.FullID(), branch.Name)
+			}
+		}
+
+		// 3) Make 'to' the provenance of every commit downstream of the run, and
+		// collect the run's subvenance (oldest first, so that adjacent ranges in
+		// the same branch can be merged)
+		var subvenance []*pfs.CommitRange
+		for i := len(run) - 1; i >= 0; i-- {
+			for _, subv := range run[i].Subvenance {
+				subvCommits := d.commits(subv.Lower.Repo.Name).ReadWrite(stm)
+				var lowerParent *pfs.Commit
+				commit := subv.Upper
+				for {
+					if commit == nil {
+						return fmt.Errorf("encountered nil parent commit in %s/%s...%s", subv.Lower.Repo.Name, subv.Lower.ID, subv.Upper.ID)
+					}
+					subvCommitInfo := &pfs.CommitInfo{}
+					if err := subvCommits.Update(commit.ID, subvCommitInfo, func() error {
+						for _, provCommit := range subvCommitInfo.Provenance {
+							if _, ok := deleted[provCommit.ID]; ok {
+								provCommit.ID = toInfo.Commit.ID
+							}
+						}
+						return nil
+					}); err != nil {
+						return fmt.Errorf("err updating provenance of downstream commit %s: %v", commit.FullID(), err)
+					}
+					if commit.ID == subv.Lower.ID {
+						lowerParent = subvCommitInfo.ParentCommit
+						break
+					}
+					commit = subvCommitInfo.ParentCommit
+				}
+				merged := false
+				if lowerParent != nil {
+					for _, r := range subvenance {
+						if r.Upper.Repo.Name == lowerParent.Repo.Name && r.Upper.ID == lowerParent.ID {
+							r.Upper = subv.Upper
+							merged = true
+							break
+						}
+					}
+				}
+				if !merged {
+					subvenance = append(subvenance, &pfs.CommitRange{
+						Lower: subv.Lower,
+						Upper: subv.Upper,
+					})
+				}
+			}
+		}
+
+		// 4) Delete the rest of the run, and link 'to' to 'from's parent
+		for id := range deleted {
+			if err := commits.Delete(id); err != nil {
+				return err
+			}
+		}
+		if fromInfo.ParentCommit != nil {
+			parentInfo := &pfs.CommitInfo{}
+			if err := commits.Update(fromInfo.ParentCommit.ID, parentInfo, func() error {
+				for i, child := range parentInfo.ChildCommits {
+					if child.ID == fromInfo.Commit.ID {
+						parentInfo.ChildCommits[i] = toInfo.Commit
+					}
+				}
+				return nil
+			}); err != nil {
+				return fmt.Errorf("err rewriting children of ancestor commit %s: %v", fromInfo.ParentCommit.FullID(), err)
+			}
+		}
+		toInfo.ParentCommit = fromInfo.ParentCommit
+		toInfo.Subvenance = subvenance
+		return commits.Put(toInfo.Commit.ID, toInfo)
+	}); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // createBranch creates a new branch or updates an existing branch (must be one
 // or the other). Most importantly, it sets 'branch.DirectProvenance' to
 // 'provenance' and then for all (downstream) branches, restores the invariant:
@@ -1616,7 +1944,7 @@ func (d *driver) deleteCommit(ctx context.Context, userCommit *pfs.Commit) error
 // This invariant is assumed to hold for all branches upstream of 'branch', but not
 // for 'branch' itself once 'b.Provenance' has been set.
 func (d *driver) createBranch(ctx context.Context, branch *pfs.Branch, commit *pfs.Commit, provenance []*pfs.Branch) error {
-	if err := d.checkIsAuthorized(ctx, branch.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInBranch(ctx, branch.Repo, branch.Name, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	// Validate request. The request must do exactly one of:
@@ -1631,7 +1959,7 @@ func (d *driver) createBranch(ctx context.Context, branch *pfs.Branch, commit *p
 		}
 	}
 
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		// if 'commit' is a branch, resolve it
 		var err error
 		if commit != nil {
@@ -1768,10 +2096,10 @@ func (d *driver) listBranch(ctx context.Context, repo *pfs.Repo) ([]*pfs.BranchI
 }
 
 func (d *driver) deleteBranch(ctx context.Context, branch *pfs.Branch, force bool) error {
-	if err := d.checkIsAuthorized(ctx, branch.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInBranch(ctx, branch.Repo, branch.Name, auth.Scope_WRITER); err != nil {
 		return err
 	}
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		return d.deleteBranchSTM(stm, branch, force)
 	})
 	return err
@@ -1849,7 +2177,7 @@ func validatePath(path string) error {
 
 func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Delimiter,
 	targetFileDatums int64, targetFileBytes int64, overwriteIndex *pfs.OverwriteIndex, reader io.Reader) error {
-	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInCommit(ctx, file.Commit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	commitInfo, err := d.inspectCommit(ctx, file.Commit, false)
@@ -1872,25 +2200,19 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 	}
 
 	if delimiter == pfs.Delimiter_NONE {
-		objects, size, err := d.pachClient.PutObjectSplit(reader)
+		objects, _, err := d.pachClient.PutObjectSplitObjects(reader)
 		if err != nil {
 			return err
 		}
 
-		// Here we use the invariant that every one but the last object
-		// should have a size of ChunkSize.
-		for i, object := range objects {
+		// Objects are content-defined chunks of the file, so they vary in size
+		records.PhysicalSizeBytes = objects.PhysicalSizeBytes
+		for i, object := range objects.Objects {
 			record := &pfs.PutFileRecord{
+				SizeBytes:  objects.Sizes[i],
 				ObjectHash: object.Hash,
 			}
 
-			if size > pfs.ChunkSize {
-				record.SizeBytes = pfs.ChunkSize
-			} else {
-				record.SizeBytes = size
-			}
-			size -= pfs.ChunkSize
-
 			// The first record takes care of the overwriting
 			if i == 0 && overwriteIndex != nil && overwriteIndex.Index != 0 {
 				record.OverwriteIndex = overwriteIndex
@@ -1901,30 +2223,65 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 
 		return d.upsertPutFileRecords(ctx, file, records)
 	}
+	s, err := newSplitter(delimiter, reader)
+	if err != nil {
+		return err
+	}
 	buffer := &bytes.Buffer{}
+	var header, footer []byte // of the split file in 'buffer'
 	var datumsWritten int64
 	var bytesWritten int64
 	var filesPut int
 	EOF := false
 	var eg errgroup.Group
-	decoder := json.NewDecoder(reader)
-	bufioR := bufio.NewReader(reader)
 
 	indexToRecord := make(map[int]*pfs.PutFileRecord)
 	var mu sync.Mutex
+	putBuffer := func() {
+		buffer.Write(footer)
+		_buffer := buffer
+		index := filesPut
+		eg.Go(func() error {
+			// Check whether the split file is already stored, so that its
+			// size is only added to the commit's physical size if it's new
+			hash := pfs.NewHash()
+			hash.Write(_buffer.Bytes())
+			resp, err := d.pachClient.ObjectAPIClient.CheckObject(d.pachClient.Ctx(), &pfs.CheckObjectRequest{
+				Object: &pfs.Object{Hash: pfs.EncodeHash(hash.Sum(nil))},
+			})
+			if err != nil {
+				return err
+			}
+			object, size, err := d.pachClient.PutObject(_buffer)
+			if err != nil {
+				return err
+			}
+			var physicalSize int64
+			if !resp.Exists {
+				// Objects may be compressed in object storage, so get the
+				// size of the stored data
+				objectInfo, err := d.pachClient.InspectObject(object.Hash)
+				if err != nil {
+					return err
+				}
+				physicalSize = int64(objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			indexToRecord[index] = &pfs.PutFileRecord{
+				SizeBytes:  size,
+				ObjectHash: object.Hash,
+			}
+			records.PhysicalSizeBytes += physicalSize
+			return nil
+		})
+		datumsWritten = 0
+		bytesWritten = 0
+		buffer = &bytes.Buffer{}
+		filesPut++
+	}
 	for !EOF {
-		var err error
-		var value []byte
-		switch delimiter {
-		case pfs.Delimiter_JSON:
-			var jsonValue json.RawMessage
-			err = decoder.Decode(&jsonValue)
-			value = jsonValue
-		case pfs.Delimiter_LINE:
-			value, err = bufioR.ReadBytes('\n')
-		default:
-			return fmt.Errorf("unrecognized delimiter %s", delimiter.String())
-		}
+		value, err := s.next()
 		if err != nil {
 			if err == io.EOF {
 				EOF = true
@@ -1932,33 +2289,26 @@ func (d *driver) putFile(ctx context.Context, file *pfs.File, delimiter pfs.Deli
 				return err
 			}
 		}
-		buffer.Write(value)
-		bytesWritten += int64(len(value))
-		datumsWritten++
+		if len(value) > 0 {
+			// A new header (e.g. the next table in a SQL dump) starts a new
+			// split file
+			if buffer.Len() != 0 && !bytes.Equal(header, s.header()) {
+				putBuffer()
+			}
+			if buffer.Len() == 0 {
+				header, footer = s.header(), s.footer()
+				buffer.Write(header)
+			}
+			buffer.Write(value)
+			bytesWritten += int64(len(value))
+			datumsWritten++
+		}
 		if buffer.Len() != 0 &&
 			((targetFileBytes != 0 && bytesWritten >= targetFileBytes) ||
 				(targetFileDatums != 0 && datumsWritten >= targetFileDatums) ||
 				(targetFileBytes == 0 && targetFileDatums == 0) ||
 				EOF) {
-			_buffer := buffer
-			index := filesPut
-			eg.Go(func() error {
-				object, size, err := d.pachClient.PutObject(_buffer)
-				if err != nil {
-					return err
-				}
-				mu.Lock()
-				defer mu.Unlock()
-				indexToRecord[index] = &pfs.PutFileRecord{
-					SizeBytes:  size,
-					ObjectHash: object.Hash,
-				}
-				return nil
-			})
-			datumsWritten = 0
-			bytesWritten = 0
-			buffer = &bytes.Buffer{}
-			filesPut++
+			putBuffer()
 		}
 	}
 	if err := eg.Wait(); err != nil {
@@ -1977,7 +2327,7 @@ func (d *driver) copyFile(ctx context.Context, src *pfs.File, dst *pfs.File, ove
 	if err := d.checkIsAuthorized(ctx, src.Commit.Repo, auth.Scope_READER); err != nil {
 		return err
 	}
-	if err := d.checkIsAuthorized(ctx, dst.Commit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInCommit(ctx, dst.Commit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	if err := validatePath(dst.Path); err != nil {
@@ -2117,13 +2467,19 @@ func (d *driver) getTreeForFile(ctx context.Context, file *pfs.File) (hashtree.H
 	if err != nil {
 		return nil, err
 	}
-	return d.getTreeForOpenCommit(ctx, scratchPrefix, parentTree)
+	tree, _, err := d.getTreeForOpenCommit(ctx, scratchPrefix, parentTree)
+	return tree, err
 }
 
-func (d *driver) getTreeForOpenCommit(ctx context.Context, prefix string, parentTree hashtree.HashTree) (hashtree.HashTree, error) {
+// getTreeForOpenCommit applies the writes under 'prefix' to 'parentTree'. It
+// returns the resulting tree and the number of bytes that the writes added to
+// object storage.
+func (d *driver) getTreeForOpenCommit(ctx context.Context, prefix string, parentTree hashtree.HashTree) (hashtree.HashTree, uint64, error) {
 	var finishedTree hashtree.HashTree
-	_, err := col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	var physicalSize uint64
+	_, err := d.store.NewSTM(ctx, func(stm col.STM) error {
 		tree := parentTree.Open()
+		physicalSize = 0
 
 		recordsCol := d.putFileRecords.ReadOnly(ctx)
 		iter, err := recordsCol.ListPrefix(prefix)
@@ -2144,6 +2500,7 @@ func (d *driver) getTreeForOpenCommit(ctx context.Context, prefix string, parent
 			if err != nil {
 				return err
 			}
+			physicalSize += uint64(putFileRecords.PhysicalSizeBytes)
 		}
 		finishedTree, err = tree.Finish()
 		if err != nil {
@@ -2152,9 +2509,9 @@ func (d *driver) getTreeForOpenCommit(ctx context.Context, prefix string, parent
 		return nil
 	})
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-	return finishedTree, nil
+	return finishedTree, physicalSize, nil
 }
 
 func (d *driver) getFile(ctx context.Context, file *pfs.File, offset int64, size int64) (io.Reader, error) {
@@ -2327,7 +2684,7 @@ func (d *driver) diffFile(ctx context.Context, newFile *pfs.File, oldFile *pfs.F
 }
 
 func (d *driver) deleteFile(ctx context.Context, file *pfs.File) error {
-	if err := d.checkIsAuthorized(ctx, file.Commit.Repo, auth.Scope_WRITER); err != nil {
+	if err := d.checkIsAuthorizedInCommit(ctx, file.Commit, auth.Scope_WRITER); err != nil {
 		return err
 	}
 	commitInfo, err := d.inspectCommit(ctx, file.Commit, false)
@@ -2366,7 +2723,7 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 		return err
 	}
 
-	_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+	_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
 		commitsCol := d.openCommits.ReadOnly(ctx)
 		var commit pfs.Commit
 		err := commitsCol.Get(file.Commit.ID, &commit)
@@ -2386,11 +2743,15 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 			return err
 		}
 		if newRecords.Tombstone {
+			// The deleted data isn't part of the commit, so it doesn't count
+			// towards the commit's physical size
 			existingRecords.Tombstone = true
 			existingRecords.Records = nil
+			existingRecords.PhysicalSizeBytes = 0
 		} else {
 			existingRecords.Split = newRecords.Split
 			existingRecords.Records = append(existingRecords.Records, newRecords.Records...)
+			existingRecords.PhysicalSizeBytes += newRecords.PhysicalSizeBytes
 		}
 		recordsCol.Put(prefix, &existingRecords)
 		return nil
@@ -2401,7 +2762,7 @@ func (d *driver) upsertPutFileRecords(ctx context.Context, file *pfs.File, newRe
 	// If there is a tombstone, remove any records for children under this directory
 	// This allows us to support deleting dirs / adding children properly, e.g. `TestDeleteDir`
 	if newRecords.Tombstone {
-		_, err = col.NewSTM(ctx, d.etcdClient, func(stm col.STM) error {
+		_, err = d.store.NewSTM(ctx, func(stm col.STM) error {
 			revision := stm.Rev(d.openCommits.Path(file.Commit.ID))
 			if revision == 0 {
 				return fmt.Errorf("commit %v is not open", file.Commit.ID)
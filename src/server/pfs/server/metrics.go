@@ -0,0 +1,77 @@
+package server
+
+import (
+	"sync"
+
+	"github.com/golang/groupcache"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	cacheGets = prometheus.NewDesc("pachyderm_groupcache_gets_total",
+		"Number of gets from the block server's caches, including gets from peers, by cache.",
+		[]string{"cache"}, nil)
+	cacheHits = prometheus.NewDesc("pachyderm_groupcache_hits_total",
+		"Number of gets from the block server's caches that were served from a cache, by cache.",
+		[]string{"cache"}, nil)
+	cachePeerLoads = prometheus.NewDesc("pachyderm_groupcache_peer_loads_total",
+		"Number of cache misses that were loaded from a peer, by cache.",
+		[]string{"cache"}, nil)
+	cacheLocalLoads = prometheus.NewDesc("pachyderm_groupcache_local_loads_total",
+		"Number of cache misses that were loaded from object storage, by cache.",
+		[]string{"cache"}, nil)
+	cacheLoadErrors = prometheus.NewDesc("pachyderm_groupcache_load_errors_total",
+		"Number of cache misses that couldn't be loaded, from a peer or from object storage, by cache.",
+		[]string{"cache"}, nil)
+	cacheBytes = prometheus.NewDesc("pachyderm_groupcache_bytes",
+		"Number of bytes in the block server's caches, by cache.",
+		[]string{"cache"}, nil)
+	cacheEvictions = prometheus.NewDesc("pachyderm_groupcache_evictions_total",
+		"Number of entries evicted from the block server's caches, by cache.",
+		[]string{"cache"}, nil)
+
+	cacheStats = &cacheCollector{groups: make(map[string]*groupcache.Group)}
+)
+
+func init() {
+	prometheus.MustRegister(cacheStats)
+}
+
+// cacheCollector reads the stats of the block server's caches when metrics
+// are scraped
+type cacheCollector struct {
+	mu     sync.Mutex
+	groups map[string]*groupcache.Group
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range []*prometheus.Desc{cacheGets, cacheHits, cachePeerLoads, cacheLocalLoads, cacheLoadErrors, cacheBytes, cacheEvictions} {
+		ch <- desc
+	}
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name, group := range c.groups {
+		counter := func(desc *prometheus.Desc, value int64) {
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, float64(value), name)
+		}
+		main, hot := group.CacheStats(groupcache.MainCache), group.CacheStats(groupcache.HotCache)
+		counter(cacheGets, group.Stats.Gets.Get())
+		counter(cacheHits, group.Stats.CacheHits.Get())
+		counter(cachePeerLoads, group.Stats.PeerLoads.Get())
+		counter(cacheLocalLoads, group.Stats.LocalLoads.Get())
+		counter(cacheLoadErrors, group.Stats.PeerErrors.Get()+group.Stats.LocalLoadErrs.Get())
+		counter(cacheEvictions, main.Evictions+hot.Evictions)
+		ch <- prometheus.MustNewConstMetric(cacheBytes, prometheus.GaugeValue, float64(main.Bytes+hot.Bytes), name)
+	}
+}
+
+// exportCacheStats exports the stats of 'group' as Prometheus metrics with
+// the label cache="name", replacing the group previously exported as 'name'
+func exportCacheStats(name string, group *groupcache.Group) {
+	cacheStats.mu.Lock()
+	defer cacheStats.mu.Unlock()
+	cacheStats.groups[name] = group
+}
@@ -25,6 +25,8 @@ import (
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	"github.com/pachyderm/pachyderm/src/server/pkg/chunker"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
@@ -39,12 +41,21 @@ const (
 	objectInfoCacheShares = 1
 	maxCachedObjectDenom  = 4                // We will only cache objects less than 1/maxCachedObjectDenom of total cache size
 	bufferSize            = 15 * 1024 * 1024 // 15 MB
+
+	// rewrapKeysLockPath is the etcd lock held by the pachd that's
+	// re-wrapping objects' data keys
+	rewrapKeysLockPath = "rewrap-keys-lock"
+	// rewrappedKeyIDKey is the etcd key that stores the ID of the master key
+	// that all objects' data keys have been re-wrapped with
+	rewrappedKeyIDKey = "rewrapped-key-id"
 )
 
 type objBlockAPIServer struct {
 	log.Logger
 	dir       string
 	objClient obj.Client
+	// compression is the codec that new objects are compressed with
+	compression pfsclient.Compression
 
 	// cache
 	objectCache     *groupcache.Group
@@ -64,7 +75,16 @@ type objBlockAPIServer struct {
 // In test mode, we use unique names for cache groups, since we might want
 // to run multiple block servers locally, which would conflict if groups
 // had the same name.
-func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objClient obj.Client, test bool) (*objBlockAPIServer, error) {
+func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objClient obj.Client, compression pfsclient.Compression, test bool) (*objBlockAPIServer, error) {
+	// Export metrics about the requests that reach object storage (so, after
+	// encryption, the bytes that are actually transferred)
+	objClient = obj.NewMetricsClient(objClient)
+	// Encrypt everything that's written to object storage if the storage
+	// secret contains a master key
+	objClient, err := obj.NewEncryptedClientFromSecret(objClient)
+	if err != nil {
+		return nil, err
+	}
 	// defensive mesaure incase IsNotExist checking breaks due to underlying changes
 	if err := obj.TestIsNotExist(objClient); err != nil {
 		return nil, err
@@ -74,6 +94,7 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 		Logger:           log.NewLogger("pfs.BlockAPI.Obj"),
 		dir:              dir,
 		objClient:        objClient,
+		compression:      compression,
 		objectIndexes:    make(map[string]*pfsclient.ObjectIndex),
 		objectCacheBytes: oneCacheShare * objectCacheShares,
 	}
@@ -91,21 +112,69 @@ func newObjBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, objC
 	s.objectCache = groupcache.NewGroup(objectGroupName, oneCacheShare*objectCacheShares, groupcache.GetterFunc(s.objectGetter))
 	s.tagCache = groupcache.NewGroup(tagGroupName, oneCacheShare*tagCacheShares, groupcache.GetterFunc(s.tagGetter))
 	s.objectInfoCache = groupcache.NewGroup(objectInfoGroupName, oneCacheShare*objectInfoCacheShares, groupcache.GetterFunc(s.objectInfoGetter))
-	// Periodically print cache stats for debugging purposes
-	// TODO: make the stats accessible via HTTP or gRPC.
-	go func() {
-		ticker := time.NewTicker(time.Minute)
-		for {
-			<-ticker.C
-			logrus.Infof("objectCache stats: %+v", s.objectCache.Stats)
-			logrus.Infof("tagCache stats: %+v", s.tagCache.Stats)
-			logrus.Infof("objectInfoCache stats: %+v", s.objectInfoCache.Stats)
-		}
-	}()
+	// Export the caches' stats. They're labelled with the groups' base names,
+	// so that the block servers started by tests replace each other's stats
+	exportCacheStats("object", s.objectCache)
+	exportCacheStats("tag", s.tagCache)
+	exportCacheStats("objectInfo", s.objectInfoCache)
 	go s.watchGC(etcdAddress)
+	go s.rewrapKeys(etcdAddress)
 	return s, nil
 }
 
+// rewrapKeys re-wraps the data keys of objects that were encrypted when a
+// previous master key was current (which is a no-op unless objects are
+// encrypted and previous master keys are configured). Only one pachd
+// re-wraps keys at a time, and once all objects have been re-wrapped with a
+// master key, it's recorded in etcd so that other pachds (and restarts)
+// don't walk object storage again.
+func (s *objBlockAPIServer) rewrapKeys(etcdAddress string) {
+	keyID := obj.CurrentKeyID(s.objClient)
+	if keyID == "" {
+		return
+	}
+	b := backoff.NewInfiniteBackOff()
+	backoff.RetryNotify(func() error {
+		etcdClient, err := etcd.New(etcd.Config{
+			Endpoints:   []string{etcdAddress},
+			DialOptions: client.EtcdDialOptions(),
+		})
+		if err != nil {
+			return fmt.Errorf("error instantiating etcd client: %v", err)
+		}
+		defer etcdClient.Close()
+
+		lock := dlock.NewDLock(etcdClient, rewrapKeysLockPath)
+		ctx, err := lock.Lock(context.Background())
+		if err != nil {
+			return err
+		}
+		defer lock.Unlock(ctx)
+
+		resp, err := etcdClient.Get(ctx, rewrappedKeyIDKey)
+		if err != nil {
+			return err
+		}
+		if len(resp.Kvs) > 0 && string(resp.Kvs[0].Value) == keyID {
+			return nil
+		}
+		rewrapped, err := obj.RewrapKeys(s.objClient, s.dir)
+		if err != nil {
+			return err
+		}
+		if _, err := etcdClient.Put(ctx, rewrappedKeyIDKey, keyID); err != nil {
+			return err
+		}
+		if rewrapped > 0 {
+			logrus.Infof("re-wrapped the data keys of %d objects with the current master key; previous master keys are no longer needed", rewrapped)
+		}
+		return nil
+	}, b, func(err error, d time.Duration) error {
+		logrus.Errorf("error re-wrapping data keys with the current master key: %v; retrying in %s", err, d)
+		return nil
+	})
+}
+
 // watchGC watches for GC runs and invalidate all cache when GC happens.
 func (s *objBlockAPIServer) watchGC(etcdAddress string) {
 	b := backoff.NewInfiniteBackOff()
@@ -158,44 +227,44 @@ func (s *objBlockAPIServer) getGeneration() int {
 	return s.generation
 }
 
-func newMinioBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+func newMinioBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, compression pfsclient.Compression) (*objBlockAPIServer, error) {
 	objClient, err := obj.NewMinioClientFromSecret("")
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, false)
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, compression, false)
 }
 
-func newAmazonBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+func newAmazonBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, compression pfsclient.Compression) (*objBlockAPIServer, error) {
 	objClient, err := obj.NewAmazonClientFromSecret("")
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, false)
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, compression, false)
 }
 
-func newGoogleBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+func newGoogleBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, compression pfsclient.Compression) (*objBlockAPIServer, error) {
 	objClient, err := obj.NewGoogleClientFromSecret(context.Background(), "")
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, false)
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, compression, false)
 }
 
-func newMicrosoftBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+func newMicrosoftBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, compression pfsclient.Compression) (*objBlockAPIServer, error) {
 	objClient, err := obj.NewMicrosoftClientFromSecret("")
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, false)
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, compression, false)
 }
 
-func newLocalBlockAPIServer(dir string, cacheBytes int64, etcdAddress string) (*objBlockAPIServer, error) {
+func newLocalBlockAPIServer(dir string, cacheBytes int64, etcdAddress string, compression pfsclient.Compression) (*objBlockAPIServer, error) {
 	objClient, err := obj.NewLocalClient(dir)
 	if err != nil {
 		return nil, err
 	}
-	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, true)
+	return newObjBlockAPIServer(dir, cacheBytes, etcdAddress, objClient, compression, true)
 }
 
 func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer) (retErr error) {
@@ -205,7 +274,7 @@ func (s *objBlockAPIServer) PutObject(server pfsclient.ObjectAPI_PutObjectServer
 	putObjectReader := &putObjectReader{
 		server: server,
 	}
-	object, err := s.putObject(server.Context(), putObjectReader, false)
+	object, _, _, err := s.putObject(server.Context(), putObjectReader, nil)
 	if err != nil {
 		return err
 	}
@@ -227,14 +296,20 @@ func (s *objBlockAPIServer) PutObjectSplit(server pfsclient.ObjectAPI_PutObjectS
 	func() { s.Log(nil, nil, nil, 0) }()
 	defer func(start time.Time) { s.Log(nil, nil, retErr, time.Since(start)) }(time.Now())
 	defer drainObjectServer(server)
-	var objects []*pfsclient.Object
+	objects := &pfsclient.Objects{}
 	putObjectReader := &putObjectReader{
 		server: server,
 	}
+	// Split the data into content-defined chunks, so that regions of data
+	// that are unchanged between two puts (e.g. of two versions of a large
+	// file) are stored as the same objects
+	c := chunker.New(putObjectReader)
 	for {
-		object, err := s.putObject(server.Context(), putObjectReader, true)
+		object, size, physicalSize, err := s.putObject(server.Context(), putObjectReader, c)
 		if object != nil {
-			objects = append(objects, object)
+			objects.Objects = append(objects.Objects, object)
+			objects.Sizes = append(objects.Sizes, size)
+			objects.PhysicalSizeBytes += physicalSize
 		}
 		if err != nil {
 			if err == io.EOF {
@@ -243,31 +318,47 @@ func (s *objBlockAPIServer) PutObjectSplit(server pfsclient.ObjectAPI_PutObjectS
 			return err
 		}
 	}
-	return server.SendAndClose(&pfsclient.Objects{objects})
+	return server.SendAndClose(objects)
 }
 
-func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader, split bool) (_ *pfsclient.Object, retErr error) {
+// putObject stores the data in 'dataReader' as an object, or, if 'c' is
+// set, the next chunk of the data read by 'c'. It returns the object, its
+// size, and the number of bytes that it added to object storage (which is 0
+// if the object was already stored).
+func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader, c *chunker.Chunker) (_ *pfsclient.Object, _ int64, _ int64, retErr error) {
 	hash := pfsclient.NewHash()
-	r := io.TeeReader(dataReader, hash)
 	block := &pfsclient.Block{Hash: uuid.NewWithoutDashes()}
 	var size int64
+	// counts the (compressed) bytes written to the block
+	blockW := &countWriter{}
 	if err := func() (retErr error) {
 		blockPath := s.blockPath(block)
-		w, err := s.objClient.Writer(blockPath)
+		objW, err := obj.TracingClient(ctx, s.objClient).Writer(blockPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := objW.Close(); err != nil && retErr == nil {
+				retErr = err
+			}
+		}()
+		blockW.w = objW
+		compressor, err := newCompressor(s.compression, blockW)
 		if err != nil {
 			return err
 		}
 		defer func() {
-			if err := w.Close(); err != nil && retErr == nil {
+			if err := compressor.Close(); err != nil && retErr == nil {
 				retErr = err
 			}
 		}()
-		if split {
-			size, err = io.CopyN(w, r, pfsclient.ChunkSize)
+		w := io.MultiWriter(compressor, hash)
+		if c != nil {
+			size, err = c.Next(w)
 		} else {
 			buf := grpcutil.GetBuffer()
 			defer grpcutil.PutBuffer(buf)
-			size, err = io.CopyBuffer(w, r, buf)
+			size, err = io.CopyBuffer(w, dataReader, buf)
 		}
 		if err != nil {
 			if err != io.EOF {
@@ -284,33 +375,37 @@ func (s *objBlockAPIServer) putObject(ctx context.Context, dataReader io.Reader,
 				}
 			}()
 		} else {
-			return nil, err
+			return nil, 0, 0, err
 		}
 	}
 	object := &pfsclient.Object{Hash: pfsclient.EncodeHash(hash.Sum(nil))}
 	// Now that we have a hash of the object we can check if it already exists.
 	resp, err := s.CheckObject(ctx, &pfsclient.CheckObjectRequest{object})
 	if err != nil {
-		return nil, err
+		return nil, 0, 0, err
 	}
 	if resp.Exists {
 		// the object already exists so we delete the block we put
 		if err := s.objClient.Delete(s.blockPath(block)); err != nil {
-			return nil, err
-		}
-	} else {
-		blockRef := &pfsclient.BlockRef{
-			Block: block,
-			Range: &pfsclient.ByteRange{
-				Lower: 0,
-				Upper: uint64(size),
-			},
-		}
-		if err := s.writeProto(s.objectPath(object), blockRef); err != nil {
-			return nil, err
+			return nil, 0, 0, err
 		}
+		return object, size, 0, nil
+	}
+	blockRef := &pfsclient.BlockRef{
+		Block: block,
+		Range: &pfsclient.ByteRange{
+			Lower: 0,
+			Upper: uint64(blockW.count),
+		},
+		Compression: s.compression,
+	}
+	if s.compression != pfsclient.Compression_UNCOMPRESSED {
+		blockRef.SizeBytes = uint64(size)
+	}
+	if err := s.writeProto(s.objectPath(object), blockRef); err != nil {
+		return nil, 0, 0, err
 	}
-	return object, nil
+	return object, size, blockW.count, nil
 }
 
 func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer pfsclient.ObjectAPI_GetObjectServer) (retErr error) {
@@ -331,12 +426,11 @@ func (s *objBlockAPIServer) GetObject(request *pfsclient.Object, getObjectServer
 		logrus.Errorf("objectInfo.BlockRef.Range is nil; info: %+v; request: %v", objectInfo, request)
 		return nil
 	}
-	objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+	objectSize := objectSize(objectInfo.BlockRef)
 	if (objectSize) >= uint64(s.objectCacheBytes/maxCachedObjectDenom) {
 		// The object is a substantial portion of the available cache space so
 		// we bypass the cache and stream it directly out of the underlying store.
-		blockPath := s.blockPath(objectInfo.BlockRef.Block)
-		r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower, objectSize)
+		r, err := s.blockRefReader(getObjectServer.Context(), objectInfo.BlockRef, 0, objectSize)
 		if err != nil {
 			return err
 		}
@@ -372,7 +466,7 @@ func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, get
 			continue
 		}
 
-		objectSize := objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+		objectSize := objectSize(objectInfo.BlockRef)
 		if offset > objectSize {
 			offset -= objectSize
 			continue
@@ -382,8 +476,7 @@ func (s *objBlockAPIServer) GetObjects(request *pfsclient.GetObjectsRequest, get
 			readSize = size
 		}
 		if request.TotalSize >= uint64(s.objectCacheBytes/maxCachedObjectDenom) {
-			blockPath := s.blockPath(objectInfo.BlockRef.Block)
-			r, err := s.objClient.Reader(blockPath, objectInfo.BlockRef.Range.Lower+offset, readSize)
+			r, err := s.blockRefReader(getObjectsServer.Context(), objectInfo.BlockRef, offset, readSize)
 			if err != nil {
 				return err
 			}
@@ -659,13 +752,17 @@ func (s *objBlockAPIServer) compact() (retErr error) {
 				if err != nil {
 					return err
 				}
-				blockRef, err = w.Write(object)
+				// The object's bytes are copied as-is, so they keep the
+				// compression that they were written with
+				newBlockRef, err := w.Write(object)
 				if err != nil {
 					return err
 				}
+				newBlockRef.Compression = blockRef.Compression
+				newBlockRef.SizeBytes = blockRef.SizeBytes
 				mu.Lock()
 				defer mu.Unlock()
-				objectIndex.Objects[filepath.Base(name)] = blockRef
+				objectIndex.Objects[filepath.Base(name)] = newBlockRef
 				toDelete = append(toDelete, name, blockPath)
 				return nil
 			})
@@ -806,7 +903,7 @@ func (s *objBlockAPIServer) writeInternal(path string, data []byte) (retErr erro
 }
 
 func (s *objBlockAPIServer) blockGetter(ctx groupcache.Context, key string, dest groupcache.Sink) (retErr error) {
-	return s.readObj(s.blockPath(client.NewBlock(key)), 0, 0, dest)
+	return s.readObj(cacheContext(ctx), s.blockPath(client.NewBlock(key)), 0, 0, dest)
 }
 
 func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -815,7 +912,7 @@ func (s *objBlockAPIServer) objectGetter(ctx groupcache.Context, key string, des
 	if err := s.objectInfoCache.Get(ctx, key, sink); err != nil {
 		return err
 	}
-	return s.readBlockRef(objectInfo.BlockRef, dest)
+	return s.readBlockRef(cacheContext(ctx), objectInfo.BlockRef, dest)
 }
 
 func (s *objBlockAPIServer) tagGetter(ctx groupcache.Context, key string, dest groupcache.Sink) error {
@@ -919,11 +1016,21 @@ func (s *objBlockAPIServer) objectInfoGetter(ctx groupcache.Context, key string,
 	return fmt.Errorf("objectInfoGetter: object %s not found", object.Hash)
 }
 
-func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
+// cacheContext returns the context.Context that was passed to a groupcache
+// Get, which is passed to getters as a groupcache.Context
+func cacheContext(ctx groupcache.Context) context.Context {
+	if ctx, ok := ctx.(context.Context); ok {
+		return ctx
+	}
+	return context.Background()
+}
+
+func (s *objBlockAPIServer) readObj(ctx context.Context, path string, offset uint64, size uint64, dest groupcache.Sink) (retErr error) {
 	var reader io.ReadCloser
 	var err error
+	objClient := obj.TracingClient(ctx, s.objClient)
 	backoff.RetryNotify(func() error {
-		reader, err = s.objClient.Reader(path, offset, size)
+		reader, err = objClient.Reader(path, offset, size)
 		if err != nil && obj.IsRetryable(s.objClient, err) {
 			return err
 		}
@@ -950,8 +1057,44 @@ func (s *objBlockAPIServer) readObj(path string, offset uint64, size uint64, des
 	return dest.SetBytes(data)
 }
 
-func (s *objBlockAPIServer) readBlockRef(blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
-	return s.readObj(s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+func (s *objBlockAPIServer) readBlockRef(ctx context.Context, blockRef *pfsclient.BlockRef, dest groupcache.Sink) error {
+	if blockRef.Compression == pfsclient.Compression_UNCOMPRESSED {
+		return s.readObj(ctx, s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, dest)
+	}
+	var compressed []byte
+	if err := s.readObj(ctx, s.blockPath(blockRef.Block), blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower, groupcache.AllocatingByteSliceSink(&compressed)); err != nil {
+		return err
+	}
+	r, err := newDecompressor(blockRef.Compression, bytes.NewReader(compressed))
+	if err != nil {
+		return err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	return dest.SetBytes(data)
+}
+
+// blockRefReader returns a reader for 'size' bytes of the object that
+// 'blockRef' points to, starting at 'offset'. Compressed objects are read in
+// full from object storage and decompressed.
+func (s *objBlockAPIServer) blockRefReader(ctx context.Context, blockRef *pfsclient.BlockRef, offset uint64, size uint64) (io.ReadCloser, error) {
+	blockPath := s.blockPath(blockRef.Block)
+	objClient := obj.TracingClient(ctx, s.objClient)
+	if blockRef.Compression == pfsclient.Compression_UNCOMPRESSED {
+		return objClient.Reader(blockPath, blockRef.Range.Lower+offset, size)
+	}
+	r, err := objClient.Reader(blockPath, blockRef.Range.Lower, blockRef.Range.Upper-blockRef.Range.Lower)
+	if err != nil {
+		return nil, err
+	}
+	dr, err := readDecompressed(blockRef.Compression, r, offset, size)
+	if err != nil {
+		r.Close()
+		return nil, err
+	}
+	return dr, nil
 }
 
 func (s *objBlockAPIServer) getObjectIndex(prefix string) (*pfsclient.ObjectIndex, bool) {
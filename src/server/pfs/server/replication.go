@@ -0,0 +1,633 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/auth"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/errutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+const (
+	replicationLockPath = "_replication_lock"
+	// replicationLagInterval is how often a replicator recomputes its lag while
+	// it's waiting for new commits
+	replicationLagInterval = 10 * time.Second
+)
+
+// replicationKey is the key of 'r' in the replications collections
+func replicationKey(r *pfs.Replication) string {
+	return fmt.Sprintf("%s@%s@%s", r.Branch.Repo.Name, r.Branch.Name, r.Remote)
+}
+
+// remoteAddress strips the pfs:// scheme (as used in put-file URLs) from the
+// address of a remote pachd
+func remoteAddress(remote string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(remote, "pfs://"), "/")
+}
+
+// validateReplication checks that 'r' names a branch and a remote, and returns
+// a copy of it with the remote's address normalized
+func validateReplication(r *pfs.Replication) (*pfs.Replication, error) {
+	if r == nil || r.Branch == nil || r.Branch.Repo == nil || r.Branch.Repo.Name == "" || r.Branch.Name == "" {
+		return nil, fmt.Errorf("replication must name a repo and a branch")
+	}
+	remote := remoteAddress(r.Remote)
+	if remote == "" {
+		return nil, fmt.Errorf("replication must name a remote pachd")
+	}
+	return &pfs.Replication{Branch: r.Branch, Remote: remote}, nil
+}
+
+func (d *driver) createReplication(ctx context.Context, request *pfs.CreateReplicationRequest) error {
+	d.initializePachConn()
+	replication, err := validateReplication(request.Replication)
+	if err != nil {
+		return err
+	}
+	branch := replication.Branch
+	if _, err := d.inspectRepo(ctx, branch.Repo, !includeAuth); err != nil {
+		return err
+	}
+	// The replicator reads the branch as the caller, and publishes it to the
+	// remote cluster, so only the repo's owners (and admins) may replicate it
+	if err := d.checkIsAuthorized(ctx, branch.Repo, auth.Scope_OWNER); err != nil {
+		return err
+	}
+	remoteRepo := request.RemoteRepo
+	if remoteRepo == "" {
+		remoteRepo = branch.Repo.Name
+	}
+	if err := validateRepoName(remoteRepo); err != nil {
+		return err
+	}
+
+	// If auth is activated, get a token that lets the replicator read the
+	// branch as the caller. Tokens can't be restricted to a repo, and the
+	// replicator runs for as long as the replication exists, so the token has
+	// the caller's full access and doesn't expire; it's revoked when the
+	// replication is deleted.
+	var authToken, creator string
+	me, err := d.pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if err == nil {
+		resp, err := d.pachClient.GetAuthToken(auth.In2Out(ctx), &auth.GetAuthTokenRequest{Subject: me.Username})
+		if err != nil {
+			return fmt.Errorf("could not get a token for the replicator: %v", grpcutil.ScrubGRPC(err))
+		}
+		authToken = resp.Token
+		creator = me.Username
+	}
+
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		return d.replications.ReadWrite(stm).Create(replicationKey(replication), &pfs.EtcdReplication{
+			Replication:     replication,
+			RemoteRepo:      remoteRepo,
+			RemoteAuthToken: request.RemoteAuthToken,
+			AuthToken:       authToken,
+			Created:         now(),
+			Creator:         creator,
+		})
+	}); err != nil {
+		d.revokeReplicationToken(ctx, authToken)
+		if errutil.IsAlreadyExistError(err) {
+			return fmt.Errorf("%s@%s is already replicated to %s", branch.Repo.Name, branch.Name, replication.Remote)
+		}
+		return err
+	}
+	return nil
+}
+
+func (d *driver) listReplication(ctx context.Context, repo *pfs.Repo) (*pfs.ReplicationInfos, error) {
+	replications := d.replications.ReadOnly(ctx)
+	replicationInfos := d.replicationInfos.ReadOnly(ctx)
+	iterator, err := replications.List()
+	if err != nil {
+		return nil, err
+	}
+	result := &pfs.ReplicationInfos{}
+	for {
+		var key string
+		etcdReplication := &pfs.EtcdReplication{}
+		ok, err := iterator.Next(&key, etcdReplication)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if repo != nil && repo.Name != etcdReplication.Replication.Branch.Repo.Name {
+			continue
+		}
+		// The replicator might not have written its status yet
+		replicationInfo := &pfs.ReplicationInfo{}
+		if err := replicationInfos.Get(key, replicationInfo); err != nil && !col.IsErrNotFound(err) {
+			return nil, err
+		}
+		replicationInfo.Replication = etcdReplication.Replication
+		replicationInfo.RemoteRepo = etcdReplication.RemoteRepo
+		replicationInfo.Created = etcdReplication.Created
+		result.ReplicationInfo = append(result.ReplicationInfo, replicationInfo)
+	}
+	return result, nil
+}
+
+func (d *driver) deleteReplication(ctx context.Context, replication *pfs.Replication) error {
+	d.initializePachConn()
+	replication, err := validateReplication(replication)
+	if err != nil {
+		return err
+	}
+	key := replicationKey(replication)
+	etcdReplication := &pfs.EtcdReplication{}
+	if err := d.replications.ReadOnly(ctx).Get(key, etcdReplication); err != nil {
+		if col.IsErrNotFound(err) {
+			return fmt.Errorf("%s@%s is not replicated to %s", replication.Branch.Repo.Name, replication.Branch.Name, replication.Remote)
+		}
+		return err
+	}
+	// The replication's creator, the repo's owners and admins may delete it
+	me, err := d.pachClient.WhoAmI(auth.In2Out(ctx), &auth.WhoAmIRequest{})
+	if err != nil && !auth.IsErrNotActivated(err) {
+		return grpcutil.ScrubGRPC(err)
+	}
+	if err == nil && me.Username != etcdReplication.Creator {
+		if err := d.checkIsAuthorized(ctx, replication.Branch.Repo, auth.Scope_OWNER); err != nil {
+			return err
+		}
+	}
+	if _, err := d.store.NewSTM(ctx, func(stm col.STM) error {
+		if err := d.replications.ReadWrite(stm).Delete(key); err != nil {
+			return err
+		}
+		if err := d.replicationInfos.ReadWrite(stm).Delete(key); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	d.revokeReplicationToken(ctx, etcdReplication.AuthToken)
+	return nil
+}
+
+// revokeReplicationToken revokes a token that was created for a replicator.
+// The token is used to revoke itself, as the caller may not be the user who
+// created the replication. Errors are only logged, as the replication is gone
+// either way.
+func (d *driver) revokeReplicationToken(ctx context.Context, token string) {
+	if token == "" {
+		return
+	}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs(auth.ContextTokenKey, token))
+	if _, err := d.pachClient.RevokeAuthToken(ctx, &auth.RevokeAuthTokenRequest{Token: token}); err != nil {
+		logrus.Errorf("could not revoke replicator's auth token: %v", grpcutil.ScrubGRPC(err))
+	}
+}
+
+// replicationMaster runs a replicator for each replication. Only one pachd
+// runs replicators at a time.
+func (d *driver) replicationMaster() {
+	lock := dlock.NewDLock(d.etcdClient, path.Join(d.prefix, replicationLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel() // stops all replicators
+		ctx, err := lock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer lock.Unlock(ctx)
+
+		watcher, err := d.replications.ReadOnly(ctx).Watch()
+		if err != nil {
+			return fmt.Errorf("error creating watch: %v", err)
+		}
+		defer watcher.Close()
+		cancels := make(map[string]func())
+		for {
+			event, ok := <-watcher.Watch()
+			if !ok {
+				return fmt.Errorf("replication watch closed unexpectedly")
+			}
+			switch event.Type {
+			case watch.EventError:
+				return event.Err
+			case watch.EventPut:
+				var key string
+				etcdReplication := &pfs.EtcdReplication{}
+				if err := event.Unmarshal(&key, etcdReplication); err != nil {
+					return err
+				}
+				if cancel, ok := cancels[key]; ok {
+					cancel()
+				}
+				replicatorCtx, cancel := context.WithCancel(ctx)
+				cancels[key] = cancel
+				go newReplicator(d, key, etcdReplication).run(replicatorCtx)
+			case watch.EventDelete:
+				key := string(event.Key)
+				if cancel, ok := cancels[key]; ok {
+					cancel()
+					delete(cancels, key)
+				}
+			}
+		}
+	}, backoff.NewInfiniteBackOff(), func(err error, t time.Duration) error {
+		logrus.Errorf("error in replication master: %v; retrying in %v", err, t)
+		return nil
+	})
+}
+
+// replicator copies the commits on one branch to a remote pachd. Commits are
+// recreated in the remote cluster with BuildCommit, so they keep their IDs,
+// parents and provenance, after the objects that they reference are shipped
+// with the ObjectAPI.
+type replicator struct {
+	d    *driver
+	key  string
+	spec *pfs.EtcdReplication
+
+	// mu guards info, which is the replicator's status (as written to etcd)
+	mu   sync.Mutex
+	info *pfs.ReplicationInfo
+}
+
+func newReplicator(d *driver, key string, spec *pfs.EtcdReplication) *replicator {
+	return &replicator{
+		d:    d,
+		key:  key,
+		spec: spec,
+		info: &pfs.ReplicationInfo{
+			Replication: spec.Replication,
+			RemoteRepo:  spec.RemoteRepo,
+			Created:     spec.Created,
+		},
+	}
+}
+
+// run replicates commits until 'ctx' is cancelled, retrying on errors
+func (r *replicator) run(ctx context.Context) {
+	b := backoff.NewInfiniteBackOff()
+	backoff.RetryNotify(func() error {
+		return r.replicate(ctx, b)
+	}, b, func(err error, t time.Duration) error {
+		if ctx.Err() != nil {
+			return ctx.Err() // the replication was deleted
+		}
+		logrus.Errorf("error replicating %s: %v; retrying in %v", r.key, err, t)
+		r.update(ctx, func(info *pfs.ReplicationInfo) {
+			info.State = pfs.ReplicationState_REPLICATION_FAILING
+			info.Reason = err.Error()
+		})
+		return nil
+	})
+}
+
+// update applies 'f' to the replicator's status and writes it to etcd, unless
+// the replication has been deleted
+func (r *replicator) update(ctx context.Context, f func(info *pfs.ReplicationInfo)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f(r.info)
+	r.info.Updated = now()
+	info := proto.Clone(r.info).(*pfs.ReplicationInfo)
+	if _, err := r.d.store.NewSTM(ctx, func(stm col.STM) error {
+		if err := r.d.replications.ReadWrite(stm).Get(r.key, &pfs.EtcdReplication{}); err != nil {
+			return err
+		}
+		return r.d.replicationInfos.ReadWrite(stm).Put(r.key, info)
+	}); err != nil && ctx.Err() == nil {
+		logrus.Errorf("could not update the status of replication %s: %v", r.key, err)
+	}
+}
+
+func (r *replicator) lastReplicated() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.info.LastReplicated == nil {
+		return ""
+	}
+	return r.info.LastReplicated.ID
+}
+
+// replicate connects to both clusters and replicates the branch's commits,
+// starting after the remote branch's head. It only returns on error.
+func (r *replicator) replicate(ctx context.Context, b backoff.BackOff) error {
+	repo := r.spec.Replication.Branch.Repo.Name
+	branch := r.spec.Replication.Branch.Name
+
+	// The replicator reads this cluster through the pachd API, as the user
+	// who created the replication
+	source, err := client.NewFromAddress(r.d.address)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+	source.SetAuthToken(r.spec.AuthToken)
+	source = source.WithCtx(ctx)
+	remote, err := client.NewFromAddress(r.spec.Replication.Remote)
+	if err != nil {
+		return fmt.Errorf("could not connect to %s: %v", r.spec.Replication.Remote, err)
+	}
+	defer remote.Close()
+	remote.SetAuthToken(r.spec.RemoteAuthToken)
+	remote = remote.WithCtx(ctx)
+
+	if err := remote.CreateRepo(r.spec.RemoteRepo); err != nil && !errutil.IsAlreadyExistError(err) {
+		return fmt.Errorf("could not create repo %s in %s: %v", r.spec.RemoteRepo, r.spec.Replication.Remote, err)
+	}
+	var from string
+	branchInfo, err := remote.InspectBranch(r.spec.RemoteRepo, branch)
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+	if branchInfo != nil && branchInfo.Head != nil {
+		from = branchInfo.Head.ID
+	}
+	r.update(ctx, func(info *pfs.ReplicationInfo) {
+		info.State = pfs.ReplicationState_REPLICATION_RUNNING
+		info.Reason = ""
+		info.LastReplicated = nil
+		if from != "" {
+			info.LastReplicated = client.NewCommit(repo, from)
+		}
+	})
+	if err := r.updateLag(ctx, source); err != nil {
+		return err
+	}
+
+	commitInfos, err := source.SubscribeCommit(repo, branch, from)
+	if err != nil {
+		return err
+	}
+	defer commitInfos.Close()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		ticker := time.NewTicker(replicationLagInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if err := r.updateLag(ctx, source); err != nil && ctx.Err() == nil {
+					logrus.Errorf("could not compute the lag of replication %s: %v", r.key, err)
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	for {
+		commitInfo, err := commitInfos.Next()
+		if err != nil {
+			return err
+		}
+		if commitInfo.Finished == nil {
+			commitInfo, err = source.BlockCommit(repo, commitInfo.Commit.ID)
+			if err != nil {
+				return err
+			}
+		}
+		if err := r.replicateCommit(ctx, source, remote, commitInfo); err != nil {
+			return fmt.Errorf("could not replicate commit %s: %v", commitInfo.Commit.ID, err)
+		}
+		b.Reset()
+		if err := r.updateLag(ctx, source); err != nil {
+			return err
+		}
+	}
+}
+
+// updateLag counts the commits on the branch that haven't been replicated
+func (r *replicator) updateLag(ctx context.Context, source *client.APIClient) error {
+	commitInfos, err := source.ListCommit(r.spec.Replication.Branch.Repo.Name, r.spec.Replication.Branch.Name, r.lastReplicated(), 0)
+	if err != nil && !isNotFoundErr(err) {
+		return err
+	}
+	// commitInfos is sorted newest-first
+	var lag time.Duration
+	for _, commitInfo := range commitInfos {
+		if commitInfo.Finished != nil {
+			finished, err := types.TimestampFromProto(commitInfo.Finished)
+			if err != nil {
+				return err
+			}
+			lag = time.Since(finished)
+		}
+	}
+	r.update(ctx, func(info *pfs.ReplicationInfo) {
+		info.LagCommits = int64(len(commitInfos))
+		info.Lag = types.DurationProto(lag)
+	})
+	return nil
+}
+
+// replicateCommit ships the objects that 'commitInfo' references to the
+// remote cluster, and then builds the commit there
+func (r *replicator) replicateCommit(ctx context.Context, source, remote *client.APIClient, commitInfo *pfs.CommitInfo) error {
+	var objects, shippedBytes uint64
+	ship := func(object *pfs.Object) error {
+		size, err := shipObject(source, remote, object)
+		if err != nil {
+			return err
+		}
+		if size >= 0 {
+			objects++
+			shippedBytes += uint64(size)
+		}
+		return nil
+	}
+	tree, err := r.shipTree(source, remote, commitInfo, ship)
+	if err != nil {
+		return err
+	}
+	provenance, err := r.remoteProvenance(ctx, remote, commitInfo)
+	if err != nil {
+		return err
+	}
+	parent := client.NewCommit(r.spec.RemoteRepo, "")
+	if commitInfo.ParentCommit != nil {
+		parent.ID = commitInfo.ParentCommit.ID
+	}
+	if _, err := remote.PfsAPIClient.BuildCommit(remote.Ctx(), &pfs.BuildCommitRequest{
+		Parent:     parent,
+		Branch:     r.spec.Replication.Branch.Name,
+		Provenance: provenance,
+		Tree:       tree,
+		ID:         commitInfo.Commit.ID,
+	}); err != nil {
+		// The commit may have been built before this replicator restarted
+		if err := grpcutil.ScrubGRPC(err); !errutil.IsAlreadyExistError(err) {
+			return err
+		}
+	}
+	r.update(ctx, func(info *pfs.ReplicationInfo) {
+		info.LastReplicated = commitInfo.Commit
+		info.CommitsReplicated++
+		info.ObjectsReplicated += objects
+		info.BytesReplicated += shippedBytes
+	})
+	return nil
+}
+
+// remoteProvenance maps the provenance of 'commitInfo' onto the remote
+// cluster. Provenance commits on branches that are replicated to the same
+// remote are renamed to the repos they're replicated to, and must already have
+// been replicated (so that the replicator retries until they have been). All
+// other provenance (e.g. the pipeline's spec commit, or branches that aren't
+// replicated) doesn't exist in the remote cluster, and is dropped.
+func (r *replicator) remoteProvenance(ctx context.Context, remote *client.APIClient, commitInfo *pfs.CommitInfo) ([]*pfs.Commit, error) {
+	if len(commitInfo.BranchProvenance) != len(commitInfo.Provenance) {
+		return nil, nil // the branches of the provenance commits aren't known
+	}
+	remoteRepos := make(map[string]string)
+	iterator, err := r.d.replications.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var key string
+		etcdReplication := &pfs.EtcdReplication{}
+		ok, err := iterator.Next(&key, etcdReplication)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if etcdReplication.Replication.Remote == r.spec.Replication.Remote {
+			remoteRepos[branchKey(etcdReplication.Replication.Branch)] = etcdReplication.RemoteRepo
+		}
+	}
+	var provenance []*pfs.Commit
+	for i, provCommit := range commitInfo.Provenance {
+		remoteRepo, ok := remoteRepos[branchKey(commitInfo.BranchProvenance[i])]
+		if !ok {
+			continue
+		}
+		if _, err := remote.InspectCommit(remoteRepo, provCommit.ID); err != nil {
+			return nil, fmt.Errorf("provenance commit %s/%s hasn't been replicated to %s/%s yet: %v",
+				provCommit.Repo.Name, provCommit.ID, remoteRepo, provCommit.ID, err)
+		}
+		provenance = append(provenance, client.NewCommit(remoteRepo, provCommit.ID))
+	}
+	return provenance, nil
+}
+
+// shipTree ships the file objects in the tree of 'commitInfo' that aren't in
+// its parent's tree (and so haven't been shipped already), and then the tree
+// itself. It returns the tree object. Commits that were finished without a
+// tree get an empty one.
+func (r *replicator) shipTree(source, remote *client.APIClient, commitInfo *pfs.CommitInfo, ship func(*pfs.Object) error) (*pfs.Object, error) {
+	if commitInfo.Tree == nil {
+		tree, err := hashtree.NewHashTree().Finish()
+		if err != nil {
+			return nil, err
+		}
+		data, err := hashtree.Serialize(tree)
+		if err != nil {
+			return nil, err
+		}
+		object, _, err := remote.PutObject(bytes.NewReader(data))
+		return object, err
+	}
+	shipped := make(map[string]bool)
+	if commitInfo.ParentCommit != nil {
+		parentInfo, err := source.InspectCommit(commitInfo.ParentCommit.Repo.Name, commitInfo.ParentCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+		if parentInfo.Tree != nil {
+			if err := walkTreeObjects(source, parentInfo.Tree, func(object *pfs.Object) error {
+				shipped[object.Hash] = true
+				return nil
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := walkTreeObjects(source, commitInfo.Tree, func(object *pfs.Object) error {
+		if shipped[object.Hash] {
+			return nil
+		}
+		shipped[object.Hash] = true
+		return ship(object)
+	}); err != nil {
+		return nil, err
+	}
+	if err := ship(commitInfo.Tree); err != nil {
+		return nil, err
+	}
+	return commitInfo.Tree, nil
+}
+
+// walkTreeObjects calls 'f' on each file object referenced by the hashtree in
+// 'tree'
+func walkTreeObjects(pachClient *client.APIClient, tree *pfs.Object, f func(*pfs.Object) error) error {
+	var buf bytes.Buffer
+	if err := pachClient.GetObject(tree.Hash, &buf); err != nil {
+		return err
+	}
+	t, err := hashtree.Deserialize(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return t.Walk("/", func(path string, node *hashtree.NodeProto) error {
+		if node.FileNode == nil {
+			return nil
+		}
+		for _, object := range node.FileNode.Objects {
+			if err := f(object); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// shipObject copies 'object' from 'source' to 'remote', unless the remote
+// cluster already has it. It returns the object's size, or -1 if it wasn't
+// copied.
+func shipObject(source, remote *client.APIClient, object *pfs.Object) (int64, error) {
+	resp, err := remote.ObjectAPIClient.CheckObject(remote.Ctx(), &pfs.CheckObjectRequest{Object: object})
+	if err != nil {
+		return 0, grpcutil.ScrubGRPC(err)
+	}
+	if resp.Exists {
+		return -1, nil
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(source.GetObject(object.Hash, pw))
+	}()
+	put, size, err := remote.PutObject(pr)
+	pr.Close() // unblocks GetObject if PutObject failed
+	if err != nil {
+		return 0, err
+	}
+	if put.Hash != object.Hash {
+		return 0, fmt.Errorf("object %s was stored as %s in the remote cluster", object.Hash, put.Hash)
+	}
+	return size, nil
+}
@@ -23,22 +23,39 @@ type BlockAPIServer interface {
 	pfsclient.ObjectAPIServer
 }
 
-// NewAPIServer creates an APIServer.
+// NewAPIServer creates an APIServer, which also replicates branches to other
+// clusters (see CreateReplication).
 // cacheSize is the number of commit trees which will be cached in the server.
 func NewAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (APIServer, error) {
+	apiServer, err := newAPIServer(address, etcdAddresses, etcdPrefix, cacheSize)
+	if err != nil {
+		return nil, err
+	}
+	go apiServer.driver.replicationMaster()
+	return apiServer, nil
+}
+
+// NewSidecarAPIServer creates an APIServer for a worker's pachd sidecar.
+// Unlike NewAPIServer, it doesn't replicate branches.
+func NewSidecarAPIServer(address string, etcdAddresses []string, etcdPrefix string, cacheSize int64) (APIServer, error) {
 	return newAPIServer(address, etcdAddresses, etcdPrefix, cacheSize)
 }
 
 // NewBlockAPIServer creates a BlockAPIServer using the credentials it finds in
-// the environment
-func NewBlockAPIServer(dir string, cacheBytes int64, backend string, etcdAddress string) (BlockAPIServer, error) {
+// the environment. New blocks are compressed with the codec named by
+// 'compression' (see ParseCompression).
+func NewBlockAPIServer(dir string, cacheBytes int64, backend string, etcdAddress string, compression string) (BlockAPIServer, error) {
+	codec, err := ParseCompression(compression)
+	if err != nil {
+		return nil, err
+	}
 	switch backend {
 	case MinioBackendEnvVar:
 		// S3 compatible doesn't like leading slashes
 		if len(dir) > 0 && dir[0] == '/' {
 			dir = dir[1:]
 		}
-		blockAPIServer, err := newMinioBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer, err := newMinioBlockAPIServer(dir, cacheBytes, etcdAddress, codec)
 		if err != nil {
 			return nil, err
 		}
@@ -48,20 +65,20 @@ func NewBlockAPIServer(dir string, cacheBytes int64, backend string, etcdAddress
 		if len(dir) > 0 && dir[0] == '/' {
 			dir = dir[1:]
 		}
-		blockAPIServer, err := newAmazonBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer, err := newAmazonBlockAPIServer(dir, cacheBytes, etcdAddress, codec)
 		if err != nil {
 			return nil, err
 		}
 		return blockAPIServer, nil
 	case GoogleBackendEnvVar:
 		// TODO figure out if google likes leading slashses
-		blockAPIServer, err := newGoogleBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer, err := newGoogleBlockAPIServer(dir, cacheBytes, etcdAddress, codec)
 		if err != nil {
 			return nil, err
 		}
 		return blockAPIServer, nil
 	case MicrosoftBackendEnvVar:
-		blockAPIServer, err := newMicrosoftBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer, err := newMicrosoftBlockAPIServer(dir, cacheBytes, etcdAddress, codec)
 		if err != nil {
 			return nil, err
 		}
@@ -69,7 +86,7 @@ func NewBlockAPIServer(dir string, cacheBytes int64, backend string, etcdAddress
 	case LocalBackendEnvVar:
 		fallthrough
 	default:
-		blockAPIServer, err := newLocalBlockAPIServer(dir, cacheBytes, etcdAddress)
+		blockAPIServer, err := newLocalBlockAPIServer(dir, cacheBytes, etcdAddress, codec)
 		if err != nil {
 			return nil, err
 		}
@@ -530,6 +530,51 @@ func TestDeleteCommit(t *testing.T) {
 	require.Equal(t, 1, len(branches))
 }
 
+func TestSquashCommits(t *testing.T) {
+	client := getClient(t)
+
+	repo := "test"
+	require.NoError(t, client.CreateRepo(repo))
+
+	var commits []*pfs.Commit
+	for i := 0; i < 4; i++ {
+		commit, err := client.StartCommit(repo, "master")
+		require.NoError(t, err)
+		_, err = client.PutFile(repo, commit.ID, fmt.Sprintf("file%d", i), strings.NewReader("foo\n"))
+		require.NoError(t, err)
+		require.NoError(t, client.FinishCommit(repo, commit.ID))
+		commits = append(commits, commit)
+	}
+
+	// Squash commits 1-3 into commit 3
+	squashed, err := client.SquashCommits(repo, commits[1].ID, commits[3].ID)
+	require.NoError(t, err)
+	require.Equal(t, commits[3].ID, squashed.ID)
+
+	// The intermediate commits are gone
+	_, err = client.InspectCommit(repo, commits[1].ID)
+	require.YesError(t, err)
+	_, err = client.InspectCommit(repo, commits[2].ID)
+	require.YesError(t, err)
+
+	// The squashed commit's parent is commit 0, and it still has every file
+	commitInfo, err := client.InspectCommit(repo, "master")
+	require.NoError(t, err)
+	require.Equal(t, commits[3].ID, commitInfo.Commit.ID)
+	require.Equal(t, commits[0].ID, commitInfo.ParentCommit.ID)
+	fileInfos, err := client.ListFile(repo, "master", "")
+	require.NoError(t, err)
+	require.Equal(t, 4, len(fileInfos))
+
+	commitInfos, err := client.ListCommit(repo, "master", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(commitInfos))
+
+	// Commits can't be squashed out of order
+	_, err = client.SquashCommits(repo, commits[3].ID, commits[0].ID)
+	require.YesError(t, err)
+}
+
 func TestDeleteCommitOnlyCommitInBranch(t *testing.T) {
 	client := getClient(t)
 
@@ -894,6 +939,84 @@ func TestPutFileBig(t *testing.T) {
 	require.Equal(t, string(expectedOutputA), buffer.String())
 }
 
+func TestPutFileDedup(t *testing.T) {
+	client := getClient(t)
+
+	repo := "test"
+	require.NoError(t, client.CreateRepo(repo))
+
+	content := generateRandomString(int(pfs.ChunkSize * 2))
+	commit1, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFile(repo, commit1.ID, "foo", strings.NewReader(content))
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit1.ID))
+	commitInfo, err := client.InspectCommit(repo, commit1.ID)
+	require.NoError(t, err)
+	require.Equal(t, len(content), int(commitInfo.PhysicalSizeBytes))
+
+	// Edit the start of the file, which shifts the rest of its content.
+	// Only the chunk containing the edit should be stored again.
+	edited := "edit" + content
+	commit2, err := client.StartCommit(repo, "master")
+	require.NoError(t, err)
+	_, err = client.PutFileOverwrite(repo, commit2.ID, "foo", strings.NewReader(edited), 0)
+	require.NoError(t, err)
+	require.NoError(t, client.FinishCommit(repo, commit2.ID))
+	commitInfo, err = client.InspectCommit(repo, commit2.ID)
+	require.NoError(t, err)
+	require.Equal(t, len(edited), int(commitInfo.SizeBytes))
+	require.True(t, commitInfo.PhysicalSizeBytes < uint64(len(edited)/2))
+
+	var buffer bytes.Buffer
+	require.NoError(t, client.GetFile(repo, commit2.ID, "foo", 0, 0, &buffer))
+	require.Equal(t, edited, buffer.String())
+
+	repoInfo, err := client.InspectRepo(repo)
+	require.NoError(t, err)
+	require.Equal(t, commitInfo.PhysicalSizeBytes+uint64(len(content)), repoInfo.PhysicalSizeBytes)
+}
+
+func TestPutFileCompressed(t *testing.T) {
+	for _, compression := range []pfs.Compression{pfs.Compression_GZIP, pfs.Compression_SNAPPY} {
+		t.Run(compression.String(), func(t *testing.T) {
+			client := getClientWithCompression(t, compression)
+
+			repo := tu.UniqueString("test")
+			require.NoError(t, client.CreateRepo(repo))
+
+			// Text compresses well, so the stored objects should be much
+			// smaller than the file
+			content := strings.Repeat("log line\n", 1024*1024)
+			commit, err := client.StartCommit(repo, "master")
+			require.NoError(t, err)
+			_, err = client.PutFile(repo, commit.ID, "foo", strings.NewReader(content))
+			require.NoError(t, err)
+			require.NoError(t, client.FinishCommit(repo, commit.ID))
+
+			fileInfo, err := client.InspectFile(repo, commit.ID, "foo")
+			require.NoError(t, err)
+			require.Equal(t, len(content), int(fileInfo.SizeBytes))
+			var storedBytes uint64
+			for _, object := range fileInfo.Objects {
+				objectInfo, err := client.InspectObject(object.Hash)
+				require.NoError(t, err)
+				require.Equal(t, compression, objectInfo.BlockRef.Compression)
+				storedBytes += objectInfo.BlockRef.Range.Upper - objectInfo.BlockRef.Range.Lower
+			}
+			require.True(t, storedBytes < uint64(len(content)/4))
+
+			var buffer bytes.Buffer
+			require.NoError(t, client.GetFile(repo, commit.ID, "foo", 0, 0, &buffer))
+			require.Equal(t, content, buffer.String())
+			// Byte ranges are of the decompressed data
+			buffer.Reset()
+			require.NoError(t, client.GetFile(repo, commit.ID, "foo", 9*1000, 9*10, &buffer))
+			require.Equal(t, strings.Repeat("log line\n", 10), buffer.String())
+		})
+	}
+}
+
 func TestPutFile(t *testing.T) {
 	client := getClient(t)
 
@@ -2332,6 +2455,12 @@ func startPFSServers(t *testing.T) {
 }
 
 func getClient(t *testing.T) *pclient.APIClient {
+	return getClientWithCompression(t, pfs.Compression_UNCOMPRESSED)
+}
+
+// getClientWithCompression is like getClient, except that the PFS servers
+// compress the blocks that they write with 'compression'
+func getClientWithCompression(t *testing.T, compression pfs.Compression) *pclient.APIClient {
 	startPFSServers(t)
 	dbName := "pachyderm_test_" + uuid.NewWithoutDashes()[0:12]
 	testDBs = append(testDBs, dbName)
@@ -2349,7 +2478,7 @@ func getClient(t *testing.T) *pclient.APIClient {
 	prefix := generateRandomString(32)
 	for i, port := range ports {
 		address := addresses[i]
-		blockAPIServer, err := newLocalBlockAPIServer(root, 256*1024*1024, etcdAddress)
+		blockAPIServer, err := newLocalBlockAPIServer(root, 256*1024*1024, etcdAddress, compression)
 		require.NoError(t, err)
 		apiServer, err := newLocalAPIServer(address, prefix)
 		require.NoError(t, err)
@@ -2635,6 +2764,43 @@ func TestPutFileSplit(t *testing.T) {
 	}
 }
 
+func TestPutFileSplitCSVAndSQL(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration tests in short mode")
+	}
+
+	c := getClient(t)
+	repo := tu.UniqueString("TestPutFileSplitCSVAndSQL")
+	require.NoError(t, c.CreateRepo(repo))
+	commit, err := c.StartCommit(repo, "master")
+	require.NoError(t, err)
+
+	// Each split CSV file starts with the header row
+	_, err = c.PutFileSplit(repo, commit.ID, "csv", pfs.Delimiter_CSV, 2, 0, false,
+		strings.NewReader("id,name\n1,a\n2,\"b\nc\"\n3,d\n"))
+	require.NoError(t, err)
+	// Each split SQL file starts with the schema and the COPY statement
+	schema := "CREATE TABLE t (id integer);\nCOPY t (id) FROM stdin;\n"
+	_, err = c.PutFileSplit(repo, commit.ID, "sql", pfs.Delimiter_SQL, 1, 0, false,
+		strings.NewReader(schema+"1\n2\n\\.\n"))
+	require.NoError(t, err)
+	require.NoError(t, c.FinishCommit(repo, commit.ID))
+
+	for path, expected := range map[string][]string{
+		"csv": {"id,name\n1,a\n2,\"b\nc\"\n", "id,name\n3,d\n"},
+		"sql": {schema + "1\n\\.\n", schema + "2\n\\.\n"},
+	} {
+		files, err := c.ListFile(repo, commit.ID, path)
+		require.NoError(t, err)
+		require.Equal(t, len(expected), len(files))
+		for i, fileInfo := range files {
+			var buffer bytes.Buffer
+			require.NoError(t, c.GetFile(repo, commit.ID, fileInfo.File.Path, 0, 0, &buffer))
+			require.Equal(t, expected[i], buffer.String())
+		}
+	}
+}
+
 func TestPutFileSplitDelete(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping integration tests in short mode")
@@ -4077,3 +4243,84 @@ func TestStartCommitOutputBranch(t *testing.T) {
 	_, err := c.StartCommit("out", "master")
 	require.YesError(t, err)
 }
+
+func TestReplication(t *testing.T) {
+	c := getClient(t)
+	remote := getClient(t) // a second cluster stands in for the remote pachd
+
+	require.NoError(t, c.CreateRepo("in"))
+	require.NoError(t, c.CreateRepo("out"))
+	require.NoError(t, c.CreateBranch("out", "master", "", []*pfs.Branch{pclient.NewBranch("in", "master")}))
+	writeCommit := func(file string) (*pfs.Commit, *pfs.Commit) {
+		inCommit, err := c.StartCommit("in", "master")
+		require.NoError(t, err)
+		_, err = c.PutFile("in", inCommit.ID, file, strings.NewReader(file+"\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit("in", inCommit.ID))
+		outCommitInfo, err := c.InspectCommit("out", "master")
+		require.NoError(t, err)
+		_, err = c.PutFile("out", outCommitInfo.Commit.ID, file, strings.NewReader(file+" out\n"))
+		require.NoError(t, err)
+		require.NoError(t, c.FinishCommit("out", outCommitInfo.Commit.ID))
+		return inCommit, outCommitInfo.Commit
+	}
+
+	// Existing commits are replicated, as are new ones
+	in1, out1 := writeCommit("foo")
+	require.NoError(t, c.CreateReplication("out", "master", remote.GetAddress(), "", ""))
+	require.NoError(t, c.CreateReplication("in", "master", "pfs://"+remote.GetAddress(), "in-dr", ""))
+	require.YesError(t, c.CreateReplication("in", "master", remote.GetAddress(), "", ""))
+	in2, out2 := writeCommit("bar")
+
+	require.NoError(t, backoff.Retry(func() error {
+		replicationInfos, err := c.ListReplication("")
+		if err != nil {
+			return err
+		}
+		if len(replicationInfos) != 2 {
+			return fmt.Errorf("expected 2 replications, but got %d", len(replicationInfos))
+		}
+		for _, replicationInfo := range replicationInfos {
+			if replicationInfo.State != pfs.ReplicationState_REPLICATION_RUNNING || replicationInfo.LagCommits != 0 {
+				return fmt.Errorf("replication of %s is %v with %d commits of lag (%s)", replicationInfo.Replication.Branch.Repo.Name,
+					replicationInfo.State, replicationInfo.LagCommits, replicationInfo.Reason)
+			}
+		}
+		return nil
+	}, backoff.NewTestingBackOff()))
+
+	// Commits keep their IDs, parents and provenance (renamed to the repos that
+	// it's replicated to)
+	for repo, commits := range map[string][]*pfs.Commit{"in-dr": {in1, in2}, "out": {out1, out2}} {
+		commitInfos, err := remote.ListCommit(repo, "master", "", 0)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(commitInfos))
+		require.Equal(t, commits[1].ID, commitInfos[0].Commit.ID)
+		require.Equal(t, commits[0].ID, commitInfos[0].ParentCommit.ID)
+		require.Equal(t, commits[0].ID, commitInfos[1].Commit.ID)
+	}
+	outCommitInfo, err := remote.InspectCommit("out", out2.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(outCommitInfo.Provenance))
+	require.Equal(t, "in-dr", outCommitInfo.Provenance[0].Repo.Name)
+	require.Equal(t, in2.ID, outCommitInfo.Provenance[0].ID)
+	var buf bytes.Buffer
+	require.NoError(t, remote.GetFile("out", out2.ID, "foo", 0, 0, &buf))
+	require.Equal(t, "foo out\n", buf.String())
+	buf.Reset()
+	require.NoError(t, remote.GetFile("in-dr", in2.ID, "bar", 0, 0, &buf))
+	require.Equal(t, "bar\n", buf.String())
+
+	replicationInfos, err := c.ListReplication("in")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(replicationInfos))
+	require.Equal(t, remote.GetAddress(), replicationInfos[0].Replication.Remote)
+	require.Equal(t, in2.ID, replicationInfos[0].LastReplicated.ID)
+
+	require.NoError(t, c.DeleteReplication("in", "master", remote.GetAddress()))
+	require.NoError(t, c.DeleteReplication("out", "master", remote.GetAddress()))
+	require.YesError(t, c.DeleteReplication("out", "master", remote.GetAddress()))
+	replicationInfos, err = c.ListReplication("")
+	require.NoError(t, err)
+	require.Equal(t, 0, len(replicationInfos))
+}
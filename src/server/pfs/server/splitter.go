@@ -0,0 +1,195 @@
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+// A splitter reads the values (e.g. lines or JSON documents) that a file is
+// split into by PutFile
+type splitter interface {
+	// next returns the next value in the file. Like io.Reader, it may return
+	// the file's last value along with io.EOF.
+	next() ([]byte, error)
+	// header returns the data (e.g. a CSV header row) that precedes the values
+	// in each split file. If the header changes after a call to next, the
+	// value returned by next starts a new split file.
+	header() []byte
+	// footer returns the data that follows the values in each split file that
+	// starts with the current header
+	footer() []byte
+}
+
+func newSplitter(delimiter pfs.Delimiter, r io.Reader) (splitter, error) {
+	switch delimiter {
+	case pfs.Delimiter_JSON:
+		return &jsonSplitter{decoder: json.NewDecoder(r)}, nil
+	case pfs.Delimiter_LINE:
+		return &lineSplitter{r: bufio.NewReader(r)}, nil
+	case pfs.Delimiter_CSV:
+		return &csvSplitter{r: bufio.NewReader(r)}, nil
+	case pfs.Delimiter_SQL:
+		return &sqlSplitter{r: bufio.NewReader(r)}, nil
+	}
+	return nil, fmt.Errorf("unrecognized delimiter %s", delimiter.String())
+}
+
+type jsonSplitter struct {
+	decoder *json.Decoder
+}
+
+func (s *jsonSplitter) next() ([]byte, error) {
+	var value json.RawMessage
+	err := s.decoder.Decode(&value)
+	return value, err
+}
+
+func (s *jsonSplitter) header() []byte { return nil }
+func (s *jsonSplitter) footer() []byte { return nil }
+
+type lineSplitter struct {
+	r *bufio.Reader
+}
+
+func (s *lineSplitter) next() ([]byte, error) {
+	return s.r.ReadBytes('\n')
+}
+
+func (s *lineSplitter) header() []byte { return nil }
+func (s *lineSplitter) footer() []byte { return nil }
+
+// csvSplitter splits CSV files into records. Records may span several lines
+// if they contain quoted newlines. The file's first record is its header,
+// which is repeated at the start of every split file.
+type csvSplitter struct {
+	r            *bufio.Reader
+	headerRecord []byte
+	readHeader   bool
+}
+
+// record reads the next CSV record, including its trailing newline
+func (s *csvSplitter) record() ([]byte, error) {
+	var record []byte
+	inQuotes := false
+	for {
+		line, err := s.r.ReadBytes('\n')
+		record = append(record, line...)
+		// Quotes inside quoted fields are escaped by doubling them, so a
+		// record ends at the first newline that follows an even number of
+		// quotes
+		if bytes.Count(line, []byte{'"'})%2 == 1 {
+			inQuotes = !inQuotes
+		}
+		if !inQuotes || err != nil {
+			if err == io.EOF && inQuotes {
+				return record, fmt.Errorf("unterminated quoted field in CSV record %q", record)
+			}
+			return record, err
+		}
+	}
+}
+
+func (s *csvSplitter) next() ([]byte, error) {
+	if !s.readHeader {
+		s.readHeader = true
+		headerRecord, err := s.record()
+		if err != nil {
+			return nil, err
+		}
+		s.headerRecord = headerRecord
+	}
+	return s.record()
+}
+
+func (s *csvSplitter) header() []byte { return s.headerRecord }
+func (s *csvSplitter) footer() []byte { return nil }
+
+// sqlSplitter splits SQL dumps, as written by pg_dump, into data statements:
+// either INSERT statements or the rows of COPY statements. Every other
+// statement (e.g. SET or CREATE TABLE) that precedes a value is part of the
+// schema that's repeated at the start of every split file, so that each one
+// can be loaded on its own. Statements that follow the last value in the
+// dump (such as CREATE INDEX) aren't written to any split file.
+type sqlSplitter struct {
+	r *bufio.Reader
+	// schema holds the statements that aren't data statements
+	schema []byte
+	// copyStatement is the COPY statement whose rows are currently being
+	// read, if any
+	copyStatement []byte
+}
+
+var (
+	sqlCopyPrefix   = []byte("COPY ")
+	sqlInsertPrefix = []byte("INSERT INTO ")
+	sqlCopyEnd      = []byte("\\.\n") // ends the rows of a COPY statement
+)
+
+func (s *sqlSplitter) next() ([]byte, error) {
+	for {
+		line, err := s.r.ReadBytes('\n')
+		if len(line) == 0 {
+			return nil, err
+		}
+		switch {
+		case s.copyStatement != nil:
+			if bytes.Equal(bytes.TrimRight(line, "\r\n"), bytes.TrimRight(sqlCopyEnd, "\n")) {
+				s.copyStatement = nil
+				break
+			}
+			return line, err
+		case bytes.HasPrefix(line, sqlCopyPrefix):
+			s.copyStatement = line
+		case bytes.HasPrefix(line, sqlInsertPrefix):
+			return s.statement(line, err)
+		default:
+			s.schema = append(s.schema, line...)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// statement reads the rest of the statement that starts with 'line', which
+// ends at a line ending in a semicolon outside of a quoted string
+func (s *sqlSplitter) statement(line []byte, err error) ([]byte, error) {
+	var statement []byte
+	inQuotes := false
+	for {
+		statement = append(statement, line...)
+		if bytes.Count(line, []byte{'\''})%2 == 1 {
+			inQuotes = !inQuotes
+		}
+		if !inQuotes && bytes.HasSuffix(bytes.TrimSpace(line), []byte{';'}) {
+			return statement, err
+		}
+		if err != nil {
+			if err == io.EOF {
+				return statement, fmt.Errorf("unterminated SQL statement %q", statement)
+			}
+			return statement, err
+		}
+		line, err = s.r.ReadBytes('\n')
+	}
+}
+
+func (s *sqlSplitter) header() []byte {
+	if s.copyStatement != nil {
+		header := make([]byte, 0, len(s.schema)+len(s.copyStatement))
+		return append(append(header, s.schema...), s.copyStatement...)
+	}
+	return s.schema
+}
+
+func (s *sqlSplitter) footer() []byte {
+	if s.copyStatement != nil {
+		return sqlCopyEnd
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+// splitValue is a value returned by a splitter, along with the header and
+// footer of the split file that it's written to
+type splitValue struct {
+	header, value, footer string
+}
+
+func readSplitValues(t *testing.T, delimiter pfs.Delimiter, data string) []splitValue {
+	s, err := newSplitter(delimiter, strings.NewReader(data))
+	require.NoError(t, err)
+	var result []splitValue
+	for {
+		value, err := s.next()
+		if len(value) > 0 {
+			result = append(result, splitValue{string(s.header()), string(value), string(s.footer())})
+		}
+		if err == io.EOF {
+			return result
+		}
+		require.NoError(t, err)
+	}
+}
+
+func TestCSVSplitter(t *testing.T) {
+	header := "id,name,bio\n"
+	values := readSplitValues(t, pfs.Delimiter_CSV, header+
+		"1,alice,\"likes \"\"go\"\"\"\n"+
+		"2,bob,\"first line\nsecond line\"\n"+
+		"3,carol,")
+	require.Equal(t, []splitValue{
+		{header, "1,alice,\"likes \"\"go\"\"\"\n", ""},
+		{header, "2,bob,\"first line\nsecond line\"\n", ""},
+		{header, "3,carol,", ""},
+	}, values)
+
+	// A file with only a header has no values
+	require.Equal(t, 0, len(readSplitValues(t, pfs.Delimiter_CSV, header)))
+
+	s, err := newSplitter(pfs.Delimiter_CSV, strings.NewReader(header+"1,\"unterminated\n"))
+	require.NoError(t, err)
+	_, err = s.next()
+	require.YesError(t, err)
+}
+
+func TestSQLSplitter(t *testing.T) {
+	schema := "SET client_encoding = 'UTF8';\n" +
+		"\n" +
+		"CREATE TABLE public.users (\n" +
+		"    id integer,\n" +
+		"    name text\n" +
+		");\n"
+	copyUsers := "COPY public.users (id, name) FROM stdin;\n"
+	comment := "\n-- Data for Name: events\n"
+	values := readSplitValues(t, pfs.Delimiter_SQL, schema+
+		copyUsers+
+		"1\talice\n"+
+		"2\tbob\n"+
+		"\\.\n"+
+		comment+
+		"INSERT INTO public.events VALUES (1, 'a;\n"+
+		"b');\n"+
+		"INSERT INTO public.events VALUES (2, 'c');\n"+
+		"\n"+
+		"CREATE INDEX users_name ON public.users (name);\n")
+	require.Equal(t, []splitValue{
+		{schema + copyUsers, "1\talice\n", "\\.\n"},
+		{schema + copyUsers, "2\tbob\n", "\\.\n"},
+		{schema + comment, "INSERT INTO public.events VALUES (1, 'a;\nb');\n", ""},
+		{schema + comment, "INSERT INTO public.events VALUES (2, 'c');\n", ""},
+	}, values)
+
+	s, err := newSplitter(pfs.Delimiter_SQL, strings.NewReader("INSERT INTO t VALUES ('a\n"))
+	require.NoError(t, err)
+	_, err = s.next()
+	require.YesError(t, err)
+}
@@ -0,0 +1,107 @@
+// Package chunker splits data streams into content-defined chunks, so that
+// streams that share regions of data (e.g. two versions of a large file that
+// differ only in a few places) are split into mostly identical chunks, which
+// PFS then only stores once.
+package chunker
+
+import (
+	"bufio"
+	"io"
+	"math/bits"
+	"math/rand"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+)
+
+const (
+	// MinSize is the smallest chunk that a Chunker produces (other than the
+	// last chunk in a stream)
+	MinSize = 1024 * 1024 // 1 MB
+	// AvgSize is the expected size of the chunks that a Chunker produces. It
+	// must be a power of two.
+	AvgSize = 4 * 1024 * 1024 // 4 MB
+
+	// windowSize is the number of bytes that the rolling hash covers
+	windowSize = 48
+)
+
+// MaxSize is the largest chunk that a Chunker produces
+var MaxSize = int(pfs.ChunkSize)
+
+// table maps each byte to a random value for the buzhash rolling hash. It's
+// generated from a fixed seed, as chunk boundaries (and therefore object
+// hashes) must be stable across versions of pachd for deduplication to work.
+var table [256]uint64
+
+func init() {
+	r := rand.New(rand.NewSource(0x70616368))
+	for i := range table {
+		table[i] = r.Uint64()
+	}
+}
+
+// A Chunker splits the data read from a stream into chunks. Chunk boundaries
+// are placed where a rolling hash of the last few bytes matches a pattern, so
+// they depend only on the data near them, rather than on their offset in the
+// stream.
+type Chunker struct {
+	r                *bufio.Reader
+	minSize, maxSize int
+	mask             uint64
+	window           [windowSize]byte
+	sawEOF           bool
+}
+
+// New returns a Chunker that reads from 'r'
+func New(r io.Reader) *Chunker {
+	return newChunker(r, MinSize, AvgSize, MaxSize)
+}
+
+func newChunker(r io.Reader, minSize, avgSize, maxSize int) *Chunker {
+	return &Chunker{
+		r:       bufio.NewReader(r),
+		minSize: minSize,
+		maxSize: maxSize,
+		mask:    uint64(avgSize - 1),
+	}
+}
+
+// Next copies the next chunk in the stream to 'w' and returns its size. Like
+// io.CopyN, it returns io.EOF if the stream ended before the end of the
+// chunk, in which case the (possibly empty) chunk copied to 'w' is the last
+// one.
+func (c *Chunker) Next(w io.Writer) (int64, error) {
+	if c.sawEOF {
+		return 0, io.EOF
+	}
+	bw := bufio.NewWriter(w)
+	var hash uint64
+	size := 0
+	for {
+		b, err := c.r.ReadByte()
+		if err != nil {
+			if err == io.EOF {
+				c.sawEOF = true
+			}
+			if err := bw.Flush(); err != nil {
+				return int64(size), err
+			}
+			return int64(size), err
+		}
+		if err := bw.WriteByte(b); err != nil {
+			return int64(size), err
+		}
+		// Roll the hash forward. Bytes are only removed from the hash once
+		// the window is full, so that the hash only depends on the last
+		// windowSize bytes of the chunk.
+		hash = bits.RotateLeft64(hash, 1) ^ table[b]
+		if size >= windowSize {
+			hash ^= bits.RotateLeft64(table[c.window[size%windowSize]], windowSize)
+		}
+		c.window[size%windowSize] = b
+		size++
+		if size >= c.maxSize || (size >= c.minSize && hash&c.mask == 0) {
+			return int64(size), bw.Flush()
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package chunker
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+const (
+	testMinSize = 1024
+	testAvgSize = 4 * 1024
+	testMaxSize = 16 * 1024
+)
+
+func chunks(t *testing.T, data []byte) []string {
+	c := newChunker(bytes.NewReader(data), testMinSize, testAvgSize, testMaxSize)
+	var result []string
+	for {
+		var buf bytes.Buffer
+		_, err := c.Next(&buf)
+		if buf.Len() > 0 {
+			result = append(result, buf.String())
+		}
+		if err == io.EOF {
+			return result
+		}
+		require.NoError(t, err)
+	}
+}
+
+func randomBytes(r *rand.Rand, n int) []byte {
+	data := make([]byte, n)
+	r.Read(data)
+	return data
+}
+
+func TestChunkSizes(t *testing.T) {
+	data := randomBytes(rand.New(rand.NewSource(1)), 1024*1024)
+	result := chunks(t, data)
+	var joined bytes.Buffer
+	for i, chunk := range result {
+		require.True(t, len(chunk) <= testMaxSize)
+		if i < len(result)-1 {
+			require.True(t, len(chunk) >= testMinSize)
+		}
+		joined.WriteString(chunk)
+	}
+	require.Equal(t, data, joined.Bytes())
+	// Chunks should be roughly the average size
+	require.True(t, len(result) > len(data)/testMaxSize)
+	require.True(t, len(result) < len(data)/testMinSize)
+}
+
+func TestChunksAreContentDefined(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	data := randomBytes(r, 1024*1024)
+	// Insert data near the start of the stream, which shifts the offsets of
+	// all of the data after it
+	edited := append(append(append([]byte{}, data[:1000]...), randomBytes(r, 100)...), data[1000:]...)
+
+	original := make(map[string]bool)
+	for _, chunk := range chunks(t, data) {
+		original[chunk] = true
+	}
+	editedChunks := chunks(t, edited)
+	var shared int
+	for _, chunk := range editedChunks {
+		if original[chunk] {
+			shared++
+		}
+	}
+	// Only the chunks around the edit should differ
+	require.True(t, shared >= len(editedChunks)-2)
+}
+
+func TestEmptyStream(t *testing.T) {
+	require.Equal(t, 0, len(chunks(t, nil)))
+}
@@ -38,6 +38,23 @@ type collection struct {
 
 // NewCollection creates a new collection.
 func NewCollection(etcdClient *etcd.Client, prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) Collection {
+	return newCollection(etcdClient, prefix, indexes, template, keyCheck)
+}
+
+// NewEtcdStore returns a Store whose collections are stored in etcd.
+func NewEtcdStore(etcdClient *etcd.Client) Store {
+	return &etcdStore{etcdClient: etcdClient}
+}
+
+type etcdStore struct {
+	etcdClient *etcd.Client
+}
+
+func (s *etcdStore) NewCollection(prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) Collection {
+	return NewCollection(s.etcdClient, prefix, indexes, template, keyCheck)
+}
+
+func newCollection(etcdClient *etcd.Client, prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) *collection {
 	// We want to ensure that the prefix always ends with a trailing
 	// slash.  Otherwise, when you list the items under a collection
 	// such as `foo`, you might end up listing items under `foobar`
@@ -534,6 +551,47 @@ func (c *readonlyCollection) ListPaginated() (Iterator, error) {
 	}, nil
 }
 
+// ListRange returns an iterator over the items whose keys are in the range
+// [start, end), in lexicographic order of their keys. If 'end' is "", the
+// range has no upper bound. If 'limit' is nonzero, at most 'limit' items are
+// returned.
+func (c *readonlyCollection) ListRange(start, end string, limit int64) (Iterator, error) {
+	resp, err := c.etcdClient.Get(c.ctx, c.prefix+start, rangeOptions(c.prefix, end, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	return &iterator{
+		resp: resp,
+		col:  c,
+	}, nil
+}
+
+// GetByIndexRange is like GetByIndex, except that it only returns the items
+// whose keys are in the range [start, end), in lexicographic order of their
+// keys (as in ListRange).
+func (c *readonlyCollection) GetByIndexRange(index Index, val interface{}, start, end string, limit int64) (Iterator, error) {
+	dir := c.indexDir(index, val) + "/"
+	resp, err := c.etcdClient.Get(c.ctx, dir+start, rangeOptions(dir, end, limit)...)
+	if err != nil {
+		return nil, err
+	}
+	return &indirectIterator{
+		resp: resp,
+		col:  c,
+	}, nil
+}
+
+// rangeOptions returns the options for reading the keys under 'dir' that
+// sort before dir+end (or all of them, if 'end' is ""), at most 'limit' of
+// them
+func rangeOptions(dir string, end string, limit int64) []etcd.OpOption {
+	endKey := endKeyFromPrefix(dir)
+	if end != "" {
+		endKey = dir + end
+	}
+	return []etcd.OpOption{etcd.WithRange(endKey), etcd.WithLimit(limit)}
+}
+
 type iterator struct {
 	index int
 	resp  *etcd.GetResponse
@@ -622,12 +680,28 @@ func (c *readonlyCollection) WatchWithPrev() (watch.Watcher, error) {
 
 // WatchByIndex watches items in a collection that match a particular index
 func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.Watcher, error) {
-	eventCh := make(chan *watch.Event)
-	done := make(chan struct{})
 	watcher, err := watch.NewWatcher(c.ctx, c.etcdClient, c.prefix, c.indexDir(index, val), c.template)
 	if err != nil {
 		return nil, err
 	}
+	return c.watchIndex(watcher, func(key string) ([]byte, bool, error) {
+		resp, err := c.etcdClient.Get(c.ctx, c.Path(key))
+		if err != nil {
+			return nil, false, err
+		}
+		if len(resp.Kvs) == 0 {
+			return nil, false, nil
+		}
+		return resp.Kvs[0].Value, true, nil
+	}), nil
+}
+
+// watchIndex turns a watcher on an index directory into a watcher on the
+// items that the index points to.  'get' returns the current value of an item
+// in the collection, and false if the item no longer exists.
+func (c *collection) watchIndex(watcher watch.Watcher, get func(key string) ([]byte, bool, error)) watch.Watcher {
+	eventCh := make(chan *watch.Event)
+	done := make(chan struct{})
 	go func() (retErr error) {
 		defer func() {
 			if retErr != nil {
@@ -659,18 +733,18 @@ func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.W
 				// pass along the error
 				return ev.Err
 			case watch.EventPut:
-				resp, err := c.etcdClient.Get(c.ctx, c.Path(path.Base(string(ev.Key))))
+				value, ok, err := get(path.Base(string(ev.Key)))
 				if err != nil {
 					return err
 				}
-				if len(resp.Kvs) == 0 {
+				if !ok {
 					// this happens only if the item was deleted shortly after
 					// we receive this event.
 					continue
 				}
 				directEv = &watch.Event{
 					Key:      []byte(path.Base(string(ev.Key))),
-					Value:    resp.Kvs[0].Value,
+					Value:    value,
 					Type:     ev.Type,
 					Template: c.template,
 				}
@@ -684,7 +758,7 @@ func (c *readonlyCollection) WatchByIndex(index Index, val interface{}) (watch.W
 			eventCh <- directEv
 		}
 	}()
-	return watch.MakeWatcher(eventCh, done), nil
+	return watch.MakeWatcher(eventCh, done)
 }
 
 // WatchOne watches a given item.  The first value returned from the watch
@@ -332,6 +332,58 @@ func TestBoolIndex(t *testing.T) {
 
 var epsilon = &types.BoolValue{Value: true}
 
+func TestListRange(t *testing.T) {
+	etcdClient := getEtcdClient()
+	uuidPrefix := uuid.NewWithoutDashes()
+
+	jobInfos := NewCollection(etcdClient, uuidPrefix, []Index{pipelineIndex}, &pps.JobInfo{}, nil)
+	_, err := NewSTM(context.Background(), etcdClient, func(stm STM) error {
+		jobInfos := jobInfos.ReadWrite(stm)
+		for _, id := range []string{"j1", "j2", "j3", "j4"} {
+			pipeline := "p1"
+			if id == "j3" {
+				pipeline = "p2"
+			}
+			jobInfos.Put(id, &pps.JobInfo{
+				Job:      &pps.Job{ID: id},
+				Pipeline: &pps.Pipeline{Name: pipeline},
+			})
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	readAll := func(iter Iterator) []string {
+		var result []string
+		for {
+			var ID string
+			ok, err := iter.Next(&ID, &pps.JobInfo{})
+			require.NoError(t, err)
+			if !ok {
+				return result
+			}
+			result = append(result, ID)
+		}
+	}
+	jobInfosReadonly := jobInfos.ReadOnly(context.Background())
+	iter, err := jobInfosReadonly.ListRange("", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"j1", "j2", "j3", "j4"}, readAll(iter))
+	iter, err = jobInfosReadonly.ListRange("j2", "j4", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"j2", "j3"}, readAll(iter))
+	iter, err = jobInfosReadonly.ListRange("j2", "", 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"j2"}, readAll(iter))
+
+	iter, err = jobInfosReadonly.GetByIndexRange(pipelineIndex, &pps.Pipeline{Name: "p1"}, "j2", "", 0)
+	require.NoError(t, err)
+	require.Equal(t, []string{"j2", "j4"}, readAll(iter))
+	iter, err = jobInfosReadonly.GetByIndexRange(pipelineIndex, &pps.Pipeline{Name: "p1"}, "", "j4", 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"j1"}, readAll(iter))
+}
+
 func TestTTL(t *testing.T) {
 	etcdClient := getEtcdClient()
 	uuidPrefix := uuid.NewWithoutDashes()
@@ -0,0 +1,640 @@
+package collection
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+
+	"github.com/gogo/protobuf/proto"
+	netcontext "golang.org/x/net/context"
+)
+
+// MemoryStore is an embedded key-value store that can back collections in
+// place of etcd.  It keeps everything in memory, so it's meant for tests and
+// for single-process deployments.  It supports the same semantics as etcd
+// does for collections: transactions, secondary indexes, watches and TTLs.
+// It implements Store.
+type MemoryStore struct {
+	mu sync.Mutex
+	// kvs holds the contents of the store
+	kvs map[string]*memoryKV
+	// rev is the revision of the last transaction committed to the store
+	rev int64
+	// watchers holds the watchers that are currently watching the store
+	watchers map[*memoryWatcher]bool
+}
+
+type memoryKV struct {
+	value  string
+	modRev int64
+	// ttl is the TTL that the kv was written with, expires is the time at
+	// which it's removed, and timer removes it (all are zero if ttl is 0)
+	ttl     int64
+	expires time.Time
+	timer   *time.Timer
+}
+
+// NewMemoryStore creates a new, empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		kvs:      make(map[string]*memoryKV),
+		watchers: make(map[*memoryWatcher]bool),
+	}
+}
+
+// NewCollection creates a new collection backed by 's'.  The collection's
+// ReadWrite methods must be passed an STM created by s.NewSTM.
+func (s *MemoryStore) NewCollection(prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) Collection {
+	return &memoryCollection{
+		collection: newCollection(nil, prefix, indexes, template, keyCheck),
+		store:      s,
+	}
+}
+
+// NewSTM is like the package-level NewSTM, except that it runs the
+// transaction against 's'.  It returns the revision at which the transaction
+// was committed.
+func (s *MemoryStore) NewSTM(ctx netcontext.Context, apply func(STM) error) (int64, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+		stm := &memorySTM{
+			store: s,
+			ctx:   ctx,
+			rset:  make(map[string]int64),
+			wset:  make(map[string]memoryPut),
+		}
+		if err := apply(stm); err != nil {
+			return 0, err
+		}
+		if rev, ok := s.commit(stm); ok {
+			return rev, nil
+		}
+	}
+}
+
+// get returns the kv at 'key', or nil if 'key' doesn't exist.  The caller
+// must hold s.mu.
+func (s *MemoryStore) get(key string) *memoryKV {
+	return s.kvs[key]
+}
+
+// list returns the keys under 'prefix', sorted lexicographically.  The caller
+// must hold s.mu.
+func (s *MemoryStore) list(prefix string) []string {
+	var keys []string
+	for key := range s.kvs {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// put writes 'value' to 'key' at the current revision and returns the event
+// for the write.  The caller must hold s.mu.
+func (s *MemoryStore) put(key string, value string, ttl int64) *watch.Event {
+	prev := s.del(key)
+	kv := &memoryKV{
+		value:  value,
+		modRev: s.rev,
+		ttl:    ttl,
+	}
+	if ttl > 0 {
+		kv.expires = time.Now().Add(time.Duration(ttl) * time.Second)
+		kv.timer = time.AfterFunc(time.Duration(ttl)*time.Second, func() {
+			s.expire(key, kv.modRev)
+		})
+	}
+	s.kvs[key] = kv
+	ev := &watch.Event{
+		Key:   []byte(key),
+		Value: []byte(value),
+		Type:  watch.EventPut,
+		Rev:   s.rev,
+	}
+	if prev != nil {
+		ev.PrevKey = []byte(key)
+		ev.PrevValue = []byte(prev.value)
+	}
+	return ev
+}
+
+// del removes 'key' from the store and returns the removed kv, or nil if
+// 'key' doesn't exist.  The caller must hold s.mu.
+func (s *MemoryStore) del(key string) *memoryKV {
+	kv, ok := s.kvs[key]
+	if !ok {
+		return nil
+	}
+	if kv.timer != nil {
+		kv.timer.Stop()
+	}
+	delete(s.kvs, key)
+	return kv
+}
+
+// delEvent is like del, except that it returns the event for the delete, or
+// nil if 'key' doesn't exist.  The caller must hold s.mu.
+func (s *MemoryStore) delEvent(key string) *watch.Event {
+	kv := s.del(key)
+	if kv == nil {
+		return nil
+	}
+	return &watch.Event{
+		Key:       []byte(key),
+		PrevKey:   []byte(key),
+		PrevValue: []byte(kv.value),
+		Type:      watch.EventDelete,
+		Rev:       s.rev,
+	}
+}
+
+// expire removes 'key' once its TTL has run out, unless it has been written
+// since.
+func (s *MemoryStore) expire(key string, modRev int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if kv, ok := s.kvs[key]; !ok || kv.modRev != modRev {
+		return
+	}
+	s.rev++
+	s.notify([]*watch.Event{s.delEvent(key)})
+}
+
+// commit applies the writes in 's' to the store if none of the keys that
+// 's' read have changed since, and returns the new revision.  It returns
+// false if the transaction conflicted and should be retried.
+func (s *MemoryStore) commit(stm *memorySTM) (int64, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, modRev := range stm.rset {
+		var current int64
+		if kv := s.get(key); kv != nil {
+			current = kv.modRev
+		}
+		if current != modRev {
+			return 0, false
+		}
+	}
+	if len(stm.wset) == 0 && len(stm.delPrefixes) == 0 {
+		return s.rev, true
+	}
+	s.rev++
+	var events []*watch.Event
+	for _, prefix := range stm.delPrefixes {
+		for _, key := range s.list(prefix) {
+			if _, ok := stm.wset[key]; !ok {
+				events = append(events, s.delEvent(key))
+			}
+		}
+	}
+	keys := make([]string, 0, len(stm.wset))
+	for key := range stm.wset {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		w := stm.wset[key]
+		if w.del {
+			if ev := s.delEvent(key); ev != nil {
+				events = append(events, ev)
+			}
+			continue
+		}
+		events = append(events, s.put(key, w.val, w.ttl))
+	}
+	s.notify(events)
+	return s.rev, true
+}
+
+// notify delivers 'events' to the watchers that are interested in them.  The
+// caller must hold s.mu.
+func (s *MemoryStore) notify(events []*watch.Event) {
+	for w := range s.watchers {
+		for _, ev := range events {
+			if strings.HasPrefix(string(ev.Key), w.prefix) {
+				w.push(ev)
+			}
+		}
+	}
+}
+
+// watch returns a watcher that delivers the items under 'prefix' followed by
+// any changes to them, like watch.NewWatcher does for etcd.
+func (s *MemoryStore) watch(ctx context.Context, trimPrefix, prefix string, withPrev bool, template proto.Message) watch.Watcher {
+	w := &memoryWatcher{
+		prefix: prefix,
+		notify: make(chan struct{}, 1),
+	}
+	s.mu.Lock()
+	// Send the current items sorted by mod revision--how the items would have
+	// been returned if we watched them from the beginning.
+	keys := s.list(prefix)
+	sort.SliceStable(keys, func(i, j int) bool {
+		return s.kvs[keys[i]].modRev < s.kvs[keys[j]].modRev
+	})
+	for _, key := range keys {
+		kv := s.kvs[key]
+		w.push(&watch.Event{
+			Key:   []byte(key),
+			Value: []byte(kv.value),
+			Type:  watch.EventPut,
+			Rev:   kv.modRev,
+		})
+	}
+	s.watchers[w] = true
+	s.mu.Unlock()
+
+	eventCh := make(chan *watch.Event)
+	done := make(chan struct{})
+	go func() {
+		defer func() {
+			s.mu.Lock()
+			delete(s.watchers, w)
+			s.mu.Unlock()
+			close(eventCh)
+		}()
+		for {
+			for _, e := range w.pop() {
+				ev := &watch.Event{
+					Key:      []byte(strings.TrimPrefix(string(e.Key), trimPrefix)),
+					Value:    e.Value,
+					Type:     e.Type,
+					Rev:      e.Rev,
+					Template: template,
+				}
+				if withPrev && e.PrevKey != nil {
+					ev.PrevKey = []byte(strings.TrimPrefix(string(e.PrevKey), trimPrefix))
+					ev.PrevValue = e.PrevValue
+				}
+				select {
+				case eventCh <- ev:
+				case <-done:
+					return
+				case <-ctx.Done():
+					return
+				}
+			}
+			select {
+			case <-w.notify:
+			case <-done:
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return watch.MakeWatcher(eventCh, done)
+}
+
+// memoryWatcher queues the events for a watch on a MemoryStore, so that
+// writes to the store never block on slow watchers.
+type memoryWatcher struct {
+	prefix string
+	mu     sync.Mutex
+	queue  []*watch.Event
+	notify chan struct{}
+}
+
+func (w *memoryWatcher) push(ev *watch.Event) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.queue = append(w.queue, ev)
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (w *memoryWatcher) pop() []*watch.Event {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	events := w.queue
+	w.queue = nil
+	return events
+}
+
+// memorySTM implements optimistic transactions on a MemoryStore.  Reads are
+// recorded in rset and writes are buffered in wset, and the transaction is
+// retried if any of the keys that it read changed before it committed.
+type memorySTM struct {
+	store *MemoryStore
+	ctx   context.Context
+	// rset holds the mod revisions of the keys that have been read (0 if the
+	// key didn't exist)
+	rset map[string]int64
+	// wset holds the pending writes
+	wset map[string]memoryPut
+	// delPrefixes holds the prefixes passed to DelAll
+	delPrefixes []string
+}
+
+type memoryPut struct {
+	val string
+	ttl int64
+	del bool
+}
+
+func (s *memorySTM) Context() netcontext.Context {
+	return s.ctx
+}
+
+// read returns the kv at 'key' (or nil if 'key' doesn't exist), and adds
+// 'key' to the read set.
+func (s *memorySTM) read(key string) *memoryKV {
+	s.store.mu.Lock()
+	defer s.store.mu.Unlock()
+	kv := s.store.get(key)
+	var modRev int64
+	if kv != nil {
+		modRev = kv.modRev
+	}
+	if _, ok := s.rset[key]; !ok {
+		s.rset[key] = modRev
+	}
+	return kv
+}
+
+// deleted returns true if 'key' has been deleted by a call to DelAll in
+// this transaction.
+func (s *memorySTM) deleted(key string) bool {
+	for _, prefix := range s.delPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *memorySTM) Get(key string) (string, error) {
+	if w, ok := s.wset[key]; ok {
+		if w.del {
+			return "", ErrNotFound{Key: key}
+		}
+		return w.val, nil
+	}
+	kv := s.read(key)
+	if kv == nil || s.deleted(key) {
+		return "", ErrNotFound{Key: key}
+	}
+	return kv.value, nil
+}
+
+func (s *memorySTM) Put(key, val string, ttl int64) error {
+	s.wset[key] = memoryPut{val: val, ttl: ttl}
+	return nil
+}
+
+func (s *memorySTM) Rev(key string) int64 {
+	if kv := s.read(key); kv != nil {
+		return kv.modRev
+	}
+	return 0
+}
+
+func (s *memorySTM) Del(key string) {
+	s.wset[key] = memoryPut{del: true}
+}
+
+func (s *memorySTM) DelAll(key string) {
+	for k := range s.wset {
+		if strings.HasPrefix(k, key) {
+			delete(s.wset, k)
+		}
+	}
+	s.delPrefixes = append(s.delPrefixes, key)
+}
+
+func (s *memorySTM) TTL(key string) (int64, error) {
+	if w, ok := s.wset[key]; ok {
+		if w.del {
+			return 0, ErrNotFound{Key: key}
+		}
+		return w.ttl, nil
+	}
+	kv := s.read(key)
+	if kv == nil || s.deleted(key) {
+		return 0, ErrNotFound{Key: key}
+	}
+	if kv.ttl == 0 {
+		return 0, nil
+	}
+	// Round up, like etcd does for leases
+	return int64((time.Until(kv.expires) + time.Second - 1) / time.Second), nil
+}
+
+type memoryCollection struct {
+	*collection
+	store *MemoryStore
+}
+
+func (c *memoryCollection) ReadOnly(ctx context.Context) ReadonlyCollection {
+	return &memoryReadonlyCollection{
+		memoryCollection: c,
+		ctx:              ctx,
+	}
+}
+
+type memoryReadonlyCollection struct {
+	*memoryCollection
+	ctx context.Context
+}
+
+// snapshot returns copies of the kvs under 'prefix' sorted lexicographically
+// by key, or by mod revision in descending order if 'byRev' is true.
+func (c *memoryReadonlyCollection) snapshot(prefix string, byRev bool) []memoryItem {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	var items []memoryItem
+	for _, key := range c.store.list(prefix) {
+		kv := c.store.kvs[key]
+		items = append(items, memoryItem{key, kv.value, kv.modRev})
+	}
+	if byRev {
+		sort.SliceStable(items, func(i, j int) bool {
+			return items[i].modRev > items[j].modRev
+		})
+	}
+	return items
+}
+
+func (c *memoryReadonlyCollection) Get(key string, val proto.Message) error {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return err
+	}
+	c.store.mu.Lock()
+	kv := c.store.get(c.Path(key))
+	c.store.mu.Unlock()
+	if kv == nil {
+		return ErrNotFound{c.prefix, key}
+	}
+	return proto.Unmarshal([]byte(kv.value), val)
+}
+
+func (c *memoryReadonlyCollection) GetByIndex(index Index, val interface{}) (Iterator, error) {
+	return &memoryIterator{
+		items: c.snapshot(c.indexDir(index, val)+"/", true),
+		col:   c,
+		get:   true,
+	}, nil
+}
+
+func (c *memoryReadonlyCollection) GetBlock(key string, val proto.Message) error {
+	if err := watch.CheckType(c.template, val); err != nil {
+		return err
+	}
+	watcher := c.store.watch(c.ctx, c.prefix, c.Path(key), false, c.template)
+	defer watcher.Close()
+	e, ok := <-watcher.Watch()
+	if !ok {
+		return c.ctx.Err()
+	}
+	return e.Unmarshal(&key, val)
+}
+
+func (c *memoryReadonlyCollection) List() (Iterator, error) {
+	return &memoryIterator{
+		items: c.snapshot(c.prefix, true),
+		col:   c,
+	}, nil
+}
+
+func (c *memoryReadonlyCollection) ListPaginated() (Iterator, error) {
+	return &memoryIterator{
+		items:   c.snapshot(c.prefix, false),
+		col:     c,
+		fullKey: true,
+	}, nil
+}
+
+func (c *memoryReadonlyCollection) ListPrefix(prefix string) (Iterator, error) {
+	queryPrefix := c.prefix
+	if prefix != "" {
+		queryPrefix = filepath.Join(c.prefix, prefix)
+	}
+	return &memoryIterator{
+		items:   c.snapshot(queryPrefix, false),
+		col:     c,
+		fullKey: true,
+	}, nil
+}
+
+func (c *memoryReadonlyCollection) ListRange(start, end string, limit int64) (Iterator, error) {
+	return &memoryIterator{
+		items: inRange(c.snapshot(c.prefix, false), c.prefix, start, end, limit),
+		col:   c,
+	}, nil
+}
+
+func (c *memoryReadonlyCollection) GetByIndexRange(index Index, val interface{}, start, end string, limit int64) (Iterator, error) {
+	dir := c.indexDir(index, val) + "/"
+	return &memoryIterator{
+		items: inRange(c.snapshot(dir, false), dir, start, end, limit),
+		col:   c,
+		get:   true,
+	}, nil
+}
+
+// inRange returns the items whose keys are in the range [dir+start, dir+end)
+// (with no upper bound if 'end' is ""), at most 'limit' of them if 'limit' is
+// nonzero.  'items' must be sorted by key.
+func inRange(items []memoryItem, dir string, start, end string, limit int64) []memoryItem {
+	var result []memoryItem
+	for _, item := range items {
+		if limit > 0 && int64(len(result)) >= limit {
+			break
+		}
+		if item.key < dir+start || (end != "" && item.key >= dir+end) {
+			continue
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func (c *memoryReadonlyCollection) Count() (int64, error) {
+	c.store.mu.Lock()
+	defer c.store.mu.Unlock()
+	return int64(len(c.store.list(c.prefix))), nil
+}
+
+func (c *memoryReadonlyCollection) Watch() (watch.Watcher, error) {
+	return c.store.watch(c.ctx, c.prefix, c.prefix, false, c.template), nil
+}
+
+func (c *memoryReadonlyCollection) WatchWithPrev() (watch.Watcher, error) {
+	return c.store.watch(c.ctx, c.prefix, c.prefix, true, c.template), nil
+}
+
+func (c *memoryReadonlyCollection) WatchOne(key string) (watch.Watcher, error) {
+	return c.store.watch(c.ctx, c.prefix, c.Path(key), false, c.template), nil
+}
+
+func (c *memoryReadonlyCollection) WatchByIndex(index Index, val interface{}) (watch.Watcher, error) {
+	watcher := c.store.watch(c.ctx, c.prefix, c.indexDir(index, val), false, c.template)
+	return c.watchIndex(watcher, func(key string) ([]byte, bool, error) {
+		c.store.mu.Lock()
+		defer c.store.mu.Unlock()
+		kv := c.store.get(c.Path(key))
+		if kv == nil {
+			return nil, false, nil
+		}
+		return []byte(kv.value), true, nil
+	}), nil
+}
+
+type memoryItem struct {
+	key    string
+	value  string
+	modRev int64
+}
+
+// memoryIterator iterates over a snapshot of a MemoryStore.  If 'get' is
+// true, the items are index entries and the values are read from the
+// collection.  If 'fullKey' is true, Next returns the full keys of the items,
+// like the paginated etcd iterators do.
+type memoryIterator struct {
+	items   []memoryItem
+	col     *memoryReadonlyCollection
+	get     bool
+	fullKey bool
+}
+
+func (i *memoryIterator) Next(key *string, val proto.Message) (ok bool, retErr error) {
+	if err := watch.CheckType(i.col.template, val); err != nil {
+		return false, err
+	}
+	for len(i.items) > 0 {
+		item := i.items[0]
+		i.items = i.items[1:]
+		if i.get {
+			*key = path.Base(item.key)
+			if err := i.col.Get(*key, val); err != nil {
+				if IsErrNotFound(err) {
+					// the item was deleted after we read the index
+					continue
+				}
+				return false, err
+			}
+			return true, nil
+		}
+		*key = path.Base(item.key)
+		if i.fullKey {
+			*key = item.key
+		}
+		if err := proto.Unmarshal([]byte(item.value), val); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,224 @@
+package collection
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+
+	"github.com/gogo/protobuf/types"
+)
+
+func TestMemoryIndex(t *testing.T) {
+	store := NewMemoryStore()
+	jobInfos := store.NewCollection("jobs", []Index{pipelineIndex}, &pps.JobInfo{}, nil)
+
+	j1 := &pps.JobInfo{Job: &pps.Job{ID: "j1"}, Pipeline: &pps.Pipeline{Name: "p1"}}
+	j2 := &pps.JobInfo{Job: &pps.Job{ID: "j2"}, Pipeline: &pps.Pipeline{Name: "p1"}}
+	j3 := &pps.JobInfo{Job: &pps.Job{ID: "j3"}, Pipeline: &pps.Pipeline{Name: "p2"}}
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		jobInfos := jobInfos.ReadWrite(stm)
+		require.NoError(t, jobInfos.Create(j1.Job.ID, j1))
+		require.NoError(t, jobInfos.Create(j2.Job.ID, j2))
+		return jobInfos.Create(j3.Job.ID, j3)
+	})
+	require.NoError(t, err)
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
+		return jobInfos.ReadWrite(stm).Create(j1.Job.ID, j1)
+	})
+	require.True(t, IsErrExists(err))
+
+	jobInfosReadonly := jobInfos.ReadOnly(context.Background())
+	count, err := jobInfosReadonly.Count()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+
+	// Items are returned newest first
+	iter, err := jobInfosReadonly.GetByIndex(pipelineIndex, j1.Pipeline)
+	require.NoError(t, err)
+	var ID string
+	job := &pps.JobInfo{}
+	var IDs []string
+	for {
+		ok, err := iter.Next(&ID, job)
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		IDs = append(IDs, ID)
+	}
+	require.Equal(t, []string{"j1", "j2"}, IDs)
+
+	// Moving a job to another pipeline updates the index
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
+		job := &pps.JobInfo{}
+		return jobInfos.ReadWrite(stm).Update(j1.Job.ID, job, func() error {
+			job.Pipeline = j3.Pipeline
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	iter, err = jobInfosReadonly.GetByIndex(pipelineIndex, j3.Pipeline)
+	require.NoError(t, err)
+	IDs = nil
+	for {
+		ok, err := iter.Next(&ID, job)
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		IDs = append(IDs, ID)
+	}
+	require.Equal(t, []string{"j1", "j3"}, IDs)
+
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
+		jobInfos.ReadWrite(stm).DeleteAll()
+		return nil
+	})
+	require.NoError(t, err)
+	count, err = jobInfosReadonly.Count()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+	require.Equal(t, 0, len(store.kvs))
+}
+
+func TestMemoryMultiIndexWatch(t *testing.T) {
+	store := NewMemoryStore()
+	commitInfos := store.NewCollection("commits", []Index{commitMultiIndex}, &pfs.CommitInfo{}, nil)
+	c1 := &pfs.Commit{ID: "c1"}
+	c2 := &pfs.Commit{ID: "c2"}
+	c3 := &pfs.CommitInfo{Commit: &pfs.Commit{ID: "c3"}, Provenance: []*pfs.Commit{c1, c2}}
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		return commitInfos.ReadWrite(stm).Put(c3.Commit.ID, c3)
+	})
+	require.NoError(t, err)
+
+	watcher, err := commitInfos.ReadOnly(context.Background()).WatchByIndex(commitMultiIndex, c2)
+	require.NoError(t, err)
+	defer watcher.Close()
+	var ID string
+	commitInfo := &pfs.CommitInfo{}
+	ev := <-watcher.Watch()
+	require.Equal(t, watch.EventPut, ev.Type)
+	require.NoError(t, ev.Unmarshal(&ID, commitInfo))
+	require.Equal(t, "c3", ID)
+	require.Equal(t, c3, commitInfo)
+
+	// Removing c2 from c3's provenance removes c3 from the index
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
+		commitInfo := &pfs.CommitInfo{}
+		return commitInfos.ReadWrite(stm).Update(c3.Commit.ID, commitInfo, func() error {
+			commitInfo.Provenance = []*pfs.Commit{c1}
+			return nil
+		})
+	})
+	require.NoError(t, err)
+	ev = <-watcher.Watch()
+	require.Equal(t, watch.EventDelete, ev.Type)
+	require.Equal(t, "c3", string(ev.Key))
+}
+
+func TestMemorySTMConflict(t *testing.T) {
+	store := NewMemoryStore()
+	counters := store.NewCollection("counters", nil, nil, nil)
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		return counters.ReadWriteInt(stm).Create("counter", 0)
+	})
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				_, err := store.NewSTM(context.Background(), func(stm STM) error {
+					return counters.ReadWriteInt(stm).Increment("counter")
+				})
+				require.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
+		value, err := counters.ReadWriteInt(stm).Get("counter")
+		require.Equal(t, 100, value)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestMemoryTTL(t *testing.T) {
+	store := NewMemoryStore()
+	clxn := store.NewCollection("ttl", nil, &types.BoolValue{}, nil)
+	const TTL = 2
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		return clxn.ReadWrite(stm).PutTTL("key", epsilon, TTL)
+	})
+	require.NoError(t, err)
+
+	var actualTTL int64
+	_, err = store.NewSTM(context.Background(), func(stm STM) error {
+		var err error
+		actualTTL, err = clxn.ReadWrite(stm).TTL("key")
+		return err
+	})
+	require.NoError(t, err)
+	require.True(t, actualTTL > 0 && actualTTL <= TTL, "actualTTL was %v", actualTTL)
+
+	watcher, err := clxn.ReadOnly(context.Background()).WatchOne("key")
+	require.NoError(t, err)
+	defer watcher.Close()
+	require.Equal(t, watch.EventPut, (<-watcher.Watch()).Type)
+	select {
+	case ev := <-watcher.Watch():
+		require.Equal(t, watch.EventDelete, ev.Type)
+	case <-time.After((TTL + 1) * time.Second):
+		t.Fatal("key did not expire")
+	}
+	err = clxn.ReadOnly(context.Background()).Get("key", &types.BoolValue{})
+	require.True(t, IsErrNotFound(err))
+}
+
+func TestMemoryListRange(t *testing.T) {
+	store := NewMemoryStore()
+	jobInfos := store.NewCollection("jobs", []Index{pipelineIndex}, &pps.JobInfo{}, nil)
+	_, err := store.NewSTM(context.Background(), func(stm STM) error {
+		jobInfos := jobInfos.ReadWrite(stm)
+		for _, id := range []string{"j4", "j1", "j3", "j2"} {
+			if err := jobInfos.Put(id, &pps.JobInfo{Job: &pps.Job{ID: id}, Pipeline: &pps.Pipeline{Name: "p1"}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	collect := func(iter Iterator, err error) []string {
+		require.NoError(t, err)
+		var IDs []string
+		var ID string
+		job := &pps.JobInfo{}
+		for {
+			ok, err := iter.Next(&ID, job)
+			require.NoError(t, err)
+			if !ok {
+				return IDs
+			}
+			require.Equal(t, ID, job.Job.ID)
+			IDs = append(IDs, ID)
+		}
+	}
+	jobInfosReadonly := jobInfos.ReadOnly(context.Background())
+	require.Equal(t, []string{"j2", "j3", "j4"}, collect(jobInfosReadonly.ListRange("j2", "", 0)))
+	require.Equal(t, []string{"j1", "j2"}, collect(jobInfosReadonly.ListRange("", "j3", 0)))
+	require.Equal(t, []string{"j2", "j3"}, collect(jobInfosReadonly.ListRange("j2", "", 2)))
+	pipeline := &pps.Pipeline{Name: "p1"}
+	require.Equal(t, []string{"j2", "j3"}, collect(jobInfosReadonly.GetByIndexRange(pipelineIndex, pipeline, "j2", "j4", 0)))
+	require.Equal(t, []string{"j1"}, collect(jobInfosReadonly.GetByIndexRange(pipelineIndex, pipeline, "", "", 1)))
+}
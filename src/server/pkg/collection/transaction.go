@@ -19,13 +19,17 @@ package collection
 
 import (
 	"fmt"
+	"strconv"
 
 	v3 "github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"golang.org/x/net/context"
 )
 
-// STM is an interface for software transactional memory.
+// STM is an interface for software transactional memory. NewSTM creates STMs
+// that run against etcd, and Store.NewSTM creates STMs that run against the
+// store.
 type STM interface {
 	// Get returns the value for a key and inserts the key in the txn's read set.
 	// If Get fails, it aborts the transaction with an error, never returning.
@@ -44,7 +48,11 @@ type STM interface {
 	// DelAll is called.
 	DelAll(key string)
 	Context() context.Context
+}
 
+// etcdSTM is implemented by the STMs that are backed by etcd.
+type etcdSTM interface {
+	STM
 	// commit attempts to apply the txn's changes to the server.
 	commit() *v3.TxnResponse
 	reset()
@@ -54,9 +62,31 @@ type STM interface {
 // stmError safely passes STM errors through panic to the STM error channel.
 type stmError struct{ err error }
 
-// NewSTM intiates a new STM operation. It uses a serializable model.
+// NewSTM intiates a new STM operation. It uses a serializable model. The
+// transaction is traced as a child of the span in 'ctx', if any, and the span
+// records how many times 'apply' ran (i.e. the number of conflicting
+// transactions plus one).
 func NewSTM(ctx context.Context, c *v3.Client, apply func(STM) error) (*v3.TxnResponse, error) {
-	return newSTMSerializable(ctx, c, apply)
+	span, ctx := tracing.StartSpan(ctx, "etcd.STM")
+	if span == nil {
+		return newSTMSerializable(ctx, c, apply)
+	}
+	attempts := 0
+	resp, err := newSTMSerializable(ctx, c, func(stm STM) error {
+		attempts++
+		return apply(stm)
+	})
+	span.SetAttribute("attempts", strconv.Itoa(attempts))
+	span.Finish(err)
+	return resp, err
+}
+
+func (s *etcdStore) NewSTM(ctx context.Context, apply func(STM) error) (int64, error) {
+	resp, err := NewSTM(ctx, s.etcdClient, apply)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Header.Revision, nil
 }
 
 // newSTMRepeatable initiates new repeatable read transaction; reads within
@@ -87,7 +117,7 @@ type stmResponse struct {
 	err  error
 }
 
-func runSTM(s STM, apply func(STM) error) (*v3.TxnResponse, error) {
+func runSTM(s etcdSTM, apply func(STM) error) (*v3.TxnResponse, error) {
 	outc := make(chan stmResponse, 1)
 	go func() {
 		defer func() {
@@ -346,7 +376,7 @@ func respToValue(key string, resp *v3.GetResponse) (string, error) {
 // is because fetchTTL calls iface.fetch(), and the implementation of 'fetch' is
 // different for stm and stmSerializeable. Passing the interface ensures the
 // correct version of fetch() is called
-func (s *stm) fetchTTL(iface STM, key string) (int64, error) {
+func (s *stm) fetchTTL(iface etcdSTM, key string) (int64, error) {
 	// check wset cache
 	if wv, ok := s.wset[key]; ok {
 		return wv.ttl, nil
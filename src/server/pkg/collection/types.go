@@ -6,12 +6,16 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 
 	"github.com/gogo/protobuf/proto"
+	netcontext "golang.org/x/net/context"
 )
 
 // Collection implements helper functions that makes common operations
 // on top of etcd more pleasant to work with.  It's called collection
 // because most of our data is modelled as collections, such as repos,
 // commits, branches, etc.
+//
+// Collections are created by a Store, which decides where they're stored
+// (NewCollection creates them in etcd directly).
 type Collection interface {
 	// Path returns the full etcd path of the given key in the collection
 	Path(string) string
@@ -29,6 +33,18 @@ type Collection interface {
 	ReadOnly(ctx context.Context) ReadonlyCollection
 }
 
+// Store creates collections, and the STMs that read and write them.  The
+// collections created by a Store must only be written with STMs created by
+// the same Store.  NewEtcdStore returns a Store that's backed by etcd, and
+// MemoryStore is a Store that's kept in memory.
+type Store interface {
+	// NewCollection creates a new collection (see NewCollection)
+	NewCollection(prefix string, indexes []Index, template proto.Message, keyCheck func(string) error) Collection
+	// NewSTM runs 'apply' in a transaction (see NewSTM), and returns the
+	// revision at which the transaction was committed
+	NewSTM(ctx netcontext.Context, apply func(STM) error) (int64, error)
+}
+
 // Index specifies a secondary index on a collection.
 //
 // Indexes are created in a transactional manner thanks to etcd's
@@ -101,6 +117,13 @@ type ReadonlyCollection interface {
 	List() (Iterator, error)
 	ListPaginated() (Iterator, error)
 	ListPrefix(prefix string) (Iterator, error)
+	// ListRange returns the items whose keys are in the range [start, end),
+	// in order of their keys. If 'end' is "", the range has no upper bound,
+	// and if 'limit' is nonzero, at most 'limit' items are returned.
+	ListRange(start, end string, limit int64) (Iterator, error)
+	// GetByIndexRange is like GetByIndex, but returns only the items whose
+	// keys are in the range [start, end), in the same way as ListRange.
+	GetByIndexRange(index Index, val interface{}, start, end string, limit int64) (Iterator, error)
 	Count() (int64, error)
 	Watch() (watch.Watcher, error)
 	// WatchWithPrev is like Watch, but the events will include the previous
@@ -1,5 +1,9 @@
 package dag
 
+import (
+	"sort"
+)
+
 // DAG represents a directected acyclic graph
 type DAG struct {
 	parents  map[string][]string
@@ -42,6 +46,59 @@ func (d *DAG) Sorted() []string {
 	return result
 }
 
+// Cycle returns the nodes of a cycle in d, if d contains one (in which case
+// it isn't really a DAG), or nil otherwise. Each node in the result is a
+// parent of the next, and the first node is repeated at the end.
+func (d *DAG) Cycle() []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var stack []string // the nodes being visited, each a child of the previous
+	var visit func(id string) []string
+	visit = func(id string) []string {
+		switch state[id] {
+		case visited:
+			return nil
+		case visiting:
+			// 'id' is on the stack, so it's an ancestor of the nodes above it,
+			// and a parent of the last of them
+			i := len(stack) - 1
+			for stack[i] != id {
+				i--
+			}
+			result := []string{id}
+			for j := len(stack) - 1; j >= i; j-- {
+				result = append(result, stack[j])
+			}
+			return result
+		}
+		state[id] = visiting
+		stack = append(stack, id)
+		for _, parentID := range d.parents[id] {
+			if cycle := visit(parentID); cycle != nil {
+				return cycle
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[id] = visited
+		return nil
+	}
+	// Visit the nodes in order, so that the same cycle is always reported
+	var ids []string
+	for id := range d.parents {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if cycle := visit(id); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
 // Leaves returns a slice containing all leaves in d.
 func (d *DAG) Leaves() []string {
 	var result []string
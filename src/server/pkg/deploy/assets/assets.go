@@ -12,7 +12,9 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	auth "github.com/pachyderm/pachyderm/src/server/auth/server"
 	"github.com/pachyderm/pachyderm/src/server/http"
+	"github.com/pachyderm/pachyderm/src/server/pfs/s3"
 	pfs "github.com/pachyderm/pachyderm/src/server/pfs/server"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 	"github.com/pachyderm/pachyderm/src/server/pps/server/githook"
 	apps "k8s.io/api/apps/v1beta1"
 	"k8s.io/api/core/v1"
@@ -95,6 +97,18 @@ type AssetOpts struct {
 	// its cache of PFS blocks. If empty, assets.go will choose a default size.
 	BlockCacheSize string
 
+	// BlockCompression is the codec (none, gzip or snappy) that pachd
+	// compresses new PFS blocks with. If empty, blocks aren't compressed.
+	BlockCompression string
+
+	// EncryptionKey is the base64-encoded 256-bit master key that pachd
+	// encrypts data in object storage with. If empty, data isn't encrypted.
+	EncryptionKey string
+
+	// MaxConcurrentJobs is the maximum number of jobs that may run at once
+	// across the cluster. If 0, there's no limit.
+	MaxConcurrentJobs int64
+
 	// PachdCPURequest is the amount of CPU we request for each pachd node. If
 	// empty, assets.go will choose a default size.
 	PachdCPURequest string
@@ -348,8 +362,7 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 				MatchLabels: labels(pachdName),
 			},
 			Template: v1.PodTemplateSpec{
-				ObjectMeta: objectMeta(pachdName, labels(pachdName),
-					map[string]string{"iam.amazonaws.com/role": opts.IAMRole}, opts.Namespace),
+				ObjectMeta: objectMeta(pachdName, labels(pachdName), pachdAnnotations(opts), opts.Namespace),
 				Spec: v1.PodSpec{
 					Containers: []v1.Container{
 						{
@@ -369,6 +382,8 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 								{Name: "METRICS", Value: strconv.FormatBool(opts.Metrics)},
 								{Name: "LOG_LEVEL", Value: opts.LogLevel},
 								{Name: "BLOCK_CACHE_BYTES", Value: opts.BlockCacheSize},
+								{Name: "BLOCK_COMPRESSION", Value: opts.BlockCompression},
+								{Name: "MAX_CONCURRENT_JOBS", Value: strconv.FormatInt(opts.MaxConcurrentJobs, 10)},
 								{Name: "IAM_ROLE", Value: opts.IAMRole},
 								{Name: auth.DisableAuthenticationEnvVar, Value: strconv.FormatBool(opts.DisableAuthentication)},
 								{
@@ -391,11 +406,21 @@ func PachdDeployment(opts *AssetOpts, objectStoreBackend backend, hostPath strin
 									ContainerPort: 651,
 									Name:          "trace-port",
 								},
+								{
+									ContainerPort: prom.PachdPort,
+									Protocol:      "TCP",
+									Name:          "metrics-port",
+								},
 								{
 									ContainerPort: http.HTTPPort,
 									Protocol:      "TCP",
 									Name:          "api-http-port",
 								},
+								{
+									ContainerPort: s3.S3Port,
+									Protocol:      "TCP",
+									Name:          "api-s3-port",
+								},
 								{
 									ContainerPort: githook.GitHookPort,
 									Protocol:      "TCP",
@@ -455,6 +480,11 @@ func PachdService(opts *AssetOpts) *v1.Service {
 					Name:     "api-http-port",
 					NodePort: 30000 + http.HTTPPort,
 				},
+				{
+					Port:     s3.S3Port,
+					Name:     "api-s3-port",
+					NodePort: 30000 + s3.S3Port,
+				},
 				{
 					Port:     githook.GitHookPort,
 					Name:     "api-git-port",
@@ -1012,6 +1042,12 @@ func WriteSecret(w io.Writer, data map[string][]byte, opts *AssetOpts) {
 	if opts.DashOnly {
 		return
 	}
+	if opts.EncryptionKey != "" {
+		if data == nil {
+			data = make(map[string][]byte)
+		}
+		data["encryption-key"] = []byte(opts.EncryptionKey)
+	}
 	secret := &v1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "Secret",
@@ -1280,6 +1316,14 @@ func Images(opts *AssetOpts) []string {
 	}
 }
 
+// pachdAnnotations returns the annotations of the pachd pod, which tell
+// Prometheus to scrape pachd's metrics
+func pachdAnnotations(opts *AssetOpts) map[string]string {
+	annotations := prom.ScrapeAnnotations(prom.PachdPort)
+	annotations["iam.amazonaws.com/role"] = opts.IAMRole
+	return annotations
+}
+
 func labels(name string) map[string]string {
 	return map[string]string{
 		"app":   name,
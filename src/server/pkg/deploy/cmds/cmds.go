@@ -68,6 +68,9 @@ func DeployCmd(noMetrics *bool) *cobra.Command {
 	var pachdCPURequest string
 	var pachdNonCacheMemRequest string
 	var blockCacheSize string
+	var blockCompression string
+	var encryptionKeyFile string
+	var maxConcurrentJobs int64
 	var etcdCPURequest string
 	var etcdMemRequest string
 	var logLevel string
@@ -413,6 +416,8 @@ particular backend, run "pachctl deploy storage <backend>"`,
 				PachdCPURequest:         pachdCPURequest,
 				PachdNonCacheMemRequest: pachdNonCacheMemRequest,
 				BlockCacheSize:          blockCacheSize,
+				BlockCompression:        blockCompression,
+				MaxConcurrentJobs:       maxConcurrentJobs,
 				EtcdCPURequest:          etcdCPURequest,
 				EtcdMemRequest:          etcdMemRequest,
 				EtcdNodes:               etcdNodes,
@@ -425,6 +430,13 @@ particular backend, run "pachctl deploy storage <backend>"`,
 				NoRBAC:                  noRBAC,
 				Namespace:               namespace,
 			}
+			if encryptionKeyFile != "" {
+				key, err := ioutil.ReadFile(encryptionKeyFile)
+				if err != nil {
+					return fmt.Errorf("error reading encryption key file %s: %v", encryptionKeyFile, err)
+				}
+				opts.EncryptionKey = strings.TrimSpace(string(key))
+			}
 			return nil
 		}),
 	}
@@ -467,6 +479,18 @@ particular backend, run "pachctl deploy storage <backend>"`,
 	deploy.PersistentFlags().StringVar(&blockCacheSize, "block-cache-size", "",
 		"Size of pachd's in-memory cache for PFS files. Size is specified in "+
 			"bytes, with allowed SI suffixes (M, K, G, Mi, Ki, Gi, etc).")
+	deploy.PersistentFlags().StringVar(&blockCompression, "block-compression", "none",
+		"The codec that pachd compresses new PFS blocks with in object storage: "+
+			"none, gzip or snappy. Existing blocks stay readable if this is changed.")
+	deploy.PersistentFlags().StringVar(&encryptionKeyFile, "encryption-key-file", "",
+		"A file containing a base64-encoded 256-bit master key (e.g. from "+
+			"'head -c 32 /dev/urandom | base64'). If set, pachd encrypts all of "+
+			"the data that it writes to object storage, with a data key per "+
+			"object that's wrapped with the master key.")
+	deploy.PersistentFlags().Int64Var(&maxConcurrentJobs, "max-concurrent-jobs", 0,
+		"The maximum number of jobs that may run at once across the cluster. "+
+			"Other jobs wait in a queue, ordered by their pipelines' priority. "+
+			"If 0, there's no limit.")
 	deploy.PersistentFlags().StringVar(&pachdNonCacheMemRequest,
 		"pachd-memory-request", "", "(rarely set) The size of PachD's memory "+
 			"request in addition to its block cache (set via --block-cache-size). "+
@@ -0,0 +1,570 @@
+package obj
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+)
+
+// Objects written by an encrypted client have the following format:
+//
+//	magic | master key ID | wrapped data key | nonce prefix | segments...
+//
+// Each object is encrypted with its own random data key, which is stored in
+// the object's header, encrypted ("wrapped") with a master key. The data is
+// split into segments of segmentSize bytes, each of which is encrypted with
+// AES-GCM separately, so that ranges of the object can be read without
+// decrypting all of it. A segment's nonce is made of the object's nonce
+// prefix, the segment's index and a flag that's set only for the last
+// segment, so that segments can't be reordered or truncated.
+const (
+	keySize         = 32 // AES-256
+	keyIDSize       = 8
+	nonceSize       = 12
+	noncePrefixSize = nonceSize - 5 // 4 bytes of segment index and 1 flag byte
+	tagSize         = 16
+	wrappedKeySize  = nonceSize + keySize + tagSize
+	segmentSize     = 64 * 1024
+
+	encryptedSegmentSize = segmentSize + tagSize
+)
+
+var encryptedMagic = []byte("PACHENC1")
+
+var encryptedHeaderSize = len(encryptedMagic) + keyIDSize + wrappedKeySize + noncePrefixSize
+
+// masterKey is a key that wraps objects' data keys
+type masterKey struct {
+	id   []byte
+	aead cipher.AEAD
+}
+
+func newMasterKey(key []byte) (*masterKey, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("encryption keys must be %d bytes, but got a key of %d bytes", keySize, len(key))
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	id := sha256.Sum256(key)
+	return &masterKey{id: id[:keyIDSize], aead: aead}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (k *masterKey) wrap(dataKey []byte) ([]byte, error) {
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return k.aead.Seal(nonce, nonce, dataKey, k.id), nil
+}
+
+func (k *masterKey) unwrap(wrappedKey []byte) ([]byte, error) {
+	return k.aead.Open(nil, wrappedKey[:nonceSize], wrappedKey[nonceSize:], k.id)
+}
+
+type encryptedClient struct {
+	Client
+	// current is the master key that wraps the data keys of new objects
+	current *masterKey
+	// keys holds every master key that can unwrap data keys, by ID
+	keys map[string]*masterKey
+}
+
+// NewEncryptedClient returns a Client that encrypts the objects that it
+// writes to 'c', and decrypts the objects that it reads. New objects' data
+// keys are wrapped with 'currentKey'; 'previousKeys' are only used to
+// unwrap the data keys of objects written before the current key was rotated
+// in. Objects that aren't encrypted (e.g. objects that were written before
+// encryption was enabled) are read as-is.
+func NewEncryptedClient(c Client, currentKey []byte, previousKeys ...[]byte) (Client, error) {
+	current, err := newMasterKey(currentKey)
+	if err != nil {
+		return nil, err
+	}
+	client := &encryptedClient{
+		Client:  c,
+		current: current,
+		keys:    map[string]*masterKey{string(current.id): current},
+	}
+	for _, key := range previousKeys {
+		previous, err := newMasterKey(key)
+		if err != nil {
+			return nil, err
+		}
+		client.keys[string(previous.id)] = previous
+	}
+	return client, nil
+}
+
+// NewEncryptedClientFromSecret wraps 'c' in an encrypted client if the
+// mounted storage secret contains a master key ("encryption-key", which is a
+// base64-encoded 256-bit key). Previous master keys, which are still needed
+// to read objects until their data keys are re-wrapped (see RewrapKeys), may
+// be given as whitespace-separated base64-encoded keys in
+// "previous-encryption-keys". If the secret doesn't contain a master key, 'c'
+// is returned as-is.
+func NewEncryptedClientFromSecret(c Client) (Client, error) {
+	encodedKey, err := readSecretFile("/encryption-key")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return c, nil
+		}
+		return nil, err
+	}
+	if encodedKey == "" {
+		return c, nil
+	}
+	currentKey, err := base64.StdEncoding.DecodeString(encodedKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode encryption-key: %v", err)
+	}
+	var previousKeys [][]byte
+	encodedKeys, err := readSecretFile("/previous-encryption-keys")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, encodedKey := range strings.Fields(encodedKeys) {
+		key, err := base64.StdEncoding.DecodeString(encodedKey)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode previous-encryption-keys: %v", err)
+		}
+		previousKeys = append(previousKeys, key)
+	}
+	return NewEncryptedClient(c, currentKey, previousKeys...)
+}
+
+// rewrapDir is the directory (under the prefix given to RewrapKeys) that
+// holds the re-wrapped copies of objects while they're being swapped in
+const rewrapDir = "rewrap"
+
+// CurrentKeyID returns the (hex-encoded) ID of the master key that 'c' wraps
+// new objects' data keys with, or "" if 'c' isn't an encrypted client
+func CurrentKeyID(c Client) string {
+	client, ok := c.(*encryptedClient)
+	if !ok {
+		return ""
+	}
+	return hex.EncodeToString(client.current.id)
+}
+
+// RewrapKeys re-wraps the data keys of the objects under 'prefix' that were
+// wrapped with a previous master key, so that they're wrapped with the
+// current master key. Only the objects' headers change, but since object
+// stores can't modify objects in place, each object is rewritten: the
+// re-wrapped object is first written to a temporary object, which is then
+// copied over the original. Object stores replace objects atomically, so
+// readers see either the original or the re-wrapped object, and an object is
+// only replaced once its re-wrapped copy has been written in full. If
+// RewrapKeys is interrupted, the next call finishes the swaps that it
+// started. Objects that are deleted while they're being re-wrapped (e.g. by
+// garbage collection) aren't recreated. RewrapKeys must not run concurrently
+// with itself. Once it has
+// finished, the previous master keys are no longer needed. It returns the
+// number of objects that it rewrote, and does nothing if 'c' isn't an
+// encrypted client.
+func RewrapKeys(c Client, prefix string) (int, error) {
+	client, ok := c.(*encryptedClient)
+	if !ok || len(client.keys) == 1 {
+		return 0, nil
+	}
+	tmpDir := path.Join(prefix, rewrapDir) + "/"
+	var rewrapped int
+	err := client.Walk(prefix, func(name string) error {
+		if strings.HasPrefix(name, tmpDir) {
+			return client.finishRewrap(path.Join(prefix, strings.TrimPrefix(name, tmpDir)), name)
+		}
+		ok, err := client.rewrapKey(name, path.Join(tmpDir, strings.TrimPrefix(name, prefix)))
+		if err != nil {
+			return err
+		}
+		if ok {
+			rewrapped++
+		}
+		return nil
+	})
+	return rewrapped, err
+}
+
+// rewrapKey re-wraps the data key of the object 'name', using 'tmpName' to
+// hold the re-wrapped object until it's swapped in. It returns false if the
+// object isn't encrypted, or its data key is already wrapped with the
+// current master key.
+func (c *encryptedClient) rewrapKey(name string, tmpName string) (bool, error) {
+	header, err := c.readHeader(name)
+	if err != nil || header == nil {
+		return false, err
+	}
+	keyID := header[len(encryptedMagic) : len(encryptedMagic)+keyIDSize]
+	if bytes.Equal(keyID, c.current.id) {
+		return false, nil
+	}
+	dataKey, err := c.unwrapDataKey(header)
+	if err != nil {
+		return false, err
+	}
+	wrappedKey, err := c.current.wrap(dataKey)
+	if err != nil {
+		return false, err
+	}
+	newHeader := make([]byte, encryptedHeaderSize)
+	copy(newHeader, header)
+	copy(newHeader[len(encryptedMagic):], c.current.id)
+	copy(newHeader[len(encryptedMagic)+keyIDSize:], wrappedKey)
+	if err := c.copyObject(tmpName, name, newHeader); err != nil {
+		return false, err
+	}
+	// Garbage collection or compaction may have deleted 'name' while it was
+	// being copied, in which case swapping in 'tmpName' would recreate it
+	if !c.Client.Exists(name) {
+		return false, c.Client.Delete(tmpName)
+	}
+	if err := c.copyObject(name, tmpName, nil); err != nil {
+		return false, err
+	}
+	return true, c.Client.Delete(tmpName)
+}
+
+// finishRewrap handles the temporary object 'tmpName' that an interrupted
+// call to RewrapKeys left behind for the object 'name'
+func (c *encryptedClient) finishRewrap(name string, tmpName string) error {
+	header, err := c.readHeader(name)
+	if err != nil && !c.IsNotExist(err) {
+		return err
+	}
+	switch {
+	case err != nil:
+		// 'name' has since been deleted (e.g. by garbage collection)
+	case header != nil && !bytes.Equal(header[len(encryptedMagic):len(encryptedMagic)+keyIDSize], c.current.id):
+		// The swap hadn't started, so 'tmpName' may be incomplete. Start over.
+		if err := c.Client.Delete(tmpName); err != nil && !c.IsNotExist(err) {
+			return err
+		}
+		_, err := c.rewrapKey(name, tmpName)
+		return err
+	default:
+		// 'name' has been (possibly partially) overwritten, which only happens
+		// once 'tmpName' is complete, so finish the swap
+		if err := c.copyObject(name, tmpName, nil); err != nil {
+			if c.IsNotExist(err) {
+				// This call to RewrapKeys has already finished the swap
+				return nil
+			}
+			return err
+		}
+	}
+	if err := c.Client.Delete(tmpName); err != nil && !c.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// copyObject copies the stored object 'src' to 'dst'. If 'header' is set, it
+// replaces the header of 'src'.
+func (c *encryptedClient) copyObject(dst string, src string, header []byte) (retErr error) {
+	r, err := c.Client.Reader(src, uint64(len(header)), 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := r.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	w, err := c.Client.Writer(dst)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := w.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+	}()
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(w, r)
+	return err
+}
+
+// readHeader returns the header of the object 'name', or nil if the object
+// isn't encrypted (i.e. it's shorter than a header, or doesn't start with
+// the magic bytes)
+func (c *encryptedClient) readHeader(name string) ([]byte, error) {
+	r, err := c.Client.Reader(name, 0, uint64(encryptedHeaderSize))
+	if err != nil {
+		if c.IsNotExist(err) {
+			return nil, err
+		}
+		// Some object stores can't read ranges of objects that are smaller
+		// than the range (e.g. empty objects), so read the whole object to
+		// find out whether it's one of those
+		var retryErr error
+		r, retryErr = c.Client.Reader(name, 0, 0)
+		if retryErr != nil {
+			return nil, err
+		}
+	}
+	defer r.Close()
+	header := make([]byte, encryptedHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !bytes.Equal(header[:len(encryptedMagic)], encryptedMagic) {
+		return nil, nil
+	}
+	return header, nil
+}
+
+func (c *encryptedClient) unwrapDataKey(header []byte) ([]byte, error) {
+	keyID := header[len(encryptedMagic) : len(encryptedMagic)+keyIDSize]
+	key, ok := c.keys[string(keyID)]
+	if !ok {
+		return nil, fmt.Errorf("object is encrypted with unknown master key %x", keyID)
+	}
+	wrappedKey := header[len(encryptedMagic)+keyIDSize : len(encryptedMagic)+keyIDSize+wrappedKeySize]
+	return key.unwrap(wrappedKey)
+}
+
+func (c *encryptedClient) Writer(name string) (io.WriteCloser, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	wrappedKey, err := c.current.wrap(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	noncePrefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(noncePrefix); err != nil {
+		return nil, err
+	}
+	w, err := c.Client.Writer(name)
+	if err != nil {
+		return nil, err
+	}
+	header := make([]byte, 0, encryptedHeaderSize)
+	header = append(header, encryptedMagic...)
+	header = append(header, c.current.id...)
+	header = append(header, wrappedKey...)
+	header = append(header, noncePrefix...)
+	if _, err := w.Write(header); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return &encryptedWriter{
+		w:           w,
+		aead:        aead,
+		noncePrefix: noncePrefix,
+		buf:         make([]byte, 0, segmentSize),
+	}, nil
+}
+
+func (c *encryptedClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	header, err := c.readHeader(name)
+	if err != nil {
+		return nil, err
+	}
+	if header == nil {
+		return c.Client.Reader(name, offset, size)
+	}
+	dataKey, err := c.unwrapDataKey(header)
+	if err != nil {
+		return nil, fmt.Errorf("could not decrypt %s: %v", name, err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	// Read the segments that hold the requested range
+	firstSegment := offset / segmentSize
+	var encryptedSize uint64
+	if size > 0 {
+		lastSegment := (offset + size - 1) / segmentSize
+		encryptedSize = (lastSegment - firstSegment + 1) * encryptedSegmentSize
+	}
+	r, err := c.Client.Reader(name, uint64(encryptedHeaderSize)+firstSegment*encryptedSegmentSize, encryptedSize)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptingReader{
+		r:           r,
+		name:        name,
+		aead:        aead,
+		noncePrefix: header[encryptedHeaderSize-noncePrefixSize:],
+		segment:     uint32(firstSegment),
+		skip:        offset % segmentSize,
+		remaining:   size,
+		limited:     size > 0,
+	}, nil
+}
+
+func segmentNonce(noncePrefix []byte, segment uint32, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	copy(nonce, noncePrefix)
+	binary.BigEndian.PutUint32(nonce[noncePrefixSize:], segment)
+	if last {
+		nonce[nonceSize-1] = 1
+	}
+	return nonce
+}
+
+type encryptedWriter struct {
+	w           io.WriteCloser
+	aead        cipher.AEAD
+	noncePrefix []byte
+	segment     uint32
+	// buf holds data that hasn't been encrypted yet. A full segment isn't
+	// written until more data arrives, as the last segment is encrypted
+	// differently.
+	buf []byte
+}
+
+func (w *encryptedWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if len(w.buf) == segmentSize {
+			if err := w.writeSegment(false); err != nil {
+				return written, err
+			}
+		}
+		n := segmentSize - len(w.buf)
+		if n > len(p) {
+			n = len(p)
+		}
+		w.buf = append(w.buf, p[:n]...)
+		p = p[n:]
+		written += n
+	}
+	return written, nil
+}
+
+func (w *encryptedWriter) writeSegment(last bool) error {
+	encrypted := w.aead.Seal(nil, segmentNonce(w.noncePrefix, w.segment, last), w.buf, nil)
+	if _, err := w.w.Write(encrypted); err != nil {
+		return err
+	}
+	w.segment++
+	w.buf = w.buf[:0]
+	return nil
+}
+
+func (w *encryptedWriter) Close() error {
+	if err := w.writeSegment(true); err != nil {
+		w.w.Close()
+		return err
+	}
+	return w.w.Close()
+}
+
+type decryptingReader struct {
+	r           io.ReadCloser
+	name        string
+	aead        cipher.AEAD
+	noncePrefix []byte
+	segment     uint32
+	// skip is the number of bytes to skip at the start of the first segment
+	skip uint64
+	// remaining is the number of bytes left to read, if 'limited' is set
+	remaining uint64
+	limited   bool
+	// plaintext holds decrypted data that hasn't been read yet
+	plaintext []byte
+	sawLast   bool
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	if r.limited && r.remaining == 0 {
+		return 0, io.EOF
+	}
+	if len(r.plaintext) == 0 {
+		if err := r.readSegment(); err != nil {
+			return 0, err
+		}
+	}
+	n := len(p)
+	if n > len(r.plaintext) {
+		n = len(r.plaintext)
+	}
+	if r.limited && uint64(n) > r.remaining {
+		n = int(r.remaining)
+	}
+	copy(p, r.plaintext[:n])
+	r.plaintext = r.plaintext[n:]
+	r.remaining -= uint64(n)
+	return n, nil
+}
+
+// readSegment reads and decrypts the next non-empty segment
+func (r *decryptingReader) readSegment() error {
+	for len(r.plaintext) == 0 {
+		if r.sawLast {
+			return io.EOF
+		}
+		encrypted := make([]byte, encryptedSegmentSize)
+		n, err := io.ReadFull(r.r, encrypted)
+		if err == io.EOF {
+			// Reads of a range of the object may stop before the last
+			// segment, but reads of the whole object must not
+			if r.limited {
+				return io.EOF
+			}
+			return fmt.Errorf("encrypted object %s is truncated", r.name)
+		} else if err != nil && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		encrypted = encrypted[:n]
+		// Only the last segment can be shorter than a full segment, but a full
+		// segment may be the last one too
+		var plaintext []byte
+		if n == encryptedSegmentSize {
+			plaintext, err = r.aead.Open(nil, segmentNonce(r.noncePrefix, r.segment, false), encrypted, nil)
+		}
+		if n < encryptedSegmentSize || err != nil {
+			plaintext, err = r.aead.Open(nil, segmentNonce(r.noncePrefix, r.segment, true), encrypted, nil)
+			if err != nil {
+				return fmt.Errorf("could not decrypt %s: %v", r.name, err)
+			}
+			r.sawLast = true
+		}
+		r.segment++
+		if r.skip > 0 {
+			if r.skip > uint64(len(plaintext)) {
+				return io.EOF
+			}
+			plaintext = plaintext[r.skip:]
+			r.skip = 0
+		}
+		r.plaintext = plaintext
+	}
+	return nil
+}
+
+func (r *decryptingReader) Close() error {
+	return r.r.Close()
+}
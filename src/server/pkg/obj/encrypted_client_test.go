@@ -0,0 +1,233 @@
+package obj
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func newKey(t *testing.T) []byte {
+	key := make([]byte, keySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	return key
+}
+
+func writeObject(t *testing.T, c Client, name string, data []byte) {
+	w, err := c.Writer(name)
+	require.NoError(t, err)
+	_, err = w.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+}
+
+func readObject(t *testing.T, c Client, name string, offset, size uint64) []byte {
+	r, err := c.Reader(name, offset, size)
+	require.NoError(t, err)
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	return data
+}
+
+func newTestLocalClient(t *testing.T) Client {
+	dir, err := ioutil.TempDir("", "encrypted_client_test")
+	require.NoError(t, err)
+	c, err := NewLocalClient(dir)
+	require.NoError(t, err)
+	return c
+}
+
+func TestEncryptedClient(t *testing.T) {
+	local := newTestLocalClient(t)
+	defer os.RemoveAll(local.(*localClient).root)
+	c, err := NewEncryptedClient(local, newKey(t))
+	require.NoError(t, err)
+
+	data := make([]byte, 3*segmentSize+100)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+	writeObject(t, c, "object", data)
+
+	// The stored object doesn't contain the plaintext
+	stored := readObject(t, local, "object", 0, 0)
+	require.False(t, bytes.Contains(stored, data[:64]))
+
+	require.Equal(t, data, readObject(t, c, "object", 0, 0))
+	for _, r := range [][2]uint64{{0, 10}, {segmentSize - 5, 10}, {2*segmentSize + 1, segmentSize + 99}, {100, 0}} {
+		expected := data[r[0]:]
+		if r[1] > 0 {
+			expected = expected[:r[1]]
+		}
+		require.Equal(t, expected, readObject(t, c, "object", r[0], r[1]))
+	}
+
+	// Empty objects and objects that are a multiple of the segment size
+	writeObject(t, c, "empty", nil)
+	require.Equal(t, 0, len(readObject(t, c, "empty", 0, 0)))
+	writeObject(t, c, "segment", data[:segmentSize])
+	require.Equal(t, data[:segmentSize], readObject(t, c, "segment", 0, 0))
+
+	// Unencrypted objects are readable
+	writeObject(t, local, "plaintext", []byte("foo"))
+	require.Equal(t, []byte("foo"), readObject(t, c, "plaintext", 0, 0))
+
+	// Truncated objects aren't
+	writeObject(t, local, "truncated", stored[:len(stored)-100])
+	r, err := c.Reader("truncated", 0, 0)
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	require.YesError(t, err)
+}
+
+func TestEncryptedClientRewrapKeys(t *testing.T) {
+	local := newTestLocalClient(t)
+	defer os.RemoveAll(local.(*localClient).root)
+	oldKey, newKey := newKey(t), newKey(t)
+	c, err := NewEncryptedClient(local, oldKey)
+	require.NoError(t, err)
+	writeObject(t, c, "dir/object", []byte("foo"))
+
+	// Rotate the master key
+	c, err = NewEncryptedClient(local, newKey, oldKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), readObject(t, c, "dir/object", 0, 0))
+	writeObject(t, c, "dir/new", []byte("bar"))
+	rewrapped, err := RewrapKeys(c, "dir")
+	require.NoError(t, err)
+	require.Equal(t, 1, rewrapped)
+	require.Equal(t, []string{"dir/new", "dir/object"}, walkObjects(t, local, "dir"))
+
+	// The old key is no longer needed
+	c, err = NewEncryptedClient(local, newKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), readObject(t, c, "dir/object", 0, 0))
+	require.Equal(t, []byte("bar"), readObject(t, c, "dir/new", 0, 0))
+
+	// Objects can't be read without their master key
+	c, err = NewEncryptedClient(local, oldKey)
+	require.NoError(t, err)
+	_, err = c.Reader("dir/object", 0, 0)
+	require.YesError(t, err)
+}
+
+func walkObjects(t *testing.T, c Client, prefix string) []string {
+	var names []string
+	require.NoError(t, c.Walk(prefix, func(name string) error {
+		names = append(names, name)
+		return nil
+	}))
+	sort.Strings(names)
+	return names
+}
+
+func TestEncryptedClientRewrapKeysInterrupted(t *testing.T) {
+	local := newTestLocalClient(t)
+	defer os.RemoveAll(local.(*localClient).root)
+	oldKey, newKey := newKey(t), newKey(t)
+	c, err := NewEncryptedClient(local, oldKey)
+	require.NoError(t, err)
+	writeObject(t, c, "dir/a", []byte("foo"))
+	writeObject(t, c, "dir/b", []byte("bar"))
+	stored := readObject(t, local, "dir/b", 0, 0)
+
+	// Simulate a re-wrap that was interrupted after writing the temporary
+	// object of 'a', and one that was interrupted while swapping in 'b'
+	c, err = NewEncryptedClient(local, newKey, oldKey)
+	require.NoError(t, err)
+	writeObject(t, local, "dir/rewrap/a", []byte("incomplete"))
+	_, err = c.(*encryptedClient).rewrapKey("dir/b", "dir/rewrap/b")
+	require.NoError(t, err)
+	rewrappedB := readObject(t, local, "dir/b", 0, 0)
+	writeObject(t, local, "dir/rewrap/b", rewrappedB)
+	writeObject(t, local, "dir/b", rewrappedB[:len(rewrappedB)-10])
+	require.NotEqual(t, stored, rewrappedB)
+
+	_, err = RewrapKeys(c, "dir")
+	require.NoError(t, err)
+	require.Equal(t, []string{"dir/a", "dir/b"}, walkObjects(t, local, "dir"))
+	c, err = NewEncryptedClient(local, newKey)
+	require.NoError(t, err)
+	require.Equal(t, []byte("foo"), readObject(t, c, "dir/a", 0, 0))
+	require.Equal(t, []byte("bar"), readObject(t, c, "dir/b", 0, 0))
+}
+
+// deletingClient deletes the object 'victim' when the first object written
+// through it is closed, like garbage collection running during a re-wrap
+type deletingClient struct {
+	Client
+	victim string
+}
+
+func (c *deletingClient) Writer(name string) (io.WriteCloser, error) {
+	w, err := c.Client.Writer(name)
+	if err != nil {
+		return nil, err
+	}
+	return &deletingWriter{WriteCloser: w, c: c}, nil
+}
+
+type deletingWriter struct {
+	io.WriteCloser
+	c *deletingClient
+}
+
+func (w *deletingWriter) Close() error {
+	if err := w.WriteCloser.Close(); err != nil {
+		return err
+	}
+	if w.c.victim == "" {
+		return nil
+	}
+	victim := w.c.victim
+	w.c.victim = ""
+	return w.c.Client.Delete(victim)
+}
+
+func TestEncryptedClientRewrapKeysDeleted(t *testing.T) {
+	local := newTestLocalClient(t)
+	defer os.RemoveAll(local.(*localClient).root)
+	oldKey, newKey := newKey(t), newKey(t)
+	c, err := NewEncryptedClient(local, oldKey)
+	require.NoError(t, err)
+	writeObject(t, c, "dir/object", []byte("foo"))
+
+	// 'dir/object' is deleted once its re-wrapped copy has been written, and
+	// isn't recreated
+	c, err = NewEncryptedClient(&deletingClient{Client: local, victim: "dir/object"}, newKey, oldKey)
+	require.NoError(t, err)
+	rewrapped, err := RewrapKeys(c, "dir")
+	require.NoError(t, err)
+	require.Equal(t, 0, rewrapped)
+	require.Equal(t, 0, len(walkObjects(t, local, "dir")))
+}
+
+// failingClient fails every read
+type failingClient struct {
+	Client
+}
+
+func (c failingClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	return nil, errors.New("connection reset")
+}
+
+func TestEncryptedClientReadError(t *testing.T) {
+	local := newTestLocalClient(t)
+	defer os.RemoveAll(local.(*localClient).root)
+	c, err := NewEncryptedClient(local, newKey(t))
+	require.NoError(t, err)
+	writeObject(t, c, "object", []byte("foo"))
+
+	// Errors reading the header aren't mistaken for unencrypted objects
+	c, err = NewEncryptedClient(failingClient{local}, newKey(t))
+	require.NoError(t, err)
+	_, err = c.Reader("object", 0, 0)
+	require.YesError(t, err)
+}
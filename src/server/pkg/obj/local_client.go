@@ -40,8 +40,24 @@ func (c *localClient) Reader(path string, offset uint64, size uint64) (io.ReadCl
 	if err != nil {
 		return nil, err
 	}
+	if _, err := file.Seek(int64(offset), io.SeekStart); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if size == 0 {
+		return file, nil
+	}
+	return &limitedReadCloser{io.LimitReader(file, int64(size)), file}, nil
+}
 
-	return file, nil
+// limitedReadCloser reads a range of a file
+type limitedReadCloser struct {
+	io.Reader
+	file *os.File
+}
+
+func (r *limitedReadCloser) Close() error {
+	return r.file.Close()
 }
 
 func (c *localClient) Delete(path string) error {
@@ -0,0 +1,142 @@
+package obj
+
+import (
+	"io"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
+)
+
+var (
+	objBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_object_storage_bytes_total",
+		Help: "Number of bytes read from and written to object storage, by operation (read or write).",
+	}, []string{"op"})
+	objDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pachyderm_object_storage_duration_seconds",
+		Help:    "Time taken by object storage operations, by operation. Reads and writes are timed from when the object is opened until it's closed.",
+		Buckets: prom.DefBuckets,
+	}, []string{"op"})
+	objErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_object_storage_errors_total",
+		Help: "Number of object storage operations that failed, by operation. Reads of objects that don't exist aren't counted.",
+	}, []string{"op"})
+)
+
+func init() {
+	prometheus.MustRegister(objBytes, objDuration, objErrors)
+}
+
+type metricsClient struct {
+	Client
+}
+
+// NewMetricsClient returns a Client that exports the number of bytes that
+// are read from and written to 'c', and the latency and errors of its
+// operations, as Prometheus metrics
+func NewMetricsClient(c Client) Client {
+	return &metricsClient{Client: c}
+}
+
+func (c *metricsClient) observe(op string, start time.Time, err error) {
+	objDuration.WithLabelValues(op).Observe(time.Since(start).Seconds())
+	if err != nil && !c.IsNotExist(err) {
+		objErrors.WithLabelValues(op).Inc()
+	}
+}
+
+func (c *metricsClient) Writer(name string) (io.WriteCloser, error) {
+	start := time.Now()
+	w, err := c.Client.Writer(name)
+	if err != nil {
+		c.observe("write", start, err)
+		return nil, err
+	}
+	return &metricsWriter{c: c, w: w, start: start}, nil
+}
+
+func (c *metricsClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	start := time.Now()
+	r, err := c.Client.Reader(name, offset, size)
+	if err != nil {
+		c.observe("read", start, err)
+		return nil, err
+	}
+	return &metricsReader{c: c, r: r, start: start}, nil
+}
+
+func (c *metricsClient) Delete(name string) error {
+	start := time.Now()
+	err := c.Client.Delete(name)
+	c.observe("delete", start, err)
+	return err
+}
+
+func (c *metricsClient) Walk(prefix string, fn func(name string) error) error {
+	start := time.Now()
+	err := c.Client.Walk(prefix, fn)
+	c.observe("walk", start, err)
+	return err
+}
+
+func (c *metricsClient) Exists(name string) bool {
+	start := time.Now()
+	defer c.observe("exists", start, nil)
+	return c.Client.Exists(name)
+}
+
+type metricsWriter struct {
+	c     *metricsClient
+	w     io.WriteCloser
+	start time.Time
+	// err is the first error returned by Write
+	err error
+}
+
+func (w *metricsWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	objBytes.WithLabelValues("write").Add(float64(n))
+	if err != nil && w.err == nil {
+		w.err = err
+	}
+	return n, err
+}
+
+func (w *metricsWriter) Close() error {
+	err := w.w.Close()
+	if w.err != nil {
+		w.c.observe("write", w.start, w.err)
+	} else {
+		w.c.observe("write", w.start, err)
+	}
+	return err
+}
+
+type metricsReader struct {
+	c     *metricsClient
+	r     io.ReadCloser
+	start time.Time
+	// err is the first error (other than io.EOF) returned by Read
+	err error
+}
+
+func (r *metricsReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	objBytes.WithLabelValues("read").Add(float64(n))
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+func (r *metricsReader) Close() error {
+	err := r.r.Close()
+	if r.err != nil {
+		r.c.observe("read", r.start, r.err)
+	} else {
+		r.c.observe("read", r.start, err)
+	}
+	return err
+}
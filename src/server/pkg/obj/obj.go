@@ -223,7 +223,7 @@ func NewAmazonClientFromSecret(bucket string) (Client, error) {
 	// Get Cloudfront distribution (not required, though we can log a warning)
 	distribution, err := readSecretFile("/amazon-distribution")
 	if err != nil {
-		log.Warnln("AWS deployed without cloudfront distribution\n")
+		log.Warnln("AWS deployed without cloudfront distribution")
 	} else {
 		log.Infof("AWS deployed with cloudfront distribution at %v\n", string(distribution))
 	}
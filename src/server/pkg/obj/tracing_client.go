@@ -0,0 +1,106 @@
+package obj
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
+	"golang.org/x/net/context"
+)
+
+type tracingClient struct {
+	Client
+	ctx context.Context
+}
+
+// TracingClient returns a Client whose operations on 'c' are traced as
+// children of the span in 'ctx'. Reads and writes are traced from when the
+// object is opened until it's closed. If 'ctx' doesn't contain a span, 'c' is
+// returned as-is.
+func TracingClient(ctx context.Context, c Client) Client {
+	if tracing.TraceParent(ctx) == "" {
+		return c
+	}
+	return &tracingClient{Client: c, ctx: ctx}
+}
+
+func (c *tracingClient) Writer(name string) (io.WriteCloser, error) {
+	span, _ := tracing.StartSpan(c.ctx, "obj.Writer", "object", name)
+	w, err := c.Client.Writer(name)
+	if err != nil {
+		span.Finish(err)
+		return nil, err
+	}
+	return &tracingWriter{w: w, span: span}, nil
+}
+
+func (c *tracingClient) Reader(name string, offset uint64, size uint64) (io.ReadCloser, error) {
+	span, _ := tracing.StartSpan(c.ctx, "obj.Reader", "object", name,
+		"offset", fmt.Sprint(offset), "size", fmt.Sprint(size))
+	r, err := c.Client.Reader(name, offset, size)
+	if err != nil {
+		span.Finish(err)
+		return nil, err
+	}
+	return &tracingReader{r: r, span: span}, nil
+}
+
+func (c *tracingClient) Delete(name string) error {
+	span, _ := tracing.StartSpan(c.ctx, "obj.Delete", "object", name)
+	err := c.Client.Delete(name)
+	span.Finish(err)
+	return err
+}
+
+func (c *tracingClient) Walk(prefix string, fn func(name string) error) error {
+	span, _ := tracing.StartSpan(c.ctx, "obj.Walk", "prefix", prefix)
+	err := c.Client.Walk(prefix, fn)
+	span.Finish(err)
+	return err
+}
+
+func (c *tracingClient) Exists(name string) bool {
+	span, _ := tracing.StartSpan(c.ctx, "obj.Exists", "object", name)
+	exists := c.Client.Exists(name)
+	span.SetAttribute("exists", fmt.Sprint(exists))
+	span.Finish(nil)
+	return exists
+}
+
+type tracingWriter struct {
+	w     io.WriteCloser
+	span  *tracing.Span
+	bytes int
+}
+
+func (w *tracingWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.bytes += n
+	return n, err
+}
+
+func (w *tracingWriter) Close() error {
+	err := w.w.Close()
+	w.span.SetAttribute("bytes", fmt.Sprint(w.bytes))
+	w.span.Finish(err)
+	return err
+}
+
+type tracingReader struct {
+	r     io.ReadCloser
+	span  *tracing.Span
+	bytes int
+}
+
+func (r *tracingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	r.bytes += n
+	return n, err
+}
+
+func (r *tracingReader) Close() error {
+	err := r.r.Close()
+	r.span.SetAttribute("bytes", fmt.Sprint(r.bytes))
+	r.span.Finish(err)
+	return err
+}
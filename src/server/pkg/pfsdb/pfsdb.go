@@ -5,18 +5,19 @@ import (
 	"fmt"
 	"path"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/uuid"
 )
 
 const (
-	reposPrefix          = "/repos"
-	putFileRecordsPrefix = "/putFileRecords"
-	commitsPrefix        = "/commits"
-	branchesPrefix       = "/branches"
-	openCommitsPrefix    = "/openCommits"
+	reposPrefix            = "/repos"
+	putFileRecordsPrefix   = "/putFileRecords"
+	commitsPrefix          = "/commits"
+	branchesPrefix         = "/branches"
+	openCommitsPrefix      = "/openCommits"
+	replicationsPrefix     = "/replications"
+	replicationInfosPrefix = "/replicationInfos"
 )
 
 var (
@@ -25,9 +26,8 @@ var (
 )
 
 // Repos returns a collection of repos
-func Repos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Repos(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, reposPrefix),
 		nil,
 		&pfs.RepoInfo{},
@@ -36,9 +36,8 @@ func Repos(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 }
 
 // PutFileRecords returns a collection of putFileRecords
-func PutFileRecords(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func PutFileRecords(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, putFileRecordsPrefix),
 		nil,
 		&pfs.PutFileRecords{},
@@ -47,9 +46,8 @@ func PutFileRecords(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 }
 
 // Commits returns a collection of commits
-func Commits(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Commits(store col.Store, etcdPrefix string, repo string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, commitsPrefix, repo),
 		[]col.Index{ProvenanceIndex},
 		&pfs.CommitInfo{},
@@ -58,9 +56,8 @@ func Commits(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collec
 }
 
 // Branches returns a collection of branches
-func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Branches(store col.Store, etcdPrefix string, repo string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, branchesPrefix, repo),
 		nil,
 		&pfs.BranchInfo{},
@@ -74,12 +71,32 @@ func Branches(etcdClient *etcd.Client, etcdPrefix string, repo string) col.Colle
 }
 
 // OpenCommits returns a collection of open commits
-func OpenCommits(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func OpenCommits(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, openCommitsPrefix),
 		nil,
 		&pfs.Commit{},
 		nil,
 	)
 }
+
+// Replications returns a collection of replications
+func Replications(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
+		path.Join(etcdPrefix, replicationsPrefix),
+		nil,
+		&pfs.EtcdReplication{},
+		nil,
+	)
+}
+
+// ReplicationInfos returns a collection of replication statuses, which are
+// written by the replicators. It's keyed the same way as Replications.
+func ReplicationInfos(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
+		path.Join(etcdPrefix, replicationInfosPrefix),
+		nil,
+		&pfs.ReplicationInfo{},
+		nil,
+	)
+}
@@ -4,8 +4,6 @@ package ppsdb
 import (
 	"path"
 
-	etcd "github.com/coreos/etcd/clientv3"
-
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 )
@@ -13,6 +11,9 @@ import (
 const (
 	pipelinesPrefix = "/pipelines"
 	jobsPrefix      = "/jobs"
+	jobQueuePrefix  = "/job_queue"
+
+	maxConcurrentJobsKey = "/max_concurrent_jobs"
 )
 
 var (
@@ -28,9 +29,8 @@ var (
 )
 
 // Pipelines returns a Collection of pipelines
-func Pipelines(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Pipelines(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, pipelinesPrefix),
 		[]col.Index{},
 		&pps.EtcdPipelineInfo{},
@@ -39,12 +39,29 @@ func Pipelines(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
 }
 
 // Jobs returns a Collection of jobs
-func Jobs(etcdClient *etcd.Client, etcdPrefix string) col.Collection {
-	return col.NewCollection(
-		etcdClient,
+func Jobs(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
 		path.Join(etcdPrefix, jobsPrefix),
 		[]col.Index{JobsPipelineIndex, JobsOutputIndex},
 		&pps.EtcdJobInfo{},
 		nil,
 	)
 }
+
+// MaxConcurrentJobsKey returns the etcd key at which pachd stores the
+// cluster's max_concurrent_jobs setting, so that workers know whether their
+// jobs need to wait in the job queue
+func MaxConcurrentJobsKey(etcdPrefix string) string {
+	return path.Join(etcdPrefix, maxConcurrentJobsKey)
+}
+
+// JobQueue returns a Collection of the jobs that are waiting for, or have
+// been admitted to, one of the cluster's max_concurrent_jobs slots
+func JobQueue(store col.Store, etcdPrefix string) col.Collection {
+	return store.NewCollection(
+		path.Join(etcdPrefix, jobQueuePrefix),
+		nil,
+		&pps.EtcdJobQueueEntry{},
+		nil,
+	)
+}
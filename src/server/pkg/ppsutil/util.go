@@ -25,10 +25,15 @@ import (
 	"net/url"
 	"os"
 	"path"
+	"regexp"
 	"strings"
+	"text/template"
+	"time"
 
+	"github.com/ghodss/yaml"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pps"
@@ -36,7 +41,6 @@ import (
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsconsts"
 
-	etcd "github.com/coreos/etcd/clientv3"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
 	"k8s.io/api/core/v1"
@@ -128,6 +132,10 @@ func getNumNodes(kubeClient *kube.Clientset) (int, error) {
 //
 // This is only exported for testing
 func GetExpectedNumWorkers(kubeClient *kube.Clientset, spec *ppsclient.ParallelismSpec) (int, error) {
+	if spec != nil && spec.Autoscaling != nil {
+		// Autoscaling pipelines may be scaled up to 'max' workers
+		return int(spec.Autoscaling.Max), nil
+	}
 	if spec == nil || (spec.Constant == 0 && spec.Coefficient == 0) {
 		return 1, nil
 	} else if spec.Constant > 0 && spec.Coefficient == 0 {
@@ -159,12 +167,13 @@ func GetPipelineInfo(pachClient *client.APIClient, ptr *pps.EtcdPipelineInfo) (*
 	result.Reason = ptr.Reason
 	result.JobCounts = ptr.JobCounts
 	result.SpecCommit = ptr.SpecCommit
+	result.AutoscalingStatus = ptr.AutoscalingStatus
 	return result, nil
 }
 
 // FailPipeline updates the pipeline's state to failed and sets the failure reason
-func FailPipeline(ctx context.Context, etcdClient *etcd.Client, pipelinesCollection col.Collection, pipelineName string, reason string) error {
-	_, err := col.NewSTM(ctx, etcdClient, func(stm col.STM) error {
+func FailPipeline(ctx context.Context, store col.Store, pipelinesCollection col.Collection, pipelineName string, reason string) error {
+	_, err := store.NewSTM(ctx, func(stm col.STM) error {
 		pipelines := pipelinesCollection.ReadWrite(stm)
 		pipelinePtr := new(pps.EtcdPipelineInfo)
 		if err := pipelines.Get(pipelineName, pipelinePtr); err != nil {
@@ -207,6 +216,111 @@ func JobInput(pipelineInfo *pps.PipelineInfo, outputCommitInfo *pfs.CommitInfo)
 	return jobInput
 }
 
+const (
+	// DefaultUserImage is the image used for jobs when the user does not specify
+	// an image.
+	DefaultUserImage = "ubuntu:16.04"
+	// DefaultDatumTries is the number of times a worker tries to process a
+	// datum if the pipeline doesn't set datum_tries
+	DefaultDatumTries = 3
+	// DefaultDatumBackoff is how long a worker waits before retrying a failed
+	// datum if the pipeline doesn't set datum_backoff
+	DefaultDatumBackoff = time.Second
+)
+
+// SetPipelineDefaults fills in the fields of 'pipelineInfo' that the user
+// didn't set with pachd's defaults. pachd calls it when a pipeline is created
+// or updated, and pachctl calls it to compare pipeline specs with the
+// pipelines that pachd created from them.
+func SetPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
+	now := time.Now()
+	if pipelineInfo.Transform.Image == "" {
+		pipelineInfo.Transform.Image = DefaultUserImage
+	}
+	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
+		if input.Atom != nil {
+			if input.Atom.Branch == "" {
+				input.Atom.Branch = "master"
+			}
+			if input.Atom.Name == "" {
+				input.Atom.Name = input.Atom.Repo
+			}
+		}
+		if input.Cron != nil {
+			if input.Cron.Start == nil {
+				start, _ := types.TimestampProto(now)
+				input.Cron.Start = start
+			}
+			if input.Cron.Repo == "" {
+				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineInfo.Pipeline.Name, input.Cron.Name)
+			}
+		}
+		if input.Git != nil {
+			if input.Git.Branch == "" {
+				input.Git.Branch = "master"
+			}
+			if input.Git.Name == "" {
+				// We know URL looks like:
+				// "https://github.com/sjezewski/testgithook.git",
+				tokens := strings.Split(path.Base(input.Git.URL), ".")
+				input.Git.Name = tokens[0]
+			}
+		}
+	})
+	if pipelineInfo.OutputBranch == "" {
+		// Output branches default to master
+		pipelineInfo.OutputBranch = "master"
+	}
+	if pipelineInfo.CacheSize == "" {
+		pipelineInfo.CacheSize = "64M"
+	}
+	if pipelineInfo.ResourceRequests == nil && pipelineInfo.CacheSize != "" {
+		pipelineInfo.ResourceRequests = &pps.ResourceSpec{
+			Memory: pipelineInfo.CacheSize,
+		}
+	}
+	if pipelineInfo.MaxQueueSize < 1 {
+		pipelineInfo.MaxQueueSize = 1
+	}
+	if pipelineInfo.DatumTries == 0 {
+		pipelineInfo.DatumTries = DefaultDatumTries
+	}
+	if pipelineInfo.DatumBackoff == nil {
+		pipelineInfo.DatumBackoff = types.DurationProto(DefaultDatumBackoff)
+	}
+}
+
+// PipelineInfoFromReq converts a CreatePipelineRequest into the PipelineInfo
+// that it describes (without pachd's defaults, see SetPipelineDefaults).
+func PipelineInfoFromReq(request *ppsclient.CreatePipelineRequest) *ppsclient.PipelineInfo {
+	return &ppsclient.PipelineInfo{
+		Pipeline:           request.Pipeline,
+		Transform:          request.Transform,
+		ParallelismSpec:    request.ParallelismSpec,
+		Input:              request.Input,
+		OutputBranch:       request.OutputBranch,
+		Egress:             request.Egress,
+		ScaleDownThreshold: request.ScaleDownThreshold,
+		ResourceRequests:   request.ResourceRequests,
+		ResourceLimits:     request.ResourceLimits,
+		Description:        request.Description,
+		Incremental:        request.Incremental,
+		CacheSize:          request.CacheSize,
+		EnableStats:        request.EnableStats,
+		Salt:               request.Salt,
+		Batch:              request.Batch,
+		MaxQueueSize:       request.MaxQueueSize,
+		Service:            request.Service,
+		ChunkSpec:          request.ChunkSpec,
+		DatumTimeout:       request.DatumTimeout,
+		JobTimeout:         request.JobTimeout,
+		DatumTries:         request.DatumTries,
+		DatumBackoff:       request.DatumBackoff,
+		SkipFailedDatums:   request.SkipFailedDatums,
+		Priority:           request.Priority,
+	}
+}
+
 // PipelineReqFromInfo converts a PipelineInfo into a CreatePipelineRequest.
 func PipelineReqFromInfo(pipelineInfo *ppsclient.PipelineInfo) *ppsclient.CreatePipelineRequest {
 	return &ppsclient.CreatePipelineRequest{
@@ -229,24 +343,36 @@ func PipelineReqFromInfo(pipelineInfo *ppsclient.PipelineInfo) *ppsclient.Create
 		ChunkSpec:          pipelineInfo.ChunkSpec,
 		DatumTimeout:       pipelineInfo.DatumTimeout,
 		JobTimeout:         pipelineInfo.JobTimeout,
+		DatumTries:         pipelineInfo.DatumTries,
+		DatumBackoff:       pipelineInfo.DatumBackoff,
+		SkipFailedDatums:   pipelineInfo.SkipFailedDatums,
+		Priority:           pipelineInfo.Priority,
 		Salt:               pipelineInfo.Salt,
 	}
 }
 
-// PipelineManifestReader helps with unmarshalling pipeline configs from JSON. It's used by
-// create-pipeline and update-pipeline
+// PipelineManifestReader helps with unmarshalling pipeline configs from JSON
+// or YAML. It's used by create-pipeline and update-pipeline. A manifest may
+// contain several pipelines, as a stream of JSON objects, a JSON array, or a
+// series of YAML documents separated by "---".
 type PipelineManifestReader struct {
 	buf     bytes.Buffer
 	decoder *json.Decoder
 }
 
-// NewPipelineManifestReader creates a new manifest reader from a path.
-func NewPipelineManifestReader(path string) (result *PipelineManifestReader, retErr error) {
+// NewPipelineManifestReader creates a new manifest reader from a path. If
+// 'args' is non-nil, the manifest is treated as a template and rendered with
+// 'args' (see RenderPipelineTemplate) before it's parsed.
+func NewPipelineManifestReader(path string, args map[string]string) (result *PipelineManifestReader, retErr error) {
 	result = &PipelineManifestReader{}
-	var pipelineReader io.Reader
+	var rawBytes []byte
 	if path == "-" {
-		pipelineReader = io.TeeReader(os.Stdin, &result.buf)
 		fmt.Print("Reading from stdin.\n")
+		var err error
+		rawBytes, err = ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
 	} else if url, err := url.Parse(path); err == nil && url.Scheme != "" {
 		resp, err := http.Get(url.String())
 		if err != nil {
@@ -257,20 +383,28 @@ func NewPipelineManifestReader(path string) (result *PipelineManifestReader, ret
 				retErr = err
 			}
 		}()
-		rawBytes, err := ioutil.ReadAll(resp.Body)
+		rawBytes, err = ioutil.ReadAll(resp.Body)
 		if err != nil {
 			return nil, err
 		}
-		pipelineReader = io.TeeReader(strings.NewReader(string(rawBytes)), &result.buf)
 	} else {
-		rawBytes, err := ioutil.ReadFile(path)
+		rawBytes, err = ioutil.ReadFile(path)
 		if err != nil {
 			return nil, err
 		}
-
-		pipelineReader = io.TeeReader(strings.NewReader(string(rawBytes)), &result.buf)
 	}
-	result.decoder = json.NewDecoder(pipelineReader)
+	if args != nil {
+		var err error
+		rawBytes, err = RenderPipelineTemplate(rawBytes, args)
+		if err != nil {
+			return nil, err
+		}
+	}
+	jsonBytes, err := manifestToJSON(rawBytes)
+	if err != nil {
+		return nil, err
+	}
+	result.decoder = json.NewDecoder(io.TeeReader(bytes.NewReader(jsonBytes), &result.buf))
 	return result, nil
 }
 
@@ -286,6 +420,94 @@ func (r *PipelineManifestReader) NextCreatePipelineRequest() (*ppsclient.CreateP
 	return &result, nil
 }
 
+// NextRequest gets the next request from the manifest reader. Manifests read
+// by 'pachctl apply' may declare repos alongside pipelines, so the request is
+// a *pfs.CreateRepoRequest if the spec has a top-level "repo" field, and a
+// *pps.CreatePipelineRequest otherwise.
+func (r *PipelineManifestReader) NextRequest() (proto.Message, error) {
+	var raw json.RawMessage
+	if err := r.decoder.Decode(&raw); err != nil {
+		if err == io.EOF {
+			return nil, err
+		}
+		return nil, fmt.Errorf("malformed spec: %s", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil, fmt.Errorf("malformed spec: %s", err)
+	}
+	var result proto.Message = &ppsclient.CreatePipelineRequest{}
+	if _, ok := fields["repo"]; ok {
+		result = &pfs.CreateRepoRequest{}
+	}
+	if err := jsonpb.Unmarshal(bytes.NewReader(raw), result); err != nil {
+		return nil, fmt.Errorf("malformed spec: %s", err)
+	}
+	return result, nil
+}
+
+// RenderPipelineTemplate renders the pipeline manifest 'manifest', which may
+// refer to the parameters in 'args' as {{.param}} (it's a Go text/template).
+// Referring to a parameter that isn't in 'args' is an error.
+func RenderPipelineTemplate(manifest []byte, args map[string]string) ([]byte, error) {
+	tmpl, err := template.New("pipeline").Option("missingkey=error").Parse(string(manifest))
+	if err != nil {
+		return nil, fmt.Errorf("malformed pipeline template: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, args); err != nil {
+		return nil, fmt.Errorf("could not render pipeline template: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// yamlDocumentSeparator matches the lines that separate the documents in a
+// YAML stream
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// manifestToJSON converts a pipeline manifest to a stream of JSON objects,
+// one per pipeline. JSON object streams are returned unchanged (so that
+// syntax errors are reported against the original manifest), while JSON
+// arrays and YAML documents are converted.
+func manifestToJSON(manifest []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(manifest)
+	if len(trimmed) == 0 || trimmed[0] == '{' {
+		return manifest, nil
+	}
+	var docs [][]byte
+	if trimmed[0] == '[' {
+		docs = [][]byte{trimmed}
+	} else {
+		for _, doc := range yamlDocumentSeparator.Split(string(manifest), -1) {
+			if strings.TrimSpace(doc) == "" {
+				continue
+			}
+			jsonDoc, err := yaml.YAMLToJSON([]byte(doc))
+			if err != nil {
+				return nil, fmt.Errorf("malformed pipeline spec: %v", err)
+			}
+			docs = append(docs, jsonDoc)
+		}
+	}
+	var result bytes.Buffer
+	for _, doc := range docs {
+		if doc[0] != '[' {
+			result.Write(doc)
+			result.WriteString("\n")
+			continue
+		}
+		var pipelines []json.RawMessage
+		if err := json.Unmarshal(doc, &pipelines); err != nil {
+			return nil, fmt.Errorf("malformed pipeline spec: %v", err)
+		}
+		for _, pipeline := range pipelines {
+			result.Write(pipeline)
+			result.WriteString("\n")
+		}
+	}
+	return result.Bytes(), nil
+}
+
 // DescribeSyntaxError describes a syntax error encountered parsing json.
 func DescribeSyntaxError(originalErr error, parsedBuffer bytes.Buffer) error {
 
@@ -320,7 +542,7 @@ func DescribeSyntaxError(originalErr error, parsedBuffer bytes.Buffer) error {
 // otherwise.
 func IsTerminal(state pps.JobState) bool {
 	switch state {
-	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED:
+	case pps.JobState_JOB_SUCCESS, pps.JobState_JOB_SUCCESS_WITH_FAILURES, pps.JobState_JOB_FAILURE, pps.JobState_JOB_KILLED:
 		return true
 	case pps.JobState_JOB_STARTING, pps.JobState_JOB_RUNNING:
 		return false
@@ -0,0 +1,84 @@
+package ppsutil
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+)
+
+func readManifest(t *testing.T, manifest string, args map[string]string) ([]string, error) {
+	f, err := ioutil.TempFile("", "pipeline")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(manifest)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	reader, err := NewPipelineManifestReader(f.Name(), args)
+	if err != nil {
+		return nil, err
+	}
+	var specs []string
+	for {
+		request, err := reader.NextCreatePipelineRequest()
+		if err == io.EOF {
+			return specs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		specs = append(specs, request.Pipeline.Name+":"+request.Transform.Image)
+	}
+}
+
+func TestManifestJSONStream(t *testing.T) {
+	specs, err := readManifest(t, `
+{"pipeline": {"name": "a"}, "transform": {"image": "ubuntu"}}
+{"pipeline": {"name": "b"}, "transform": {"image": "alpine"}}
+`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:ubuntu", "b:alpine"}, specs)
+}
+
+func TestManifestJSONArray(t *testing.T) {
+	specs, err := readManifest(t, `[
+  {"pipeline": {"name": "a"}, "transform": {"image": "ubuntu"}},
+  {"pipeline": {"name": "b"}, "transform": {"image": "alpine"}}
+]`, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a:ubuntu", "b:alpine"}, specs)
+}
+
+func TestManifestYAMLTemplate(t *testing.T) {
+	manifest := `
+pipeline:
+  name: {{.name}}-edges
+transform:
+  image: {{.image}}
+---
+pipeline:
+  name: {{.name}}-montage
+transform:
+  image: {{.image}}
+`
+	specs, err := readManifest(t, manifest, map[string]string{"name": "images", "image": "ubuntu"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"images-edges:ubuntu", "images-montage:ubuntu"}, specs)
+
+	// Missing parameters are an error, rather than being rendered as ""
+	_, err = readManifest(t, manifest, map[string]string{"name": "images"})
+	require.YesError(t, err)
+	require.Matches(t, "image", err.Error())
+}
+
+func TestManifestMalformed(t *testing.T) {
+	_, err := readManifest(t, `{"pipeline": {"name": "a"`, nil)
+	require.YesError(t, err)
+	require.Matches(t, "malformed pipeline spec", err.Error())
+
+	_, err = readManifest(t, "pipeline: [", nil)
+	require.YesError(t, err)
+	require.Matches(t, "malformed pipeline spec", err.Error())
+}
@@ -0,0 +1,65 @@
+package prom
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_rpc_requests_total",
+		Help: "Number of RPCs served, by service, method and status code.",
+	}, []string{"service", "method", "code"})
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pachyderm_rpc_duration_seconds",
+		Help:    "Time taken to serve RPCs (for streaming RPCs, the lifetime of the stream), by service and method.",
+		Buckets: DefBuckets,
+	}, []string{"service", "method"})
+)
+
+func init() {
+	prometheus.MustRegister(rpcRequests, rpcDuration)
+}
+
+// splitMethod splits a gRPC method name, such as "/pfs.API/PutFile", into
+// its service ("pfs.API") and method ("PutFile")
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "unknown", fullMethod
+}
+
+func observeRPC(fullMethod string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	rpcRequests.WithLabelValues(service, method, status.Code(err).String()).Inc()
+	rpcDuration.WithLabelValues(service, method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor that records the latency
+// and status code of every unary RPC that a server serves
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		observeRPC(info.FullMethod, start, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor that records the
+// duration and status code of every streaming RPC that a server serves
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		err := handler(srv, stream)
+		observeRPC(info.FullMethod, start, err)
+		return err
+	}
+}
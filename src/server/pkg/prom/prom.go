@@ -0,0 +1,103 @@
+// Package prom holds what pachd and the workers share for exporting metrics
+// to Prometheus: the ports and path that metrics are served on, histogram
+// buckets, gRPC interceptors, and the pod annotations that get them scraped.
+// Metrics themselves are created with the Prometheus client library, and
+// registered in its default registry.
+package prom
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+const (
+	// MetricsPath is the HTTP path at which metrics are served
+	MetricsPath = "/metrics"
+	// PachdPort is the port on which pachd (in both full and sidecar mode)
+	// serves metrics
+	PachdPort = 656
+	// WorkerPort is the port on which workers serve metrics. It differs from
+	// PachdPort because each worker pod also runs a pachd sidecar.
+	WorkerPort = 657
+
+	// sidecarLabel is the label that distinguishes the pachd sidecar's
+	// metrics from the worker's, in the metrics that a worker serves
+	sidecarLabel = "pachd_sidecar"
+)
+
+// DefBuckets are the default histogram buckets, in seconds, which suit
+// latencies of RPCs and object storage requests
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+// DurationBuckets are histogram buckets, in seconds, that suit longer
+// operations, such as downloading, processing or uploading a datum
+var DurationBuckets = []float64{.1, .5, 1, 5, 10, 30, 60, 300, 600, 1800, 3600}
+
+// ListenAndServe serves the metrics in the default Prometheus registry, and
+// those collected by 'gatherers', at MetricsPath on 'port'. Like
+// http.ListenAndServe, it only returns on error.
+func ListenAndServe(port uint16, gatherers ...prometheus.Gatherer) error {
+	mux := http.NewServeMux()
+	mux.Handle(MetricsPath, promhttp.HandlerFor(
+		append(prometheus.Gatherers{prometheus.DefaultGatherer}, gatherers...),
+		// Serve the metrics that could be gathered, e.g. if the sidecar is
+		// down
+		promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError},
+	))
+	return http.ListenAndServe(fmt.Sprintf(":%d", port), mux)
+}
+
+// SidecarGatherer returns a Gatherer that scrapes the metrics of the pachd
+// sidecar in a worker's pod, and labels them with pachd_sidecar="true". Pods
+// can only be annotated with one port to scrape, so workers serve their
+// sidecar's metrics along with their own.
+func SidecarGatherer() prometheus.Gatherer {
+	return sidecarGatherer(fmt.Sprintf("http://localhost:%d%s", PachdPort, MetricsPath))
+}
+
+func sidecarGatherer(url string) prometheus.Gatherer {
+	return prometheus.GathererFunc(func() ([]*dto.MetricFamily, error) {
+		resp, err := http.Get(url)
+		if err != nil {
+			return nil, fmt.Errorf("could not scrape the pachd sidecar: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("could not scrape the pachd sidecar: %s", resp.Status)
+		}
+		var parser expfmt.TextParser
+		families, err := parser.TextToMetricFamilies(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse the pachd sidecar's metrics: %v", err)
+		}
+		var result []*dto.MetricFamily
+		for _, family := range families {
+			for _, metric := range family.Metric {
+				metric.Label = append(metric.Label, &dto.LabelPair{
+					Name:  proto.String(sidecarLabel),
+					Value: proto.String("true"),
+				})
+			}
+			result = append(result, family)
+		}
+		return result, nil
+	})
+}
+
+// ScrapeAnnotations returns the pod annotations that tell Prometheus (when
+// it's configured with the conventional Kubernetes pod relabelling rules) to
+// scrape the metrics served on 'port'
+func ScrapeAnnotations(port int) map[string]string {
+	return map[string]string{
+		"prometheus.io/scrape": "true",
+		"prometheus.io/port":   strconv.Itoa(port),
+		"prometheus.io/path":   MetricsPath,
+	}
+}
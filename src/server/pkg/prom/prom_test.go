@@ -0,0 +1,65 @@
+package prom
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	dto "github.com/prometheus/client_model/go"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSplitMethod(t *testing.T) {
+	service, method := splitMethod("/pfs.API/PutFile")
+	require.Equal(t, "pfs.API", service)
+	require.Equal(t, "PutFile", method)
+	service, method = splitMethod("PutFile")
+	require.Equal(t, "unknown", service)
+	require.Equal(t, "PutFile", method)
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.API/Fail"}
+	for i := 0; i < 2; i++ {
+		_, err := interceptor(context.Background(), nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.NotFound, "not found")
+		})
+		require.YesError(t, err)
+	}
+	var m dto.Metric
+	require.NoError(t, rpcRequests.WithLabelValues("test.API", "Fail", "NotFound").Write(&m))
+	require.Equal(t, float64(2), m.GetCounter().GetValue())
+}
+
+func TestSidecarGatherer(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `# HELP pachyderm_rpc_requests_total Number of RPCs served.
+# TYPE pachyderm_rpc_requests_total counter
+pachyderm_rpc_requests_total{service="pfs.API",method="PutFile",code="OK"} 3
+`)
+	}))
+	defer server.Close()
+	families, err := sidecarGatherer(server.URL).Gather()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(families))
+	require.Equal(t, "pachyderm_rpc_requests_total", families[0].GetName())
+	require.Equal(t, 1, len(families[0].Metric))
+	metric := families[0].Metric[0]
+	require.Equal(t, float64(3), metric.GetCounter().GetValue())
+	labels := make(map[string]string)
+	for _, label := range metric.Label {
+		labels[label.GetName()] = label.GetValue()
+	}
+	require.Equal(t, "true", labels[sidecarLabel])
+	require.Equal(t, "PutFile", labels["method"])
+
+	server.Close()
+	_, err = sidecarGatherer(server.URL).Gather()
+	require.YesError(t, err)
+}
@@ -14,6 +14,7 @@ import (
 	pachclient "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/server/pkg/chunker"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
 
@@ -429,25 +430,26 @@ func PushFile(client *pachclient.APIClient, pfsFile *pfs.File, osFile io.ReadSee
 		return err
 	}
 
+	// pachd splits files into content-defined chunks, so we split osFile the
+	// same way and only upload the data after the first chunk that differs
 	var i int
-	var object *pfs.Object
+	var offset int64
 	if fileInfo != nil {
-		for i, object = range fileInfo.Objects {
+		c := chunker.New(osFile)
+		for ; i < len(fileInfo.Objects); i++ {
 			hash := pfs.NewHash()
-			if _, err := io.CopyN(hash, osFile, pfs.ChunkSize); err != nil {
-				if err == io.EOF {
-					break
-				}
+			size, err := c.Next(hash)
+			if err != nil && err != io.EOF {
 				return err
 			}
-
-			if object.Hash != pfs.EncodeHash(hash.Sum(nil)) {
+			if size == 0 || fileInfo.Objects[i].Hash != pfs.EncodeHash(hash.Sum(nil)) {
 				break
 			}
+			offset += size
 		}
 	}
 
-	if _, err := osFile.Seek(int64(i)*pfs.ChunkSize, 0); err != nil {
+	if _, err := osFile.Seek(offset, 0); err != nil {
 		return err
 	}
 
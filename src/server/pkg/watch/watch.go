@@ -10,6 +10,7 @@ import (
 	"reflect"
 
 	etcd "github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	"github.com/gogo/protobuf/proto"
 )
 
@@ -94,37 +95,64 @@ func (s byModRev) Less(i, j int) bool {
 
 // NewWatcher watches a given etcd prefix for events.
 func NewWatcher(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message) (Watcher, error) {
-	return newWatcher(ctx, client, []byte(trimPrefix), prefix, false, template)
+	return newWatcher(ctx, client, []byte(trimPrefix), prefix, false, 0, template)
 }
 
 // NewWatcherWithPrev is like NewWatcher, except that the returned events
 // include the previous version of the values.
 func NewWatcherWithPrev(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, template proto.Message) (Watcher, error) {
-	return newWatcher(ctx, client, []byte(trimPrefix), prefix, true, template)
+	return newWatcher(ctx, client, []byte(trimPrefix), prefix, true, 0, template)
 }
 
-func newWatcher(ctx context.Context, client *etcd.Client, trimPrefix []byte, prefix string, withPrev bool, template proto.Message) (Watcher, error) {
+// NewWatcherFromRevision is like NewWatcherWithPrev, except that instead of
+// returning the current items followed by later changes, it returns every
+// change made to the prefix at or after revision 'rev'. This lets a caller
+// that records the revision of the last event it handled resume from there.
+// If 'rev' has been compacted, the watcher returns an error event for which
+// IsErrCompacted is true.
+func NewWatcherFromRevision(ctx context.Context, client *etcd.Client, trimPrefix, prefix string, rev int64, template proto.Message) (Watcher, error) {
+	if rev <= 0 {
+		return nil, fmt.Errorf("invalid revision %d", rev)
+	}
+	return newWatcher(ctx, client, []byte(trimPrefix), prefix, true, rev, template)
+}
+
+// IsErrCompacted returns true if 'err' indicates that a watch's start
+// revision has been compacted
+func IsErrCompacted(err error) bool {
+	return err == rpctypes.ErrCompacted
+}
+
+func newWatcher(ctx context.Context, client *etcd.Client, trimPrefix []byte, prefix string, withPrev bool, fromRevision int64, template proto.Message) (Watcher, error) {
 	eventCh := make(chan *Event)
 	done := make(chan struct{})
-	// First list the collection to get the current items
-	// Sort by mod revision--how the items would have been returned if we watched
-	// them from the beginning.
-	resp, err := client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
-	if err != nil {
-		return nil, err
+	var resp *etcd.GetResponse
+	nextRevision := fromRevision
+	if fromRevision == 0 {
+		// First list the collection to get the current items
+		// Sort by mod revision--how the items would have been returned if we watched
+		// them from the beginning.
+		var err error
+		resp, err = client.Get(ctx, prefix, etcd.WithPrefix(), etcd.WithSort(etcd.SortByModRevision, etcd.SortAscend))
+		if err != nil {
+			return nil, err
+		}
+		nextRevision = resp.Header.Revision + 1
 	}
 
-	nextRevision := resp.Header.Revision + 1
 	etcdWatcher := etcd.NewWatcher(client)
 	// Issue a watch that uses the revision timestamp returned by the
 	// Get request earlier.  That way even if some items are added between
 	// when we list the collection and when we start watching the collection,
 	// we won't miss any items.
-	options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
-	if withPrev {
-		options = append(options, etcd.WithPrevKV())
+	watchOptions := func() []etcd.OpOption {
+		options := []etcd.OpOption{etcd.WithPrefix(), etcd.WithRev(nextRevision)}
+		if withPrev {
+			options = append(options, etcd.WithPrevKV())
+		}
+		return options
 	}
-	rch := etcdWatcher.Watch(ctx, prefix, options...)
+	rch := etcdWatcher.Watch(ctx, prefix, watchOptions()...)
 
 	go func() (retErr error) {
 		defer func() {
@@ -140,13 +168,15 @@ func newWatcher(ctx context.Context, client *etcd.Client, trimPrefix []byte, pre
 			close(eventCh)
 			etcdWatcher.Close()
 		}()
-		for _, etcdKv := range resp.Kvs {
-			eventCh <- &Event{
-				Key:      bytes.TrimPrefix(etcdKv.Key, trimPrefix),
-				Value:    etcdKv.Value,
-				Type:     EventPut,
-				Rev:      etcdKv.ModRevision,
-				Template: template,
+		if resp != nil {
+			for _, etcdKv := range resp.Kvs {
+				eventCh <- &Event{
+					Key:      bytes.TrimPrefix(etcdKv.Key, trimPrefix),
+					Value:    etcdKv.Value,
+					Type:     EventPut,
+					Rev:      etcdKv.ModRevision,
+					Template: template,
+				}
 			}
 		}
 		for {
@@ -162,7 +192,7 @@ func newWatcher(ctx context.Context, client *etcd.Client, trimPrefix []byte, pre
 					return err
 				}
 				etcdWatcher = etcd.NewWatcher(client)
-				rch = etcdWatcher.Watch(ctx, prefix, etcd.WithPrefix(), etcd.WithRev(nextRevision))
+				rch = etcdWatcher.Watch(ctx, prefix, watchOptions()...)
 				continue
 			}
 			if err := resp.Err(); err != nil {
@@ -0,0 +1,331 @@
+package cmds
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/types"
+	pachdclient "github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dag"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+type applyOp string
+
+const (
+	applyCreate applyOp = "create"
+	applyUpdate applyOp = "update"
+	applyDelete applyOp = "delete"
+)
+
+// applyAction is one step of an applyPlan: the creation, update or deletion
+// of a repo or a pipeline
+type applyAction struct {
+	op       applyOp
+	repo     *pfs.CreateRepoRequest
+	pipeline *ppsclient.CreatePipelineRequest
+	// changed holds the (top-level) fields of an updated repo or pipeline that
+	// differ from the existing one
+	changed []string
+}
+
+func (a *applyAction) name() string {
+	if a.repo != nil {
+		return a.repo.Repo.Name
+	}
+	return a.pipeline.Pipeline.Name
+}
+
+func (a *applyAction) kind() string {
+	if a.repo != nil {
+		return "repo"
+	}
+	return "pipeline"
+}
+
+// applyPlan is the list of actions that 'pachctl apply' takes to make the
+// cluster match a set of specs. Creates and updates come first, in
+// topological order (so that a pipeline's inputs exist before it does),
+// followed by deletes, in reverse topological order.
+type applyPlan struct {
+	actions   []*applyAction
+	unchanged int
+	reprocess bool
+}
+
+// readApplySpecs reads the repo and pipeline specs in 'specPath', which is
+// either a file or a directory, in which case every .json, .yaml and .yml
+// file under it is read
+func readApplySpecs(specPath string, args map[string]string) ([]*pfs.CreateRepoRequest, []*ppsclient.CreatePipelineRequest, error) {
+	var paths []string
+	if err := filepath.Walk(specPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		switch filepath.Ext(path) {
+		case ".json", ".yaml", ".yml":
+			paths = append(paths, path)
+		default:
+			if path == specPath {
+				paths = append(paths, path) // a file named explicitly
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, nil, err
+	}
+	var repos []*pfs.CreateRepoRequest
+	var pipelines []*ppsclient.CreatePipelineRequest
+	seen := make(map[string]string)
+	for _, path := range paths {
+		cfgReader, err := ppsutil.NewPipelineManifestReader(path, args)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", path, err)
+		}
+		for {
+			request, err := cfgReader.NextRequest()
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, nil, fmt.Errorf("%s: %v", path, err)
+			}
+			var name string
+			switch request := request.(type) {
+			case *pfs.CreateRepoRequest:
+				name = request.Repo.GetName()
+				repos = append(repos, request)
+			case *ppsclient.CreatePipelineRequest:
+				name = request.Pipeline.GetName()
+				pipelines = append(pipelines, request)
+			}
+			if name == "" {
+				return nil, nil, fmt.Errorf("%s: spec is missing a name", path)
+			}
+			if other, ok := seen[name]; ok {
+				return nil, nil, fmt.Errorf("%s: %s is also declared in %s", path, name, other)
+			}
+			seen[name] = path
+		}
+	}
+	return repos, pipelines, nil
+}
+
+// makeApplyPlan diffs the desired repos and pipelines against the existing
+// ones and returns the actions needed to reconcile them. Fields that a spec
+// doesn't set are compared with pachd's defaults (see changedFields). Existing
+// pipelines that aren't in the specs are deleted only if 'prune' is set, and
+// existing repos are never deleted.
+func makeApplyPlan(repos []*pfs.CreateRepoRequest, pipelines []*ppsclient.CreatePipelineRequest,
+	repoInfos []*pfs.RepoInfo, pipelineInfos []*ppsclient.PipelineInfo, prune bool, reprocess bool) (*applyPlan, error) {
+	plan := &applyPlan{reprocess: reprocess}
+	existingRepos := make(map[string]*pfs.RepoInfo)
+	for _, repoInfo := range repoInfos {
+		existingRepos[repoInfo.Repo.Name] = repoInfo
+	}
+	existingPipelines := make(map[string]*ppsclient.PipelineInfo)
+	for _, pipelineInfo := range pipelineInfos {
+		existingPipelines[pipelineInfo.Pipeline.Name] = pipelineInfo
+	}
+
+	// Nodes of the DAG are repos and pipelines, and a pipeline's parents are
+	// its input repos
+	nodes := make(map[string][]string)
+	actions := make(map[string]*applyAction)
+	for _, repo := range repos {
+		nodes[repo.Repo.Name] = nil
+		existing, ok := existingRepos[repo.Repo.Name]
+		switch {
+		case !ok:
+			actions[repo.Repo.Name] = &applyAction{op: applyCreate, repo: repo}
+		case existing.Description != repo.Description:
+			actions[repo.Repo.Name] = &applyAction{op: applyUpdate, repo: repo, changed: []string{"description"}}
+		default:
+			plan.unchanged++
+		}
+	}
+	desiredPipelines := make(map[string]bool)
+	for _, pipeline := range pipelines {
+		desiredPipelines[pipeline.Pipeline.Name] = true
+		nodes[pipeline.Pipeline.Name] = inputRepos(pipeline.Input)
+		existing, ok := existingPipelines[pipeline.Pipeline.Name]
+		if !ok {
+			actions[pipeline.Pipeline.Name] = &applyAction{op: applyCreate, pipeline: pipeline}
+			continue
+		}
+		changed, err := changedFields(pipeline, existing)
+		if err != nil {
+			return nil, err
+		}
+		if len(changed) == 0 {
+			plan.unchanged++
+			continue
+		}
+		actions[pipeline.Pipeline.Name] = &applyAction{op: applyUpdate, pipeline: pipeline, changed: changed}
+	}
+	var deletes []string
+	if prune {
+		for name, pipelineInfo := range existingPipelines {
+			if desiredPipelines[name] {
+				continue
+			}
+			nodes[name] = inputRepos(pipelineInfo.Input)
+			actions[name] = &applyAction{
+				op:       applyDelete,
+				pipeline: &ppsclient.CreatePipelineRequest{Pipeline: pipelineInfo.Pipeline},
+			}
+			deletes = append(deletes, name)
+		}
+	}
+
+	// Pipelines that read from each other can't be ordered (or created)
+	d := dag.NewDAG(nodes)
+	if cycle := d.Cycle(); cycle != nil {
+		return nil, fmt.Errorf("pipelines can't read from each other in a cycle, but the specs contain one: %s", strings.Join(cycle, " -> "))
+	}
+	sorted := d.Sorted()
+	for _, name := range sorted {
+		if action, ok := actions[name]; ok && action.op != applyDelete {
+			plan.actions = append(plan.actions, action)
+		}
+	}
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if action, ok := actions[sorted[i]]; ok && action.op == applyDelete {
+			plan.actions = append(plan.actions, action)
+		}
+	}
+	return plan, nil
+}
+
+// inputRepos returns the repos that 'input' reads from. Cron and git repos
+// are created by their pipeline, so they're not included
+func inputRepos(input *ppsclient.Input) []string {
+	var result []string
+	if input == nil {
+		return nil
+	}
+	ppsclient.VisitInput(input, func(input *ppsclient.Input) {
+		if input.Atom != nil {
+			result = append(result, input.Atom.Repo)
+		}
+	})
+	return result
+}
+
+// changedFields returns the top-level fields of the pipeline that pachd would
+// create from 'desired' that differ from 'existing'. Fields that 'desired'
+// doesn't set are compared with pachd's defaults, so a field that's removed
+// from a spec, or set to its zero value, is a change.
+func changedFields(desired *ppsclient.CreatePipelineRequest, existing *ppsclient.PipelineInfo) ([]string, error) {
+	desiredInfo := ppsutil.PipelineInfoFromReq(proto.Clone(desired).(*ppsclient.CreatePipelineRequest))
+	// Cron inputs that don't set a start time start when they're created, so
+	// they keep the start time of the existing pipeline
+	existingStarts := make(map[string]*types.Timestamp)
+	ppsclient.VisitInput(existing.Input, func(input *ppsclient.Input) {
+		if input.Cron != nil {
+			existingStarts[input.Cron.Name] = input.Cron.Start
+		}
+	})
+	ppsclient.VisitInput(desiredInfo.Input, func(input *ppsclient.Input) {
+		if input.Cron != nil && input.Cron.Start == nil {
+			input.Cron.Start = existingStarts[input.Cron.Name]
+		}
+	})
+	ppsutil.SetPipelineDefaults(desiredInfo)
+	desiredFields, err := specFields(ppsutil.PipelineReqFromInfo(desiredInfo))
+	if err != nil {
+		return nil, err
+	}
+	existingFields, err := specFields(ppsutil.PipelineReqFromInfo(existing))
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	for field, value := range desiredFields {
+		if field == "salt" && desired.Salt == "" {
+			continue // generated by pachd
+		}
+		if !reflect.DeepEqual(value, existingFields[field]) {
+			result = append(result, field)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// specFields returns the top-level fields of 'request', including those that
+// are unset
+func specFields(request *ppsclient.CreatePipelineRequest) (map[string]interface{}, error) {
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	spec, err := marshaler.MarshalToString(request)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(spec), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// print writes a human-readable description of 'p' to 'w'
+func (p *applyPlan) print(w io.Writer) {
+	counts := make(map[applyOp]int)
+	for _, action := range p.actions {
+		counts[action.op]++
+		switch action.op {
+		case applyCreate:
+			fmt.Fprintf(w, "+ create %s %s\n", action.kind(), action.name())
+		case applyDelete:
+			fmt.Fprintf(w, "- delete %s %s\n", action.kind(), action.name())
+		case applyUpdate:
+			fmt.Fprintf(w, "~ update %s %s (%s)\n", action.kind(), action.name(), strings.Join(action.changed, ", "))
+			if action.pipeline == nil {
+				break
+			}
+			if p.reprocess {
+				fmt.Fprintf(w, "    all of the pipeline's datums will be reprocessed\n")
+			} else {
+				fmt.Fprintf(w, "    datums that were already processed won't be reprocessed (pass --reprocess to reprocess them)\n")
+			}
+		}
+	}
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d to delete, %d unchanged.\n",
+		counts[applyCreate], counts[applyUpdate], counts[applyDelete], p.unchanged)
+}
+
+// apply runs the actions in 'p', in order, stopping at the first error
+func (p *applyPlan) apply(client *pachdclient.APIClient) error {
+	for _, action := range p.actions {
+		var err error
+		switch {
+		case action.repo != nil:
+			request := proto.Clone(action.repo).(*pfs.CreateRepoRequest)
+			request.Update = action.op == applyUpdate
+			_, err = client.PfsAPIClient.CreateRepo(client.Ctx(), request)
+		case action.op == applyDelete:
+			err = client.DeletePipeline(action.name())
+		default:
+			request := proto.Clone(action.pipeline).(*ppsclient.CreatePipelineRequest)
+			request.Update = action.op == applyUpdate
+			request.Reprocess = request.Update && p.reprocess
+			_, err = client.PpsAPIClient.CreatePipeline(client.Ctx(), request)
+		}
+		if err != nil {
+			return fmt.Errorf("could not %s %s %s: %v", action.op, action.kind(), action.name(), err)
+		}
+	}
+	return nil
+}
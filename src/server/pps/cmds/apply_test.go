@@ -0,0 +1,127 @@
+package cmds
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pachyderm/pachyderm/src/client"
+	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/require"
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+func testPipeline(name string, image string, inputRepo string) *pps.CreatePipelineRequest {
+	return &pps.CreatePipelineRequest{
+		Pipeline:  client.NewPipeline(name),
+		Transform: &pps.Transform{Image: image, Cmd: []string{"sh"}},
+		Input:     client.NewAtomInput(inputRepo, "/*"),
+	}
+}
+
+// existingPipeline returns the PipelineInfo that pachd would create from
+// 'request', with pachd's defaults filled in
+func existingPipeline(request *pps.CreatePipelineRequest) *pps.PipelineInfo {
+	pipelineInfo := ppsutil.PipelineInfoFromReq(proto.Clone(request).(*pps.CreatePipelineRequest))
+	pipelineInfo.Salt = "salt"
+	ppsutil.SetPipelineDefaults(pipelineInfo)
+	return pipelineInfo
+}
+
+func actionNames(plan *applyPlan) []string {
+	var result []string
+	for _, action := range plan.actions {
+		result = append(result, string(action.op)+" "+action.name())
+	}
+	return result
+}
+
+func TestApplyPlanCreatesInTopologicalOrder(t *testing.T) {
+	repos := []*pfs.CreateRepoRequest{{Repo: client.NewRepo("images")}}
+	pipelines := []*pps.CreatePipelineRequest{
+		testPipeline("montage", "ubuntu", "edges"),
+		testPipeline("edges", "ubuntu", "images"),
+	}
+	plan, err := makeApplyPlan(repos, pipelines, nil, nil, false, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"create images", "create edges", "create montage"}, actionNames(plan))
+}
+
+func TestApplyPlanCycle(t *testing.T) {
+	pipelines := []*pps.CreatePipelineRequest{
+		testPipeline("edges", "ubuntu", "montage"),
+		testPipeline("montage", "ubuntu", "edges"),
+	}
+	_, err := makeApplyPlan(nil, pipelines, nil, nil, false, false)
+	require.YesError(t, err)
+	require.Matches(t, "edges -> montage -> edges", err.Error())
+}
+
+func TestApplyPlanUpdates(t *testing.T) {
+	edges := testPipeline("edges", "ubuntu", "images")
+	montage := testPipeline("montage", "ubuntu", "edges")
+	repoInfos := []*pfs.RepoInfo{
+		{Repo: client.NewRepo("images"), Description: "old"},
+		{Repo: client.NewRepo("edges")},
+		{Repo: client.NewRepo("montage")},
+	}
+	pipelineInfos := []*pps.PipelineInfo{existingPipeline(edges), existingPipeline(montage)}
+
+	// Fields filled in by pachd aren't reported as changes
+	plan, err := makeApplyPlan(
+		[]*pfs.CreateRepoRequest{{Repo: client.NewRepo("images"), Description: "old"}},
+		[]*pps.CreatePipelineRequest{edges, montage}, repoInfos, pipelineInfos, false, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(plan.actions))
+	require.Equal(t, 3, plan.unchanged)
+
+	newEdges := testPipeline("edges", "alpine", "images")
+	plan, err = makeApplyPlan(
+		[]*pfs.CreateRepoRequest{{Repo: client.NewRepo("images"), Description: "new"}},
+		[]*pps.CreatePipelineRequest{newEdges, montage}, repoInfos, pipelineInfos, false, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"update images", "update edges"}, actionNames(plan))
+	require.Equal(t, []string{"description"}, plan.actions[0].changed)
+	require.Equal(t, []string{"transform"}, plan.actions[1].changed)
+
+	var buf bytes.Buffer
+	plan.print(&buf)
+	require.Matches(t, "~ update pipeline edges \\(transform\\)", buf.String())
+	require.Matches(t, "will be reprocessed", buf.String())
+	require.Matches(t, "0 to create, 2 to update, 0 to delete, 1 unchanged", buf.String())
+}
+
+func TestApplyPlanRemovedFields(t *testing.T) {
+	edges := testPipeline("edges", "ubuntu", "images")
+	edges.EnableStats = true
+	edges.Egress = &pps.Egress{URL: "s3://bucket/dir"}
+	pipelineInfos := []*pps.PipelineInfo{existingPipeline(edges)}
+
+	// Fields that are removed from the spec, or set to their zero value, are
+	// changes
+	newEdges := testPipeline("edges", "ubuntu", "images")
+	plan, err := makeApplyPlan(nil, []*pps.CreatePipelineRequest{newEdges}, nil, pipelineInfos, false, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"update edges"}, actionNames(plan))
+	require.Equal(t, []string{"egress", "enable_stats"}, plan.actions[0].changed)
+}
+
+func TestApplyPlanPrune(t *testing.T) {
+	edges := testPipeline("edges", "ubuntu", "images")
+	montage := testPipeline("montage", "ubuntu", "edges")
+	collage := testPipeline("collage", "ubuntu", "montage")
+	pipelineInfos := []*pps.PipelineInfo{
+		existingPipeline(edges), existingPipeline(montage), existingPipeline(collage),
+	}
+
+	// Without --prune, pipelines that aren't in the specs are left alone
+	plan, err := makeApplyPlan(nil, []*pps.CreatePipelineRequest{edges}, nil, pipelineInfos, false, false)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(plan.actions))
+
+	// With --prune, they're deleted downstream-first
+	plan, err = makeApplyPlan(nil, []*pps.CreatePipelineRequest{edges}, nil, pipelineInfos, true, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"delete collage", "delete montage"}, actionNames(plan))
+}
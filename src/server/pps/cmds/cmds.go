@@ -1,16 +1,20 @@
 package cmds
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"os/user"
 	"sort"
+	"strconv"
 	"strings"
 	"text/tabwriter"
 
 	"github.com/fsouza/go-dockerclient"
+	"github.com/ghodss/yaml"
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
 	pachdclient "github.com/pachyderm/pachyderm/src/client"
@@ -266,6 +270,7 @@ $ pachctl flush-job foo/XXX -p bar -p baz
 	}
 	var pageSize int64
 	var page int64
+	var failed bool
 	listDatum := &cobra.Command{
 		Use:   "list-datum job-id",
 		Short: "Return the datums in a job.",
@@ -281,7 +286,11 @@ $ pachctl flush-job foo/XXX -p bar -p baz
 			if page < 0 {
 				return fmt.Errorf("page must be zero or positive")
 			}
-			resp, err := client.ListDatum(args[0], pageSize, page)
+			listDatum := client.ListDatum
+			if failed {
+				listDatum = client.ListFailedDatum
+			}
+			resp, err := listDatum(args[0], pageSize, page)
 			if err != nil {
 				return err
 			}
@@ -304,6 +313,7 @@ $ pachctl flush-job foo/XXX -p bar -p baz
 	rawFlag(listDatum)
 	listDatum.Flags().Int64Var(&pageSize, "pageSize", 0, "Specify the number of results sent back in a single page")
 	listDatum.Flags().Int64Var(&page, "page", 0, "Specify the page of results to send")
+	listDatum.Flags().BoolVar(&failed, "failed", false, "List only the datums that the job skipped because they failed (see skip_failed_datums in the pipeline spec)")
 
 	inspectDatum := &cobra.Command{
 		Use:   "inspect-datum job-id datum-id",
@@ -426,12 +436,19 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	var username string
 	var password string
 	var pipelinePath string
+	var pipelineArgs cmdutil.RepeatedStringArg
+	var pipelineArgsFile string
+	var dryRun bool
 	createPipeline := &cobra.Command{
 		Use:   "create-pipeline -f pipeline.json",
 		Short: "Create a new pipeline.",
-		Long:  fmt.Sprintf("Create a new pipeline from a %s", pipelineSpec),
+		Long:  fmt.Sprintf("Create a new pipeline from a %s", pipelineSpec) + pipelineTemplateHelp,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
+			templateArgs, err := parseTemplateArgs(pipelineArgs, pipelineArgsFile)
+			if err != nil {
+				return err
+			}
+			cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath, templateArgs)
 			if err != nil {
 				return err
 			}
@@ -439,13 +456,25 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return fmt.Errorf("error connecting to pachd: %v", err)
 			}
-			for {
-				request, err := cfgReader.NextCreatePipelineRequest()
-				if err == io.EOF {
-					break
-				} else if err != nil {
+			requests, err := readPipelineRequests(cfgReader)
+			if err != nil {
+				return err
+			}
+			// Rendered templates are validated in full before any pipeline is
+			// submitted
+			if templateArgs != nil || dryRun {
+				if err := validatePipelineRequests(client, requests); err != nil {
 					return err
 				}
+			}
+			for _, request := range requests {
+				if dryRun {
+					if err := marshaller.Marshal(os.Stdout, request); err != nil {
+						return err
+					}
+					fmt.Println()
+					continue
+				}
 				if pushImages {
 					pushedImage, err := pushImage(registry, username, password, request.Transform.Image)
 					if err != nil {
@@ -468,14 +497,21 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	createPipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	createPipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	createPipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
+	createPipeline.Flags().VarP(&pipelineArgs, "arg", "", "A parameter of the pipeline template, as 'key=value' (may be repeated).")
+	createPipeline.Flags().StringVar(&pipelineArgsFile, "args-file", "", "A YAML or JSON file containing the parameters of the pipeline template.")
+	createPipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, validate and print the (rendered) pipelines without submitting them.")
 
 	var reprocess bool
 	updatePipeline := &cobra.Command{
 		Use:   "update-pipeline -f pipeline.json",
 		Short: "Update an existing Pachyderm pipeline.",
-		Long:  fmt.Sprintf("Update a Pachyderm pipeline with a new %s", pipelineSpec),
+		Long:  fmt.Sprintf("Update a Pachyderm pipeline with a new %s", pipelineSpec) + pipelineTemplateHelp,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) (retErr error) {
-			cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath)
+			templateArgs, err := parseTemplateArgs(pipelineArgs, pipelineArgsFile)
+			if err != nil {
+				return err
+			}
+			cfgReader, err := ppsutil.NewPipelineManifestReader(pipelinePath, templateArgs)
 			if err != nil {
 				return err
 			}
@@ -483,15 +519,29 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			if err != nil {
 				return fmt.Errorf("error connecting to pachd: %v", err)
 			}
-			for {
-				request, err := cfgReader.NextCreatePipelineRequest()
-				if err == io.EOF {
-					break
-				} else if err != nil {
-					return err
-				}
+			requests, err := readPipelineRequests(cfgReader)
+			if err != nil {
+				return err
+			}
+			for _, request := range requests {
 				request.Update = true
 				request.Reprocess = reprocess
+			}
+			// Rendered templates are validated in full before any pipeline is
+			// submitted
+			if templateArgs != nil || dryRun {
+				if err := validatePipelineRequests(client, requests); err != nil {
+					return err
+				}
+			}
+			for _, request := range requests {
+				if dryRun {
+					if err := marshaller.Marshal(os.Stdout, request); err != nil {
+						return err
+					}
+					fmt.Println()
+					continue
+				}
 				if pushImages {
 					pushedImage, err := pushImage(registry, username, password, request.Transform.Image)
 					if err != nil {
@@ -514,6 +564,9 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	updatePipeline.Flags().StringVarP(&registry, "registry", "r", "docker.io", "The registry to push images to.")
 	updatePipeline.Flags().StringVarP(&username, "username", "u", "", "The username to push images as, defaults to your OS username.")
 	updatePipeline.Flags().StringVarP(&password, "password", "", "", "Your password for the registry being pushed to.")
+	updatePipeline.Flags().VarP(&pipelineArgs, "arg", "", "A parameter of the pipeline template, as 'key=value' (may be repeated).")
+	updatePipeline.Flags().StringVar(&pipelineArgsFile, "args-file", "", "A YAML or JSON file containing the parameters of the pipeline template.")
+	updatePipeline.Flags().BoolVar(&dryRun, "dry-run", false, "If true, validate and print the (rendered) pipelines without submitting them.")
 	updatePipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by previous version of the pipeline.")
 
 	inspectPipeline := &cobra.Command{
@@ -596,7 +649,7 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 			}, editor, f.Name()); err != nil {
 				return err
 			}
-			cfgReader, err := ppsutil.NewPipelineManifestReader(f.Name())
+			cfgReader, err := ppsutil.NewPipelineManifestReader(f.Name(), nil)
 			if err != nil {
 				return err
 			}
@@ -623,15 +676,46 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	editPipeline.Flags().StringVar(&editor, "editor", "", "Editor to use for modifying the manifest.")
 
 	var spec bool
+	var history string
 	listPipeline := &cobra.Command{
 		Use:   "list-pipeline",
 		Short: "Return info about all pipelines.",
-		Long:  "Return info about all pipelines.",
+		Long: `Return info about all pipelines.
+
+If --history is passed, the versions of a single pipeline are listed instead,
+newest first, along with the changes that each version made to the pipeline's
+spec. A previous version can be restored with 'rollback-pipeline'.`,
 		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
 			client, err := pachdclient.NewOnUserMachine(metrics, "user")
 			if err != nil {
 				return fmt.Errorf("error connecting to pachd: %v", err)
 			}
+			if history != "" {
+				versions, err := client.ListPipelineHistory(history)
+				if err != nil {
+					return err
+				}
+				if raw {
+					for _, version := range versions {
+						if err := marshaller.Marshal(os.Stdout, version); err != nil {
+							return err
+						}
+					}
+					return nil
+				}
+				writer := tabwriter.NewWriter(os.Stdout, 20, 1, 3, ' ', 0)
+				pretty.PrintPipelineVersionHeader(writer)
+				for i, version := range versions {
+					var previous *ppsclient.PipelineVersion
+					if i+1 < len(versions) {
+						previous = versions[i+1] // versions are newest first
+					}
+					if err := pretty.PrintPipelineVersion(writer, version, previous); err != nil {
+						return err
+					}
+				}
+				return writer.Flush()
+			}
 			pipelineInfos, err := client.ListPipeline()
 			if err != nil {
 				return err
@@ -662,6 +746,27 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	}
 	rawFlag(listPipeline)
 	listPipeline.Flags().BoolVarP(&spec, "spec", "s", false, "Output create-pipeline compatibility specs.")
+	listPipeline.Flags().StringVar(&history, "history", "", "List the versions of this pipeline.")
+
+	rollbackPipeline := &cobra.Command{
+		Use:   "rollback-pipeline pipeline-name version",
+		Short: "Restore a previous version of a pipeline.",
+		Long: `Restore a previous version of a pipeline. The pipeline is updated (as with
+'update-pipeline') with the spec it had at 'version', which creates a new
+version of the pipeline. Use 'list-pipeline --history' to list its versions.`,
+		Run: cmdutil.RunFixedArgs(2, func(args []string) error {
+			version, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %q: %v", args[1], err)
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			return client.RollbackPipeline(args[0], version, reprocess)
+		}),
+	}
+	rollbackPipeline.Flags().BoolVar(&reprocess, "reprocess", false, "If true, reprocess datums that were already processed by the current version of the pipeline.")
 
 	var all bool
 	deletePipeline := &cobra.Command{
@@ -732,6 +837,113 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 		}),
 	}
 
+	var includeCommitStrs []string
+	var excludeCommitStrs []string
+	rerunPipeline := &cobra.Command{
+		Use:   "rerun-pipeline pipeline-name",
+		Short: "Rerun a pipeline's jobs over a set of input commits.",
+		Long: `Rerun a pipeline's previous jobs over a set of input commits, creating new output commits.
+
+Each job runs with the pipeline's current spec, and all of its datums are
+reprocessed rather than skipped. The pipeline itself isn't updated, and the
+new output commits are not added to the pipeline's output branch.
+
+Examples:
+
+` + codestart + `# rerun all of pipeline foo's jobs
+$ pachctl rerun-pipeline foo
+
+# rerun the jobs of pipeline foo whose input commits in repo bar are
+# ancestors of bar/XXX
+$ pachctl rerun-pipeline foo -i bar/XXX
+
+# rerun the jobs of pipeline foo, except for those whose input commits in
+# repo bar are ancestors of bar/YYY
+$ pachctl rerun-pipeline foo -x bar/YYY
+` + codeend,
+		Run: cmdutil.RunFixedArgs(1, func(args []string) error {
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return err
+			}
+			include, err := cmdutil.ParseCommits(includeCommitStrs)
+			if err != nil {
+				return err
+			}
+			exclude, err := cmdutil.ParseCommits(excludeCommitStrs)
+			if err != nil {
+				return err
+			}
+			if err := client.RerunPipeline(args[0], include, exclude); err != nil {
+				cmdutil.ErrorAndExit("error from RerunPipeline: %s", err.Error())
+			}
+			return nil
+		}),
+	}
+	rerunPipeline.Flags().StringSliceVarP(&includeCommitStrs, "include", "i", []string{}, "Rerun only jobs whose input commits are ancestors of these commits.")
+	rerunPipeline.Flags().StringSliceVarP(&excludeCommitStrs, "exclude", "x", []string{}, "Don't rerun jobs whose input commits are ancestors of these commits.")
+
+	var applyPath string
+	var prune bool
+	applyCmd := &cobra.Command{
+		Use:   "apply -f dag/",
+		Short: "Make the cluster's repos and pipelines match a set of specs.",
+		Long: `Make the cluster's repos and pipelines match a set of specs.
+
+-f names a spec file, or a directory whose .json, .yaml and .yml files
+(including those in subdirectories) are read. Each file may contain several
+specs, as with create-pipeline. Specs with a top-level "repo" field declare
+repos (e.g. {"repo": {"name": "images"}, "description": "..."}), and all other
+specs declare pipelines.
+
+apply compares the specs with the existing repos and pipelines, prints a plan
+of the repos and pipelines it will create, update and delete, and then carries
+it out, creating and updating them in topological order. Fields that a spec
+doesn't set are compared with pachd's defaults, so removing a field from a
+spec updates the pipeline. Existing pipelines that aren't in the specs are deleted only if --prune is passed, and
+repos are never deleted.
+
+If --arg or --args-file is passed, the specs are templates, rendered as they
+are by create-pipeline.`,
+		Run: cmdutil.RunFixedArgs(0, func(args []string) error {
+			templateArgs, err := parseTemplateArgs(pipelineArgs, pipelineArgsFile)
+			if err != nil {
+				return err
+			}
+			repos, pipelines, err := readApplySpecs(applyPath, templateArgs)
+			if err != nil {
+				return err
+			}
+			client, err := pachdclient.NewOnUserMachine(metrics, "user")
+			if err != nil {
+				return fmt.Errorf("error connecting to pachd: %v", err)
+			}
+			repoInfos, err := client.ListRepo()
+			if err != nil {
+				return err
+			}
+			pipelineInfos, err := client.ListPipeline()
+			if err != nil {
+				return err
+			}
+			plan, err := makeApplyPlan(repos, pipelines, repoInfos, pipelineInfos, prune, reprocess)
+			if err != nil {
+				return err
+			}
+			plan.print(os.Stdout)
+			if dryRun {
+				return nil
+			}
+			return plan.apply(client)
+		}),
+	}
+	applyCmd.Flags().StringVarP(&applyPath, "file", "f", "", "The file or directory containing the specs.")
+	applyCmd.Flags().VarP(&pipelineArgs, "arg", "", "A parameter of the spec templates, as 'key=value' (may be repeated).")
+	applyCmd.Flags().StringVar(&pipelineArgsFile, "args-file", "", "A YAML or JSON file containing the parameters of the spec templates.")
+	applyCmd.Flags().BoolVar(&dryRun, "dry-run", false, "If true, print the plan without carrying it out.")
+	applyCmd.Flags().BoolVar(&prune, "prune", false, "If true, delete pipelines that aren't in the specs.")
+	applyCmd.Flags().BoolVar(&reprocess, "reprocess", false, "If true, updated pipelines reprocess datums that were already processed by their previous version.")
+
 	var result []*cobra.Command
 	result = append(result, job)
 	result = append(result, inspectJob)
@@ -753,6 +965,9 @@ All jobs created by a pipeline will create commits in the pipeline's repo.
 	result = append(result, deletePipeline)
 	result = append(result, startPipeline)
 	result = append(result, stopPipeline)
+	result = append(result, rerunPipeline)
+	result = append(result, rollbackPipeline)
+	result = append(result, applyCmd)
 	return result, nil
 }
 
@@ -834,3 +1049,85 @@ func pushImage(registry string, username string, password string, image string)
 	}
 	return fmt.Sprintf("%s:%s", pushRepo, pushTag), nil
 }
+
+const pipelineTemplateHelp = `
+
+The pipeline specification may be written in JSON or YAML, and may contain
+several pipelines (as a series of JSON objects, a JSON array, or YAML documents
+separated by "---"). If --arg or --args-file is passed, the specification is a
+template: placeholders such as {{.image}} are replaced with the values of the
+parameters passed with --arg (e.g. --arg image=ubuntu) or in --args-file, and
+the rendered pipelines are validated before any of them is submitted.`
+
+// parseTemplateArgs parses the pipeline template parameters passed to
+// create-pipeline and update-pipeline. Parameters passed with --arg take
+// precedence over those in --args-file. It returns nil if neither flag was
+// passed, in which case the pipeline spec is not treated as a template.
+func parseTemplateArgs(args []string, argsFile string) (map[string]string, error) {
+	if len(args) == 0 && argsFile == "" {
+		return nil, nil
+	}
+	result := make(map[string]string)
+	if argsFile != "" {
+		yamlBytes, err := ioutil.ReadFile(argsFile)
+		if err != nil {
+			return nil, err
+		}
+		jsonBytes, err := yaml.YAMLToJSON(yamlBytes)
+		if err != nil {
+			return nil, fmt.Errorf("malformed args file %s: %v", argsFile, err)
+		}
+		var fileArgs map[string]interface{}
+		decoder := json.NewDecoder(bytes.NewReader(jsonBytes))
+		decoder.UseNumber() // render integers as written, not in scientific notation
+		if err := decoder.Decode(&fileArgs); err != nil {
+			return nil, fmt.Errorf("malformed args file %s (must contain a map of parameters): %v", argsFile, err)
+		}
+		for key, value := range fileArgs {
+			result[key] = fmt.Sprint(value)
+		}
+	}
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("malformed arg %q (must be 'key=value')", arg)
+		}
+		result[parts[0]] = parts[1]
+	}
+	return result, nil
+}
+
+// readPipelineRequests reads all of the pipelines in 'cfgReader'
+func readPipelineRequests(cfgReader *ppsutil.PipelineManifestReader) ([]*ppsclient.CreatePipelineRequest, error) {
+	var result []*ppsclient.CreatePipelineRequest
+	for {
+		request, err := cfgReader.NextCreatePipelineRequest()
+		if err == io.EOF {
+			return result, nil
+		} else if err != nil {
+			return nil, err
+		}
+		result = append(result, request)
+	}
+}
+
+// validatePipelineRequests asks pachd to validate each of 'requests' (with
+// CreatePipelineRequest.DryRun) before any of them is submitted, so that a
+// malformed (e.g. badly rendered) pipeline is reported before the pipelines
+// that precede it are created. Each pipeline may take its input from the
+// output repos of the pipelines that precede it, which don't exist yet.
+func validatePipelineRequests(client *pachdclient.APIClient, requests []*ppsclient.CreatePipelineRequest) error {
+	var newRepos []string
+	for _, request := range requests {
+		request.DryRun = true
+		request.DryRunNewRepos = newRepos
+		_, err := client.PpsAPIClient.CreatePipeline(client.Ctx(), request)
+		request.DryRun = false
+		request.DryRunNewRepos = nil
+		if err != nil {
+			return fmt.Errorf("invalid pipeline %s: %v", request.Pipeline.GetName(), err)
+		}
+		newRepos = append(newRepos, request.Pipeline.GetName())
+	}
+	return nil
+}
@@ -6,16 +6,20 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"reflect"
+	"sort"
 	"strings"
 	"text/tabwriter"
 	"text/template"
 
 	"github.com/docker/go-units"
 	"github.com/fatih/color"
+	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/types"
 	"github.com/pachyderm/pachyderm/src/client"
 	pfsclient "github.com/pachyderm/pachyderm/src/client/pfs"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	"github.com/pachyderm/pachyderm/src/server/pkg/pretty"
 )
 
@@ -65,6 +69,108 @@ func PrintPipelineInfo(w io.Writer, pipelineInfo *ppsclient.PipelineInfo) {
 	fmt.Fprintf(w, "%s\t\n", pipelineState(pipelineInfo.State))
 }
 
+// PrintPipelineVersionHeader prints a pipeline version header.
+func PrintPipelineVersionHeader(w io.Writer) {
+	fmt.Fprint(w, "VERSION\tCREATED\tAUTHOR\tCHANGES\t\n")
+}
+
+// PrintPipelineVersion pretty-prints a version of a pipeline, along with the
+// changes made to the pipeline's spec since 'previous' (which may be nil, if
+// 'version' is the pipeline's first version)
+func PrintPipelineVersion(w io.Writer, version *ppsclient.PipelineVersion, previous *ppsclient.PipelineVersion) error {
+	fmt.Fprintf(w, "%d\t", version.Version)
+	fmt.Fprintf(w, "%s\t", pretty.Ago(version.CreatedAt))
+	if version.Author != "" {
+		fmt.Fprintf(w, "%s\t", version.Author)
+	} else {
+		fmt.Fprint(w, "-\t")
+	}
+	if previous == nil {
+		fmt.Fprint(w, "created\t\n")
+		return nil
+	}
+	changes, err := SpecDiff(previous.PipelineInfo, version.PipelineInfo)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Fprint(w, "-\t\n")
+		return nil
+	}
+	fmt.Fprintf(w, "%s\t\n", strings.Join(changes, "; "))
+	return nil
+}
+
+// SpecDiff returns the fields of the spec of 'newInfo' that differ from the
+// spec of 'oldInfo', as "field: old -> new", sorted by field
+func SpecDiff(oldInfo *ppsclient.PipelineInfo, newInfo *ppsclient.PipelineInfo) ([]string, error) {
+	oldSpec, err := specFields(oldInfo)
+	if err != nil {
+		return nil, err
+	}
+	newSpec, err := specFields(newInfo)
+	if err != nil {
+		return nil, err
+	}
+	var result []string
+	diffFields("", oldSpec, newSpec, &result)
+	sort.Strings(result)
+	return result, nil
+}
+
+func specFields(pipelineInfo *ppsclient.PipelineInfo) (interface{}, error) {
+	marshaler := &jsonpb.Marshaler{OrigName: true}
+	spec, err := marshaler.MarshalToString(ppsutil.PipelineReqFromInfo(pipelineInfo))
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	if err := json.Unmarshal([]byte(spec), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// diffFields appends the differences between the JSON values 'oldValue' and
+// 'newValue' (at 'prefix') to 'result'. Objects are compared field by field,
+// and other values are compared as a whole
+func diffFields(prefix string, oldValue interface{}, newValue interface{}, result *[]string) {
+	oldFields, oldOk := oldValue.(map[string]interface{})
+	newFields, newOk := newValue.(map[string]interface{})
+	if oldOk && newOk {
+		keys := make(map[string]bool)
+		for key := range oldFields {
+			keys[key] = true
+		}
+		for key := range newFields {
+			keys[key] = true
+		}
+		for key := range keys {
+			field := key
+			if prefix != "" {
+				field = prefix + "." + key
+			}
+			diffFields(field, oldFields[key], newFields[key], result)
+		}
+		return
+	}
+	if reflect.DeepEqual(oldValue, newValue) {
+		return
+	}
+	*result = append(*result, fmt.Sprintf("%s: %s -> %s", prefix, compactJSON(oldValue), compactJSON(newValue)))
+}
+
+func compactJSON(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(result)
+}
+
 // PrintJobInputHeader pretty prints a job input header.
 func PrintJobInputHeader(w io.Writer) {
 	fmt.Fprint(w, "NAME\tREPO\tCOMMIT\tGLOB\tLAZY\t\n")
@@ -126,7 +232,8 @@ Pipeline: {{.Pipeline.Name}} {{end}} {{if .ParentJob}}
 Parent: {{.ParentJob.ID}} {{end}}
 Started: {{prettyAgo .Started}} {{if .Finished}}
 Duration: {{prettyTimeDifference .Started .Finished}} {{end}}
-State: {{jobState .State}}
+State: {{jobState .State}} {{if .QueuePosition}}
+Queue Position: {{.QueuePosition}} {{end}}
 Reason: {{.Reason}}
 Processed: {{.DataProcessed}}
 Failed: {{.DataFailed}}
@@ -137,7 +244,11 @@ Data Uploaded: {{prettySize .Stats.UploadBytes}}
 Download Time: {{prettyDuration .Stats.DownloadTime}}
 Process Time: {{prettyDuration .Stats.ProcessTime}}
 Upload Time: {{prettyDuration .Stats.UploadTime}}
+Datum Attempts: {{.Stats.Attempts}}
 Datum Timeout: {{.DatumTimeout}}
+Datum Tries: {{.DatumTries}}
+Datum Backoff: {{.DatumBackoff}}
+Skip Failed Datums: {{.SkipFailedDatums}}
 Job Timeout: {{.JobTimeout}}
 Worker Status:
 {{workerStatus .}}Restarts: {{.Restart}}
@@ -178,6 +289,10 @@ Created: {{prettyAgo .CreatedAt}}
 State: {{pipelineState .State}}
 Reason: {{.Reason}}
 Parallelism Spec: {{.ParallelismSpec}}
+{{ if .AutoscalingStatus }}Autoscaling:
+	Workers: {{ .AutoscalingStatus.Workers }}
+	Reason: {{ .AutoscalingStatus.Reason }}
+	Updated: {{ prettyAgo .AutoscalingStatus.Updated }} {{end}}
 {{ if .ResourceRequests }}ResourceRequests:
 	CPU: {{ .ResourceRequests.Cpu }}
 	Memory: {{ .ResourceRequests.Memory }} {{end}}
@@ -186,7 +301,11 @@ Parallelism Spec: {{.ParallelismSpec}}
 	Memory: {{ .ResourceLimits.Memory }}
 	GPU: {{ .ResourceLimits.Gpu }} {{end}}
 Datum Timeout: {{.DatumTimeout}}
+Datum Tries: {{.DatumTries}}
+Datum Backoff: {{.DatumBackoff}}
+Skip Failed Datums: {{.SkipFailedDatums}}
 Job Timeout: {{.JobTimeout}}
+Priority: {{.Priority}}
 Input:
 {{pipelineInput .}}
 {{ if .GithookURL }}Githook URL: {{.GithookURL}} {{end}}
@@ -229,6 +348,7 @@ func PrintDetailedDatumInfo(w io.Writer, datumInfo *ppsclient.DatumInfo) {
 	fmt.Fprintf(w, "ID\t%s\n", datumInfo.Datum.ID)
 	fmt.Fprintf(w, "Job ID\t%s\n", datumInfo.Datum.Job.ID)
 	fmt.Fprintf(w, "State\t%s\n", datumInfo.State)
+	fmt.Fprintf(w, "Attempts\t%d\n", datumInfo.Attempts)
 	fmt.Fprintf(w, "Data Downloaded\t%s\n", pretty.Size(datumInfo.Stats.DownloadBytes))
 	fmt.Fprintf(w, "Data Uploaded\t%s\n", pretty.Size(datumInfo.Stats.UploadBytes))
 
@@ -305,6 +425,8 @@ func jobState(jobState ppsclient.JobState) string {
 		return color.New(color.FgRed).SprintFunc()("failure")
 	case ppsclient.JobState_JOB_SUCCESS:
 		return color.New(color.FgGreen).SprintFunc()("success")
+	case ppsclient.JobState_JOB_SUCCESS_WITH_FAILURES:
+		return color.New(color.FgYellow).SprintFunc()("success (with failures)")
 	case ppsclient.JobState_JOB_KILLED:
 		return color.New(color.FgRed).SprintFunc()("killed")
 	}
@@ -363,7 +485,8 @@ func pipelineInput(pipelineInfo *ppsclient.PipelineInfo) string {
 
 func jobCounts(counts map[int32]int32) string {
 	var buffer bytes.Buffer
-	for i := int32(ppsclient.JobState_JOB_STARTING); i <= int32(ppsclient.JobState_JOB_SUCCESS); i++ {
+	// Job states are numbered from 0, so this covers every state
+	for i := int32(0); i < int32(len(ppsclient.JobState_name)); i++ {
 		fmt.Fprintf(&buffer, "%s: %d\t", jobState(ppsclient.JobState(i)), counts[i])
 	}
 	return buffer.String()
@@ -394,6 +517,12 @@ func ShorthandInput(input *ppsclient.Input) string {
 			subInput = append(subInput, ShorthandInput(input))
 		}
 		return "(" + strings.Join(subInput, " ∪ ") + ")"
+	case input.Join != nil:
+		var subInput []string
+		for _, input := range input.Join {
+			subInput = append(subInput, ShorthandInput(input))
+		}
+		return "(" + strings.Join(subInput, " ⋈ ") + ")"
 	case input.Cron != nil:
 		return fmt.Sprintf("%s:%s", input.Cron.Name, input.Cron.Spec)
 	}
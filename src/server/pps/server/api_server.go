@@ -8,6 +8,7 @@ import (
 	"io"
 	"path"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -19,6 +20,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -56,7 +58,13 @@ const (
 	MaxPodsPerChunk = 3
 	// DefaultUserImage is the image used for jobs when the user does not specify
 	// an image.
-	DefaultUserImage = "ubuntu:16.04"
+	DefaultUserImage = ppsutil.DefaultUserImage
+	// DefaultDatumTries is the number of times a worker tries to process a
+	// datum if the pipeline doesn't set datum_tries
+	DefaultDatumTries = ppsutil.DefaultDatumTries
+	// DefaultDatumBackoff is how long a worker waits before retrying a failed
+	// datum if the pipeline doesn't set datum_backoff
+	DefaultDatumBackoff = ppsutil.DefaultDatumBackoff
 )
 
 var (
@@ -118,10 +126,17 @@ type apiServer struct {
 	storageHostPath       string
 	iamRole               string
 	imagePullSecret       string
-	reporter              *metrics.Reporter
+	// maxConcurrentJobs is the number of jobs that may run at once across the
+	// cluster (0 means there's no limit)
+	maxConcurrentJobs int64
+	reporter          *metrics.Reporter
+	// store holds the collections below. Transactions that touch them must
+	// be run with store.NewSTM.
+	store col.Store
 	// collections
 	pipelines col.Collection
 	jobs      col.Collection
+	jobQueue  col.Collection
 }
 
 func merge(from, to map[string]bool) {
@@ -161,6 +176,12 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 				return err
 			}
 		}
+	case input.Join != nil:
+		for _, input := range input.Join {
+			if err := validateNames(names, input); err != nil {
+				return err
+			}
+		}
 	case input.Git != nil:
 		if names[input.Git.Name] == true {
 			return fmt.Errorf("name %s was used more than once", input.Git.Name)
@@ -170,7 +191,10 @@ func validateNames(names map[string]bool, input *pps.Input) error {
 	return nil
 }
 
-func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName string, input *pps.Input, job bool) error {
+// validateInput validates the input of a job or pipeline. Pipeline inputs
+// from the repos in 'newRepos' (which will be created by the time the pipeline
+// is) don't need to exist yet.
+func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName string, input *pps.Input, job bool, newRepos map[string]bool) error {
 	if err := validateNames(make(map[string]bool), input); err != nil {
 		return err
 	}
@@ -193,6 +217,11 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 				case len(input.Atom.Glob) == 0:
 					return fmt.Errorf("input must specify a glob")
 				}
+				if input.Atom.JoinOn != "" {
+					if _, err := regexp.Compile(input.Atom.JoinOn); err != nil {
+						return fmt.Errorf("error parsing join_on of input %s: %v", input.Atom.Name, err)
+					}
+				}
 				// Note that input.Atom.Commit is empty if a) this is a job b) one of
 				// the job pipeline's input branches has no commits yet
 				if job && input.Atom.Commit != "" {
@@ -200,7 +229,7 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 					if _, err := pachClient.InspectCommit(input.Atom.Repo, input.Atom.Commit); err != nil {
 						return err
 					}
-				} else {
+				} else if !newRepos[input.Atom.Repo] {
 					// for pipelines we only check that the repo exists
 					if _, err := pachClient.InspectRepo(input.Atom.Repo); err != nil {
 						return err
@@ -219,6 +248,17 @@ func (a *apiServer) validateInput(pachClient *client.APIClient, pipelineName str
 				}
 				set = true
 			}
+			if input.Join != nil {
+				if set {
+					return fmt.Errorf("multiple input types set")
+				}
+				set = true
+				for _, input := range input.Join {
+					if input.Atom == nil || input.Atom.JoinOn == "" {
+						return fmt.Errorf("the inputs of a join must be atom inputs that set join_on")
+					}
+				}
+			}
 			if input.Cron != nil {
 				if set {
 					return fmt.Errorf("multiple input types set")
@@ -256,7 +296,7 @@ func (a *apiServer) validateJob(pachClient *client.APIClient, jobInfo *pps.JobIn
 	if err := validateTransform(jobInfo.Transform); err != nil {
 		return err
 	}
-	return a.validateInput(pachClient, jobInfo.Pipeline.Name, jobInfo.Input, true)
+	return a.validateInput(pachClient, jobInfo.Pipeline.Name, jobInfo.Input, true, nil)
 }
 
 func (a *apiServer) validateKube() {
@@ -343,12 +383,13 @@ func (a *apiServer) CreateJob(ctx context.Context, request *pps.CreateJobRequest
 	ctx = pachClient.Ctx() // pachClient will propagate auth info
 
 	job := &pps.Job{uuid.NewWithoutDashes()}
-	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+	_, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 		jobPtr := &pps.EtcdJobInfo{
 			Job:          job,
 			OutputCommit: request.OutputCommit,
 			Pipeline:     request.Pipeline,
 			Stats:        &pps.ProcessStats{},
+			TraceParent:  tracing.TraceParent(ctx),
 		}
 		return a.updateJobState(stm, jobPtr, pps.JobState_JOB_STARTING)
 	})
@@ -403,6 +444,12 @@ func (a *apiServer) InspectJob(ctx context.Context, request *pps.InspectJobReque
 	if err != nil {
 		return nil, err
 	}
+	if jobInfo.State == pps.JobState_JOB_STARTING {
+		jobInfo.QueuePosition, err = a.jobQueuePosition(ctx, jobInfo.Job.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
 	// If the job is running we fill in WorkerStatus field, otherwise we just
 	// return the jobInfo.
 	if jobInfo.State != pps.JobState_JOB_RUNNING {
@@ -510,6 +557,7 @@ func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.Etc
 		StatsCommit:   jobPtr.StatsCommit,
 		State:         jobPtr.State,
 		Reason:        jobPtr.Reason,
+		Rerun:         jobPtr.Rerun,
 	}
 	commitInfo, err := pachClient.InspectCommit(jobPtr.OutputCommit.Repo.Name, jobPtr.OutputCommit.ID)
 	if err != nil {
@@ -555,10 +603,20 @@ func (a *apiServer) jobInfoFromPtr(pachClient *client.APIClient, jobPtr *pps.Etc
 	result.Incremental = pipelineInfo.Incremental
 	result.EnableStats = pipelineInfo.EnableStats
 	result.Salt = pipelineInfo.Salt
+	if jobPtr.Rerun {
+		// Re-run jobs hash their datums with a salt of their own, so that
+		// none of their datums are skipped, and so that their output doesn't
+		// replace the output of the pipeline's other jobs
+		result.Salt = fmt.Sprintf("%s-%s", pipelineInfo.Salt, jobPtr.Job.ID)
+	}
 	result.Batch = pipelineInfo.Batch
 	result.ChunkSpec = pipelineInfo.ChunkSpec
 	result.DatumTimeout = pipelineInfo.DatumTimeout
 	result.JobTimeout = pipelineInfo.JobTimeout
+	result.DatumTries = pipelineInfo.DatumTries
+	result.DatumBackoff = pipelineInfo.DatumBackoff
+	result.SkipFailedDatums = pipelineInfo.SkipFailedDatums
+	result.Priority = pipelineInfo.Priority
 	return result, nil
 }
 
@@ -636,7 +694,7 @@ func (a *apiServer) DeleteJob(ctx context.Context, request *pps.DeleteJobRequest
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
 
-	_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+	_, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 		return a.jobs.ReadWrite(stm).Delete(request.Job.ID)
 	})
 	if err != nil {
@@ -685,7 +743,7 @@ func (a *apiServer) RestartDatum(ctx context.Context, request *pps.RestartDatumR
 // listDatum contains our internal implementation of ListDatum, which is shared
 // between ListDatum and ListDatumStream. When ListDatum is removed, this should
 // be inlined into ListDatumStream
-func (a *apiServer) listDatum(pachClient *client.APIClient, job *pps.Job, page, pageSize int64) (response *pps.ListDatumResponse, retErr error) {
+func (a *apiServer) listDatum(pachClient *client.APIClient, job *pps.Job, page, pageSize int64, failed bool) (response *pps.ListDatumResponse, retErr error) {
 	response = &pps.ListDatumResponse{}
 	ctx := pachClient.Ctx()
 	pfsClient := pachClient.PfsAPIClient
@@ -731,6 +789,53 @@ func (a *apiServer) listDatum(pachClient *client.APIClient, job *pps.Job, page,
 	if err != nil {
 		return nil, err
 	}
+	// If only failed datums were requested, read them from the job's failure
+	// manifest
+	if failed {
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := a.jobs.ReadOnly(ctx).Get(job.ID, jobPtr); err != nil {
+			return nil, err
+		}
+		if jobPtr.FailedDatums == nil {
+			return response, nil
+		}
+		manifestBytes, err := pachClient.ReadObject(jobPtr.FailedDatums.Hash)
+		if err != nil {
+			return nil, err
+		}
+		manifest := &workerpkg.FailedDatums{}
+		if err := manifest.Unmarshal(manifestBytes); err != nil {
+			return nil, err
+		}
+		indices := manifest.Indices
+		if pageSize > 0 {
+			start, end, err := getPageBounds(len(indices))
+			if err != nil {
+				return nil, err
+			}
+			response.Page = page
+			response.TotalPages = getTotalPages(len(indices))
+			indices = indices[start:end]
+		}
+		for _, i := range indices {
+			if i < 0 || int(i) >= df.Len() {
+				return nil, fmt.Errorf("failed datum %d is out of range for job %s (which has %d datums)", i, job.ID, df.Len())
+			}
+			datum := df.Datum(int(i))
+			datumInfo := &pps.DatumInfo{
+				Datum: &pps.Datum{
+					ID:  workerpkg.DatumID(datum),
+					Job: jobInfo.Job,
+				},
+				State: pps.DatumState_FAILED,
+			}
+			for _, input := range datum {
+				datumInfo.Data = append(datumInfo.Data, input.FileInfo)
+			}
+			response.DatumInfos = append(response.DatumInfos, datumInfo)
+		}
+		return response, nil
+	}
 	// If there's no stats commit (job not finished), compute datums using jobInfo
 	if jobInfo.StatsCommit == nil {
 		start := 0
@@ -861,7 +966,7 @@ func (a *apiServer) ListDatum(ctx context.Context, request *pps.ListDatumRequest
 		}
 	}(time.Now())
 	pachClient := a.getPachClient().WithCtx(ctx)
-	return a.listDatum(pachClient, request.Job, request.Page, request.PageSize)
+	return a.listDatum(pachClient, request.Job, request.Page, request.PageSize, request.Failed)
 }
 
 func (a *apiServer) ListDatumStream(req *pps.ListDatumRequest, resp pps.API_ListDatumStreamServer) (retErr error) {
@@ -871,7 +976,7 @@ func (a *apiServer) ListDatumStream(req *pps.ListDatumRequest, resp pps.API_List
 		a.Log(req, fmt.Sprintf("stream containing %d DatumInfos", sent), retErr, time.Since(start))
 	}(time.Now())
 	pachClient := a.getPachClient().WithCtx(resp.Context())
-	ldr, err := a.listDatum(pachClient, req.Job, req.Page, req.PageSize)
+	ldr, err := a.listDatum(pachClient, req.Job, req.Page, req.PageSize, req.Failed)
 	if err != nil {
 		return err
 	}
@@ -950,6 +1055,7 @@ func (a *apiServer) getDatum(pachClient *client.APIClient, repo string, commit *
 		return nil, err
 	}
 	datumInfo.Stats = stats
+	datumInfo.Attempts = stats.Attempts
 	buffer.Reset()
 	if err := pachClient.GetFile(commit.Repo.Name, commit.ID, fmt.Sprintf("/%v/index", datumID), 0, 0, &buffer); err != nil {
 		return nil, err
@@ -1233,8 +1339,8 @@ func (a *apiServer) getLogsFromStats(pachClient *client.APIClient, request *pps.
 	return eg.Wait()
 }
 
-func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo) error {
-	if err := a.validateInput(pachClient, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false); err != nil {
+func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, newRepos map[string]bool) error {
+	if err := a.validateInput(pachClient, pipelineInfo.Pipeline.Name, pipelineInfo.Input, false, newRepos); err != nil {
 		return err
 	}
 	if err := validateTransform(pipelineInfo.Transform); err != nil {
@@ -1255,6 +1361,28 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 		if pipelineInfo.Service != nil && pipelineInfo.ParallelismSpec.Constant != 1 {
 			return fmt.Errorf("services can only be run with a constant parallelism of 1")
 		}
+		if autoscaling := pipelineInfo.ParallelismSpec.Autoscaling; autoscaling != nil {
+			if pipelineInfo.ParallelismSpec.Constant != 0 ||
+				pipelineInfo.ParallelismSpec.Coefficient != 0 {
+				return fmt.Errorf("contradictory parallelism strategies: " +
+					"ParallelismSpec.Autoscaling can't be set with ParallelismSpec.Constant " +
+					"or ParallelismSpec.Coefficient")
+			}
+			if autoscaling.Max == 0 {
+				return fmt.Errorf("ParallelismSpec.Autoscaling.Max must be > 0")
+			}
+			if autoscaling.Min > autoscaling.Max {
+				return fmt.Errorf("ParallelismSpec.Autoscaling.Min must be <= ParallelismSpec.Autoscaling.Max")
+			}
+			if autoscaling.TargetDuration != nil {
+				if _, err := types.DurationFromProto(autoscaling.TargetDuration); err != nil {
+					return fmt.Errorf("invalid ParallelismSpec.Autoscaling.TargetDuration: %v", err)
+				}
+			}
+			if pipelineInfo.ScaleDownThreshold != nil {
+				return fmt.Errorf("scale_down_threshold can't be used with ParallelismSpec.Autoscaling")
+			}
+		}
 	}
 	if pipelineInfo.OutputBranch == "" {
 		return fmt.Errorf("pipeline needs to specify an output branch")
@@ -1300,6 +1428,18 @@ func (a *apiServer) validatePipeline(pachClient *client.APIClient, pipelineInfo
 			return err
 		}
 	}
+	if pipelineInfo.DatumTries < 1 {
+		return fmt.Errorf("datum_tries must be at least 1")
+	}
+	if pipelineInfo.DatumBackoff != nil {
+		datumBackoff, err := types.DurationFromProto(pipelineInfo.DatumBackoff)
+		if err != nil {
+			return err
+		}
+		if datumBackoff < 0 {
+			return fmt.Errorf("datum_backoff must not be negative")
+		}
+	}
 	return nil
 }
 
@@ -1521,6 +1661,14 @@ func (a *apiServer) sudo(pachClient *client.APIClient, f func(*client.APIClient)
 // pipeline.
 func (a *apiServer) makePipelineInfoCommit(pachClient *client.APIClient, pipelineInfo *pps.PipelineInfo, update bool) (*pfs.Commit, error) {
 	pipelineName := pipelineInfo.Pipeline.Name
+	// The spec commit's description records the user creating this version of
+	// the pipeline (see ListPipelineHistory)
+	var author string
+	if me, err := pachClient.WhoAmI(pachClient.Ctx(), &auth.WhoAmIRequest{}); err == nil {
+		author = me.Username
+	} else if !auth.IsErrNotActivated(err) {
+		return nil, err
+	}
 	var commit *pfs.Commit
 	if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
 		// If we're creating a new pipeline, create the pipeline branch
@@ -1555,7 +1703,11 @@ func (a *apiServer) makePipelineInfoCommit(pachClient *client.APIClient, pipelin
 		if _, err := superUserClient.PutFile(ppsconsts.SpecRepo, commit.ID, ppsconsts.SpecFile, bytes.NewReader(data)); err != nil {
 			return grpcutil.ScrubGRPC(err)
 		}
-		return grpcutil.ScrubGRPC(superUserClient.FinishCommit(ppsconsts.SpecRepo, commit.ID))
+		_, err = superUserClient.PfsAPIClient.FinishCommit(superUserClient.Ctx(), &pfs.FinishCommitRequest{
+			Commit:      commit,
+			Description: author,
+		})
+		return grpcutil.ScrubGRPC(err)
 	}); err != nil {
 		return nil, err
 	}
@@ -1673,44 +1825,37 @@ func (a *apiServer) addPipelineToRepoACLs(pachClient *client.APIClient, pipeline
 func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
-	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreatePipeline")
-	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	// Dry runs don't change anything, so they aren't reported
+	if !request.DryRun {
+		metricsFn := metrics.ReportUserAction(ctx, a.reporter, "CreatePipeline")
+		defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	}
 	pachClient := a.getPachClient().WithCtx(ctx)
 	ctx = pachClient.Ctx() // pachClient will propagate auth info
 	pfsClient := pachClient.PfsAPIClient
 	if request.Salt == "" {
 		request.Salt = uuid.NewWithoutDashes()
 	}
-	pipelineInfo := &pps.PipelineInfo{
-		Pipeline:           request.Pipeline,
-		Version:            1,
-		Transform:          request.Transform,
-		ParallelismSpec:    request.ParallelismSpec,
-		Input:              request.Input,
-		OutputBranch:       request.OutputBranch,
-		Egress:             request.Egress,
-		CreatedAt:          now(),
-		ScaleDownThreshold: request.ScaleDownThreshold,
-		ResourceRequests:   request.ResourceRequests,
-		ResourceLimits:     request.ResourceLimits,
-		Description:        request.Description,
-		Incremental:        request.Incremental,
-		CacheSize:          request.CacheSize,
-		EnableStats:        request.EnableStats,
-		Salt:               request.Salt,
-		Batch:              request.Batch,
-		MaxQueueSize:       request.MaxQueueSize,
-		Service:            request.Service,
-		ChunkSpec:          request.ChunkSpec,
-		DatumTimeout:       request.DatumTimeout,
-		JobTimeout:         request.JobTimeout,
-	}
-	setPipelineDefaults(pipelineInfo)
-
-	// Validate new pipeline
-	if err := a.validatePipeline(pachClient, pipelineInfo); err != nil {
+	pipelineInfo := ppsutil.PipelineInfoFromReq(request)
+	pipelineInfo.Version = 1
+	pipelineInfo.CreatedAt = now()
+	ppsutil.SetPipelineDefaults(pipelineInfo)
+
+	// Validate new pipeline. Dry runs may name repos that will be created
+	// before the pipeline is, and which its inputs don't need to exist yet
+	var newRepos map[string]bool
+	if request.DryRun {
+		newRepos = make(map[string]bool)
+		for _, repo := range request.DryRunNewRepos {
+			newRepos[repo] = true
+		}
+	}
+	if err := a.validatePipeline(pachClient, pipelineInfo, newRepos); err != nil {
 		return nil, err
 	}
+	if request.DryRun {
+		return &types.Empty{}, nil
+	}
 	var visitErr error
 	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
 		if input.Cron != nil {
@@ -1765,7 +1910,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 			pipelinePtr     pps.EtcdPipelineInfo
 			oldPipelineInfo *pps.PipelineInfo
 		)
-		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 			// Read existing PipelineInfo from PFS output repo
 			return a.pipelines.ReadWrite(stm).Update(pipelineName, &pipelinePtr, func() error {
 				var err error
@@ -1835,7 +1980,7 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 		}
 
 		// Put a pointer to the new PipelineInfo commit into etcd
-		if _, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err = a.store.NewSTM(ctx, func(stm col.STM) error {
 			err = a.pipelines.ReadWrite(stm).Create(pipelineName, pipelinePtr)
 			if isAlreadyExistsErr(err) {
 				if err := a.sudo(pachClient, func(superUserClient *client.APIClient) error {
@@ -1870,58 +2015,6 @@ func (a *apiServer) CreatePipeline(ctx context.Context, request *pps.CreatePipel
 }
 
 // setPipelineDefaults sets the default values for a pipeline info
-func setPipelineDefaults(pipelineInfo *pps.PipelineInfo) {
-	now := time.Now()
-	if pipelineInfo.Transform.Image == "" {
-		pipelineInfo.Transform.Image = DefaultUserImage
-	}
-	pps.VisitInput(pipelineInfo.Input, func(input *pps.Input) {
-		if input.Atom != nil {
-			if input.Atom.Branch == "" {
-				input.Atom.Branch = "master"
-			}
-			if input.Atom.Name == "" {
-				input.Atom.Name = input.Atom.Repo
-			}
-		}
-		if input.Cron != nil {
-			if input.Cron.Start == nil {
-				start, _ := types.TimestampProto(now)
-				input.Cron.Start = start
-			}
-			if input.Cron.Repo == "" {
-				input.Cron.Repo = fmt.Sprintf("%s_%s", pipelineInfo.Pipeline.Name, input.Cron.Name)
-			}
-		}
-		if input.Git != nil {
-			if input.Git.Branch == "" {
-				input.Git.Branch = "master"
-			}
-			if input.Git.Name == "" {
-				// We know URL looks like:
-				// "https://github.com/sjezewski/testgithook.git",
-				tokens := strings.Split(path.Base(input.Git.URL), ".")
-				input.Git.Name = tokens[0]
-			}
-		}
-	})
-	if pipelineInfo.OutputBranch == "" {
-		// Output branches default to master
-		pipelineInfo.OutputBranch = "master"
-	}
-	if pipelineInfo.CacheSize == "" {
-		pipelineInfo.CacheSize = "64M"
-	}
-	if pipelineInfo.ResourceRequests == nil && pipelineInfo.CacheSize != "" {
-		pipelineInfo.ResourceRequests = &pps.ResourceSpec{
-			Memory: pipelineInfo.CacheSize,
-		}
-	}
-	if pipelineInfo.MaxQueueSize < 1 {
-		pipelineInfo.MaxQueueSize = 1
-	}
-}
-
 func (a *apiServer) InspectPipeline(ctx context.Context, request *pps.InspectPipelineRequest) (response *pps.PipelineInfo, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
@@ -2161,7 +2254,7 @@ func (a *apiServer) deletePipeline(pachClient *client.APIClient, request *pps.De
 	})
 	// Delete EtcdPipelineInfo
 	eg.Go(func() error {
-		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 			return a.pipelines.ReadWrite(stm).Delete(request.Pipeline.Name)
 		}); err != nil {
 			return fmt.Errorf("collection.Delete: %v", err)
@@ -2254,11 +2347,239 @@ func (a *apiServer) StopPipeline(ctx context.Context, request *pps.StopPipelineR
 	return &types.Empty{}, nil
 }
 
+// RerunPipeline re-runs the pipeline's previous jobs whose input commits are
+// selected by request.Include and request.Exclude. A new job is created for
+// each selected set of input commits, which runs with the pipeline's current
+// spec. The pipeline itself isn't updated: re-run jobs hash their datums with
+// a salt of their own (see jobInfoFromPtr), so none of their datums are
+// skipped, and their output doesn't replace that of the pipeline's other jobs.
+// The new jobs' output commits are not added to the output branch, so the
+// pipeline's current output is unaffected.
 func (a *apiServer) RerunPipeline(ctx context.Context, request *pps.RerunPipelineRequest) (response *types.Empty, retErr error) {
 	func() { a.Log(request, nil, nil, 0) }()
 	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "RerunPipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	pachClient := a.getPachClient().WithCtx(ctx)
+	ctx = pachClient.Ctx() // pachClient will propagate auth info
+
+	// Get request.Pipeline's info
+	pipelineInfo, err := a.inspectPipeline(pachClient, request.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// check if the caller is authorized to update this pipeline
+	if err := a.authorizePipelineOp(pachClient, pipelineOpUpdate, pipelineInfo.Input, pipelineInfo.Pipeline.Name); err != nil {
+		return nil, err
+	}
+
+	// Choose the jobs to re-run
+	include, err := a.commitAncestors(pachClient, request.Include)
+	if err != nil {
+		return nil, err
+	}
+	exclude, err := a.commitAncestors(pachClient, request.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	jobInfos, err := a.listJob(pachClient, pipelineInfo.Pipeline, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(jobInfos, func(i, j int) bool {
+		return jobInfos[i].Started.Compare(jobInfos[j].Started) < 0
+	})
+	var outputCommits []*pfs.Commit
+	seen := make(map[string]bool) // don't re-run the same input commits twice
+	for _, jobInfo := range jobInfos {
+		var key []string
+		selected := true
+		pps.VisitInput(jobInfo.Input, func(input *pps.Input) {
+			var repo, commit string
+			switch {
+			case input.Atom != nil:
+				repo, commit = input.Atom.Repo, input.Atom.Commit
+			case input.Cron != nil:
+				repo, commit = input.Cron.Repo, input.Cron.Commit
+			case input.Git != nil:
+				repo, commit = input.Git.Name, input.Git.Commit
+			default:
+				return
+			}
+			if include[repo] != nil && !include[repo][commit] {
+				selected = false
+			}
+			if exclude[repo][commit] {
+				selected = false
+			}
+			key = append(key, path.Join(repo, commit))
+		})
+		sort.Strings(key)
+		if !selected || seen[strings.Join(key, ",")] {
+			continue
+		}
+		seen[strings.Join(key, ",")] = true
+		outputCommits = append(outputCommits, jobInfo.OutputCommit)
+	}
+	if len(outputCommits) == 0 {
+		return nil, fmt.Errorf("no jobs of pipeline %s match the given commits", request.Pipeline.Name)
+	}
+
+	pipelinePtr := &pps.EtcdPipelineInfo{}
+	if err := a.pipelines.ReadOnly(ctx).Get(request.Pipeline.Name, pipelinePtr); err != nil {
+		return nil, err
+	}
+
+	// Create an output commit and a job for each re-run. The output commit has
+	// the same provenance as the original job's output commit, except for the
+	// spec commit, which is replaced with the pipeline's current spec commit
+	for _, outputCommit := range outputCommits {
+		commitInfo, err := pachClient.InspectCommit(outputCommit.Repo.Name, outputCommit.ID)
+		if err != nil {
+			return nil, err
+		}
+		var provenance []*pfs.Commit
+		for i, provCommit := range commitInfo.Provenance {
+			if commitInfo.BranchProvenance[i].Repo.Name == ppsconsts.SpecRepo {
+				provCommit = pipelinePtr.SpecCommit
+			}
+			provenance = append(provenance, provCommit)
+		}
+		newCommit, err := pachClient.PfsAPIClient.StartCommit(ctx, &pfs.StartCommitRequest{
+			Parent:           client.NewCommit(outputCommit.Repo.Name, ""),
+			Provenance:       provenance,
+			BranchProvenance: commitInfo.BranchProvenance,
+			Description:      fmt.Sprintf("rerun of %s", outputCommit.ID),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
+			jobPtr := &pps.EtcdJobInfo{
+				Job:          &pps.Job{ID: uuid.NewWithoutDashes()},
+				OutputCommit: newCommit,
+				Pipeline:     request.Pipeline,
+				Stats:        &pps.ProcessStats{},
+				Rerun:        true,
+			}
+			return a.updateJobState(stm, jobPtr, pps.JobState_JOB_STARTING)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return &types.Empty{}, nil
+}
+
+// commitAncestors returns, for each repo, the set of commit IDs that are
+// ancestors of one of 'commits' (as in ListCommit, a commit is considered an
+// ancestor of itself). Repos with no commits in 'commits' are not in the
+// result.
+func (a *apiServer) commitAncestors(pachClient *client.APIClient, commits []*pfs.Commit) (map[string]map[string]bool, error) {
+	result := make(map[string]map[string]bool)
+	for _, commit := range commits {
+		commitInfos, err := pachClient.ListCommit(commit.Repo.Name, commit.ID, "", 0)
+		if err != nil {
+			return nil, err
+		}
+		if result[commit.Repo.Name] == nil {
+			result[commit.Repo.Name] = make(map[string]bool)
+		}
+		for _, commitInfo := range commitInfos {
+			result[commit.Repo.Name][commitInfo.Commit.ID] = true
+		}
+	}
+	return result, nil
+}
+
+// ListPipelineHistory returns the versions of a pipeline's spec. Each version
+// is a commit in the pipeline's branch of the spec repo, written by
+// makePipelineInfoCommit.
+func (a *apiServer) ListPipelineHistory(ctx context.Context, request *pps.ListPipelineHistoryRequest) (response *pps.PipelineHistory, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	pachClient := a.getPachClient().WithCtx(ctx)
+
+	// inspect the pipeline here so that if it doesn't exist users get a
+	// sensible error message
+	if _, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err != nil {
+		return nil, err
+	}
+	return a.listPipelineHistory(pachClient, request.Pipeline.Name)
+}
 
-	return nil, fmt.Errorf("TODO")
+func (a *apiServer) listPipelineHistory(pachClient *client.APIClient, pipelineName string) (*pps.PipelineHistory, error) {
+	commitInfos, err := pachClient.ListCommit(ppsconsts.SpecRepo, pipelineName, "", 0)
+	if err != nil {
+		return nil, err
+	}
+	result := &pps.PipelineHistory{}
+	for _, commitInfo := range commitInfos {
+		if commitInfo.Finished == nil {
+			continue // a CreatePipeline call failed while writing this version
+		}
+		var buf bytes.Buffer
+		if err := pachClient.GetFile(ppsconsts.SpecRepo, commitInfo.Commit.ID, ppsconsts.SpecFile, 0, 0, &buf); err != nil {
+			return nil, fmt.Errorf("could not read PipelineInfo from spec commit %s: %v", commitInfo.Commit.ID, err)
+		}
+		pipelineInfo := &pps.PipelineInfo{}
+		if err := pipelineInfo.Unmarshal(buf.Bytes()); err != nil {
+			return nil, fmt.Errorf("could not unmarshal PipelineInfo from spec commit %s: %v", commitInfo.Commit.ID, err)
+		}
+		result.Versions = append(result.Versions, &pps.PipelineVersion{
+			Version:      pipelineInfo.Version,
+			CreatedAt:    pipelineInfo.CreatedAt,
+			Author:       commitInfo.Description,
+			SpecCommit:   commitInfo.Commit,
+			PipelineInfo: pipelineInfo,
+		})
+	}
+	return result, nil
+}
+
+// RollbackPipeline restores the spec that a pipeline had at a previous
+// version. The pipeline is updated (as with 'update-pipeline') with the old
+// spec, so rolling back creates a new version of the pipeline.
+func (a *apiServer) RollbackPipeline(ctx context.Context, request *pps.RollbackPipelineRequest) (response *types.Empty, retErr error) {
+	func() { a.Log(request, nil, nil, 0) }()
+	defer func(start time.Time) { a.Log(request, response, retErr, time.Since(start)) }(time.Now())
+	metricsFn := metrics.ReportUserAction(ctx, a.reporter, "RollbackPipeline")
+	defer func(start time.Time) { metricsFn(start, retErr) }(time.Now())
+	pachClient := a.getPachClient().WithCtx(ctx)
+	ctx = pachClient.Ctx() // pachClient will propagate auth info
+
+	if _, err := a.inspectPipeline(pachClient, request.Pipeline.Name); err != nil {
+		return nil, err
+	}
+	history, err := a.listPipelineHistory(pachClient, request.Pipeline.Name)
+	if err != nil {
+		return nil, err
+	}
+	var pipelineInfo *pps.PipelineInfo
+	for _, version := range history.Versions {
+		if version.Version == request.Version {
+			pipelineInfo = version.PipelineInfo
+			break
+		}
+	}
+	if pipelineInfo == nil {
+		return nil, fmt.Errorf("pipeline %s has no version %d", request.Pipeline.Name, request.Version)
+	}
+	if len(history.Versions) > 0 && history.Versions[0].Version == request.Version {
+		return nil, fmt.Errorf("pipeline %s is already at version %d", request.Pipeline.Name, request.Version)
+	}
+
+	// CreatePipeline checks that the caller is authorized to update this
+	// pipeline. The old version's salt is dropped, so that the pipeline keeps
+	// its current salt, or gets a new one if request.Reprocess is set
+	updateRequest := ppsutil.PipelineReqFromInfo(pipelineInfo)
+	updateRequest.Update = true
+	updateRequest.Reprocess = request.Reprocess
+	updateRequest.Salt = ""
+	if _, err := a.CreatePipeline(ctx, updateRequest); err != nil {
+		return nil, err
+	}
+	return &types.Empty{}, nil
 }
 
 func (a *apiServer) DeleteAll(ctx context.Context, request *types.Empty) (response *types.Empty, retErr error) {
@@ -2537,7 +2858,7 @@ func (a *apiServer) ActivateAuth(ctx context.Context, req *pps.ActivateAuthReque
 				if err != nil {
 					return fmt.Errorf("could not generate pipeline auth token: %v", grpcutil.ScrubGRPC(err))
 				}
-				_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+				_, err = a.store.NewSTM(ctx, func(stm col.STM) error {
 					var pipelinePtr pps.EtcdPipelineInfo
 					if err := a.pipelines.ReadWrite(stm).Update(pipelineName, &pipelinePtr, func() error {
 						pipelinePtr.AuthToken = tokenResp.Token
@@ -2618,7 +2939,7 @@ func pipelineStateToStopped(state pps.PipelineState) bool {
 }
 
 func (a *apiServer) updatePipelineState(pachClient *client.APIClient, pipelineName string, state pps.PipelineState) error {
-	_, err := col.NewSTM(pachClient.Ctx(), a.etcdClient, func(stm col.STM) error {
+	_, err := a.store.NewSTM(pachClient.Ctx(), func(stm col.STM) error {
 		pipelines := a.pipelines.ReadWrite(stm)
 		pipelinePtr := &pps.EtcdPipelineInfo{}
 		if err := pipelines.Get(pipelineName, pipelinePtr); err != nil {
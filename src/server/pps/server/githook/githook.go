@@ -26,10 +26,10 @@ const apiVersion = "v1"
 
 // gitHookServer serves GetFile requests over HTTP
 type gitHookServer struct {
-	hook       *github.Webhook
-	client     *client.APIClient
-	etcdClient *etcd.Client
-	pipelines  col.Collection
+	hook      *github.Webhook
+	client    *client.APIClient
+	store     col.Store
+	pipelines col.Collection
 }
 
 func hookPath() string {
@@ -66,11 +66,12 @@ func RunGitHookServer(address string, etcdAddress string, etcdPrefix string) err
 		return err
 	}
 	hook := github.New(&github.Config{})
+	store := col.NewEtcdStore(etcdClient)
 	s := &gitHookServer{
 		hook,
 		c,
-		etcdClient,
-		ppsdb.Pipelines(etcdClient, etcdPrefix),
+		store,
+		ppsdb.Pipelines(store, etcdPrefix),
 	}
 
 	hook.RegisterEvents(
@@ -137,7 +138,7 @@ func (s *gitHookServer) HandlePush(payload interface{}, _ webhooks.Header) (retE
 	}
 	if pl.Repository.Private {
 		for _, pipelineInfo := range pipelines {
-			if err := ppsutil.FailPipeline(context.Background(), s.etcdClient, s.pipelines, pipelineInfo.Pipeline.Name, fmt.Sprintf("unable to clone private github repo (%v)", pl.Repository.CloneURL)); err != nil {
+			if err := ppsutil.FailPipeline(context.Background(), s.store, s.pipelines, pipelineInfo.Pipeline.Name, fmt.Sprintf("unable to clone private github repo (%v)", pl.Repository.CloneURL)); err != nil {
 				// err will be handled but first we want to
 				// try and fail all relevant pipelines
 				logrus.Errorf("error marking pipeline %v as failed %v", pipelineInfo.Pipeline.Name, err)
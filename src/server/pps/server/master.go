@@ -265,7 +265,7 @@ func (a *apiServer) master() {
 }
 
 func (a *apiServer) setPipelineFailure(ctx context.Context, pipelineName string, reason string) error {
-	return ppsutil.FailPipeline(ctx, a.etcdClient, a.pipelines, pipelineName, reason)
+	return ppsutil.FailPipeline(ctx, a.store, a.pipelines, pipelineName, reason)
 }
 
 func (a *apiServer) checkOrDeployGithookService() error {
@@ -313,6 +313,14 @@ func (a *apiServer) upsertWorkersForPipeline(pipelineInfo *pps.PipelineInfo) err
 			log.Errorf("error getting number of workers, default to 1 worker: %v", err)
 			parallelism = 1
 		}
+		if autoscaling := pipelineInfo.ParallelismSpec.GetAutoscaling(); autoscaling != nil {
+			// Autoscaling pipelines start with their minimum number of workers,
+			// and the pipeline's master scales them from there
+			parallelism = int(autoscaling.Min)
+			if parallelism < 1 {
+				parallelism = 1
+			}
+		}
 		var resourceRequests *v1.ResourceList
 		var resourceLimits *v1.ResourceList
 		if pipelineInfo.ResourceRequests != nil {
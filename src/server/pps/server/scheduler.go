@@ -0,0 +1,221 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
+)
+
+const (
+	schedulerLockPath = "_scheduler_lock"
+	// schedulerInterval is how often the scheduler re-checks the job queue even
+	// if it hasn't changed, so that the slots of jobs that finished without
+	// leaving the queue (e.g. because their pipeline was deleted) are reclaimed
+	schedulerInterval = time.Minute
+)
+
+// jobScheduler admits the jobs in the job queue, in priority order, until
+// maxConcurrentJobs jobs are running. Workers add their jobs to the queue once
+// the jobs' inputs are ready, and wait to be admitted before processing them.
+func (a *apiServer) jobScheduler() {
+	schedulerLock := dlock.NewDLock(a.etcdClient, path.Join(a.etcdPrefix, schedulerLockPath))
+	backoff.RetryNotify(func() error {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		ctx, err := schedulerLock.Lock(ctx)
+		if err != nil {
+			return err
+		}
+		defer schedulerLock.Unlock(ctx)
+
+		// Tell workers whether their jobs need to wait in the queue
+		if _, err := a.etcdClient.Put(ctx, ppsdb.MaxConcurrentJobsKey(a.etcdPrefix), strconv.FormatInt(a.maxConcurrentJobs, 10)); err != nil {
+			return err
+		}
+
+		watcher, err := a.jobQueue.ReadOnly(ctx).Watch()
+		if err != nil {
+			return err
+		}
+		defer watcher.Close()
+		ticker := time.NewTicker(schedulerInterval)
+		defer ticker.Stop()
+		// queue holds the entries in the job queue, by job ID. The watch
+		// starts with the queue's current entries and then keeps 'queue' up to
+		// date, so admitting jobs doesn't re-read the queue from etcd.
+		queue := make(map[string]*pps.EtcdJobQueueEntry)
+		for {
+			select {
+			case event, ok := <-watcher.Watch():
+				if !ok {
+					return fmt.Errorf("job queue watch closed unexpectedly")
+				}
+				if event.Err != nil {
+					return event.Err
+				}
+				var jobID string
+				entry := &pps.EtcdJobQueueEntry{}
+				if err := event.Unmarshal(&jobID, entry); err != nil {
+					return err
+				}
+				if event.Type == watch.EventDelete {
+					delete(queue, jobID)
+				} else {
+					queue[jobID] = entry
+				}
+			case <-ticker.C:
+				if err := a.removeFinishedJobs(ctx, queue); err != nil {
+					return err
+				}
+			}
+			if err := a.admitJobs(ctx, queue); err != nil {
+				return err
+			}
+		}
+	}, backoff.NewInfiniteBackOff(), func(err error, d time.Duration) error {
+		log.Errorf("job scheduler: error running the scheduler process: %v; retrying in %v", err, d)
+		return nil
+	})
+}
+
+// removeFinishedJobs removes the entries of finished (or deleted) jobs from
+// the job queue. Workers remove their jobs' entries when the jobs finish, so
+// this only reclaims the slots of jobs whose workers didn't (e.g. because
+// their pipeline was deleted).
+func (a *apiServer) removeFinishedJobs(ctx context.Context, queue map[string]*pps.EtcdJobQueueEntry) error {
+	for jobID := range queue {
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := a.jobs.ReadOnly(ctx).Get(jobID, jobPtr); err != nil && !col.IsErrNotFound(err) {
+			return err
+		} else if err == nil && !ppsutil.IsTerminal(jobPtr.State) {
+			continue
+		}
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
+			return a.jobQueue.ReadWrite(stm).Delete(jobID)
+		}); err != nil && !col.IsErrNotFound(err) {
+			return err
+		}
+		delete(queue, jobID)
+	}
+	return nil
+}
+
+// admitJobs admits the waiting jobs in 'queue', in priority order, until
+// maxConcurrentJobs jobs have been admitted.
+func (a *apiServer) admitJobs(ctx context.Context, queue map[string]*pps.EtcdJobQueueEntry) error {
+	var admitted int64
+	var waiting []*pps.EtcdJobQueueEntry
+	for _, entry := range queue {
+		if entry.Admitted {
+			admitted++
+		} else {
+			waiting = append(waiting, entry)
+		}
+	}
+	if len(waiting) == 0 || (a.maxConcurrentJobs > 0 && admitted >= a.maxConcurrentJobs) {
+		return nil
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		return jobQueueLess(waiting[i], waiting[j])
+	})
+	for _, entry := range waiting {
+		if a.maxConcurrentJobs > 0 && admitted >= a.maxConcurrentJobs {
+			break
+		}
+		jobID := entry.Job.ID
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
+			entry := &pps.EtcdJobQueueEntry{}
+			return a.jobQueue.ReadWrite(stm).Update(jobID, entry, func() error {
+				entry.Admitted = true
+				return nil
+			})
+		}); err != nil {
+			if col.IsErrNotFound(err) {
+				delete(queue, jobID)
+				continue
+			}
+			return err
+		}
+		// Update 'queue' now, rather than when the watch catches up, so that
+		// the job is counted as admitted by the next call
+		entry.Admitted = true
+		admitted++
+	}
+	return nil
+}
+
+// listJobQueue returns the entries in the job queue in the order in which
+// jobs are admitted: admitted jobs first, and then waiting jobs by priority
+// and then by the time at which they were queued.
+func (a *apiServer) listJobQueue(ctx context.Context) ([]*pps.EtcdJobQueueEntry, error) {
+	var entries []*pps.EtcdJobQueueEntry
+	iter, err := a.jobQueue.ReadOnly(ctx).List()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		var jobID string
+		entry := &pps.EtcdJobQueueEntry{}
+		ok, err := iter.Next(&jobID, entry)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return jobQueueLess(entries[i], entries[j])
+	})
+	return entries, nil
+}
+
+func jobQueueLess(a, b *pps.EtcdJobQueueEntry) bool {
+	if a.Admitted != b.Admitted {
+		return a.Admitted
+	}
+	if a.Priority != b.Priority {
+		return a.Priority > b.Priority
+	}
+	if a.Queued.GetSeconds() != b.Queued.GetSeconds() {
+		return a.Queued.GetSeconds() < b.Queued.GetSeconds()
+	}
+	if a.Queued.GetNanos() != b.Queued.GetNanos() {
+		return a.Queued.GetNanos() < b.Queued.GetNanos()
+	}
+	return a.Job.ID < b.Job.ID
+}
+
+// jobQueuePosition returns the position of 'jobID' among the jobs waiting to
+// be admitted (1 means it's next), or 0 if the job isn't waiting.
+func (a *apiServer) jobQueuePosition(ctx context.Context, jobID string) (int64, error) {
+	entries, err := a.listJobQueue(ctx)
+	if err != nil {
+		return 0, err
+	}
+	var position int64
+	for _, entry := range entries {
+		if entry.Admitted {
+			continue
+		}
+		position++
+		if entry.Job.ID == jobID {
+			return position, nil
+		}
+	}
+	return 0, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pachyderm/pachyderm/src/client"
 	ppsclient "github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
 	"github.com/pachyderm/pachyderm/src/server/pkg/log"
 	"github.com/pachyderm/pachyderm/src/server/pkg/metrics"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
@@ -28,6 +29,7 @@ func NewAPIServer(
 	storageHostPath string,
 	iamRole string,
 	imagePullSecret string,
+	maxConcurrentJobs int64,
 	reporter *metrics.Reporter,
 ) (ppsclient.APIServer, error) {
 	etcdClient, err := etcd.New(etcd.Config{
@@ -38,6 +40,7 @@ func NewAPIServer(
 		return nil, fmt.Errorf("could not create etcd client: %v", err)
 	}
 
+	store := col.NewEtcdStore(etcdClient)
 	apiServer := &apiServer{
 		Logger:                log.NewLogger("pps.API"),
 		etcdPrefix:            etcdPrefix,
@@ -53,12 +56,16 @@ func NewAPIServer(
 		storageHostPath:       storageHostPath,
 		iamRole:               iamRole,
 		imagePullSecret:       imagePullSecret,
+		maxConcurrentJobs:     maxConcurrentJobs,
 		reporter:              reporter,
-		pipelines:             ppsdb.Pipelines(etcdClient, etcdPrefix),
-		jobs:                  ppsdb.Jobs(etcdClient, etcdPrefix),
+		store:                 store,
+		pipelines:             ppsdb.Pipelines(store, etcdPrefix),
+		jobs:                  ppsdb.Jobs(store, etcdPrefix),
+		jobQueue:              ppsdb.JobQueue(store, etcdPrefix),
 	}
 	apiServer.validateKube()
 	go apiServer.master() // calls a.getPachClient(), which initializes spec repo
+	go apiServer.jobScheduler()
 	return apiServer, nil
 }
 
@@ -80,6 +87,7 @@ func NewSidecarAPIServer(
 		return nil, err
 	}
 
+	store := col.NewEtcdStore(etcdClient)
 	apiServer := &apiServer{
 		Logger:     log.NewLogger("pps.API"),
 		address:    address,
@@ -87,8 +95,9 @@ func NewSidecarAPIServer(
 		etcdClient: etcdClient,
 		iamRole:    iamRole,
 		reporter:   reporter,
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
+		store:      store,
+		pipelines:  ppsdb.Pipelines(store, etcdPrefix),
+		jobs:       ppsdb.Jobs(store, etcdPrefix),
 	}
 	go apiServer.getPachClient() // connects back to pachd and inits spec repo
 	return apiServer, nil
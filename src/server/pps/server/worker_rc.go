@@ -2,13 +2,16 @@ package server
 
 import (
 	"context"
+	"os"
 
 	client "github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/enterprise"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/client/version"
 	"github.com/pachyderm/pachyderm/src/server/pkg/deploy/assets"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
 
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -39,6 +42,18 @@ type workerOptions struct {
 	service          *pps.Service
 }
 
+// tracingEnv returns the tracing configuration that pachd was deployed with,
+// so that workers and their sidecars can be given the same configuration
+func tracingEnv() []v1.EnvVar {
+	var result []v1.EnvVar
+	for _, name := range []string{tracing.EndpointEnvVar, tracing.SampleRatioEnvVar} {
+		if value := os.Getenv(name); value != "" {
+			result = append(result, v1.EnvVar{Name: name, Value: value})
+		}
+	}
+	return result
+}
+
 func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 	pullPolicy := a.workerImagePullPolicy
 	if pullPolicy == "" {
@@ -57,6 +72,7 @@ func (a *apiServer) workerPodSpec(options *workerOptions) (v1.PodSpec, error) {
 		Name:  "STORAGE_BACKEND",
 		Value: a.storageBackend,
 	}}
+	sidecarEnv = append(sidecarEnv, tracingEnv()...)
 	// This only happens in local deployment.  We want the workers to be
 	// able to read from/write to the hostpath volume as well.
 	storageVolumeName := "pach-disk"
@@ -232,6 +248,8 @@ func (a *apiServer) getWorkerOptions(pipelineName string, pipelineVersion uint64
 		Name:  client.PPSSpecCommitEnv,
 		Value: specCommitID,
 	})
+	// Export the workers' spans to the same place as pachd's
+	workerEnv = append(workerEnv, tracingEnv()...)
 
 	var volumes []v1.Volume
 	var volumeMounts []v1.VolumeMount
@@ -335,6 +353,13 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 	if err != nil {
 		return err
 	}
+	// Workers' pods are also annotated so that Prometheus scrapes the metrics
+	// that the worker serves, which include those of the pod's pachd sidecar
+	// (pods can only be annotated with one port to scrape)
+	podAnnotations := prom.ScrapeAnnotations(prom.WorkerPort)
+	for key, value := range options.annotations {
+		podAnnotations[key] = value
+	}
 	rc := &v1.ReplicationController{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "ReplicationController",
@@ -352,7 +377,7 @@ func (a *apiServer) createWorkerRc(options *workerOptions) error {
 				ObjectMeta: metav1.ObjectMeta{
 					Name:        options.rcName,
 					Labels:      options.labels,
-					Annotations: options.annotations,
+					Annotations: podAnnotations,
 				},
 				Spec: podSpec,
 			},
@@ -39,6 +39,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
 	"github.com/pachyderm/pachyderm/src/client/pkg/grpcutil"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
 	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
@@ -59,7 +60,9 @@ const (
 	chunksPrefix = "/chunks"
 	lockPrefix   = "/locks"
 
-	maxRetries = 3
+	// defaultDatumTries is the number of times a datum is tried for jobs
+	// created before pipelines had a retry policy
+	defaultDatumTries = 3
 )
 
 var (
@@ -102,12 +105,18 @@ type APIServer struct {
 	numWorkers int
 	// The namespace in which pachyderm is deployed
 	namespace string
+	// The store that holds the collections below, and the per-job locks
+	// collections. Transactions that touch them must be run with
+	// store.NewSTM.
+	store col.Store
 	// The jobs collection
 	jobs col.Collection
 	// The pipelines collection
 	pipelines col.Collection
 	// The progress collection
 	chunks col.Collection
+	// The job queue collection
+	jobQueue col.Collection
 
 	// Only one datum can be running at a time because they need to be
 	// accessing /pfs, runMu enforces this
@@ -141,7 +150,7 @@ type taggedLogger struct {
 
 // DatumID computes the id for a datum, this value is used in ListDatum and
 // InspectDatum.
-func (a *APIServer) DatumID(data []*Input) string {
+func DatumID(data []*Input) string {
 	hash := sha256.New()
 	for _, d := range data {
 		hash.Write([]byte(d.FileInfo.File.Path))
@@ -174,7 +183,7 @@ func (a *APIServer) getTaggedLogger(pachClient *client.APIClient, jobID string,
 	}
 	// InputFileID is a single string id for the data from this input, it's used in logs and in
 	// the statsTree
-	result.template.DatumID = a.DatumID(data)
+	result.template.DatumID = DatumID(data)
 	if enableStats {
 		putObjClient, err := pachClient.ObjectAPIClient.PutObject(pachClient.Ctx())
 		if err != nil {
@@ -290,6 +299,7 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 	if err != nil {
 		return nil, fmt.Errorf("error creating datum cache: %v", err)
 	}
+	store := col.NewEtcdStore(etcdClient)
 	server := &APIServer{
 		pachClient:   pachClient,
 		kubeClient:   kubeClient,
@@ -302,9 +312,11 @@ func NewAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPre
 		},
 		workerName: workerName,
 		namespace:  namespace,
-		jobs:       ppsdb.Jobs(etcdClient, etcdPrefix),
-		pipelines:  ppsdb.Pipelines(etcdClient, etcdPrefix),
-		chunks:     col.NewCollection(etcdClient, path.Join(etcdPrefix, chunksPrefix), []col.Index{}, &Chunks{}, nil),
+		store:      store,
+		jobs:       ppsdb.Jobs(store, etcdPrefix),
+		pipelines:  ppsdb.Pipelines(store, etcdPrefix),
+		chunks:     store.NewCollection(path.Join(etcdPrefix, chunksPrefix), []col.Index{}, &Chunks{}, nil),
+		jobQueue:   ppsdb.JobQueue(store, etcdPrefix),
 		datumCache: datumCache,
 	}
 	logger, err := server.getTaggedLogger(pachClient, "", nil, false)
@@ -851,7 +863,7 @@ func (a *APIServer) deleteJob(stm col.STM, jobPtr *pps.EtcdJobInfo) error {
 	return a.jobs.ReadWrite(stm).Delete(jobPtr.Job.ID)
 }
 
-type acquireDatumsFunc func(low, high int64) (failedDatumID string, _ error)
+type acquireDatumsFunc func(low, high int64) (failedDatumID string, failedDatums []int64, _ error)
 
 func (a *APIServer) acquireDatums(ctx context.Context, jobID string, chunks *Chunks, logger *taggedLogger, process acquireDatumsFunc) error {
 	complete := false
@@ -863,7 +875,7 @@ func (a *APIServer) acquireDatums(ctx context.Context, jobID string, chunks *Chu
 			var low int64
 			var high int64
 			var found bool
-			if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 				found = false
 				locks := a.locks(jobID).ReadWrite(stm)
 				// we set complete to true and then unset it if we find an incomplete chunk
@@ -903,7 +915,7 @@ func (a *APIServer) acquireDatums(ctx context.Context, jobID string, chunks *Chu
 						case <-ctx.Done():
 							break Renew
 						}
-						if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+						if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 							locks := a.locks(jobID).ReadWrite(stm)
 							var chunkState ChunkState
 							if err := locks.Get(fmt.Sprint(high), &chunkState); err != nil {
@@ -920,17 +932,18 @@ func (a *APIServer) acquireDatums(ctx context.Context, jobID string, chunks *Chu
 					}
 				}()
 				// process the datums in newRange
-				failedDatumID, err := process(low, high)
+				failedDatumID, failedDatums, err := process(low, high)
 				if err != nil {
 					return err
 				}
 
-				if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+				if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 					locks := a.locks(jobID).ReadWrite(stm)
 					if failedDatumID != "" {
 						return locks.Put(fmt.Sprint(high), &ChunkState{
-							State:   ChunkState_FAILED,
-							DatumID: failedDatumID,
+							State:        ChunkState_FAILED,
+							DatumID:      failedDatumID,
+							FailedDatums: failedDatums,
 						})
 					}
 					return locks.Put(fmt.Sprint(high), &ChunkState{State: ChunkState_COMPLETE})
@@ -1055,6 +1068,8 @@ func (a *APIServer) worker() {
 			// mean this job shouldn't run
 			jobCtx, jobCancel := context.WithCancel(a.pachClient.Ctx())
 			defer jobCancel() // cancel the job ctx
+			// This worker's spans for the job are children of the job's span
+			jobCtx = tracing.ContextWithRemoteParent(jobCtx, jobPtr.TraceParent)
 			pachClient := a.pachClient.WithCtx(jobCtx)
 
 			//  Watch for any changes to EtcdJobInfo corresponding to jobID; if
@@ -1093,12 +1108,12 @@ func (a *APIServer) worker() {
 			// handle failed datums here, just failed etcd writes.
 			if err := a.acquireDatums(
 				jobCtx, jobID, chunks, logger,
-				func(low, high int64) (string, error) {
-					failedDatumID, err := a.processDatums(pachClient, logger, jobInfo, df, low, high)
+				func(low, high int64) (string, []int64, error) {
+					failedDatumID, failedDatums, err := a.processDatums(pachClient, logger, jobInfo, df, low, high)
 					if err != nil {
-						return "", err
+						return "", nil, err
 					}
-					return failedDatumID, nil
+					return failedDatumID, failedDatums, nil
 				},
 			); err != nil {
 				if jobCtx.Err() == context.Canceled {
@@ -1115,13 +1130,18 @@ func (a *APIServer) worker() {
 }
 
 // processDatums processes datums from low to high in df, if a datum fails it
-// returns the id of the failed datum it also may return a variety of errors
-// such as network errors.
-func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLogger, jobInfo *pps.JobInfo, df DatumFactory, low, high int64) (string, error) {
-	ctx := pachClient.Ctx()
+// returns the id of the failed datum (and, if the job skips failed datums, the
+// indices of all failed datums) it also may return a variety of errors such as
+// network errors.
+func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLogger, jobInfo *pps.JobInfo, df DatumFactory, low, high int64) (_ string, _ []int64, retErr error) {
+	span, ctx := tracing.StartSpan(pachClient.Ctx(), "worker.processDatums",
+		"job", jobInfo.Job.ID, "low", fmt.Sprint(low), "high", fmt.Sprint(high))
+	defer func() { span.Finish(retErr) }()
+	pachClient = pachClient.WithCtx(ctx)
 	stats := &pps.ProcessStats{}
 	var statsMu sync.Mutex
 	var failedDatumID string
+	var failedDatums []int64
 	var eg errgroup.Group
 	var skipped int64
 	var failed int64
@@ -1141,7 +1161,7 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 				return err
 			}
 			// Hash inputs
-			tag := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, data)
+			tag := HashDatum(a.pipelineInfo.Pipeline.Name, jobInfo.Salt, data)
 			tag15, err := HashDatum15(a.pipelineInfo, data)
 			if err != nil {
 				return err
@@ -1157,6 +1177,9 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 				return nil
 			})
 			eg.Go(func() error {
+				if jobInfo.Rerun {
+					return nil // re-run jobs never reuse earlier output
+				}
 				if objectInfo, err := pachClient.InspectTag(ctx, &pfs.Tag{tag15}); err == nil {
 					foundTag15 = true
 					object = objectInfo.Object
@@ -1190,6 +1213,10 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 				return nil
 			}
 			subStats := &pps.ProcessStats{}
+			// Trace the datum's attempts, and the download, processing and
+			// upload phases of each attempt
+			datumSpan, datumCtx := tracing.StartSpan(ctx, "worker.datum", "datum", logger.template.DatumID)
+			defer func() { datumSpan.Finish(retErr) }()
 			statsPath := path.Join("/", logger.template.DatumID)
 			var statsTree hashtree.OpenHashTree
 			if a.pipelineInfo.EnableStats {
@@ -1254,16 +1281,26 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 
 			env := a.userCodeEnv(jobInfo.Job.ID, data)
 			var dir string
-			var retries int
+			datumTries := jobInfo.DatumTries
+			if datumTries < 1 {
+				datumTries = defaultDatumTries
+			}
+			datumBackoff, err := datumBackOff(jobInfo)
+			if err != nil {
+				return err
+			}
 			if err := backoff.RetryNotify(func() error {
 				if isDone(ctx) {
 					return ctx.Err() // timeout or cancelled job--don't run datum
 				}
+				subStats.Attempts++
 				// Download input data
 				puller := filesync.NewPuller()
 				// TODO parent tag shouldn't be nil
 				var err error
-				dir, err = a.downloadData(pachClient, logger, data, puller, parentTag, subStats, statsTree, path.Join(statsPath, "pfs"))
+				downloadSpan, downloadCtx := tracing.StartSpan(datumCtx, "worker.download", "attempt", fmt.Sprint(subStats.Attempts))
+				dir, err = a.downloadData(pachClient.WithCtx(downloadCtx), logger, data, puller, parentTag, subStats, statsTree, path.Join(statsPath, "pfs"))
+				downloadSpan.Finish(err)
 				// We run these cleanup functions no matter what, so that if
 				// downloadData partially succeeded, we still clean up the resources.
 				defer func() {
@@ -1285,7 +1322,7 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 				a.runMu.Lock()
 				defer a.runMu.Unlock()
 				// shadow ctx and pachClient for the context of processing this one datum
-				ctx, cancel := context.WithCancel(ctx)
+				ctx, cancel := context.WithCancel(datumCtx)
 				pachClient := pachClient.WithCtx(ctx)
 				func() {
 					a.statusMu.Lock()
@@ -1311,7 +1348,10 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 						retErr = err
 					}
 				}()
-				if err := a.runUserCode(ctx, logger, env, subStats, jobInfo.DatumTimeout); err != nil {
+				processSpan, processCtx := tracing.StartSpan(ctx, "worker.process", "attempt", fmt.Sprint(subStats.Attempts))
+				err = a.runUserCode(processCtx, logger, env, subStats, jobInfo.DatumTimeout)
+				processSpan.Finish(err)
+				if err != nil {
 					return fmt.Errorf("error runUserCode: %v", err)
 				}
 				// CleanUp is idempotent so we can call it however many times we want.
@@ -1326,14 +1366,16 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 					return err
 				}
 				atomic.AddUint64(&subStats.DownloadBytes, uint64(downSize))
-				return a.uploadOutput(pachClient, dir, tag, logger, data, subStats, statsTree, path.Join(statsPath, "pfs", "out"))
-			}, &backoff.ZeroBackOff{}, func(err error, d time.Duration) error {
+				uploadSpan, uploadCtx := tracing.StartSpan(ctx, "worker.upload", "attempt", fmt.Sprint(subStats.Attempts))
+				err = a.uploadOutput(pachClient.WithCtx(uploadCtx), dir, tag, logger, data, subStats, statsTree, path.Join(statsPath, "pfs", "out"))
+				uploadSpan.Finish(err)
+				return err
+			}, datumBackoff, func(err error, d time.Duration) error {
 				if isDone(ctx) {
 					return ctx.Err() // timeout or cancelled job, err out and don't retry
 				}
-				retries++
-				if retries >= maxRetries {
-					logger.Logf("failed to process datum with error: %+v", err)
+				if subStats.Attempts >= datumTries {
+					logger.Logf("failed to process datum after %d attempts with error: %+v", subStats.Attempts, err)
 					if statsTree != nil {
 						object, size, err := pachClient.PutObject(strings.NewReader(err.Error()))
 						if err != nil {
@@ -1346,13 +1388,21 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 					}
 					return err
 				}
-				logger.Logf("failed processing datum: %v, retrying in %v", err, d)
+				logger.Logf("failed processing datum (attempt %d of %d): %v, retrying in %v", subStats.Attempts, datumTries, err, d)
 				return nil
 			}); err != nil {
-				failedDatumID = a.DatumID(data)
+				statsMu.Lock()
+				defer statsMu.Unlock()
+				failedDatumID = DatumID(data)
+				if jobInfo.SkipFailedDatums {
+					failedDatums = append(failedDatums, i)
+				}
 				failed++
+				stats.Attempts += subStats.Attempts
+				datumSpan.Finish(err)
 				return nil
 			}
+			a.reportDatumStats(subStats)
 			statsMu.Lock()
 			defer statsMu.Unlock()
 			if err := mergeStats(stats, subStats); err != nil {
@@ -1362,9 +1412,9 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 		})
 	}
 	if err := eg.Wait(); err != nil {
-		return "", err
+		return "", nil, err
 	}
-	if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+	if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 		jobs := a.jobs.ReadWrite(stm)
 		jobID := jobInfo.Job.ID
 		jobPtr := &pps.EtcdJobInfo{}
@@ -1382,9 +1432,14 @@ func (a *APIServer) processDatums(pachClient *client.APIClient, logger *taggedLo
 		}
 		return jobs.Put(jobID, jobPtr)
 	}); err != nil {
-		return "", err
-	}
-	return failedDatumID, nil
+		return "", nil, err
+	}
+	pipeline := a.pipelineInfo.Pipeline.Name
+	datumsTotal.WithLabelValues(pipeline, "processed").Add(float64(high - low - skipped - failed))
+	datumsTotal.WithLabelValues(pipeline, "skipped").Add(float64(skipped))
+	datumsTotal.WithLabelValues(pipeline, "failed").Add(float64(failed))
+	datumAttempts.WithLabelValues(pipeline).Add(float64(stats.Attempts))
+	return failedDatumID, failedDatums, nil
 }
 
 func (a *APIServer) parentTag(pachClient *client.APIClient, jobInfo *pps.JobInfo, files []*Input) (*pfs.Tag, error) {
@@ -1450,7 +1505,7 @@ func (a *APIServer) parentTag(pachClient *client.APIClient, jobInfo *pps.JobInfo
 
 	// We have derived what files the parent saw -- compute the tag
 	if len(parentFiles) == len(files) {
-		_parentOutputTag := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, parentFiles)
+		_parentOutputTag := HashDatum(a.pipelineInfo.Pipeline.Name, jobInfo.Salt, parentFiles)
 		return &pfs.Tag{Name: _parentOutputTag}, nil
 	}
 	return nil, nil
@@ -1470,9 +1525,32 @@ func mergeStats(x, y *pps.ProcessStats) error {
 	}
 	x.DownloadBytes += y.DownloadBytes
 	x.UploadBytes += y.UploadBytes
+	x.Attempts += y.Attempts
 	return nil
 }
 
+// datumBackOff returns the backoff between attempts to process a datum in
+// the job 'jobInfo'. The wait starts at the pipeline's datum_backoff, and
+// doubles after each failed attempt
+func datumBackOff(jobInfo *pps.JobInfo) (backoff.BackOff, error) {
+	if jobInfo.DatumBackoff == nil {
+		return &backoff.ZeroBackOff{}, nil
+	}
+	initialInterval, err := types.DurationFromProto(jobInfo.DatumBackoff)
+	if err != nil {
+		return nil, err
+	}
+	if initialInterval <= 0 {
+		return &backoff.ZeroBackOff{}, nil
+	}
+	b := backoff.NewInfiniteBackOff()
+	b.InitialInterval = initialInterval
+	b.Multiplier = 2
+	b.MaxInterval = backoff.DefaultMaxInterval
+	b.Reset()
+	return b, nil
+}
+
 // lookupUser is a reimplementation of user.Lookup that doesn't require cgo.
 func lookupUser(name string) (_ *user.User, retErr error) {
 	passwd, err := os.Open("/etc/passwd")
@@ -0,0 +1,196 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	col "github.com/pachyderm/pachyderm/src/server/pkg/collection"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
+)
+
+const (
+	// autoscalingInterval is how often the master of an autoscaling pipeline
+	// re-computes the number of workers the pipeline needs
+	autoscalingInterval = 30 * time.Second
+	// defaultTargetDuration is the time in which an autoscaling pipeline tries
+	// to finish its outstanding work if its spec doesn't set target_duration
+	defaultTargetDuration = 5 * time.Minute
+	// autoscalingRecentJobs is the number of the pipeline's most recent jobs
+	// that the autoscaler reads when estimating the time to process a datum
+	autoscalingRecentJobs = 10
+)
+
+// autoscaler periodically resizes the workers of an autoscaling pipeline to
+// match its outstanding work. It's run by the master alongside jobSpawner,
+// and returns when 'ctx' is cancelled.
+func (a *APIServer) autoscaler(ctx context.Context, logger *taggedLogger) {
+	autoscaling := a.pipelineInfo.ParallelismSpec.GetAutoscaling()
+	if autoscaling == nil {
+		return
+	}
+	ticker := time.NewTicker(autoscalingInterval)
+	defer ticker.Stop()
+	for {
+		if err := a.autoscale(ctx, autoscaling, logger); err != nil && ctx.Err() == nil {
+			logger.Logf("autoscaler: error resizing workers: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// autoscale computes the number of workers this pipeline needs and, if it
+// differs from the current size of the pipeline's RC, resizes the RC and
+// records the decision in the pipeline's EtcdPipelineInfo
+func (a *APIServer) autoscale(ctx context.Context, autoscaling *pps.Autoscaling, logger *taggedLogger) error {
+	datums, chunks, datumTime, err := a.outstandingWork(ctx)
+	if err != nil {
+		return err
+	}
+	target := defaultTargetDuration
+	if autoscaling.TargetDuration != nil {
+		target, err = types.DurationFromProto(autoscaling.TargetDuration)
+		if err != nil {
+			return err
+		}
+	}
+	workers, reason := desiredWorkers(autoscaling, datums, chunks, datumTime, target)
+
+	rc := a.kubeClient.CoreV1().ReplicationControllers(a.namespace)
+	workerRc, err := rc.Get(ppsutil.PipelineRcName(
+		a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Version), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	var current int32
+	if workerRc.Spec.Replicas != nil {
+		current = *workerRc.Spec.Replicas
+	}
+	if int64(current) == workers {
+		return nil
+	}
+	logger.Logf("autoscaler: scaling workers from %d to %d: %s", current, workers, reason)
+	replicas := int32(workers)
+	workerRc.Spec.Replicas = &replicas
+	if _, err := rc.Update(workerRc); err != nil {
+		return err
+	}
+	_, err = a.store.NewSTM(ctx, func(stm col.STM) error {
+		pipelinePtr := &pps.EtcdPipelineInfo{}
+		return a.pipelines.ReadWrite(stm).Update(a.pipelineInfo.Pipeline.Name, pipelinePtr, func() error {
+			pipelinePtr.AutoscalingStatus = &pps.AutoscalingStatus{
+				Workers: workers,
+				Reason:  reason,
+				Updated: now(),
+			}
+			return nil
+		})
+	})
+	return err
+}
+
+// outstandingWork returns the number of datums and chunks that this
+// pipeline's running jobs have yet to process, along with the time it took
+// to process a datum in the pipeline's most recent job that has stats (or 0
+// if no such job exists).
+func (a *APIServer) outstandingWork(ctx context.Context) (datums int64, chunks int64, datumTime time.Duration, _ error) {
+	jobs := a.jobs.ReadOnly(ctx)
+	iter, err := jobs.GetByIndex(ppsdb.JobsPipelineIndex, a.pipelineInfo.Pipeline)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	// Jobs are returned newest first
+	for i := 0; i < autoscalingRecentJobs; i++ {
+		var jobID string
+		jobPtr := &pps.EtcdJobInfo{}
+		ok, err := iter.Next(&jobID, jobPtr)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+		if !ok {
+			break
+		}
+		if datumTime == 0 && jobPtr.Stats != nil && jobPtr.Stats.ProcessTime != nil {
+			processTime, err := types.DurationFromProto(jobPtr.Stats.ProcessTime)
+			if err != nil {
+				return 0, 0, 0, err
+			}
+			attempts := jobPtr.Stats.Attempts
+			if attempts == 0 {
+				attempts = jobPtr.DataProcessed
+			}
+			if attempts > 0 {
+				datumTime = processTime / time.Duration(attempts)
+			}
+		}
+		if jobPtr.State != pps.JobState_JOB_RUNNING {
+			continue
+		}
+		jobChunks := &Chunks{}
+		if err := a.chunks.ReadOnly(ctx).Get(jobID, jobChunks); err != nil {
+			if col.IsErrNotFound(err) {
+				continue // the master hasn't laid out this job's chunks yet
+			}
+			return 0, 0, 0, err
+		}
+		locks := a.locks(jobID).ReadOnly(ctx)
+		low := int64(0)
+		for _, high := range jobChunks.Chunks {
+			var chunkState ChunkState
+			if err := locks.Get(fmt.Sprint(high), &chunkState); err != nil && !col.IsErrNotFound(err) {
+				return 0, 0, 0, err
+			} else if err != nil || chunkState.State == ChunkState_RUNNING {
+				datums += high - low
+				chunks++
+			}
+			low = high
+		}
+	}
+	return datums, chunks, datumTime, nil
+}
+
+// desiredWorkers returns the number of workers that an autoscaling pipeline
+// should have, given its outstanding work, and a human-readable explanation
+// of the decision. It tries to process 'datums' datums, which take
+// 'datumTime' each, in 'target', but never asks for more workers than there
+// are outstanding chunks, as the extra workers would have nothing to do.
+func desiredWorkers(autoscaling *pps.Autoscaling, datums int64, chunks int64, datumTime time.Duration, target time.Duration) (int64, string) {
+	var workers int64
+	var reason string
+	switch {
+	case chunks == 0:
+		workers = int64(autoscaling.Min)
+		reason = "no outstanding chunks"
+	case datumTime == 0:
+		workers = chunks
+		reason = fmt.Sprintf("%d outstanding chunks and no datum processing time yet", chunks)
+	default:
+		work := time.Duration(datums) * datumTime
+		workers = int64((work + target - 1) / target)
+		if workers > chunks {
+			workers = chunks
+		}
+		reason = fmt.Sprintf("%d outstanding datums in %d chunks at %v per datum, with a target duration of %v",
+			datums, chunks, datumTime, target)
+	}
+	if workers < int64(autoscaling.Min) {
+		workers = int64(autoscaling.Min)
+	}
+	if workers > int64(autoscaling.Max) {
+		workers = int64(autoscaling.Max)
+	}
+	if workers < 1 {
+		// The master is one of the pipeline's workers
+		workers = 1
+	}
+	return workers, reason
+}
@@ -3,7 +3,9 @@ package worker
 import (
 	"fmt"
 	"io"
+	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
@@ -180,6 +182,95 @@ func newCrossDatumFactory(pachClient *client.APIClient, cross []*pps.Input) (Dat
 	return result, nil
 }
 
+type joinDatumFactory struct {
+	datums [][]*Input
+}
+
+func newJoinDatumFactory(pachClient *client.APIClient, join []*pps.Input) (DatumFactory, error) {
+	result := &joinDatumFactory{}
+	// keyed[i] maps each join key to the datums of join[i] with that key
+	var keyed []map[string][][]*Input
+	for _, input := range join {
+		if input.Atom == nil {
+			return nil, fmt.Errorf("the inputs of a join must be atom inputs")
+		}
+		joinOn, err := regexp.Compile(input.Atom.JoinOn)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing join_on of input %s: %v", input.Atom.Name, err)
+		}
+		datumFactory, err := newAtomDatumFactory(pachClient, input.Atom)
+		if err != nil {
+			return nil, err
+		}
+		datums := make(map[string][][]*Input)
+		for i := 0; i < datumFactory.Len(); i++ {
+			datum := datumFactory.Datum(i)
+			key, ok := joinKey(joinOn, datum[0].FileInfo.File.Path)
+			if !ok {
+				continue
+			}
+			datums[key] = append(datums[key], datum)
+		}
+		keyed = append(keyed, datums)
+	}
+	if len(keyed) == 0 {
+		return result, nil
+	}
+	// Only keys that all of the inputs have produce datums. They're sorted so
+	// that the order of the datums is deterministic.
+	var keys []string
+	for key := range keyed[0] {
+		inAll := true
+		for _, datums := range keyed[1:] {
+			if _, ok := datums[key]; !ok {
+				inAll = false
+				break
+			}
+		}
+		if inAll {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	// Each key produces the cross product of the inputs' datums with that key
+	for _, key := range keys {
+		tuples := [][]*Input{nil}
+		for _, datums := range keyed {
+			var next [][]*Input
+			for _, tuple := range tuples {
+				for _, datum := range datums[key] {
+					next = append(next, append(append([]*Input{}, tuple...), datum...))
+				}
+			}
+			tuples = next
+		}
+		result.datums = append(result.datums, tuples...)
+	}
+	return result, nil
+}
+
+// joinKey returns the join key of the file at 'path', which is the text
+// captured by joinOn's capture groups (or, if it has none, the text it
+// matches). It returns false if joinOn doesn't match 'path'.
+func joinKey(joinOn *regexp.Regexp, path string) (string, bool) {
+	match := joinOn.FindStringSubmatch(path)
+	if match == nil {
+		return "", false
+	}
+	if len(match) == 1 {
+		return match[0], true
+	}
+	return strings.Join(match[1:], "\x00"), true
+}
+
+func (d *joinDatumFactory) Len() int {
+	return len(d.datums)
+}
+
+func (d *joinDatumFactory) Datum(i int) []*Input {
+	return d.datums[i]
+}
+
 func newCronDatumFactory(pachClient *client.APIClient, input *pps.CronInput) (DatumFactory, error) {
 	return newAtomDatumFactory(pachClient, &pps.AtomInput{
 		Name:   input.Name,
@@ -199,6 +290,8 @@ func NewDatumFactory(pachClient *client.APIClient, input *pps.Input) (DatumFacto
 		return newUnionDatumFactory(pachClient, input.Union)
 	case input.Cross != nil:
 		return newCrossDatumFactory(pachClient, input.Cross)
+	case input.Join != nil:
+		return newJoinDatumFactory(pachClient, input.Join)
 	case input.Cron != nil:
 		return newCronDatumFactory(pachClient, input.Cron)
 	case input.Git != nil:
@@ -7,6 +7,7 @@ import (
 	"log"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"sync"
 	"syscall"
@@ -23,6 +24,7 @@ import (
 	"github.com/pachyderm/pachyderm/src/client"
 	"github.com/pachyderm/pachyderm/src/client/limit"
 	"github.com/pachyderm/pachyderm/src/client/pfs"
+	"github.com/pachyderm/pachyderm/src/client/pkg/tracing"
 	"github.com/pachyderm/pachyderm/src/client/pps"
 	pfsserver "github.com/pachyderm/pachyderm/src/server/pfs"
 	"github.com/pachyderm/pachyderm/src/server/pkg/backoff"
@@ -30,9 +32,11 @@ import (
 	"github.com/pachyderm/pachyderm/src/server/pkg/dlock"
 	"github.com/pachyderm/pachyderm/src/server/pkg/hashtree"
 	"github.com/pachyderm/pachyderm/src/server/pkg/obj"
+	"github.com/pachyderm/pachyderm/src/server/pkg/ppsdb"
 	"github.com/pachyderm/pachyderm/src/server/pkg/ppsutil"
 	filesync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
 	pfs_sync "github.com/pachyderm/pachyderm/src/server/pkg/sync"
+	"github.com/pachyderm/pachyderm/src/server/pkg/watch"
 )
 
 const (
@@ -105,7 +109,7 @@ func (a *APIServer) master() {
 
 		paused := false
 		// Set pipeline state to running
-		if _, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err = a.store.NewSTM(ctx, func(stm col.STM) error {
 			pipelineName := a.pipelineInfo.Pipeline.Name
 			pipelines := a.pipelines.ReadWrite(stm)
 			pipelinePtr := &pps.EtcdPipelineInfo{}
@@ -150,7 +154,7 @@ func (a *APIServer) serviceMaster() {
 
 		paused := false
 		// Set pipeline state to running
-		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 			pipelineName := a.pipelineInfo.Pipeline.Name
 			pipelines := a.pipelines.ReadWrite(stm)
 			pipelinePtr := &pps.EtcdPipelineInfo{}
@@ -300,44 +304,76 @@ func (a *APIServer) jobSpawner(pachClient *client.APIClient) error {
 					return err
 				}
 			}
-			// Check if a job was previously created for this commit. If not, make one
-			var jobInfo *pps.JobInfo
-			jobInfos, err := pachClient.ListJob("", nil, commitInfo.Commit)
-			if err != nil {
-				return err
-			}
-			if len(jobInfos) > 0 {
-				if len(jobInfos) > 1 {
-					return fmt.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
-				}
-				jobInfo = jobInfos[0]
-			} else {
-				job, err := pachClient.CreateJob(a.pipelineInfo.Pipeline.Name, commitInfo.Commit)
-				if err != nil {
-					return err
-				}
-				jobInfo, err = pachClient.InspectJob(job.ID, false)
-				if err != nil {
-					return err
-				}
-			}
-			if ppsutil.IsTerminal(jobInfo.State) {
-				// previously-created job has finished, but commit has not been closed yet
-				continue
-			}
-
-			// Now that the jobInfo is persisted, wait until all input commits are
-			// ready, split the input datums into chunks and merge the results of
-			// chunks as they're processed
-			if err := a.waitJob(pachClient, jobInfo, logger); err != nil {
+			if err := a.runJob(pachClient, commitInfo, logger); err != nil {
 				return err
 			}
 		}
 	})
 
+	// Spawn a goroutine to resize this pipeline's workers, if it autoscales.
+	// It's not part of 'eg', as it only returns once the master is cancelled
+	go a.autoscaler(pachClient.Ctx(), logger)
+
+	// Spawn a goroutine to run jobs created by RerunPipeline. Their output
+	// commits aren't on the output branch, so the goroutine above never sees
+	// them
+	eg.Go(func() error {
+		return a.rerunSpawner(pachClient, logger)
+	})
+
 	return eg.Wait() // if anything goes wrong, retry in master()
 }
 
+// rerunSpawner watches for jobs created by RerunPipeline and runs them, one at
+// a time, in the order in which they were created
+func (a *APIServer) rerunSpawner(pachClient *client.APIClient, logger *taggedLogger) error {
+	watcher, err := a.jobs.ReadOnly(pachClient.Ctx()).WatchByIndex(ppsdb.JobsPipelineIndex, a.pipelineInfo.Pipeline)
+	if err != nil {
+		return fmt.Errorf("error creating watch: %v", err)
+	}
+	defer watcher.Close()
+	for e := range watcher.Watch() {
+		if e.Type == watch.EventError {
+			return fmt.Errorf("rerun watch error: %v", e.Err)
+		} else if e.Type == watch.EventDelete {
+			continue
+		}
+		var jobID string
+		jobPtr := &pps.EtcdJobInfo{}
+		if err := e.Unmarshal(&jobID, jobPtr); err != nil {
+			return fmt.Errorf("error unmarshalling: %v", err)
+		}
+		if !jobPtr.Rerun || ppsutil.IsTerminal(jobPtr.State) {
+			continue
+		}
+		jobInfo, err := pachClient.InspectJob(jobID, false)
+		if err != nil {
+			if col.IsErrNotFound(err) {
+				continue // job was deleted
+			}
+			return err
+		}
+		if ppsutil.IsTerminal(jobInfo.State) {
+			continue // the event was out of date, and the job has finished
+		}
+		if jobInfo.PipelineVersion != a.pipelineInfo.Version {
+			// This master is out of date, and will be replaced by one that can
+			// run the job
+			continue
+		}
+		if a.pipelineInfo.ScaleDownThreshold != nil {
+			if err := a.scaleUpWorkers(logger); err != nil {
+				return err
+			}
+		}
+		if err := a.waitJob(pachClient, jobInfo, logger); err != nil {
+			return err
+		}
+		a.reportJobState(pachClient, jobInfo.Job.ID, logger)
+	}
+	return nil
+}
+
 func (a *APIServer) serviceSpawner(pachClient *client.APIClient) error {
 	ctx := pachClient.Ctx()
 	commitIter, err := pachClient.SubscribeCommit(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.OutputBranch, "")
@@ -392,7 +428,7 @@ func (a *APIServer) serviceSpawner(pachClient *client.APIClient) error {
 		defer serviceCancel() // make go vet happy: infinite loop obviates 'defer'
 		go func() {
 			serviceCtx := serviceCtx
-			if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 				jobs := a.jobs.ReadWrite(stm)
 				jobPtr := &pps.EtcdJobInfo{}
 				if err := jobs.Get(job.ID, jobPtr); err != nil {
@@ -408,7 +444,7 @@ func (a *APIServer) serviceSpawner(pachClient *client.APIClient) error {
 			}
 			select {
 			case <-serviceCtx.Done():
-				if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+				if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 					jobs := a.jobs.ReadWrite(stm)
 					jobPtr := &pps.EtcdJobInfo{}
 					if err := jobs.Get(job.ID, jobPtr); err != nil {
@@ -447,16 +483,16 @@ func plusDuration(x *types.Duration, y *types.Duration) (*types.Duration, error)
 }
 
 func (a *APIServer) locks(jobID string) col.Collection {
-	return col.NewCollection(a.etcdClient, path.Join(a.etcdPrefix, lockPrefix, jobID), nil, &ChunkState{}, nil)
+	return a.store.NewCollection(path.Join(a.etcdPrefix, lockPrefix, jobID), nil, &ChunkState{}, nil)
 }
 
 // collectDatum collects the output and stats output from a datum, and merges
 // it into the passed trees. It errors if it can't find the tree object for
 // this datum, unless failed is true in which case it tolerates missing trees.
-func (a *APIServer) collectDatum(pachClient *client.APIClient, index int, files []*Input, logger *taggedLogger,
+func (a *APIServer) collectDatum(pachClient *client.APIClient, jobInfo *pps.JobInfo, index int, files []*Input, logger *taggedLogger,
 	tree hashtree.OpenHashTree, statsTree hashtree.OpenHashTree, treeMu *sync.Mutex, failed bool) error {
-	datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, a.pipelineInfo.Salt, files)
-	datumID := a.DatumID(files)
+	datumHash := HashDatum(a.pipelineInfo.Pipeline.Name, jobInfo.Salt, files)
+	datumID := DatumID(files)
 	tag := &pfs.Tag{datumHash}
 	statsTag := &pfs.Tag{datumHash + statsTagSuffix}
 
@@ -593,7 +629,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 				if pfsserver.IsCommitNotFoundErr(err) || pfsserver.IsCommitDeletedErr(err) {
 					defer cancel() // whether we return error or nil, job is done
 					// Output commit was deleted. Delete job as well
-					if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+					if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 						// Delete the job if no other worker has deleted it yet
 						jobPtr := &pps.EtcdJobInfo{}
 						if err := a.jobs.ReadWrite(stm).Get(jobInfo.Job.ID, jobPtr); err != nil {
@@ -609,7 +645,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			}
 			if commitInfo.Tree == nil {
 				defer cancel() // whether job state update succeeds or not, job is done
-				if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+				if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 					// Read an up to date version of the jobInfo so that we
 					// don't overwrite changes that have happened since this
 					// function started.
@@ -664,7 +700,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		}
 		if len(failedInputs) > 0 {
 			reason := fmt.Sprintf("inputs %s failed", strings.Join(failedInputs, ", "))
-			if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+			if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 				jobs := a.jobs.ReadWrite(stm)
 				jobID := jobInfo.Job.ID
 				jobPtr := &pps.EtcdJobInfo{}
@@ -682,6 +718,12 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			return err
 		}
 
+		// Wait until the job is admitted to one of the cluster's
+		// max_concurrent_jobs slots
+		if err := a.waitForJobSlot(ctx, jobInfo, logger); err != nil {
+			return err
+		}
+
 		// Create a datum factory pointing at the job's inputs and split up the
 		// input data into chunks
 		df, err := NewDatumFactory(pachClient, jobInfo.Input)
@@ -697,7 +739,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		// Read the job document, and either resume (if we're recovering from a
 		// crash) or mark it running. Also write the input chunks calculated above
 		// into chunksCol
-		if _, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobID := jobInfo.Job.ID
 			jobPtr := &pps.EtcdJobInfo{}
@@ -731,6 +773,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		var treeMu sync.Mutex
 		limiter := limit.New(100)
 		var failedDatumID string
+		var failedDatums []int64 // only set if jobInfo.SkipFailedDatums
 		var eg errgroup.Group
 		for i, high := range chunks.Chunks {
 			// Watch this chunk's lock and when it's finished, handle the result
@@ -752,7 +795,13 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 						}
 						if chunkState.State != ChunkState_RUNNING {
 							if chunkState.State == ChunkState_FAILED {
-								failedDatumID = chunkState.DatumID
+								if jobInfo.SkipFailedDatums {
+									// failed datums are left out of the output tree
+									// (see collectDatum) rather than failing the job
+									failedDatums = append(failedDatums, chunkState.FailedDatums...)
+								} else {
+									failedDatumID = chunkState.DatumID
+								}
 							}
 							var low int64 // chunk lower bound
 							if i > 0 {
@@ -767,7 +816,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 									eg.Go(func() error {
 										defer limiter.Release()
 										files := df.Datum(int(i))
-										return a.collectDatum(pachClient, int(i), files, logger, tree, statsTree, &treeMu, chunkState.State == ChunkState_FAILED)
+										return a.collectDatum(pachClient, jobInfo, int(i), files, logger, tree, statsTree, &treeMu, chunkState.State == ChunkState_FAILED)
 									})
 								}
 								return nil
@@ -800,7 +849,22 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 				return err
 			}
 		}
-		// We only do this if failedDatumID == "", which is to say that all of the chunks succeeded.
+		// Write the failure manifest of a job that skipped failed datums, so that
+		// they can be listed with ListDatum
+		var failedDatumsObject *pfs.Object
+		if len(failedDatums) > 0 {
+			sort.Slice(failedDatums, func(i, j int) bool { return failedDatums[i] < failedDatums[j] })
+			manifest, err := (&FailedDatums{Indices: failedDatums}).Marshal()
+			if err != nil {
+				return err
+			}
+			failedDatumsObject, _, err = pachClient.PutObject(bytes.NewReader(manifest))
+			if err != nil {
+				return err
+			}
+		}
+		// We only do this if failedDatumID == "", which is to say that all of the
+		// chunks succeeded (or that the job skips failed datums).
 		if failedDatumID == "" {
 			// put output tree into object store
 			object, err := a.putTree(ctx, tree)
@@ -826,7 +890,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			// Handle egress
 			if err := a.egress(pachClient, logger, jobInfo); err != nil {
 				reason := fmt.Sprintf("egress error: %v", err)
-				_, err := col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+				_, err := a.store.NewSTM(ctx, func(stm col.STM) error {
 					jobs := a.jobs.ReadWrite(stm)
 					jobID := jobInfo.Job.ID
 					jobPtr := &pps.EtcdJobInfo{}
@@ -844,7 +908,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 
 		// Record the job's output commit and 'Finished' timestamp, and mark the job
 		// as a SUCCESS
-		if _, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		if _, err = a.store.NewSTM(ctx, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobID := jobInfo.Job.ID
 			jobPtr := &pps.EtcdJobInfo{}
@@ -855,6 +919,10 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 			if failedDatumID != "" {
 				return a.updateJobState(stm, jobPtr, pps.JobState_JOB_FAILURE, fmt.Sprintf("failed to process datum: %v", failedDatumID))
 			}
+			if failedDatumsObject != nil {
+				jobPtr.FailedDatums = failedDatumsObject
+				return a.updateJobState(stm, jobPtr, pps.JobState_JOB_SUCCESS_WITH_FAILURES, fmt.Sprintf("skipped %d failed datums", len(failedDatums)))
+			}
 			return a.updateJobState(stm, jobPtr, pps.JobState_JOB_SUCCESS, "")
 		}); err != nil {
 			return err
@@ -880,7 +948,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 					reason := fmt.Sprintf("job exceeded timeout (%v)", jobInfo.JobTimeout)
 					// Mark the job as failed.
 					// Workers subscribe to etcd for this state change to cancel their work
-					_, err := col.NewSTM(context.Background(), a.etcdClient, func(stm col.STM) error {
+					_, err := a.store.NewSTM(context.Background(), func(stm col.STM) error {
 						jobs := a.jobs.ReadWrite(stm)
 						jobID := jobInfo.Job.ID
 						jobPtr := &pps.EtcdJobInfo{}
@@ -903,7 +971,7 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		default:
 		}
 		// Increment the job's restart count
-		_, err = col.NewSTM(ctx, a.etcdClient, func(stm col.STM) error {
+		_, err = a.store.NewSTM(ctx, func(stm col.STM) error {
 			jobs := a.jobs.ReadWrite(stm)
 			jobID := jobInfo.Job.ID
 			jobPtr := &pps.EtcdJobInfo{}
@@ -918,9 +986,80 @@ func (a *APIServer) waitJob(pachClient *client.APIClient, jobInfo *pps.JobInfo,
 		}
 		return nil
 	})
+	a.releaseJobSlot(jobInfo, logger)
 	return nil
 }
 
+// waitForJobSlot adds 'jobInfo' to the cluster's job queue (unless it's
+// already there, e.g. because the master restarted) and blocks until pachd
+// admits it. If pachd doesn't limit the number of concurrent jobs, the job
+// doesn't need a slot, and waitForJobSlot returns immediately.
+func (a *APIServer) waitForJobSlot(ctx context.Context, jobInfo *pps.JobInfo, logger *taggedLogger) error {
+	// Jobs only wait in the queue if pachd limits the number of concurrent jobs
+	resp, err := a.etcdClient.Get(ctx, ppsdb.MaxConcurrentJobsKey(a.etcdPrefix))
+	if err != nil {
+		return err
+	}
+	if len(resp.Kvs) == 0 || string(resp.Kvs[0].Value) == "0" {
+		return nil
+	}
+	if _, err := a.store.NewSTM(ctx, func(stm col.STM) error {
+		jobQueue := a.jobQueue.ReadWrite(stm)
+		entry := &pps.EtcdJobQueueEntry{}
+		if err := jobQueue.Get(jobInfo.Job.ID, entry); err == nil || !col.IsErrNotFound(err) {
+			return err
+		}
+		return jobQueue.Put(jobInfo.Job.ID, &pps.EtcdJobQueueEntry{
+			Job:      jobInfo.Job,
+			Pipeline: jobInfo.Pipeline,
+			Priority: a.pipelineInfo.Priority,
+			Queued:   now(),
+		})
+	}); err != nil {
+		return err
+	}
+	watcher, err := a.jobQueue.ReadOnly(ctx).WatchOne(jobInfo.Job.ID)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+	for {
+		select {
+		case e, ok := <-watcher.Watch():
+			if !ok {
+				return fmt.Errorf("job queue watch closed unexpectedly")
+			}
+			switch e.Type {
+			case watch.EventError:
+				return e.Err
+			case watch.EventDelete:
+				return fmt.Errorf("job %s was removed from the job queue", jobInfo.Job.ID)
+			}
+			var jobID string
+			entry := &pps.EtcdJobQueueEntry{}
+			if err := e.Unmarshal(&jobID, entry); err != nil {
+				return err
+			}
+			if entry.Admitted {
+				return nil
+			}
+			logger.Logf("job %s is waiting for a job slot", jobInfo.Job.ID)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// releaseJobSlot removes 'jobInfo' from the cluster's job queue, so that its
+// slot can be given to another job
+func (a *APIServer) releaseJobSlot(jobInfo *pps.JobInfo, logger *taggedLogger) {
+	if _, err := a.store.NewSTM(context.Background(), func(stm col.STM) error {
+		return a.jobQueue.ReadWrite(stm).Delete(jobInfo.Job.ID)
+	}); err != nil && !col.IsErrNotFound(err) {
+		logger.Logf("error removing job %s from the job queue: %v", jobInfo.Job.ID, err)
+	}
+}
+
 func (a *APIServer) egress(pachClient *client.APIClient, logger *taggedLogger, jobInfo *pps.JobInfo) error {
 	// copy the pach client (preserving auth info) so we can set a different
 	// number of concurrent streams
@@ -928,7 +1067,9 @@ func (a *APIServer) egress(pachClient *client.APIClient, logger *taggedLogger, j
 	pachClient.SetMaxConcurrentStreams(100)
 	var egressFailureCount int
 	return backoff.RetryNotify(func() (retErr error) {
-		if jobInfo.Egress != nil {
+		// re-run jobs don't replace the pipeline's current output, so their
+		// output isn't egressed
+		if jobInfo.Egress != nil && !jobInfo.Rerun {
 			logger.Logf("Starting egress upload for job (%v)", jobInfo)
 			start := time.Now()
 			url, err := obj.ParseURL(jobInfo.Egress.URL)
@@ -969,6 +1110,65 @@ func (a *APIServer) runService(ctx context.Context, logger *taggedLogger) error
 	})
 }
 
+// runJob creates the job for the output commit 'commitInfo', unless it
+// already exists, and waits for it to finish. The job is traced from here,
+// and its span is recorded in the job's EtcdJobInfo (by CreateJob), so that
+// the spans of the workers that process the job's datums are its children.
+func (a *APIServer) runJob(pachClient *client.APIClient, commitInfo *pfs.CommitInfo, logger *taggedLogger) (retErr error) {
+	span, ctx := tracing.StartSpan(pachClient.Ctx(), "worker.job",
+		"pipeline", a.pipelineInfo.Pipeline.Name, "commit", commitInfo.Commit.ID)
+	defer func() { span.Finish(retErr) }()
+	jobClient := pachClient.WithCtx(ctx)
+
+	// Check if a job was previously created for this commit. If not, make one
+	var jobInfo *pps.JobInfo
+	jobInfos, err := jobClient.ListJob("", nil, commitInfo.Commit)
+	if err != nil {
+		return err
+	}
+	if len(jobInfos) > 0 {
+		if len(jobInfos) > 1 {
+			return fmt.Errorf("multiple jobs found for commit: %s/%s", commitInfo.Commit.Repo.Name, commitInfo.Commit.ID)
+		}
+		jobInfo = jobInfos[0]
+	} else {
+		job, err := jobClient.CreateJob(a.pipelineInfo.Pipeline.Name, commitInfo.Commit)
+		if err != nil {
+			return err
+		}
+		jobInfo, err = jobClient.InspectJob(job.ID, false)
+		if err != nil {
+			return err
+		}
+	}
+	if ppsutil.IsTerminal(jobInfo.State) {
+		// previously-created job has finished, but commit has not been closed yet
+		return nil
+	}
+
+	// Now that the jobInfo is persisted, wait until all input commits are
+	// ready, split the input datums into chunks and merge the results of
+	// chunks as they're processed
+	if err := a.waitJob(jobClient, jobInfo, logger); err != nil {
+		return err
+	}
+	a.reportJobState(jobClient, jobInfo.Job.ID, logger)
+	return nil
+}
+
+// reportJobState exports the final state of the job 'jobID', which
+// waitJob has just returned from
+func (a *APIServer) reportJobState(pachClient *client.APIClient, jobID string, logger *taggedLogger) {
+	jobInfo, err := pachClient.InspectJob(jobID, false)
+	if err != nil {
+		logger.Logf("could not inspect job %s to report its state: %v", jobID, err)
+		return
+	}
+	if ppsutil.IsTerminal(jobInfo.State) {
+		jobsFinished.WithLabelValues(a.pipelineInfo.Pipeline.Name, jobInfo.State.String()).Inc()
+	}
+}
+
 func (a *APIServer) updateJobState(stm col.STM, jobPtr *pps.EtcdJobInfo, state pps.JobState, reason string) error {
 	pipelines := a.pipelines.ReadWrite(stm)
 	pipelinePtr := &pps.EtcdPipelineInfo{}
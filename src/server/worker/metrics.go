@@ -0,0 +1,71 @@
+package worker
+
+import (
+	"github.com/gogo/protobuf/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/pachyderm/pachyderm/src/client/pps"
+	"github.com/pachyderm/pachyderm/src/server/pkg/prom"
+)
+
+var (
+	jobsFinished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_worker_jobs_finished_total",
+		Help: "Number of jobs that this worker (as its pipeline's master) saw finish, by pipeline and final state.",
+	}, []string{"pipeline", "state"})
+	datumsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_worker_datums_total",
+		Help: "Number of datums that this worker handled, by pipeline and outcome (processed, skipped or failed).",
+	}, []string{"pipeline", "outcome"})
+	datumAttempts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_worker_datum_attempts_total",
+		Help: "Number of times that this worker ran its pipeline's code on a datum, including retries, by pipeline.",
+	}, []string{"pipeline"})
+	datumDownloadTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pachyderm_worker_datum_download_seconds",
+		Help:    "Time taken to download the input data of datums that were processed successfully, by pipeline.",
+		Buckets: prom.DurationBuckets,
+	}, []string{"pipeline"})
+	datumProcessTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pachyderm_worker_datum_process_seconds",
+		Help:    "Time taken by the pipeline's code to process datums that were processed successfully, by pipeline.",
+		Buckets: prom.DurationBuckets,
+	}, []string{"pipeline"})
+	datumUploadTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pachyderm_worker_datum_upload_seconds",
+		Help:    "Time taken to upload the output of datums that were processed successfully, by pipeline.",
+		Buckets: prom.DurationBuckets,
+	}, []string{"pipeline"})
+	datumBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "pachyderm_worker_datum_bytes_total",
+		Help: "Number of bytes downloaded and uploaded by datums that were processed successfully, by pipeline and direction (download or upload).",
+	}, []string{"pipeline", "direction"})
+)
+
+func init() {
+	prometheus.MustRegister(jobsFinished, datumsTotal, datumAttempts,
+		datumDownloadTime, datumProcessTime, datumUploadTime, datumBytes)
+}
+
+// reportDatumStats exports the ProcessStats of a single datum that was
+// processed successfully
+func (a *APIServer) reportDatumStats(stats *pps.ProcessStats) {
+	pipeline := a.pipelineInfo.Pipeline.Name
+	for _, d := range []struct {
+		histogram *prometheus.HistogramVec
+		duration  *types.Duration
+	}{
+		{datumDownloadTime, stats.DownloadTime},
+		{datumProcessTime, stats.ProcessTime},
+		{datumUploadTime, stats.UploadTime},
+	} {
+		if d.duration == nil {
+			continue
+		}
+		if duration, err := types.DurationFromProto(d.duration); err == nil {
+			d.histogram.WithLabelValues(pipeline).Observe(duration.Seconds())
+		}
+	}
+	datumBytes.WithLabelValues(pipeline, "download").Add(float64(stats.DownloadBytes))
+	datumBytes.WithLabelValues(pipeline, "upload").Add(float64(stats.UploadBytes))
+}
@@ -13,6 +13,7 @@
 		CancelResponse
 		ChunkState
 		Chunks
+		FailedDatums
 */
 package worker
 
@@ -173,6 +174,9 @@ func (m *CancelResponse) GetSuccess() bool {
 type ChunkState struct {
 	State   ChunkState_State `protobuf:"varint,1,opt,name=state,proto3,enum=worker.ChunkState_State" json:"state,omitempty"`
 	DatumID string           `protobuf:"bytes,2,opt,name=datum_id,json=datumId,proto3" json:"datum_id,omitempty"`
+	// failed_datums holds the indices of every datum in the chunk that failed.
+	// It's only set for pipelines that skip failed datums
+	FailedDatums []int64 `protobuf:"varint,3,rep,packed,name=failed_datums,json=failedDatums" json:"failed_datums,omitempty"`
 }
 
 func (m *ChunkState) Reset()                    { *m = ChunkState{} }
@@ -194,6 +198,13 @@ func (m *ChunkState) GetDatumID() string {
 	return ""
 }
 
+func (m *ChunkState) GetFailedDatums() []int64 {
+	if m != nil {
+		return m.FailedDatums
+	}
+	return nil
+}
+
 type Chunks struct {
 	Chunks []int64 `protobuf:"varint,1,rep,packed,name=chunks" json:"chunks,omitempty"`
 }
@@ -210,12 +221,31 @@ func (m *Chunks) GetChunks() []int64 {
 	return nil
 }
 
+// FailedDatums is the failure manifest of a job that skipped failed datums
+// (see EtcdJobInfo.failed_datums)
+type FailedDatums struct {
+	Indices []int64 `protobuf:"varint,1,rep,packed,name=indices" json:"indices,omitempty"`
+}
+
+func (m *FailedDatums) Reset()                    { *m = FailedDatums{} }
+func (m *FailedDatums) String() string            { return proto.CompactTextString(m) }
+func (*FailedDatums) ProtoMessage()               {}
+func (*FailedDatums) Descriptor() ([]byte, []int) { return fileDescriptorWorkerService, []int{5} }
+
+func (m *FailedDatums) GetIndices() []int64 {
+	if m != nil {
+		return m.Indices
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Input)(nil), "worker.Input")
 	proto.RegisterType((*CancelRequest)(nil), "worker.CancelRequest")
 	proto.RegisterType((*CancelResponse)(nil), "worker.CancelResponse")
 	proto.RegisterType((*ChunkState)(nil), "worker.ChunkState")
 	proto.RegisterType((*Chunks)(nil), "worker.Chunks")
+	proto.RegisterType((*FailedDatums)(nil), "worker.FailedDatums")
 	proto.RegisterEnum("worker.ChunkState_State", ChunkState_State_name, ChunkState_State_value)
 }
 
@@ -493,6 +523,24 @@ func (m *ChunkState) MarshalTo(dAtA []byte) (int, error) {
 		i = encodeVarintWorkerService(dAtA, i, uint64(len(m.DatumID)))
 		i += copy(dAtA[i:], m.DatumID)
 	}
+	if len(m.FailedDatums) > 0 {
+		dAtA4 := make([]byte, len(m.FailedDatums)*10)
+		var j3 int
+		for _, num1 := range m.FailedDatums {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		dAtA[i] = 0x1a
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(j3))
+		i += copy(dAtA[i:], dAtA4[:j3])
+	}
 	return i, nil
 }
 
@@ -512,22 +560,58 @@ func (m *Chunks) MarshalTo(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.Chunks) > 0 {
-		dAtA4 := make([]byte, len(m.Chunks)*10)
-		var j3 int
+		dAtA6 := make([]byte, len(m.Chunks)*10)
+		var j5 int
 		for _, num1 := range m.Chunks {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j5++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA6[j5] = uint8(num)
+			j5++
 		}
 		dAtA[i] = 0xa
 		i++
-		i = encodeVarintWorkerService(dAtA, i, uint64(j3))
-		i += copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintWorkerService(dAtA, i, uint64(j5))
+		i += copy(dAtA[i:], dAtA6[:j5])
+	}
+	return i, nil
+}
+
+func (m *FailedDatums) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedDatums) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		dAtA8 := make([]byte, len(m.Indices)*10)
+		var j7 int
+		for _, num1 := range m.Indices {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintWorkerService(dAtA, i, uint64(j7))
+		i += copy(dAtA[i:], dAtA8[:j7])
 	}
 	return i, nil
 }
@@ -608,6 +692,13 @@ func (m *ChunkState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovWorkerService(uint64(l))
 	}
+	if len(m.FailedDatums) > 0 {
+		l = 0
+		for _, e := range m.FailedDatums {
+			l += sovWorkerService(uint64(e))
+		}
+		n += 1 + sovWorkerService(uint64(l)) + l
+	}
 	return n
 }
 
@@ -624,6 +715,19 @@ func (m *Chunks) Size() (n int) {
 	return n
 }
 
+func (m *FailedDatums) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Indices) > 0 {
+		l = 0
+		for _, e := range m.Indices {
+			l += sovWorkerService(uint64(e))
+		}
+		n += 1 + sovWorkerService(uint64(l)) + l
+	}
+	return n
+}
+
 func sovWorkerService(x uint64) (n int) {
 	for {
 		n++
@@ -1135,6 +1239,68 @@ func (m *ChunkState) Unmarshal(dAtA []byte) error {
 			}
 			m.DatumID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkerService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedDatums = append(m.FailedDatums, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkerService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWorkerService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkerService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedDatums = append(m.FailedDatums, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDatums", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWorkerService(dAtA[iNdEx:])
@@ -1268,6 +1434,118 @@ func (m *Chunks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FailedDatums) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWorkerService
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDatums: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDatums: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkerService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= (int64(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Indices = append(m.Indices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowWorkerService
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= (int(b) & 0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthWorkerService
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowWorkerService
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= (int64(b) & 0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Indices = append(m.Indices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indices", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWorkerService(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthWorkerService
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipWorkerService(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1376,40 +1654,42 @@ var (
 func init() { proto.RegisterFile("server/worker/worker_service.proto", fileDescriptorWorkerService) }
 
 var fileDescriptorWorkerService = []byte{
-	// 557 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0x1b, 0xba, 0x38, 0xed, 0x9b, 0x6d, 0x2a, 0x16, 0x4c, 0xd1, 0x90, 0xda, 0x10, 0x24,
-	0x54, 0xed, 0x90, 0xa2, 0x21, 0x0e, 0x1c, 0x59, 0xdb, 0x4d, 0x41, 0x63, 0x20, 0xb3, 0x89, 0x63,
-	0x94, 0xa4, 0x4e, 0x96, 0x2d, 0x8d, 0x43, 0xec, 0x80, 0xb6, 0xcf, 0xc1, 0x81, 0x8f, 0xc4, 0x91,
-	0x4f, 0x30, 0xa1, 0x70, 0xe4, 0x4b, 0x20, 0xdb, 0xed, 0x8a, 0x38, 0xb8, 0x7d, 0xde, 0x9f, 0x9f,
-	0xf8, 0xfd, 0x63, 0x83, 0xc7, 0x69, 0xfd, 0x85, 0xd6, 0x93, 0xaf, 0xac, 0xbe, 0xbe, 0xff, 0x0b,
-	0x25, 0xcc, 0x13, 0xea, 0x57, 0x35, 0x13, 0x0c, 0x23, 0x4d, 0xf7, 0x1f, 0x25, 0x45, 0x4e, 0x4b,
-	0x31, 0xa9, 0x52, 0x2e, 0x97, 0xde, 0xdd, 0xd0, 0x8a, 0xcb, 0xb5, 0xa6, 0x19, 0xcb, 0x98, 0x92,
-	0x13, 0xa9, 0x56, 0xf4, 0x49, 0xc6, 0x58, 0x56, 0xd0, 0x89, 0x8a, 0xe2, 0x26, 0x9d, 0xd0, 0x65,
-	0x25, 0x6e, 0xf4, 0xa6, 0xf7, 0xc7, 0x00, 0x33, 0x28, 0xab, 0x46, 0xe0, 0x03, 0xe8, 0xa7, 0x79,
-	0x41, 0xc3, 0xbc, 0x4c, 0x99, 0x63, 0xb8, 0xc6, 0xd8, 0x3e, 0xdc, 0xf1, 0x65, 0xc6, 0xe3, 0xbc,
-	0xa0, 0x41, 0x99, 0x32, 0xd2, 0x4b, 0x57, 0x0a, 0x63, 0xd8, 0x2a, 0xa3, 0x25, 0x75, 0x1e, 0xb8,
-	0xc6, 0xb8, 0x4f, 0x94, 0x96, 0xac, 0x88, 0x6e, 0x6f, 0x9c, 0xae, 0x6b, 0x8c, 0x7b, 0x44, 0x69,
-	0xbc, 0x07, 0x28, 0xae, 0xa3, 0x32, 0xb9, 0x74, 0xb6, 0x94, 0x73, 0x15, 0xe1, 0x17, 0xb0, 0x53,
-	0x45, 0x35, 0x2d, 0x45, 0x98, 0xb0, 0xe5, 0x32, 0x17, 0x8e, 0xa9, 0xf2, 0xd9, 0x2a, 0xdf, 0x54,
-	0x21, 0xb2, 0xad, 0x1d, 0x3a, 0xc2, 0xcf, 0xc0, 0xca, 0x72, 0x11, 0x36, 0x75, 0xe1, 0x20, 0x79,
-	0xd4, 0x11, 0xb4, 0x77, 0x23, 0x74, 0x92, 0x8b, 0x0b, 0x72, 0x4a, 0x50, 0x96, 0x8b, 0x8b, 0xba,
-	0xc0, 0x23, 0xb0, 0x55, 0x6f, 0xa1, 0x2c, 0x94, 0x3b, 0x96, 0xaa, 0x04, 0x14, 0x92, 0x4d, 0x70,
-	0xef, 0x1c, 0x76, 0xa6, 0x51, 0x99, 0xd0, 0x82, 0xd0, 0xcf, 0x0d, 0xe5, 0x02, 0x3f, 0x85, 0xed,
-	0x45, 0x24, 0x22, 0xf9, 0x81, 0xa0, 0x35, 0x77, 0x0c, 0xb7, 0x3b, 0xee, 0x13, 0x5b, 0xb2, 0x63,
-	0x8d, 0xb0, 0x0b, 0xe8, 0x8a, 0xc5, 0x61, 0xbe, 0xd0, 0xdd, 0x1e, 0xf5, 0xdb, 0xbb, 0x91, 0xf9,
-	0x96, 0xc5, 0xc1, 0x8c, 0x98, 0x57, 0x2c, 0x0e, 0x16, 0xde, 0x01, 0xec, 0xae, 0x4f, 0xe5, 0x15,
-	0x2b, 0x39, 0xc5, 0x0e, 0x58, 0xbc, 0x49, 0x12, 0xca, 0xb9, 0x9a, 0x64, 0x8f, 0xac, 0x43, 0xef,
-	0x9b, 0x01, 0x30, 0xbd, 0x6c, 0xca, 0xeb, 0x8f, 0x22, 0x12, 0x14, 0xfb, 0x60, 0x72, 0x29, 0x94,
-	0x6d, 0xf7, 0xd0, 0xf1, 0xf5, 0xad, 0xfb, 0x1b, 0x8b, 0xaf, 0x7e, 0x89, 0xb6, 0xe1, 0xe7, 0xd0,
-	0x5b, 0x44, 0xa2, 0x59, 0x6e, 0xca, 0xb1, 0xdb, 0xbb, 0x91, 0x35, 0x93, 0x2c, 0x98, 0x11, 0x4b,
-	0x6d, 0x06, 0x0b, 0xcf, 0x07, 0x53, 0x27, 0xb0, 0xc1, 0x22, 0x17, 0x67, 0x67, 0xc1, 0xd9, 0xc9,
-	0xa0, 0x83, 0xb7, 0xa1, 0x37, 0x7d, 0xff, 0xee, 0xc3, 0xe9, 0xfc, 0x7c, 0x3e, 0x30, 0x30, 0x00,
-	0x3a, 0x7e, 0x13, 0x9c, 0xce, 0x67, 0x83, 0xae, 0xe7, 0x02, 0x52, 0x29, 0xb9, 0xbc, 0xb2, 0x44,
-	0x29, 0x35, 0x8b, 0x2e, 0x59, 0x45, 0x87, 0xb7, 0x80, 0x3e, 0xa9, 0xda, 0xf0, 0x2b, 0x40, 0xf2,
-	0xec, 0x86, 0xe3, 0x3d, 0x5f, 0x3f, 0x2d, 0x7f, 0xfd, 0xb4, 0xfc, 0xb9, 0x9c, 0xf5, 0xfe, 0x43,
-	0x5f, 0xbe, 0x49, 0x6d, 0xd7, 0x56, 0xaf, 0x83, 0x5f, 0x03, 0xd2, 0x53, 0xc2, 0x8f, 0xef, 0xbb,
-	0xfc, 0xf7, 0x2e, 0xf6, 0xf7, 0xfe, 0xc7, 0x7a, 0x98, 0x5e, 0xe7, 0x68, 0xf0, 0xa3, 0x1d, 0x1a,
-	0x3f, 0xdb, 0xa1, 0xf1, 0xab, 0x1d, 0x1a, 0xdf, 0x7f, 0x0f, 0x3b, 0x31, 0x52, 0x19, 0x5f, 0xfe,
-	0x0d, 0x00, 0x00, 0xff, 0xff, 0xf5, 0xed, 0x0d, 0x77, 0x4a, 0x03, 0x00, 0x00,
+	// 591 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0x1b, 0xb2, 0xba, 0xed, 0x69, 0x3b, 0x15, 0x0b, 0xa6, 0x68, 0x48, 0x6d, 0xc8, 0x24,
+	0x54, 0xed, 0x22, 0x45, 0x43, 0x5c, 0x70, 0xc9, 0xfa, 0x31, 0x05, 0x8d, 0x81, 0xcc, 0x26, 0x2e,
+	0xa3, 0x34, 0x71, 0x3a, 0x6f, 0x69, 0x1c, 0x62, 0x07, 0xb4, 0x3d, 0x09, 0xaf, 0xc2, 0x1b, 0x70,
+	0xc9, 0x13, 0x4c, 0xa8, 0x5c, 0xf2, 0x12, 0xc8, 0x76, 0xbb, 0x4e, 0x5c, 0xa4, 0xfd, 0x9f, 0x9f,
+	0xff, 0xc9, 0xf9, 0x32, 0x78, 0x82, 0x96, 0x5f, 0x69, 0x39, 0xfa, 0xc6, 0xcb, 0xeb, 0xfb, 0xbf,
+	0x50, 0x41, 0x16, 0x53, 0xbf, 0x28, 0xb9, 0xe4, 0x18, 0x19, 0xba, 0xff, 0x24, 0xce, 0x18, 0xcd,
+	0xe5, 0xa8, 0x48, 0x85, 0x7a, 0xcc, 0xe9, 0x96, 0x16, 0x42, 0x3d, 0x1b, 0xba, 0xe0, 0x0b, 0xae,
+	0xe5, 0x48, 0xa9, 0x35, 0x7d, 0xb6, 0xe0, 0x7c, 0x91, 0xd1, 0x91, 0x8e, 0xe6, 0x55, 0x3a, 0xa2,
+	0xcb, 0x42, 0xde, 0x98, 0x43, 0xef, 0xaf, 0x05, 0xf5, 0x20, 0x2f, 0x2a, 0x89, 0x0f, 0xa1, 0x95,
+	0xb2, 0x8c, 0x86, 0x2c, 0x4f, 0xb9, 0x63, 0xb9, 0xd6, 0xb0, 0x7d, 0xd4, 0xf5, 0x55, 0xc6, 0x19,
+	0xcb, 0x68, 0x90, 0xa7, 0x9c, 0x34, 0xd3, 0xb5, 0xc2, 0x18, 0x76, 0xf2, 0x68, 0x49, 0x9d, 0x47,
+	0xae, 0x35, 0x6c, 0x11, 0xad, 0x15, 0xcb, 0xa2, 0xdb, 0x1b, 0xc7, 0x76, 0xad, 0x61, 0x93, 0x68,
+	0x8d, 0xf7, 0x00, 0xcd, 0xcb, 0x28, 0x8f, 0x2f, 0x9d, 0x1d, 0xed, 0x5c, 0x47, 0xf8, 0x25, 0x74,
+	0x8b, 0xa8, 0xa4, 0xb9, 0x0c, 0x63, 0xbe, 0x5c, 0x32, 0xe9, 0xd4, 0x75, 0xbe, 0xb6, 0xce, 0x37,
+	0xd6, 0x88, 0x74, 0x8c, 0xc3, 0x44, 0xf8, 0x00, 0x1a, 0x0b, 0x26, 0xc3, 0xaa, 0xcc, 0x1c, 0xa4,
+	0x3e, 0x75, 0x0c, 0xab, 0xbb, 0x01, 0x3a, 0x61, 0xf2, 0x82, 0x9c, 0x12, 0xb4, 0x60, 0xf2, 0xa2,
+	0xcc, 0xf0, 0x00, 0xda, 0xba, 0xb7, 0x50, 0x15, 0x2a, 0x9c, 0x86, 0xae, 0x04, 0x34, 0x52, 0x4d,
+	0x08, 0xef, 0x1c, 0xba, 0xe3, 0x28, 0x8f, 0x69, 0x46, 0xe8, 0x97, 0x8a, 0x0a, 0x89, 0x9f, 0x43,
+	0x27, 0x89, 0x64, 0xa4, 0x5e, 0x90, 0xb4, 0x14, 0x8e, 0xe5, 0xda, 0xc3, 0x16, 0x69, 0x2b, 0x36,
+	0x33, 0x08, 0xbb, 0x80, 0xae, 0xf8, 0x3c, 0x64, 0x89, 0xe9, 0xf6, 0xb8, 0xb5, 0xba, 0x1b, 0xd4,
+	0xdf, 0xf1, 0x79, 0x30, 0x21, 0xf5, 0x2b, 0x3e, 0x0f, 0x12, 0xef, 0x10, 0x76, 0x37, 0x5f, 0x15,
+	0x05, 0xcf, 0x05, 0xc5, 0x0e, 0x34, 0x44, 0x15, 0xc7, 0x54, 0x08, 0x3d, 0xc9, 0x26, 0xd9, 0x84,
+	0xde, 0x0f, 0x0b, 0x60, 0x7c, 0x59, 0xe5, 0xd7, 0x9f, 0x64, 0x24, 0x29, 0xf6, 0xa1, 0x2e, 0x94,
+	0xd0, 0xb6, 0xdd, 0x23, 0xc7, 0x37, 0x5b, 0xf7, 0xb7, 0x16, 0x5f, 0xff, 0x12, 0x63, 0xc3, 0x2f,
+	0xa0, 0x99, 0x44, 0xb2, 0x5a, 0x6e, 0xcb, 0x69, 0xaf, 0xee, 0x06, 0x8d, 0x89, 0x62, 0xc1, 0x84,
+	0x34, 0xf4, 0x61, 0x90, 0xe0, 0x03, 0xe8, 0xa6, 0x11, 0xcb, 0x68, 0x12, 0x6a, 0x22, 0x1c, 0xdb,
+	0xb5, 0x87, 0x36, 0xe9, 0x18, 0xa8, 0xed, 0xc2, 0xf3, 0xa1, 0x6e, 0xaa, 0x68, 0x43, 0x83, 0x5c,
+	0x9c, 0x9d, 0x05, 0x67, 0x27, 0xbd, 0x1a, 0xee, 0x40, 0x73, 0xfc, 0xe1, 0xfd, 0xc7, 0xd3, 0xe9,
+	0xf9, 0xb4, 0x67, 0x61, 0x00, 0x34, 0x7b, 0x1b, 0x9c, 0x4e, 0x27, 0x3d, 0xdb, 0x73, 0x01, 0xe9,
+	0xba, 0x84, 0xda, 0x6b, 0xac, 0x95, 0x1e, 0x98, 0x4d, 0xd6, 0x91, 0x37, 0x84, 0xce, 0xec, 0x41,
+	0x06, 0x35, 0x07, 0x96, 0x27, 0x2c, 0xa6, 0x1b, 0xe3, 0x26, 0x3c, 0xba, 0x05, 0xf4, 0x59, 0xb7,
+	0x8a, 0x5f, 0x03, 0x52, 0x55, 0x54, 0x02, 0xef, 0xf9, 0xe6, 0xa6, 0xfa, 0x9b, 0x9b, 0xea, 0x4f,
+	0xd5, 0xea, 0xf6, 0x1f, 0xfb, 0xea, 0x8a, 0x1b, 0xbb, 0xb1, 0x7a, 0x35, 0xfc, 0x06, 0x90, 0x19,
+	0x3a, 0x7e, 0x7a, 0x3f, 0xb4, 0x87, 0xab, 0xdd, 0xdf, 0xfb, 0x1f, 0x9b, 0xdd, 0x78, 0xb5, 0xe3,
+	0xde, 0xcf, 0x55, 0xdf, 0xfa, 0xb5, 0xea, 0x5b, 0xbf, 0x57, 0x7d, 0xeb, 0xfb, 0x9f, 0x7e, 0x6d,
+	0x8e, 0x74, 0xc6, 0x57, 0xff, 0x06, 0x00, 0xf9, 0xdf, 0x95, 0xb3, 0x99, 0x03, 0x00, 0x00,
 }
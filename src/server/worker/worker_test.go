@@ -106,6 +106,7 @@ func getPachClient(t testing.TB) *client.APIClient {
 }
 
 func newTestAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etcdPrefix string, t *testing.T) *APIServer {
+	store := col.NewEtcdStore(etcdClient)
 	return &APIServer{
 		pachClient: pachClient,
 		etcdClient: etcdClient,
@@ -114,8 +115,9 @@ func newTestAPIServer(pachClient *client.APIClient, etcdClient *etcd.Client, etc
 			PipelineName: "test",
 			WorkerID:     "local",
 		},
-		jobs:      ppsdb.Jobs(etcdClient, etcdPrefix),
-		pipelines: ppsdb.Pipelines(etcdClient, etcdPrefix),
-		chunks:    col.NewCollection(etcdClient, path.Join(etcdPrefix, chunksPrefix), []col.Index{}, &Chunks{}, nil),
+		store:     store,
+		jobs:      ppsdb.Jobs(store, etcdPrefix),
+		pipelines: ppsdb.Pipelines(store, etcdPrefix),
+		chunks:    store.NewCollection(path.Join(etcdPrefix, chunksPrefix), []col.Index{}, &Chunks{}, nil),
 	}
 }